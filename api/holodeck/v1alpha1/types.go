@@ -29,7 +29,10 @@ var (
 
 // EnvironmentSpec defines the desired state of infra provider
 type EnvironmentSpec struct {
-	// +kubebuilder:validation:Enum=aws;ssh
+	// Provider is one of the built-in providers (aws, ssh, vsphere, gcp) or
+	// the name of an out-of-process plugin: an executable named
+	// holodeck-provider-<name> on PATH.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9][a-z0-9-]*$`
 	Provider Provider `json:"provider"`
 
 	Auth `json:"auth"`
@@ -74,6 +77,53 @@ type EnvironmentSpec struct {
 	// CustomTemplates defines user-provided scripts to execute during provisioning.
 	// +optional
 	CustomTemplates []CustomTemplate `json:"customTemplates,omitempty"`
+
+	// VSphere defines where the VM is cloned when the provider is "vsphere".
+	// +optional
+	VSphere *VSphere `json:"vsphere,omitempty"`
+
+	// GCP defines the project and zone used when the provider is "gcp".
+	// +optional
+	GCP *GCP `json:"gcp,omitempty"`
+
+	// Plugin holds free-form settings passed verbatim to an out-of-process
+	// provider plugin.
+	// +optional
+	Plugin map[string]string `json:"plugin,omitempty"`
+
+	// Network places AWS environments in pre-existing network resources
+	// instead of creating a dedicated VPC.
+	// +optional
+	Network *Network `json:"network,omitempty"`
+
+	// TTL is how long the environment may live after it is created, e.g.
+	// "8h". On create it is resolved into ExpiresAt.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// ExpiresAt is the time after which the environment is considered
+	// expired. It is written as the ExpiresAt tag on every cloud resource
+	// so that "holodeck reap" can find and tear down forgotten environments.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// Network references pre-existing AWS network resources. Holodeck adopts
+// them as-is: it neither modifies nor deletes them.
+type Network struct {
+	// VpcID is the ID of an existing VPC.
+	VpcID string `json:"vpcId"`
+
+	// SubnetID is the ID of an existing subnet in VpcID. All instances and,
+	// for HA clusters, the load balancer are placed in it. The subnet must
+	// route to an internet gateway so that holodeck can reach the instances.
+	SubnetID string `json:"subnetId"`
+
+	// SecurityGroupIDs are existing security groups in VpcID attached to
+	// every instance. When empty, holodeck creates its own security groups
+	// in the VPC.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
 }
 
 type Provider string
@@ -84,12 +134,20 @@ const (
 	// ProviderSSH means the user already has a running instance
 	// and wants to use it as the infra provider via SSH
 	ProviderSSH Provider = "ssh"
+	// ProviderVSphere means the instance is cloned from a template on
+	// a vCenter-managed vSphere environment
+	ProviderVSphere Provider = "vsphere"
+	// ProviderGCP means the infra provider is Google Compute Engine
+	ProviderGCP Provider = "gcp"
 
 	// Possible values for the Conditions field
 	ConditionProgressing string = "Progressing"
 	ConditionDegraded    string = "Degraded"
 	ConditionAvailable   string = "Available"
 	ConditionTerminated  string = "Terminated"
+	// ConditionStopped is true while the instances of an environment are
+	// stopped by "holodeck stop"
+	ConditionStopped string = "Stopped"
 )
 
 // Instance defines an AWS instance
@@ -126,8 +184,104 @@ type Instance struct {
 
 	// if not set, the default size is 64GB
 	RootVolumeSizeGB *int32 `json:"rootVolumeSizeGB"`
+
+	// Market selects the EC2 purchasing option. Defaults to on-demand.
+	// In cluster mode it applies to every pool that does not set its own.
+	// +optional
+	Market MarketType `json:"market,omitempty"`
+
+	// SpotMaxPrice is the maximum hourly price in USD for spot instances,
+	// e.g. "0.75". Defaults to the on-demand price.
+	// +optional
+	SpotMaxPrice string `json:"spotMaxPrice,omitempty"`
+
+	// TypeFallbacks are instance types tried in order when there is no
+	// capacity for Type. They must share the architecture of Type.
+	// Single-node only.
+	// +optional
+	TypeFallbacks []string `json:"typeFallbacks,omitempty"`
+
+	// AvailabilityZones are the zones of Region tried in order when none of
+	// the instance types has capacity. The subnet is placed in the zone being
+	// tried. Defaults to a zone chosen by AWS. Single-node only.
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// CapacityReservation launches the instances into an On-Demand Capacity
+	// Reservation. In cluster mode it applies to every pool that does not
+	// set its own.
+	// +optional
+	CapacityReservation *CapacityReservation `json:"capacityReservation,omitempty"`
+
+	// PlacementGroup launches the instances into a placement group. In
+	// cluster mode it applies to every pool that does not set its own.
+	// +optional
+	PlacementGroup *PlacementGroup `json:"placementGroup,omitempty"`
 }
 
+// CapacityReservation targets an EC2 On-Demand Capacity Reservation, either
+// directly by ID or through a capacity reservation resource group. Exactly
+// one of ID and ResourceGroupArn must be set.
+type CapacityReservation struct {
+	// ID is the ID of a capacity reservation, e.g. "cr-0123456789abcdef0".
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// ResourceGroupArn is the ARN of a capacity reservation group.
+	// +optional
+	ResourceGroupArn string `json:"resourceGroupArn,omitempty"`
+}
+
+// PlacementStrategy is the EC2 placement group strategy.
+// +kubebuilder:validation:Enum=cluster;partition;spread
+type PlacementStrategy string
+
+const (
+	// PlacementCluster packs instances close together in one zone for
+	// low-latency networking.
+	PlacementCluster PlacementStrategy = "cluster"
+	// PlacementPartition spreads instances across logical partitions.
+	PlacementPartition PlacementStrategy = "partition"
+	// PlacementSpread places each instance on distinct hardware.
+	PlacementSpread PlacementStrategy = "spread"
+)
+
+// PlacementGroup selects the EC2 placement group of the instances. With a
+// Strategy, holodeck creates the group and deletes it with the environment.
+// Without one, Name references an existing group that is joined as-is and
+// never deleted.
+type PlacementGroup struct {
+	// Name of the placement group. Defaults to a name derived from the
+	// environment when holodeck creates the group.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Strategy of the placement group to create.
+	// +optional
+	Strategy PlacementStrategy `json:"strategy,omitempty"`
+
+	// PartitionCount is the number of partitions of a partition placement
+	// group, from 1 to 7. Defaults to 2.
+	// +optional
+	PartitionCount int32 `json:"partitionCount,omitempty"`
+}
+
+// MarketType is the EC2 purchasing option for instances.
+// +kubebuilder:validation:Enum=on-demand;spot;spot-with-fallback
+type MarketType string
+
+const (
+	// MarketOnDemand launches on-demand instances.
+	MarketOnDemand MarketType = "on-demand"
+	// MarketSpot launches spot instances and fails if no spot capacity is
+	// available.
+	MarketSpot MarketType = "spot"
+	// MarketSpotWithFallback launches spot instances and retries on-demand
+	// when spot capacity is unavailable or the instance is interrupted
+	// before it starts.
+	MarketSpotWithFallback MarketType = "spot-with-fallback"
+)
+
 // Describes an image or vm template.
 type Image struct {
 	// The architecture of the image.
@@ -179,11 +333,57 @@ type ClusterSpec struct {
 
 	Workers *WorkerPoolSpec `json:"workers,omitempty"`
 
+	// WorkerPools defines several named worker node pools, e.g. to mix GPU
+	// and CPU-only instance types in one cluster. Mutually exclusive with
+	// Workers.
+	// +optional
+	WorkerPools []WorkerPoolSpec `json:"workerPools,omitempty"`
+
 	// HighAvailability configures HA settings for the control plane.
 	// +optional
 	// +optional
 
 	HighAvailability *HAConfig `json:"highAvailability,omitempty"`
+
+	// Etcd defines the dedicated etcd nodes of an external etcd topology.
+	// Only valid when highAvailability.etcdTopology is "external"; defaults
+	// apply when omitted.
+	// +optional
+	Etcd *EtcdPoolSpec `json:"etcd,omitempty"`
+}
+
+// EtcdPoolSpec defines the dedicated etcd node pool used by the external
+// etcd topology.
+type EtcdPoolSpec struct {
+	// Count is the number of etcd members. Use an odd number to maintain
+	// quorum.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=7
+	// +kubebuilder:default=3
+	// +optional
+	Count int32 `json:"count,omitempty"`
+
+	// InstanceType specifies the EC2 instance type for etcd nodes.
+	// +kubebuilder:default="m5.large"
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// OS specifies the operating system by ID (e.g., "ubuntu-22.04").
+	// +optional
+	OS string `json:"os,omitempty"`
+
+	// Image allows explicit AMI specification. If OS is set, this is ignored.
+	// +optional
+	Image *Image `json:"image,omitempty"`
+
+	// RootVolumeSizeGB specifies the root volume size in GB.
+	// +kubebuilder:default=64
+	// +optional
+	RootVolumeSizeGB *int32 `json:"rootVolumeSizeGB,omitempty"`
+
+	// Version is the etcd release to install (e.g., "v3.5.21").
+	// +optional
+	Version string `json:"version,omitempty"`
 }
 
 // ControlPlaneSpec defines control-plane node configuration.
@@ -231,15 +431,46 @@ type ControlPlaneSpec struct {
 
 	RootVolumeSizeGB *int32 `json:"rootVolumeSizeGB,omitempty"`
 
+	// Market selects the EC2 purchasing option for control-plane nodes.
+	// Defaults to spec.instance.market.
+	// +optional
+	Market MarketType `json:"market,omitempty"`
+
+	// SpotMaxPrice is the maximum hourly spot price in USD for
+	// control-plane nodes. Defaults to spec.instance.spotMaxPrice.
+	// +optional
+	SpotMaxPrice string `json:"spotMaxPrice,omitempty"`
+
+	// CapacityReservation launches the control-plane nodes into an On-Demand Capacity
+	// Reservation. Defaults to spec.instance.capacityReservation.
+	// +optional
+	CapacityReservation *CapacityReservation `json:"capacityReservation,omitempty"`
+
+	// PlacementGroup launches the control-plane nodes into a placement group.
+	// Defaults to spec.instance.placementGroup.
+	// +optional
+	PlacementGroup *PlacementGroup `json:"placementGroup,omitempty"`
+
 	// Labels are additional Kubernetes labels to apply to control-plane nodes.
 	// +optional
 	// +optional
 
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// Hosts lists pre-existing control-plane hosts. Only valid with
+	// provider "ssh"; when set, Count defaults to len(Hosts).
+	// +optional
+	Hosts []Host `json:"hosts,omitempty"`
 }
 
 // WorkerPoolSpec defines worker node pool configuration.
 type WorkerPoolSpec struct {
+	// Name identifies the pool in spec.cluster.workerPools. It is used in
+	// instance names and tags and in the nvidia.com/holodeck.pool node
+	// label.
+	// +optional
+	Name string `json:"name,omitempty"`
+
 	// Count is the number of worker nodes.
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:default=1
@@ -274,11 +505,86 @@ type WorkerPoolSpec struct {
 
 	RootVolumeSizeGB *int32 `json:"rootVolumeSizeGB,omitempty"`
 
+	// Market selects the EC2 purchasing option for worker nodes.
+	// Defaults to spec.instance.market.
+	// +optional
+	Market MarketType `json:"market,omitempty"`
+
+	// SpotMaxPrice is the maximum hourly spot price in USD for worker
+	// nodes. Defaults to spec.instance.spotMaxPrice.
+	// +optional
+	SpotMaxPrice string `json:"spotMaxPrice,omitempty"`
+
+	// CapacityReservation launches the worker nodes into an On-Demand Capacity
+	// Reservation. Defaults to spec.instance.capacityReservation.
+	// +optional
+	CapacityReservation *CapacityReservation `json:"capacityReservation,omitempty"`
+
+	// PlacementGroup launches the worker nodes into a placement group.
+	// Defaults to spec.instance.placementGroup.
+	// +optional
+	PlacementGroup *PlacementGroup `json:"placementGroup,omitempty"`
+
 	// Labels are additional Kubernetes labels to apply to worker nodes.
 	// +optional
 	// +optional
 
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints are Kubernetes taints to apply to worker nodes.
+	// +optional
+	Taints []Taint `json:"taints,omitempty"`
+
+	// Hosts lists pre-existing worker hosts. Only valid with provider
+	// "ssh"; when set, Count defaults to len(Hosts).
+	// +optional
+	Hosts []Host `json:"hosts,omitempty"`
+}
+
+// Taint is a Kubernetes node taint.
+type Taint struct {
+	// Key is the taint key.
+	Key string `json:"key"`
+
+	// Value is the taint value.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Effect is the taint effect.
+	// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+	Effect string `json:"effect"`
+}
+
+// Host describes a pre-existing machine used as a cluster node by the SSH
+// provider (bring-your-own hosts).
+//
+// Credential fallback: when Username or PrivateKey is empty, the host reuses
+// spec.auth.username and spec.auth.privateKey respectively.
+type Host struct {
+	// Name is the node name used in status and logs. Defaults to Address.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Address is the SSH-reachable address of the host (host or host:port).
+	Address string `json:"address"`
+
+	// PrivateAddress is the address other nodes use to reach this host
+	// (kubeadm advertise/join address). Defaults to the host of Address,
+	// without its port.
+	// +optional
+	PrivateAddress string `json:"privateAddress,omitempty"`
+
+	// Username for the SSH connection to this host.
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// PrivateKey is the path to the private key file for this host.
+	// +optional
+	PrivateKey string `json:"privateKey,omitempty"` //nolint:gosec // G117: stores a file path, not key material
+
+	// Bastion configures a jump host used to reach this host.
+	// +optional
+	Bastion *BastionConfig `json:"bastion,omitempty"`
 }
 
 // HAConfig defines high availability configuration for the control plane.
@@ -299,7 +605,7 @@ type HAConfig struct {
 	EtcdTopology EtcdTopology `json:"etcdTopology,omitempty"`
 
 	// LoadBalancerType specifies the type of load balancer for the API server.
-	// +kubebuilder:validation:Enum=nlb;alb
+	// +kubebuilder:validation:Enum=nlb
 	// +kubebuilder:default=nlb
 	// +optional
 	// +optional
@@ -312,8 +618,9 @@ type NodeStatus struct {
 	// Name is the node's hostname or identifier.
 	Name string `json:"name"`
 
-	// Role indicates whether this is a control-plane or worker node.
-	// +kubebuilder:validation:Enum=control-plane;worker
+	// Role indicates whether this is a control-plane, worker or dedicated
+	// etcd node.
+	// +kubebuilder:validation:Enum=control-plane;worker;etcd
 	Role string `json:"role"`
 
 	// InstanceID is the cloud provider's instance identifier.
@@ -325,6 +632,10 @@ type NodeStatus struct {
 	// PrivateIP is the node's private IP address within the VPC.
 	PrivateIP string `json:"privateIp,omitempty"`
 
+	// Pool is the name of the worker pool the node belongs to, if any.
+	// +optional
+	Pool string `json:"pool,omitempty"`
+
 	// SSHUsername is the SSH username for connecting to this node.
 	// This is auto-detected from the OS but can vary per node in heterogeneous
 	// clusters (e.g., "ubuntu" for Ubuntu, "ec2-user" for Amazon Linux).
@@ -333,8 +644,13 @@ type NodeStatus struct {
 
 	SSHUsername string `json:"sshUsername,omitempty"`
 
+	// Market is the purchasing option the node was launched with
+	// ("spot" or "on-demand"), for providers that distinguish them.
+	// +optional
+	Market MarketType `json:"market,omitempty"`
+
 	// Phase indicates the current lifecycle phase of the node.
-	// +kubebuilder:validation:Enum=Pending;Provisioning;Running;Ready;Failed;Terminating
+	// +kubebuilder:validation:Enum=Pending;Provisioning;Running;Ready;Failed;Terminating;Stopped
 	Phase string `json:"phase"`
 
 	// Message provides additional details about the current phase.
@@ -371,7 +687,7 @@ type ClusterStatus struct {
 	ReadyNodes int32 `json:"readyNodes,omitempty"`
 
 	// Phase indicates the overall cluster lifecycle phase.
-	// +kubebuilder:validation:Enum=Pending;Creating;Provisioning;Ready;Degraded;Deleting;Failed
+	// +kubebuilder:validation:Enum=Pending;Creating;Provisioning;Ready;Degraded;Deleting;Failed;Stopped
 	// +optional
 	// +optional
 
@@ -412,6 +728,14 @@ type ComponentProvenance struct {
 	// +optional
 
 	Commit string `json:"commit,omitempty"`
+
+	// ObservedVersion is the version the installed component reports on
+	// the host after provisioning (e.g., nvidia-smi, kubelet --version), as
+	// opposed to the requested Version.
+	// +optional
+	// +optional
+
+	ObservedVersion string `json:"observedVersion,omitempty"`
 }
 
 // ComponentsStatus tracks provisioned component information.
@@ -464,6 +788,12 @@ type EnvironmentStatus struct {
 	// +optional
 
 	Components *ComponentsStatus `json:"components,omitempty"`
+
+	// ImageComponents are the components baked into the golden image the
+	// instance was created from, as recorded in the image tags. Provisioning
+	// skips those whose provenance matches the spec.
+	// +optional
+	ImageComponents *ComponentsStatus `json:"imageComponents,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -1091,14 +1421,81 @@ type NVIDIAContainerToolkit struct {
 	Version string `json:"version,omitempty"`
 }
 
+// LoadBalancerNLB is the type of the API server load balancer of HA
+// clusters. An ALB is rejected by validation: it terminates TLS, so it
+// cannot front the Kubernetes API.
+const LoadBalancerNLB = "nlb"
+
 // LoadBalancer defines load balancer configuration for HA clusters
 type LoadBalancer struct {
 	// Enabled enables creation of a Network Load Balancer
 	Enabled bool `json:"enabled,omitempty"`
-	// Type is the load balancer type: "nlb" (default) or "alb"
+	// Type is the load balancer type. Only "nlb" (default) is supported.
 	Type string `json:"type,omitempty"`
-	// HealthCheckPath for ALB health checks
-	HealthCheckPath string `json:"healthCheckPath,omitempty"`
+	// Internal creates the load balancer in the private subnet of the
+	// cluster, which has no route to the internet gateway, so that the API
+	// endpoint is only reachable from within the VPC. The load balancer
+	// always has the internal scheme; by default it shares the public
+	// subnet of the nodes. An adopted network has a single subnet, which
+	// the load balancer uses either way.
+	// +optional
+	Internal bool `json:"internal,omitempty"`
+}
+
+// VSphere defines the vCenter placement of a vSphere-backed instance.
+// Inventory objects are referenced by name.
+type VSphere struct {
+	// Server is the vCenter server address. Defaults to the
+	// HOLODECK_VCENTER_SERVER environment variable.
+	// +optional
+	Server string `json:"server,omitempty"`
+	// InsecureSkipVerify disables TLS certificate verification of the
+	// vCenter server.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// Datacenter holding the template and the cloned VM
+	Datacenter string `json:"datacenter"`
+	// Template is the name of the VM template to clone
+	Template string `json:"template"`
+	// Cluster is the compute cluster to place the VM on
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+	// ResourcePool to place the VM in
+	// +optional
+	ResourcePool string `json:"resourcePool,omitempty"`
+	// Datastore to place the VM disks on
+	// +optional
+	Datastore string `json:"datastore,omitempty"`
+	// Folder to place the VM in
+	// +optional
+	Folder string `json:"folder,omitempty"`
+}
+
+// GCP defines the Compute Engine placement of a GCP-backed instance. The
+// machine type is taken from instance.type.
+type GCP struct {
+	// Project is the GCP project ID
+	Project string `json:"project"`
+	// Zone to create the instance in, e.g. "us-central1-a"
+	Zone string `json:"zone"`
+	// Image is the source image, e.g.
+	// "projects/ubuntu-os-cloud/global/images/family/ubuntu-2204-lts".
+	// Defaults to the image family matching instance.os.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Accelerators attached to the instance. Not needed for machine types
+	// with built-in GPUs such as g2 or a3.
+	// +optional
+	Accelerators []GCPAccelerator `json:"accelerators,omitempty"`
+}
+
+// GCPAccelerator describes accelerators attached to a GCP instance.
+type GCPAccelerator struct {
+	// Type is the accelerator type, e.g. "nvidia-tesla-t4"
+	Type string `json:"type"`
+	// Count of accelerators of this type, defaults to 1
+	// +optional
+	Count int32 `json:"count,omitempty"`
 }
 
 type Kernel struct {
@@ -19,10 +19,16 @@ package v1alpha1
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var k8sLabelPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._\-/]*[a-zA-Z0-9])?$`)
 
+var dnsLabelPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
 func validateLabels(labels map[string]string) error {
 	for k, v := range labels {
 		if !k8sLabelPattern.MatchString(k) {
@@ -47,6 +53,61 @@ func (s *EnvironmentSpec) ValidateSSHConfigMode() error {
 	return nil
 }
 
+// ValidateHostInventory checks the bring-your-own host lists in
+// spec.cluster. Hosts are only meaningful for the SSH provider, which in
+// cluster mode requires at least one control-plane host. When a pool sets
+// both Count and Hosts the two must agree.
+func (s *EnvironmentSpec) ValidateHostInventory() error {
+	if s.Cluster == nil {
+		return nil
+	}
+
+	cpHosts := s.Cluster.ControlPlane.Hosts
+	var workerHosts []Host
+	for _, w := range s.Cluster.WorkerPoolList() {
+		workerHosts = append(workerHosts, w.Hosts...)
+	}
+
+	if s.Provider != ProviderSSH {
+		if len(cpHosts) > 0 || len(workerHosts) > 0 {
+			return fmt.Errorf("cluster hosts are only supported with provider %q, got %q", ProviderSSH, s.Provider)
+		}
+		return nil
+	}
+
+	if len(cpHosts) == 0 {
+		return fmt.Errorf("provider %q in cluster mode requires at least one controlPlane.hosts entry", ProviderSSH)
+	}
+	if c := s.Cluster.ControlPlane.Count; c != 0 && int(c) != len(cpHosts) {
+		return fmt.Errorf("control plane count %d does not match the %d declared hosts", c, len(cpHosts))
+	}
+	for _, w := range s.Cluster.WorkerPoolList() {
+		if c := w.Count; c != 0 && int(c) != len(w.Hosts) {
+			return fmt.Errorf("%s count %d does not match the %d declared hosts", w.scope(), c, len(w.Hosts))
+		}
+	}
+
+	seen := make(map[string]bool, len(cpHosts)+len(workerHosts))
+	for _, h := range append(append([]Host{}, cpHosts...), workerHosts...) {
+		if h.Address == "" {
+			return fmt.Errorf("host %q: address is required", h.Name)
+		}
+		if h.Bastion != nil && h.Bastion.Host == "" {
+			return fmt.Errorf("host %q: bastion host is required", h.Address)
+		}
+		name := h.Name
+		if name == "" {
+			name = h.Address
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate host %q in cluster inventory", name)
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
+
 // Validate validates the ClusterSpec configuration.
 func (c *ClusterSpec) Validate() error {
 	if c == nil {
@@ -64,21 +125,14 @@ func (c *ClusterSpec) Validate() error {
 	}
 
 	// Validate workers if specified
-	if c.Workers != nil {
-		if err := c.Workers.Validate(); err != nil {
-			return fmt.Errorf("workers validation failed: %w", err)
-		}
+	if err := c.validateWorkerPools(); err != nil {
+		return err
 	}
 
 	// Validate labels for shell-injection safety
 	if err := validateLabels(c.ControlPlane.Labels); err != nil {
 		return fmt.Errorf("control-plane labels: %w", err)
 	}
-	if c.Workers != nil {
-		if err := validateLabels(c.Workers.Labels); err != nil {
-			return fmt.Errorf("worker labels: %w", err)
-		}
-	}
 
 	// Validate HA configuration
 	if c.HighAvailability != nil {
@@ -87,6 +141,10 @@ func (c *ClusterSpec) Validate() error {
 		}
 	}
 
+	if err := c.validateEtcd(); err != nil {
+		return err
+	}
+
 	// Additional cross-field validations
 	if c.HighAvailability != nil && c.HighAvailability.Enabled {
 		// HA requires at least 3 control-plane nodes for quorum
@@ -152,6 +210,176 @@ func (wp *WorkerPoolSpec) Validate() error {
 	return nil
 }
 
+// scope returns how the pool is referred to in error messages.
+func (wp *WorkerPoolSpec) scope() string {
+	if wp.Name == "" {
+		return "worker"
+	}
+	return fmt.Sprintf("worker pool %q", wp.Name)
+}
+
+func validateTaints(taints []Taint) error {
+	for _, t := range taints {
+		if t.Key == "" || !k8sLabelPattern.MatchString(t.Key) {
+			return fmt.Errorf("invalid taint key %q: contains disallowed characters", t.Key)
+		}
+		if t.Value != "" && !k8sLabelPattern.MatchString(t.Value) {
+			return fmt.Errorf("invalid taint value %q for key %q: contains disallowed characters", t.Value, t.Key)
+		}
+		switch t.Effect {
+		case "NoSchedule", "PreferNoSchedule", "NoExecute":
+		default:
+			return fmt.Errorf("invalid taint effect %q for key %q (must be 'NoSchedule', 'PreferNoSchedule' or 'NoExecute')", t.Effect, t.Key)
+		}
+	}
+	return nil
+}
+
+// WorkerPoolList returns the cluster's worker pools: spec.cluster.workerPools
+// if set, otherwise the single spec.cluster.workers pool, if any.
+func (c *ClusterSpec) WorkerPoolList() []WorkerPoolSpec {
+	if c == nil {
+		return nil
+	}
+	if len(c.WorkerPools) > 0 {
+		return c.WorkerPools
+	}
+	if c.Workers != nil {
+		return []WorkerPoolSpec{*c.Workers}
+	}
+	return nil
+}
+
+// WorkerPool returns the worker pool named name, "" for the unnamed
+// spec.cluster.workers pool, or nil if there is none. The returned pool
+// aliases the spec.
+func (c *ClusterSpec) WorkerPool(name string) *WorkerPoolSpec {
+	if c == nil {
+		return nil
+	}
+	if name == "" {
+		return c.Workers
+	}
+	for i := range c.WorkerPools {
+		if c.WorkerPools[i].Name == name {
+			return &c.WorkerPools[i]
+		}
+	}
+	return nil
+}
+
+// WorkerCount returns the total number of workers across all pools.
+func (c *ClusterSpec) WorkerCount() int32 {
+	var count int32
+	for _, w := range c.WorkerPoolList() {
+		count += w.Count
+	}
+	return count
+}
+
+// validateWorkerPools checks the worker pools. Pools listed under
+// workerPools must have unique names, since the names end up in instance
+// names and node labels.
+func (c *ClusterSpec) validateWorkerPools() error {
+	if c.Workers != nil && len(c.WorkerPools) > 0 {
+		return fmt.Errorf("cluster workers and workerPools are mutually exclusive")
+	}
+	seen := make(map[string]bool, len(c.WorkerPools))
+	for _, w := range c.WorkerPools {
+		if w.Name == "" {
+			return fmt.Errorf("cluster workerPools entries require a name")
+		}
+		if !dnsLabelPattern.MatchString(w.Name) {
+			return fmt.Errorf("invalid worker pool name %q: must be a lowercase DNS label", w.Name)
+		}
+		if seen[w.Name] {
+			return fmt.Errorf("duplicate worker pool name %q", w.Name)
+		}
+		seen[w.Name] = true
+	}
+	for _, w := range c.WorkerPoolList() {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("workers validation failed: %w", err)
+		}
+		if err := validateLabels(w.Labels); err != nil {
+			return fmt.Errorf("%s labels: %w", w.scope(), err)
+		}
+		if err := validateTaints(w.Taints); err != nil {
+			return fmt.Errorf("%s taints: %w", w.scope(), err)
+		}
+	}
+	return nil
+}
+
+// ValidateWorkerPools checks the worker pools of a cluster.
+func (s *EnvironmentSpec) ValidateWorkerPools() error {
+	if s.Cluster == nil {
+		return nil
+	}
+	return s.Cluster.validateWorkerPools()
+}
+
+// Validate validates the EtcdPoolSpec configuration. A zero Count selects
+// the default pool size.
+func (ep *EtcdPoolSpec) Validate() error {
+	if ep.Count < 0 || ep.Count > 7 {
+		return fmt.Errorf("etcd count must be between 1 and 7, got %d", ep.Count)
+	}
+	if ep.Count%2 == 0 && ep.Count != 0 {
+		return fmt.Errorf("etcd count should be an odd number (1, 3, 5, 7) for quorum, got %d", ep.Count)
+	}
+	if ep.RootVolumeSizeGB != nil && *ep.RootVolumeSizeGB < 20 {
+		return fmt.Errorf("etcd root volume size must be at least 20GB, got %d", *ep.RootVolumeSizeGB)
+	}
+	return nil
+}
+
+// ExternalEtcd returns true if the cluster runs etcd on dedicated nodes.
+func (c *ClusterSpec) ExternalEtcd() bool {
+	return c != nil && c.HighAvailability != nil && c.HighAvailability.Enabled &&
+		c.HighAvailability.EtcdTopology == EtcdTopologyExternal
+}
+
+// EtcdCount returns the number of dedicated etcd nodes, 3 unless
+// spec.cluster.etcd.count is set, or 0 for a stacked topology.
+func (c *ClusterSpec) EtcdCount() int32 {
+	if !c.ExternalEtcd() {
+		return 0
+	}
+	if c.Etcd != nil && c.Etcd.Count != 0 {
+		return c.Etcd.Count
+	}
+	return 3
+}
+
+// validateEtcd checks the dedicated etcd pool, which is only meaningful for
+// an external etcd topology.
+func (c *ClusterSpec) validateEtcd() error {
+	if c.Etcd == nil {
+		return nil
+	}
+	if !c.ExternalEtcd() {
+		return fmt.Errorf("cluster etcd pool requires highAvailability.etcdTopology %q", EtcdTopologyExternal)
+	}
+	if err := c.Etcd.Validate(); err != nil {
+		return fmt.Errorf("etcd validation failed: %w", err)
+	}
+	return nil
+}
+
+// ValidateEtcd checks the etcd topology of a cluster. The external topology
+// needs holodeck to create the etcd nodes, so bring-your-own hosts cannot
+// use it.
+func (s *EnvironmentSpec) ValidateEtcd() error {
+	if s.Cluster == nil {
+		return nil
+	}
+	if s.Cluster.ExternalEtcd() && s.Provider == ProviderSSH {
+		return fmt.Errorf("external etcd topology is not supported with provider %q", ProviderSSH)
+	}
+	return s.Cluster.validateEtcd()
+}
+
 // Validate validates the HAConfig configuration.
 func (ha *HAConfig) Validate(controlPlaneCount int32) error {
 	if !ha.Enabled {
@@ -168,27 +396,8 @@ func (ha *HAConfig) Validate(controlPlaneCount int32) error {
 		)
 	}
 
-	// External etcd requires more careful consideration
-	if ha.EtcdTopology == EtcdTopologyExternal {
-		// External etcd is an advanced configuration
-		// For now, just warn that it's not fully implemented
-		// In the future, this would require additional etcd node configuration
-		return fmt.Errorf(
-			"external etcd topology is not yet supported; use 'stacked' topology",
-		)
-	}
-
 	// Validate load balancer type
-	if ha.LoadBalancerType != "" &&
-		ha.LoadBalancerType != "nlb" &&
-		ha.LoadBalancerType != "alb" {
-		return fmt.Errorf(
-			"invalid load balancer type: %s (must be 'nlb' or 'alb')",
-			ha.LoadBalancerType,
-		)
-	}
-
-	return nil
+	return validateLoadBalancerType(ha.LoadBalancerType)
 }
 
 // Validate validates the NVIDIADriver configuration.
@@ -359,3 +568,269 @@ func (k *Kubernetes) Validate() error {
 		return fmt.Errorf("unknown Kubernetes source: %s", source)
 	}
 }
+
+// Validate validates the VSphere placement configuration.
+func (v *VSphere) Validate() error {
+	if v == nil {
+		return fmt.Errorf("provider %q requires a vsphere section", ProviderVSphere)
+	}
+	if v.Datacenter == "" {
+		return fmt.Errorf("vsphere datacenter is required")
+	}
+	if v.Template == "" {
+		return fmt.Errorf("vsphere template is required")
+	}
+	return nil
+}
+
+// Validate validates the GCP placement configuration.
+func (g *GCP) Validate() error {
+	if g == nil {
+		return fmt.Errorf("provider %q requires a gcp section", ProviderGCP)
+	}
+	if g.Project == "" {
+		return fmt.Errorf("gcp project is required")
+	}
+	if g.Zone == "" {
+		return fmt.Errorf("gcp zone is required")
+	}
+	for _, a := range g.Accelerators {
+		if a.Type == "" {
+			return fmt.Errorf("gcp accelerator type is required")
+		}
+		if a.Count < 0 {
+			return fmt.Errorf("gcp accelerator %q count cannot be negative, got %d", a.Type, a.Count)
+		}
+	}
+	return nil
+}
+
+// ValidateMarket checks the EC2 purchasing options of spec.instance and the
+// cluster pools. Pools without a market inherit spec.instance.market, and
+// spotMaxPrice is only accepted where the effective market is a spot one.
+func (s *EnvironmentSpec) ValidateMarket() error {
+	if err := validateMarket("instance", s.Market, s.Market, s.SpotMaxPrice); err != nil {
+		return err
+	}
+	if s.Cluster == nil {
+		return nil
+	}
+	cp := s.Cluster.ControlPlane
+	if err := validateMarket("control-plane", cp.Market, s.Market, cp.SpotMaxPrice); err != nil {
+		return err
+	}
+	for _, w := range s.Cluster.WorkerPoolList() {
+		if err := validateMarket(w.scope(), w.Market, s.Market, w.SpotMaxPrice); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateMarket(scope string, market, inherited MarketType, spotMaxPrice string) error {
+	switch market {
+	case "", MarketOnDemand, MarketSpot, MarketSpotWithFallback:
+	default:
+		return fmt.Errorf("%s market %q is not supported, must be one of %q, %q or %q",
+			scope, market, MarketOnDemand, MarketSpot, MarketSpotWithFallback)
+	}
+	if spotMaxPrice == "" {
+		return nil
+	}
+	if market == "" {
+		market = inherited
+	}
+	if market != MarketSpot && market != MarketSpotWithFallback {
+		return fmt.Errorf("%s spotMaxPrice requires market %q or %q", scope, MarketSpot, MarketSpotWithFallback)
+	}
+	if price, err := strconv.ParseFloat(spotMaxPrice, 64); err != nil || price <= 0 {
+		return fmt.Errorf("%s spotMaxPrice %q must be a positive price in USD", scope, spotMaxPrice)
+	}
+	return nil
+}
+
+// Validate validates the pre-existing network references. A VPC and a
+// subnet in it are always adopted together: holodeck cannot add its own
+// subnet or internet gateway to a VPC it does not own.
+func (n *Network) Validate() error {
+	if n == nil {
+		return nil
+	}
+	if n.VpcID == "" {
+		return fmt.Errorf("network vpcId is required")
+	}
+	if n.SubnetID == "" {
+		return fmt.Errorf("network subnetId is required")
+	}
+	seen := make(map[string]bool, len(n.SecurityGroupIDs))
+	for _, id := range n.SecurityGroupIDs {
+		if id == "" {
+			return fmt.Errorf("network securityGroupIds cannot contain empty IDs")
+		}
+		if seen[id] {
+			return fmt.Errorf("network security group %q is listed more than once", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// ValidateFallbacks checks the instance-type and availability-zone fallback
+// lists of spec.instance. They drive the single-node launch loop only, and
+// the zones cannot be combined with an adopted subnet whose zone is fixed.
+func (s *EnvironmentSpec) ValidateFallbacks() error {
+	if len(s.TypeFallbacks) == 0 && len(s.AvailabilityZones) == 0 {
+		return nil
+	}
+	if s.Cluster != nil {
+		return fmt.Errorf("instance typeFallbacks and availabilityZones are not supported in cluster mode")
+	}
+	if len(s.AvailabilityZones) > 0 && s.Network != nil {
+		return fmt.Errorf("instance availabilityZones cannot be combined with network, the subnet %q already fixes the zone", s.Network.SubnetID)
+	}
+	if err := validateList("instance type", append([]string{s.Type}, s.TypeFallbacks...)); err != nil {
+		return err
+	}
+	return validateList("availability zone", s.AvailabilityZones)
+}
+
+func validateList(kind string, values []string) error {
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		if v == "" {
+			return fmt.Errorf("%s cannot be empty", kind)
+		}
+		if seen[v] {
+			return fmt.Errorf("%s %q is listed more than once", kind, v)
+		}
+		seen[v] = true
+	}
+	return nil
+}
+
+// ValidatePlacement checks the capacity reservation and placement group
+// targets of spec.instance and the cluster pools. Pools without their own
+// settings inherit spec.instance's, including its market: a capacity
+// reservation only holds On-Demand capacity.
+func (s *EnvironmentSpec) ValidatePlacement() error {
+	if err := validatePlacement("instance", s.Market, s.CapacityReservation, s.PlacementGroup); err != nil {
+		return err
+	}
+	if s.Cluster == nil {
+		return nil
+	}
+	cp := s.Cluster.ControlPlane
+	if err := validatePlacement("control-plane", inheritMarket(cp.Market, s.Market),
+		inheritReservation(cp.CapacityReservation, s.CapacityReservation), cp.PlacementGroup); err != nil {
+		return err
+	}
+	for _, w := range s.Cluster.WorkerPoolList() {
+		if err := validatePlacement(w.scope(), inheritMarket(w.Market, s.Market),
+			inheritReservation(w.CapacityReservation, s.CapacityReservation), w.PlacementGroup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePlacement(scope string, market MarketType, cr *CapacityReservation, pg *PlacementGroup) error {
+	if cr != nil {
+		if (cr.ID == "") == (cr.ResourceGroupArn == "") {
+			return fmt.Errorf("%s capacityReservation requires exactly one of id and resourceGroupArn", scope)
+		}
+		if market == MarketSpot || market == MarketSpotWithFallback {
+			return fmt.Errorf("%s capacityReservation cannot be combined with market %q", scope, market)
+		}
+	}
+	if pg == nil {
+		return nil
+	}
+	switch pg.Strategy {
+	case "", PlacementCluster, PlacementPartition, PlacementSpread:
+	default:
+		return fmt.Errorf("%s placementGroup strategy %q is not supported, must be one of %q, %q or %q",
+			scope, pg.Strategy, PlacementCluster, PlacementPartition, PlacementSpread)
+	}
+	if pg.Name == "" && pg.Strategy == "" {
+		return fmt.Errorf("%s placementGroup requires a name to join or a strategy to create one", scope)
+	}
+	if pg.PartitionCount != 0 {
+		if pg.Strategy != PlacementPartition {
+			return fmt.Errorf("%s placementGroup partitionCount requires strategy %q", scope, PlacementPartition)
+		}
+		if pg.PartitionCount < 1 || pg.PartitionCount > 7 {
+			return fmt.Errorf("%s placementGroup partitionCount %d must be between 1 and 7", scope, pg.PartitionCount)
+		}
+	}
+	return nil
+}
+
+func inheritMarket(market, inherited MarketType) MarketType {
+	if market == "" {
+		return inherited
+	}
+	return market
+}
+
+func inheritReservation(cr, inherited *CapacityReservation) *CapacityReservation {
+	if cr == nil {
+		return inherited
+	}
+	return cr
+}
+
+// ValidateExpiry checks that at most one of ttl and expiresAt is set and
+// that a ttl is positive.
+func (s *EnvironmentSpec) ValidateExpiry() error {
+	if s.TTL == nil {
+		return nil
+	}
+	if s.ExpiresAt != nil {
+		return fmt.Errorf("ttl and expiresAt are mutually exclusive")
+	}
+	if s.TTL.Duration <= 0 {
+		return fmt.Errorf("ttl %s must be positive", s.TTL.Duration)
+	}
+	return nil
+}
+
+// ResolveTTL pins a relative ttl to an absolute expiresAt, counted from
+// now, and clears the ttl. It is called once when the environment is
+// created, so that every resource, and every later command reading the
+// cache, agrees on when the environment expires.
+func (s *EnvironmentSpec) ResolveTTL(now time.Time) {
+	if s.TTL == nil {
+		return
+	}
+	s.ExpiresAt = &metav1.Time{Time: now.Add(s.TTL.Duration)}
+	s.TTL = nil
+}
+
+// ValidateLoadBalancer checks the load balancer settings of an HA cluster.
+func (s *EnvironmentSpec) ValidateLoadBalancer() error {
+	if s.Cluster != nil && s.Cluster.HighAvailability != nil {
+		if err := validateLoadBalancerType(s.Cluster.HighAvailability.LoadBalancerType); err != nil {
+			return err
+		}
+	}
+	if s.LoadBalancer == nil {
+		return nil
+	}
+	return validateLoadBalancerType(s.LoadBalancer.Type)
+}
+
+// validateLoadBalancerType checks the type of the API server load balancer.
+// Only an NLB can front the Kubernetes API: an ALB terminates TLS, which
+// breaks kubeadm discovery and the client certificates kubeconfigs
+// authenticate with.
+func validateLoadBalancerType(lbType string) error {
+	switch lbType {
+	case "", LoadBalancerNLB:
+		return nil
+	case "alb":
+		return fmt.Errorf("load balancer type 'alb' cannot serve the Kubernetes API: " +
+			"an ALB terminates TLS, which breaks kubeadm and client certificate authentication (use 'nlb')")
+	default:
+		return fmt.Errorf("invalid load balancer type: %s (must be 'nlb')", lbType)
+	}
+}
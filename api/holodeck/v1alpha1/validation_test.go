@@ -18,8 +18,12 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestClusterSpec_Validate(t *testing.T) {
@@ -182,7 +186,7 @@ func TestClusterSpec_Validate(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "External etcd topology (not yet supported)",
+			name: "External etcd topology",
 			cluster: &ClusterSpec{
 				Region: "us-west-2",
 				ControlPlane: ControlPlaneSpec{
@@ -192,9 +196,40 @@ func TestClusterSpec_Validate(t *testing.T) {
 					Enabled:      true,
 					EtcdTopology: EtcdTopologyExternal,
 				},
+				Etcd: &EtcdPoolSpec{Count: 5},
+			},
+			wantErr: false,
+		},
+		{
+			name: "External etcd with an even member count",
+			cluster: &ClusterSpec{
+				Region: "us-west-2",
+				ControlPlane: ControlPlaneSpec{
+					Count: 3,
+				},
+				HighAvailability: &HAConfig{
+					Enabled:      true,
+					EtcdTopology: EtcdTopologyExternal,
+				},
+				Etcd: &EtcdPoolSpec{Count: 2},
+			},
+			wantErr: true,
+			errMsg:  "etcd count should be an odd number",
+		},
+		{
+			name: "Etcd pool with stacked topology",
+			cluster: &ClusterSpec{
+				Region: "us-west-2",
+				ControlPlane: ControlPlaneSpec{
+					Count: 3,
+				},
+				HighAvailability: &HAConfig{
+					Enabled: true,
+				},
+				Etcd: &EtcdPoolSpec{Count: 3},
 			},
 			wantErr: true,
-			errMsg:  "not yet supported",
+			errMsg:  "requires highAvailability.etcdTopology",
 		},
 		{
 			name: "Invalid etcd topology",
@@ -523,23 +558,23 @@ func TestHAConfig_Validate(t *testing.T) {
 			wantErr:           false,
 		},
 		{
-			name: "Valid HA with ALB",
+			name: "HA with ALB",
 			ha: HAConfig{
 				Enabled:          true,
 				LoadBalancerType: "alb",
 			},
 			controlPlaneCount: 3,
-			wantErr:           false,
+			wantErr:           true,
+			errMsg:            "an ALB terminates TLS",
 		},
 		{
-			name: "External etcd not supported",
+			name: "Valid HA with external etcd",
 			ha: HAConfig{
 				Enabled:      true,
 				EtcdTopology: EtcdTopologyExternal,
 			},
 			controlPlaneCount: 3,
-			wantErr:           true,
-			errMsg:            "not yet supported",
+			wantErr:           false,
 		},
 		{
 			name: "Invalid etcd topology",
@@ -1245,3 +1280,868 @@ func TestEnvironmentSpec_ValidateSSHConfigMode(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentSpec_ValidateHostInventory(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   EnvironmentSpec
+		errMsg string // empty means no error
+	}{
+		{
+			name: "single-node mode is not validated",
+			spec: EnvironmentSpec{Provider: ProviderSSH},
+		},
+		{
+			name: "aws cluster without hosts is valid",
+			spec: EnvironmentSpec{
+				Provider: ProviderAWS,
+				Cluster:  &ClusterSpec{Region: "us-west-2", ControlPlane: ControlPlaneSpec{Count: 1}},
+			},
+		},
+		{
+			name: "aws cluster with hosts is rejected",
+			spec: EnvironmentSpec{
+				Provider: ProviderAWS,
+				Cluster: &ClusterSpec{ControlPlane: ControlPlaneSpec{
+					Hosts: []Host{{Address: "10.0.0.1"}},
+				}},
+			},
+			errMsg: `cluster hosts are only supported with provider "ssh", got "aws"`,
+		},
+		{
+			name: "ssh cluster without control-plane hosts is rejected",
+			spec: EnvironmentSpec{
+				Provider: ProviderSSH,
+				Cluster:  &ClusterSpec{ControlPlane: ControlPlaneSpec{Count: 1}},
+			},
+			errMsg: `provider "ssh" in cluster mode requires at least one controlPlane.hosts entry`,
+		},
+		{
+			name: "ssh cluster with hosts and workers is valid",
+			spec: EnvironmentSpec{
+				Provider: ProviderSSH,
+				Cluster: &ClusterSpec{
+					ControlPlane: ControlPlaneSpec{Hosts: []Host{{Name: "cp-0", Address: "10.0.0.1"}}},
+					Workers: &WorkerPoolSpec{Count: 2, Hosts: []Host{
+						{Address: "10.0.0.2", Username: "rocky"},
+						{Address: "10.0.0.3", Bastion: &BastionConfig{Host: "jump.example.com"}},
+					}},
+				},
+			},
+		},
+		{
+			name: "count mismatch is rejected",
+			spec: EnvironmentSpec{
+				Provider: ProviderSSH,
+				Cluster: &ClusterSpec{ControlPlane: ControlPlaneSpec{
+					Count: 3,
+					Hosts: []Host{{Address: "10.0.0.1"}},
+				}},
+			},
+			errMsg: "control plane count 3 does not match the 1 declared hosts",
+		},
+		{
+			name: "worker count mismatch is rejected",
+			spec: EnvironmentSpec{
+				Provider: ProviderSSH,
+				Cluster: &ClusterSpec{
+					ControlPlane: ControlPlaneSpec{Hosts: []Host{{Address: "10.0.0.1"}}},
+					Workers:      &WorkerPoolSpec{Count: 2, Hosts: []Host{{Address: "10.0.0.2"}}},
+				},
+			},
+			errMsg: "worker count 2 does not match the 1 declared hosts",
+		},
+		{
+			name: "hosts across worker pools must be unique",
+			spec: EnvironmentSpec{
+				Provider: ProviderSSH,
+				Cluster: &ClusterSpec{
+					ControlPlane: ControlPlaneSpec{Hosts: []Host{{Address: "10.0.0.1"}}},
+					WorkerPools: []WorkerPoolSpec{
+						{Name: "cpu", Hosts: []Host{{Address: "10.0.0.2"}}},
+						{Name: "gpu", Hosts: []Host{{Address: "10.0.0.2"}}},
+					},
+				},
+			},
+			errMsg: `duplicate host "10.0.0.2" in cluster inventory`,
+		},
+		{
+			name: "missing address is rejected",
+			spec: EnvironmentSpec{
+				Provider: ProviderSSH,
+				Cluster: &ClusterSpec{ControlPlane: ControlPlaneSpec{
+					Hosts: []Host{{Name: "cp-0"}},
+				}},
+			},
+			errMsg: `host "cp-0": address is required`,
+		},
+		{
+			name: "bastion without host is rejected",
+			spec: EnvironmentSpec{
+				Provider: ProviderSSH,
+				Cluster: &ClusterSpec{ControlPlane: ControlPlaneSpec{
+					Hosts: []Host{{Address: "10.0.0.1", Bastion: &BastionConfig{Username: "jump"}}},
+				}},
+			},
+			errMsg: `host "10.0.0.1": bastion host is required`,
+		},
+		{
+			name: "duplicate host names are rejected",
+			spec: EnvironmentSpec{
+				Provider: ProviderSSH,
+				Cluster: &ClusterSpec{
+					ControlPlane: ControlPlaneSpec{Hosts: []Host{{Address: "10.0.0.1"}}},
+					Workers:      &WorkerPoolSpec{Hosts: []Host{{Address: "10.0.0.1"}}},
+				},
+			},
+			errMsg: `duplicate host "10.0.0.1" in cluster inventory`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.ValidateHostInventory()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestVSphere_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		vsphere *VSphere
+		errMsg  string
+	}{
+		{
+			name:    "valid placement",
+			vsphere: &VSphere{Datacenter: "dc1", Template: "ubuntu-22.04"},
+		},
+		{
+			name:   "missing section",
+			errMsg: `provider "vsphere" requires a vsphere section`,
+		},
+		{
+			name:    "missing datacenter",
+			vsphere: &VSphere{Template: "ubuntu-22.04"},
+			errMsg:  "vsphere datacenter is required",
+		},
+		{
+			name:    "missing template",
+			vsphere: &VSphere{Datacenter: "dc1"},
+			errMsg:  "vsphere template is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.vsphere.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestGCP_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		gcp    *GCP
+		errMsg string
+	}{
+		{
+			name: "valid placement",
+			gcp: &GCP{Project: "p", Zone: "us-central1-a",
+				Accelerators: []GCPAccelerator{{Type: "nvidia-tesla-t4", Count: 1}}},
+		},
+		{
+			name:   "missing section",
+			errMsg: `provider "gcp" requires a gcp section`,
+		},
+		{
+			name:   "missing project",
+			gcp:    &GCP{Zone: "us-central1-a"},
+			errMsg: "gcp project is required",
+		},
+		{
+			name:   "missing zone",
+			gcp:    &GCP{Project: "p"},
+			errMsg: "gcp zone is required",
+		},
+		{
+			name:   "accelerator without type",
+			gcp:    &GCP{Project: "p", Zone: "us-central1-a", Accelerators: []GCPAccelerator{{Count: 1}}},
+			errMsg: "gcp accelerator type is required",
+		},
+		{
+			name: "negative accelerator count",
+			gcp: &GCP{Project: "p", Zone: "us-central1-a",
+				Accelerators: []GCPAccelerator{{Type: "nvidia-l4", Count: -1}}},
+			errMsg: `gcp accelerator "nvidia-l4" count cannot be negative, got -1`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.gcp.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestEnvironmentSpec_ValidateMarket(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   EnvironmentSpec
+		errMsg string
+	}{
+		{
+			name: "default on-demand",
+		},
+		{
+			name: "spot with max price",
+			spec: EnvironmentSpec{Instance: Instance{Market: MarketSpot, SpotMaxPrice: "0.75"}},
+		},
+		{
+			name:   "unknown market",
+			spec:   EnvironmentSpec{Instance: Instance{Market: "reserved"}},
+			errMsg: `instance market "reserved" is not supported, must be one of "on-demand", "spot" or "spot-with-fallback"`,
+		},
+		{
+			name:   "max price without spot",
+			spec:   EnvironmentSpec{Instance: Instance{SpotMaxPrice: "0.75"}},
+			errMsg: `instance spotMaxPrice requires market "spot" or "spot-with-fallback"`,
+		},
+		{
+			name:   "invalid max price",
+			spec:   EnvironmentSpec{Instance: Instance{Market: MarketSpotWithFallback, SpotMaxPrice: "cheap"}},
+			errMsg: `instance spotMaxPrice "cheap" must be a positive price in USD`,
+		},
+		{
+			name: "pool inherits spot market",
+			spec: EnvironmentSpec{
+				Instance: Instance{Market: MarketSpot},
+				Cluster: &ClusterSpec{
+					Workers: &WorkerPoolSpec{SpotMaxPrice: "1.20"},
+				},
+			},
+		},
+		{
+			name: "pool overrides to on-demand",
+			spec: EnvironmentSpec{
+				Instance: Instance{Market: MarketSpot},
+				Cluster: &ClusterSpec{
+					ControlPlane: ControlPlaneSpec{Market: MarketOnDemand, SpotMaxPrice: "1.20"},
+				},
+			},
+			errMsg: `control-plane spotMaxPrice requires market "spot" or "spot-with-fallback"`,
+		},
+		{
+			name: "unknown worker market",
+			spec: EnvironmentSpec{
+				Cluster: &ClusterSpec{Workers: &WorkerPoolSpec{Market: "preemptible"}},
+			},
+			errMsg: `worker market "preemptible" is not supported, must be one of "on-demand", "spot" or "spot-with-fallback"`,
+		}, {
+			name: "named worker pool market",
+			spec: EnvironmentSpec{
+				Cluster: &ClusterSpec{WorkerPools: []WorkerPoolSpec{
+					{Name: "cpu"},
+					{Name: "gpu", SpotMaxPrice: "1.20"},
+				}},
+			},
+			errMsg: `worker pool "gpu" spotMaxPrice requires market "spot" or "spot-with-fallback"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.ValidateMarket()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestNetwork_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		network *Network
+		errMsg  string
+	}{
+		{
+			name: "not set",
+		},
+		{
+			name:    "vpc and subnet",
+			network: &Network{VpcID: "vpc-1", SubnetID: "subnet-1"},
+		},
+		{
+			name:    "with security groups",
+			network: &Network{VpcID: "vpc-1", SubnetID: "subnet-1", SecurityGroupIDs: []string{"sg-1", "sg-2"}},
+		},
+		{
+			name:    "missing vpc",
+			network: &Network{SubnetID: "subnet-1"},
+			errMsg:  "network vpcId is required",
+		},
+		{
+			name:    "missing subnet",
+			network: &Network{VpcID: "vpc-1", SecurityGroupIDs: []string{"sg-1"}},
+			errMsg:  "network subnetId is required",
+		},
+		{
+			name:    "empty security group",
+			network: &Network{VpcID: "vpc-1", SubnetID: "subnet-1", SecurityGroupIDs: []string{""}},
+			errMsg:  "network securityGroupIds cannot contain empty IDs",
+		},
+		{
+			name:    "duplicate security group",
+			network: &Network{VpcID: "vpc-1", SubnetID: "subnet-1", SecurityGroupIDs: []string{"sg-1", "sg-1"}},
+			errMsg:  `network security group "sg-1" is listed more than once`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.network.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestEnvironmentSpec_ValidateFallbacks(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   EnvironmentSpec
+		errMsg string
+	}{
+		{
+			name: "no fallbacks",
+			spec: EnvironmentSpec{Instance: Instance{Type: "p5.48xlarge"}},
+		},
+		{
+			name: "types and zones",
+			spec: EnvironmentSpec{Instance: Instance{
+				Type:              "p5.48xlarge",
+				TypeFallbacks:     []string{"p4d.24xlarge", "g6.48xlarge"},
+				AvailabilityZones: []string{"us-east-1a", "us-east-1b"},
+			}},
+		},
+		{
+			name: "fallback repeats the primary type",
+			spec: EnvironmentSpec{Instance: Instance{
+				Type:          "p5.48xlarge",
+				TypeFallbacks: []string{"p5.48xlarge"},
+			}},
+			errMsg: `instance type "p5.48xlarge" is listed more than once`,
+		},
+		{
+			name: "empty zone",
+			spec: EnvironmentSpec{Instance: Instance{
+				Type:              "p5.48xlarge",
+				AvailabilityZones: []string{"us-east-1a", ""},
+			}},
+			errMsg: "availability zone cannot be empty",
+		},
+		{
+			name: "cluster mode",
+			spec: EnvironmentSpec{
+				Instance: Instance{Type: "p5.48xlarge", TypeFallbacks: []string{"p4d.24xlarge"}},
+				Cluster:  &ClusterSpec{},
+			},
+			errMsg: "instance typeFallbacks and availabilityZones are not supported in cluster mode",
+		},
+		{
+			name: "zones with an adopted subnet",
+			spec: EnvironmentSpec{
+				Instance: Instance{Type: "p5.48xlarge", AvailabilityZones: []string{"us-east-1a"}},
+				Network:  &Network{VpcID: "vpc-1", SubnetID: "subnet-1"},
+			},
+			errMsg: `instance availabilityZones cannot be combined with network, the subnet "subnet-1" already fixes the zone`,
+		},
+		{
+			name: "type fallbacks with an adopted subnet",
+			spec: EnvironmentSpec{
+				Instance: Instance{Type: "p5.48xlarge", TypeFallbacks: []string{"p4d.24xlarge"}},
+				Network:  &Network{VpcID: "vpc-1", SubnetID: "subnet-1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.ValidateFallbacks()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestEnvironmentSpec_ValidatePlacement(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   EnvironmentSpec
+		errMsg string
+	}{
+		{
+			name: "nothing set",
+			spec: EnvironmentSpec{},
+		},
+		{
+			name: "reservation by ID and created group",
+			spec: EnvironmentSpec{Instance: Instance{
+				CapacityReservation: &CapacityReservation{ID: "cr-1"},
+				PlacementGroup:      &PlacementGroup{Strategy: PlacementPartition, PartitionCount: 3},
+			}},
+		},
+		{
+			name: "joined group",
+			spec: EnvironmentSpec{Instance: Instance{
+				PlacementGroup: &PlacementGroup{Name: "existing"},
+			}},
+		},
+		{
+			name: "reservation with both targets",
+			spec: EnvironmentSpec{Instance: Instance{
+				CapacityReservation: &CapacityReservation{ID: "cr-1", ResourceGroupArn: "arn:aws:resource-groups:::group/g"},
+			}},
+			errMsg: "instance capacityReservation requires exactly one of id and resourceGroupArn",
+		},
+		{
+			name: "reservation without a target",
+			spec: EnvironmentSpec{Instance: Instance{
+				CapacityReservation: &CapacityReservation{},
+			}},
+			errMsg: "instance capacityReservation requires exactly one of id and resourceGroupArn",
+		},
+		{
+			name: "reservation with spot",
+			spec: EnvironmentSpec{Instance: Instance{
+				Market:              MarketSpot,
+				CapacityReservation: &CapacityReservation{ID: "cr-1"},
+			}},
+			errMsg: `instance capacityReservation cannot be combined with market "spot"`,
+		},
+		{
+			name: "unknown strategy",
+			spec: EnvironmentSpec{Instance: Instance{
+				PlacementGroup: &PlacementGroup{Strategy: "host"},
+			}},
+			errMsg: `instance placementGroup strategy "host" is not supported, must be one of "cluster", "partition" or "spread"`,
+		},
+		{
+			name: "group without name or strategy",
+			spec: EnvironmentSpec{Instance: Instance{
+				PlacementGroup: &PlacementGroup{},
+			}},
+			errMsg: "instance placementGroup requires a name to join or a strategy to create one",
+		},
+		{
+			name: "partition count without partition strategy",
+			spec: EnvironmentSpec{Instance: Instance{
+				PlacementGroup: &PlacementGroup{Strategy: PlacementSpread, PartitionCount: 2},
+			}},
+			errMsg: `instance placementGroup partitionCount requires strategy "partition"`,
+		},
+		{
+			name: "partition count out of range",
+			spec: EnvironmentSpec{Instance: Instance{
+				PlacementGroup: &PlacementGroup{Strategy: PlacementPartition, PartitionCount: 8},
+			}},
+			errMsg: "instance placementGroup partitionCount 8 must be between 1 and 7",
+		},
+		{
+			name: "worker inherits reservation with spot workers",
+			spec: EnvironmentSpec{
+				Instance: Instance{CapacityReservation: &CapacityReservation{ID: "cr-1"}},
+				Cluster: &ClusterSpec{
+					Workers: &WorkerPoolSpec{Market: MarketSpotWithFallback},
+				},
+			},
+			errMsg: `worker capacityReservation cannot be combined with market "spot-with-fallback"`,
+		},
+		{
+			name: "control-plane inherits spot market",
+			spec: EnvironmentSpec{
+				Instance: Instance{Market: MarketSpot},
+				Cluster: &ClusterSpec{
+					ControlPlane: ControlPlaneSpec{CapacityReservation: &CapacityReservation{ID: "cr-1"}},
+				},
+			},
+			errMsg: `control-plane capacityReservation cannot be combined with market "spot"`,
+		},
+		{
+			name: "on-demand control-plane in reservation with spot instance default",
+			spec: EnvironmentSpec{
+				Instance: Instance{Market: MarketSpot},
+				Cluster: &ClusterSpec{
+					ControlPlane: ControlPlaneSpec{
+						Market:              MarketOnDemand,
+						CapacityReservation: &CapacityReservation{ID: "cr-1"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.ValidatePlacement()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestEnvironmentSpec_ValidateExpiry(t *testing.T) {
+	expiresAt := metav1.NewTime(time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC))
+
+	tests := []struct {
+		name   string
+		spec   EnvironmentSpec
+		errMsg string
+	}{
+		{
+			name: "nothing set",
+			spec: EnvironmentSpec{},
+		},
+		{
+			name: "ttl",
+			spec: EnvironmentSpec{TTL: &metav1.Duration{Duration: 8 * time.Hour}},
+		},
+		{
+			name: "expiresAt",
+			spec: EnvironmentSpec{ExpiresAt: &expiresAt},
+		},
+		{
+			name: "both set",
+			spec: EnvironmentSpec{
+				TTL:       &metav1.Duration{Duration: time.Hour},
+				ExpiresAt: &expiresAt,
+			},
+			errMsg: "ttl and expiresAt are mutually exclusive",
+		},
+		{
+			name:   "zero ttl",
+			spec:   EnvironmentSpec{TTL: &metav1.Duration{}},
+			errMsg: "ttl 0s must be positive",
+		},
+		{
+			name:   "negative ttl",
+			spec:   EnvironmentSpec{TTL: &metav1.Duration{Duration: -time.Hour}},
+			errMsg: "ttl -1h0m0s must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.ValidateExpiry()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestEnvironmentSpec_ResolveTTL(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	expiresAt := metav1.NewTime(now.Add(time.Hour))
+
+	spec := &EnvironmentSpec{}
+	spec.ResolveTTL(now)
+	assert.Nil(t, spec.ExpiresAt)
+
+	spec = &EnvironmentSpec{TTL: &metav1.Duration{Duration: 8 * time.Hour}}
+	spec.ResolveTTL(now)
+	assert.Nil(t, spec.TTL)
+	require.NotNil(t, spec.ExpiresAt)
+	assert.Equal(t, now.Add(8*time.Hour), spec.ExpiresAt.Time)
+
+	// Resolving again, e.g. from the cache, keeps the pinned time
+	spec.ResolveTTL(now.Add(time.Hour))
+	assert.Equal(t, now.Add(8*time.Hour), spec.ExpiresAt.Time)
+
+	spec = &EnvironmentSpec{ExpiresAt: &expiresAt}
+	spec.ResolveTTL(now)
+	assert.Equal(t, expiresAt.Time, spec.ExpiresAt.Time)
+}
+
+func TestEnvironmentSpec_ValidateLoadBalancer(t *testing.T) {
+	haCluster := func(lbType string) *ClusterSpec {
+		return &ClusterSpec{HighAvailability: &HAConfig{Enabled: true, LoadBalancerType: lbType}}
+	}
+	const albErr = "load balancer type 'alb' cannot serve the Kubernetes API: " +
+		"an ALB terminates TLS, which breaks kubeadm and client certificate authentication (use 'nlb')"
+
+	tests := []struct {
+		name   string
+		spec   EnvironmentSpec
+		errMsg string
+	}{
+		{
+			name: "nothing set",
+			spec: EnvironmentSpec{},
+		},
+		{
+			name: "default nlb",
+			spec: EnvironmentSpec{Cluster: haCluster("")},
+		},
+		{
+			name: "internal nlb",
+			spec: EnvironmentSpec{
+				Cluster:      haCluster("nlb"),
+				LoadBalancer: &LoadBalancer{Type: "nlb", Internal: true},
+			},
+		},
+		{
+			name:   "alb for the API endpoint",
+			spec:   EnvironmentSpec{Cluster: haCluster("alb")},
+			errMsg: albErr,
+		},
+		{
+			name: "alb from loadBalancer.type",
+			spec: EnvironmentSpec{
+				Cluster:      haCluster(""),
+				LoadBalancer: &LoadBalancer{Type: "alb"},
+			},
+			errMsg: albErr,
+		},
+		{
+			name:   "alb without HA",
+			spec:   EnvironmentSpec{LoadBalancer: &LoadBalancer{Type: "alb"}},
+			errMsg: albErr,
+		},
+		{
+			name:   "invalid type",
+			spec:   EnvironmentSpec{LoadBalancer: &LoadBalancer{Type: "elb"}},
+			errMsg: "invalid load balancer type: elb (must be 'nlb')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.ValidateLoadBalancer()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestEnvironmentSpec_ValidateEtcd(t *testing.T) {
+	smallVolumeSize := int32(10)
+	externalCluster := func(etcd *EtcdPoolSpec) *ClusterSpec {
+		return &ClusterSpec{
+			Region:           "us-west-2",
+			ControlPlane:     ControlPlaneSpec{Count: 3},
+			HighAvailability: &HAConfig{Enabled: true, EtcdTopology: EtcdTopologyExternal},
+			Etcd:             etcd,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		spec   EnvironmentSpec
+		errMsg string
+	}{
+		{
+			name: "single node",
+			spec: EnvironmentSpec{},
+		},
+		{
+			name: "external etcd with default pool",
+			spec: EnvironmentSpec{Provider: ProviderAWS, Cluster: externalCluster(nil)},
+		},
+		{
+			name: "external etcd with explicit pool",
+			spec: EnvironmentSpec{Provider: ProviderAWS, Cluster: externalCluster(&EtcdPoolSpec{Count: 5, InstanceType: "m5.large"})},
+		},
+		{
+			name:   "too many members",
+			spec:   EnvironmentSpec{Provider: ProviderAWS, Cluster: externalCluster(&EtcdPoolSpec{Count: 9})},
+			errMsg: "etcd validation failed: etcd count must be between 1 and 7, got 9",
+		},
+		{
+			name:   "small root volume",
+			spec:   EnvironmentSpec{Provider: ProviderAWS, Cluster: externalCluster(&EtcdPoolSpec{RootVolumeSizeGB: &smallVolumeSize})},
+			errMsg: "etcd validation failed: etcd root volume size must be at least 20GB, got 10",
+		},
+		{
+			name: "pool without external topology",
+			spec: EnvironmentSpec{Cluster: &ClusterSpec{
+				Region:       "us-west-2",
+				ControlPlane: ControlPlaneSpec{Count: 1},
+				Etcd:         &EtcdPoolSpec{Count: 3},
+			}},
+			errMsg: `cluster etcd pool requires highAvailability.etcdTopology "external"`,
+		},
+		{
+			name:   "bring-your-own hosts",
+			spec:   EnvironmentSpec{Provider: ProviderSSH, Cluster: externalCluster(nil)},
+			errMsg: `external etcd topology is not supported with provider "ssh"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.ValidateEtcd()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestClusterSpec_EtcdCount(t *testing.T) {
+	stacked := &ClusterSpec{HighAvailability: &HAConfig{Enabled: true}}
+	assert.False(t, stacked.ExternalEtcd())
+	assert.Equal(t, int32(0), stacked.EtcdCount())
+
+	external := &ClusterSpec{HighAvailability: &HAConfig{Enabled: true, EtcdTopology: EtcdTopologyExternal}}
+	assert.True(t, external.ExternalEtcd())
+	assert.Equal(t, int32(3), external.EtcdCount())
+
+	external.Etcd = &EtcdPoolSpec{Count: 5}
+	assert.Equal(t, int32(5), external.EtcdCount())
+
+	external.HighAvailability.Enabled = false
+	assert.False(t, external.ExternalEtcd())
+}
+
+func TestEnvironmentSpec_ValidateWorkerPools(t *testing.T) {
+	pools := func(p ...WorkerPoolSpec) EnvironmentSpec {
+		return EnvironmentSpec{Cluster: &ClusterSpec{
+			Region:       "us-west-2",
+			ControlPlane: ControlPlaneSpec{Count: 1},
+			WorkerPools:  p,
+		}}
+	}
+
+	tests := []struct {
+		name   string
+		spec   EnvironmentSpec
+		errMsg string
+	}{
+		{
+			name: "single node",
+		},
+		{
+			name: "legacy workers",
+			spec: EnvironmentSpec{Cluster: &ClusterSpec{Workers: &WorkerPoolSpec{Count: 2}}},
+		},
+		{
+			name: "heterogeneous pools",
+			spec: pools(
+				WorkerPoolSpec{Name: "cpu", Count: 2, InstanceType: "m5.xlarge"},
+				WorkerPoolSpec{Name: "gpu", Count: 1, InstanceType: "g4dn.xlarge", Taints: []Taint{
+					{Key: "nvidia.com/gpu", Value: "present", Effect: "NoSchedule"},
+				}},
+			),
+		},
+		{
+			name: "workers and workerPools",
+			spec: EnvironmentSpec{Cluster: &ClusterSpec{
+				Workers:     &WorkerPoolSpec{Count: 1},
+				WorkerPools: []WorkerPoolSpec{{Name: "gpu", Count: 1}},
+			}},
+			errMsg: "cluster workers and workerPools are mutually exclusive",
+		},
+		{
+			name:   "unnamed pool",
+			spec:   pools(WorkerPoolSpec{Count: 1}),
+			errMsg: "cluster workerPools entries require a name",
+		},
+		{
+			name:   "invalid pool name",
+			spec:   pools(WorkerPoolSpec{Name: "GPU_Pool", Count: 1}),
+			errMsg: `invalid worker pool name "GPU_Pool": must be a lowercase DNS label`,
+		},
+		{
+			name:   "duplicate pool name",
+			spec:   pools(WorkerPoolSpec{Name: "gpu", Count: 1}, WorkerPoolSpec{Name: "gpu", Count: 2}),
+			errMsg: `duplicate worker pool name "gpu"`,
+		},
+		{
+			name:   "negative pool count",
+			spec:   pools(WorkerPoolSpec{Name: "gpu", Count: -1}),
+			errMsg: "workers validation failed: worker count cannot be negative, got -1",
+		},
+		{
+			name:   "unsafe pool label",
+			spec:   pools(WorkerPoolSpec{Name: "gpu", Labels: map[string]string{"gpu": "$(reboot)"}}),
+			errMsg: `worker pool "gpu" labels: invalid label value "$(reboot)" for key "gpu": contains disallowed characters`,
+		},
+		{
+			name:   "invalid taint effect",
+			spec:   pools(WorkerPoolSpec{Name: "gpu", Taints: []Taint{{Key: "gpu", Effect: "NoRun"}}}),
+			errMsg: `worker pool "gpu" taints: invalid taint effect "NoRun" for key "gpu" (must be 'NoSchedule', 'PreferNoSchedule' or 'NoExecute')`,
+		},
+		{
+			name:   "missing taint key",
+			spec:   pools(WorkerPoolSpec{Name: "gpu", Taints: []Taint{{Effect: "NoSchedule"}}}),
+			errMsg: `worker pool "gpu" taints: invalid taint key "": contains disallowed characters`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.ValidateWorkerPools()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestClusterSpec_WorkerPoolList(t *testing.T) {
+	var none *ClusterSpec
+	assert.Empty(t, none.WorkerPoolList())
+	assert.Equal(t, int32(0), none.WorkerCount())
+
+	legacy := &ClusterSpec{Workers: &WorkerPoolSpec{Count: 2, InstanceType: "m5.xlarge"}}
+	assert.Equal(t, []WorkerPoolSpec{{Count: 2, InstanceType: "m5.xlarge"}}, legacy.WorkerPoolList())
+	assert.Equal(t, int32(2), legacy.WorkerCount())
+
+	pools := &ClusterSpec{WorkerPools: []WorkerPoolSpec{{Name: "cpu", Count: 2}, {Name: "gpu", Count: 1}}}
+	assert.Len(t, pools.WorkerPoolList(), 2)
+	assert.Equal(t, int32(3), pools.WorkerCount())
+
+	assert.Same(t, legacy.Workers, legacy.WorkerPool(""))
+	assert.Nil(t, legacy.WorkerPool("gpu"))
+	assert.Nil(t, pools.WorkerPool(""))
+	gpu := pools.WorkerPool("gpu")
+	require.NotNil(t, gpu)
+	gpu.Count = 4
+	assert.Equal(t, int32(6), pools.WorkerCount(), "WorkerPool aliases the spec")
+}
@@ -17,6 +17,7 @@
 package ci
 
 import (
+	"context"
 	"crypto/rand"
 	"fmt"
 	"log"
@@ -34,7 +35,7 @@ const (
 	holodeckSSHKeyFile = "/github/workspace/holodeck_ssh_key"
 )
 
-func Run(log *logger.FunLogger) error {
+func Run(ctx context.Context, log *logger.FunLogger) error {
 	// Get GitHub Actions INPUT_* vars
 	err := readInputs()
 	if err != nil {
@@ -43,9 +44,10 @@ func Run(log *logger.FunLogger) error {
 
 	_, err = os.Stat(cachedir)
 	if os.IsNotExist(err) {
-		if err := entrypoint(log); err != nil {
+		if err := entrypoint(ctx, log); err != nil {
 			log.Error(err)
-			if err := cleanup(log); err != nil {
+			// Tear down detached from ctx so a cancelled run still cleans up
+			if err := cleanup(context.WithoutCancel(ctx), log); err != nil {
 				return err
 			}
 			return err
@@ -54,13 +56,13 @@ func Run(log *logger.FunLogger) error {
 	}
 
 	// Check if cache condition is Terminated
-	if ok, err := isTerminated(log); ok {
+	if ok, err := isTerminated(ctx, log); ok {
 		log.Info("Environment condition is Terminated no need to run Holodeck")
 		return nil
 	} else if err != nil {
 		log.Warning("%s", err.Error())
 	}
-	if err := cleanup(log); err != nil {
+	if err := cleanup(ctx, log); err != nil {
 		return err
 	}
 
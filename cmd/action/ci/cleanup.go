@@ -17,6 +17,7 @@
 package ci
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -27,7 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func cleanup(log *logger.FunLogger) error {
+func cleanup(ctx context.Context, log *logger.FunLogger) error {
 	log.Info("Running Cleanup function")
 
 	// Read the config file
@@ -57,7 +58,7 @@ func cleanup(log *logger.FunLogger) error {
 		return fmt.Errorf("failed to create provider: %w", err)
 	}
 
-	if err := provider.Delete(); err != nil {
+	if err := provider.Delete(ctx); err != nil {
 		log.Error(err)
 		log.Exit(1)
 	}
@@ -81,7 +82,7 @@ func cleanup(log *logger.FunLogger) error {
 	return nil
 }
 
-func isTerminated(log *logger.FunLogger) (bool, error) {
+func isTerminated(ctx context.Context, log *logger.FunLogger) (bool, error) {
 	log.Info("Checking for Terminated condition")
 
 	// Read the config file
@@ -101,7 +102,7 @@ func isTerminated(log *logger.FunLogger) (bool, error) {
 		return false, fmt.Errorf("failed to create provider: %w", err)
 	}
 
-	status, err := provider.Status()
+	status, err := provider.Status(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to get status: %w", err)
 	}
@@ -17,18 +17,19 @@
 package ci
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
 	"github.com/NVIDIA/holodeck/internal/logger"
 	"github.com/NVIDIA/holodeck/pkg/jyaml"
-	"github.com/NVIDIA/holodeck/pkg/provider/aws"
 	"github.com/NVIDIA/holodeck/pkg/provisioner"
 	"github.com/NVIDIA/holodeck/pkg/utils"
 )
 
-func entrypoint(log *logger.FunLogger) error {
+func entrypoint(ctx context.Context, log *logger.FunLogger) error {
 	log.Info("Running Entrypoint function")
 
 	configFile := os.Getenv("INPUT_HOLODECK_CONFIG")
@@ -60,15 +61,20 @@ func entrypoint(log *logger.FunLogger) error {
 		cfg.Spec.ContainerRuntime.Name = v1alpha1.ContainerRuntimeNone
 	}
 
+	if err := cfg.Spec.ValidateExpiry(); err != nil {
+		return fmt.Errorf("invalid expiry in %s: %w", configFile, err)
+	}
+
 	// Set default values for the environment
 	setCfgName(&cfg)
+	cfg.Spec.ResolveTTL(time.Now())
 
 	provider, err := newProvider(log, &cfg)
 	if err != nil {
 		return fmt.Errorf("failed to create provider: %w", err)
 	}
 
-	err = provider.Create()
+	err = provider.Create(ctx)
 	if err != nil {
 		return err
 	}
@@ -79,24 +85,18 @@ func entrypoint(log *logger.FunLogger) error {
 		return fmt.Errorf("failed to read cache file: %w", err)
 	}
 
-	// Get the host url
-	var hostUrl string
-	if cfg.Spec.Provider == v1alpha1.ProviderAWS {
-		if err := getSSHKeyFile(log, "AWS_SSH_KEY"); err != nil {
-			return err
-		}
-		cfg.Spec.PrivateKey = sshKeyFile
-		cfg.Spec.Username = "ubuntu"
-		for _, p := range cache.Status.Properties {
-			if p.Name == aws.PublicDnsName {
-				hostUrl = p.Value
-				break
-			}
-		}
+	// Get the SSH key and host url
+	if err := getSSHKeyFile(log, sshKeyEnv(cfg.Spec.Provider)); err != nil {
+		return err
+	}
+	cfg.Spec.PrivateKey = sshKeyFile
+	hostUrl, err := hostAddress(&cache)
+	if err != nil {
+		return err
 	}
 
 	// Run the provisioner
-	p, err := provisioner.New(log, sshKeyFile, cfg.Spec.Username, hostUrl,
+	p, err := provisioner.New(ctx, log, sshKeyFile, cfg.Spec.Username, hostUrl,
 		provisioner.WithSSHConfig(cfg.Spec.SSHConfig))
 	if err != nil {
 		return err
@@ -104,12 +104,12 @@ func entrypoint(log *logger.FunLogger) error {
 	defer p.Client.Close() // nolint: errcheck
 
 	log.Info("Provisioning \u2699")
-	if _, err = p.Run(cfg); err != nil {
+	if _, err = p.Run(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to run provisioner: %w", err)
 	}
 
 	if cfg.Spec.Kubernetes.Install {
-		err = utils.GetKubeConfig(log, &cfg, hostUrl, kubeconfig)
+		err = utils.GetKubeConfig(ctx, log, &cfg, hostUrl, kubeconfig)
 		if err != nil {
 			return fmt.Errorf("failed to get kubeconfig: %w", err)
 		}
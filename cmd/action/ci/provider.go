@@ -19,31 +19,20 @@ package ci
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
 	"github.com/NVIDIA/holodeck/internal/logger"
 	"github.com/NVIDIA/holodeck/pkg/provider"
-	"github.com/NVIDIA/holodeck/pkg/provider/aws"
+	_ "github.com/NVIDIA/holodeck/pkg/provider/all" // register built-in providers
 )
 
 func newProvider(log *logger.FunLogger, cfg *v1alpha1.Environment) (provider.Provider, error) {
-	var provider provider.Provider
-	var err error
-
-	switch cfg.Spec.Provider {
-	case v1alpha1.ProviderAWS:
-		provider, err = newAwsProvider(log, cfg)
-		if err != nil {
-			return nil, err
-		}
-	default:
-		return nil, fmt.Errorf("provider %s not supported", cfg.Spec.Provider)
+	reg, err := provider.Lookup(string(cfg.Spec.Provider))
+	if err != nil {
+		return nil, fmt.Errorf("provider %s not supported: %w", cfg.Spec.Provider, err)
 	}
 
-	return provider, nil
-}
-
-func newAwsProvider(log *logger.FunLogger, cfg *v1alpha1.Environment) (*aws.Provider, error) {
 	// Create cachedir directory
 	if _, err := os.Stat(cachedir); os.IsNotExist(err) {
 		err := os.Mkdir(cachedir, 0750)
@@ -56,12 +45,26 @@ func newAwsProvider(log *logger.FunLogger, cfg *v1alpha1.Environment) (*aws.Prov
 	// Set env name
 	setCfgName(cfg)
 
-	a, err := aws.New(log, *cfg, cacheFile)
-	if err != nil {
-		return nil, err
+	if reg.Defaults != nil {
+		reg.Defaults(cfg)
 	}
 
-	return a, nil
+	return reg.New(log, *cfg, cacheFile)
+}
+
+// sshKeyEnv returns the environment variable holding the SSH key for the
+// named provider, e.g. AWS_SSH_KEY for aws.
+func sshKeyEnv(name v1alpha1.Provider) string {
+	return strings.ToUpper(strings.ReplaceAll(string(name), "-", "_")) + "_SSH_KEY"
+}
+
+// hostAddress returns the SSH-reachable address of the created environment.
+func hostAddress(cache *v1alpha1.Environment) (string, error) {
+	hostUrl, err := provider.HostAddress(cache)
+	if err != nil {
+		return "", fmt.Errorf("failed to determine host URL: %w", err)
+	}
+	return hostUrl, nil
 }
 
 // look for file holodeck_ssh_key in GITHUB_WORKSPACE/holodeck_ssh_key
@@ -21,14 +21,21 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/holodeck/internal/logger"
 	cleanuppkg "github.com/NVIDIA/holodeck/pkg/cleanup"
 )
 
-// RunCleanup performs standalone VPC cleanup based on INPUT_VPC_IDS.
+// orphanCleanupTimeout bounds the deletion of each orphaned VPC, like the
+// default --timeout of holodeck cleanup, so that one stuck VPC does not hold
+// up the rest.
+const orphanCleanupTimeout = 15 * time.Minute
+
+// RunCleanup performs standalone VPC cleanup based on INPUT_VPC_IDS, or on
+// orphan discovery when INPUT_ORPHANS is true.
 // This mode is used for periodic cleanup workflows.
-func RunCleanup(log *logger.FunLogger) error {
+func RunCleanup(ctx context.Context, log *logger.FunLogger) error {
 	log.Info("Running VPC Cleanup action")
 
 	// Read AWS credentials from inputs
@@ -36,6 +43,20 @@ func RunCleanup(log *logger.FunLogger) error {
 		return err
 	}
 
+	// Determine AWS region
+	region := os.Getenv("INPUT_AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_REGION")
+		if region == "" {
+			region = os.Getenv("AWS_DEFAULT_REGION")
+		}
+	}
+
+	// Orphan discovery replaces the explicit VPC list
+	if os.Getenv("INPUT_ORPHANS") == "true" {
+		return runOrphanCleanup(ctx, log, region, os.Getenv("INPUT_ALL_REGIONS") == "true")
+	}
+
 	// Get VPC IDs from input
 	vpcIDsStr := os.Getenv("INPUT_VPC_IDS")
 	if vpcIDsStr == "" {
@@ -49,18 +70,10 @@ func RunCleanup(log *logger.FunLogger) error {
 		return nil
 	}
 
-	// Determine AWS region
-	region := os.Getenv("INPUT_AWS_REGION")
 	if region == "" {
-		region = os.Getenv("AWS_REGION")
-		if region == "" {
-			region = os.Getenv("AWS_DEFAULT_REGION")
-			if region == "" {
-				return fmt.Errorf(
-					"AWS region must be specified via INPUT_AWS_REGION or " +
-						"AWS_REGION environment variable")
-			}
-		}
+		return fmt.Errorf(
+			"AWS region must be specified via INPUT_AWS_REGION or " +
+				"AWS_REGION environment variable")
 	}
 
 	// Check force cleanup flag
@@ -87,10 +100,10 @@ func RunCleanup(log *logger.FunLogger) error {
 		var cleanupErr error
 		if forceCleanup {
 			// Skip job status check
-			cleanupErr = cleaner.DeleteVPCResources(context.Background(), vpcID)
+			cleanupErr = cleaner.DeleteVPCResources(ctx, vpcID)
 		} else {
 			// Check job status first
-			cleanupErr = cleaner.CleanupVPC(context.Background(), vpcID)
+			cleanupErr = cleaner.CleanupVPC(ctx, vpcID)
 		}
 
 		if cleanupErr != nil {
@@ -111,3 +124,75 @@ func RunCleanup(log *logger.FunLogger) error {
 	log.Info("Cleanup completed successfully: %d VPCs cleaned up", successCount)
 	return nil
 }
+
+// runOrphanCleanup deletes the orphaned holodeck VPCs, in region or, with
+// allRegions, in every enabled region. The action has no local cache files,
+// so ownership comes from the ttl and the GitHub job status check alone;
+// VPCs with neither, or whose run cannot be checked, are kept.
+func runOrphanCleanup(ctx context.Context, log *logger.FunLogger, region string, allRegions bool) error {
+	regions := []string{region}
+	if allRegions {
+		if region == "" {
+			region = "us-east-1"
+		}
+		cleaner, err := cleanuppkg.New(log, region)
+		if err != nil {
+			return fmt.Errorf("failed to create cleaner: %w", err)
+		}
+		if regions, err = cleaner.ListRegions(ctx); err != nil {
+			return err
+		}
+	} else if region == "" {
+		return fmt.Errorf(
+			"AWS region must be specified via INPUT_AWS_REGION or " +
+				"AWS_REGION environment variable")
+	}
+
+	successCount := 0
+	failCount := 0
+
+	for _, r := range regions {
+		cleaner, err := cleanuppkg.New(log, r)
+		if err != nil {
+			return fmt.Errorf("failed to create cleaner for %s: %w", r, err)
+		}
+		vpcs, err := cleaner.FindOrphans(ctx, nil)
+		if err != nil {
+			if !allRegions {
+				return err
+			}
+			log.Warning("Skipping region %s: %v", r, err)
+			continue
+		}
+
+		for _, vpc := range vpcs {
+			if ctx.Err() != nil {
+				log.Warning("Cleanup cancelled, skipping remaining VPCs")
+				break
+			}
+			if !vpc.Orphaned() {
+				log.Info("Keeping VPC %s in %s: %s", vpc.VpcID, r, vpc.Reason)
+				continue
+			}
+			log.Info("Deleting orphaned VPC %s in %s: %s", vpc.VpcID, r, vpc.Reason)
+			vpcCtx, cancel := context.WithTimeout(ctx, orphanCleanupTimeout)
+			err := cleaner.DeleteVPCResources(vpcCtx, vpc.VpcID)
+			cancel()
+			if err != nil {
+				log.Error(fmt.Errorf("failed to cleanup VPC %s: %w", vpc.VpcID, err))
+				failCount++
+			} else {
+				successCount++
+			}
+		}
+	}
+
+	if failCount > 0 {
+		return fmt.Errorf(
+			"cleanup completed with errors: %d succeeded, %d failed",
+			successCount, failCount)
+	}
+
+	log.Info("Cleanup completed successfully: %d orphaned VPCs cleaned up", successCount)
+	return nil
+}
@@ -17,8 +17,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/NVIDIA/holodeck/cmd/action/ci"
 	"github.com/NVIDIA/holodeck/internal/logger"
@@ -39,16 +42,19 @@ func main() {
 		action = "create"
 	}
 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+
 	var err error
 	switch action {
 	case "create":
-		err = ci.Run(log)
+		err = ci.Run(ctx, log)
 	case "cleanup":
-		err = ci.RunCleanup(log)
+		err = ci.RunCleanup(ctx, log)
 	default:
 		log.Error(fmt.Errorf("unknown action: %s. Valid actions: create, cleanup", action))
 		os.Exit(1)
 	}
+	stop()
 
 	if err != nil {
 		log.Error(err)
@@ -20,8 +20,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"github.com/NVIDIA/holodeck/internal/logger"
@@ -34,10 +32,15 @@ import (
 const defaultCleanupTimeout = 15 * time.Minute
 
 type command struct {
-	log         *logger.FunLogger
-	region      string
-	forceDelete bool
-	timeout     time.Duration
+	log          *logger.FunLogger
+	region       string
+	forceDelete  bool
+	timeout      time.Duration
+	orphans      bool
+	allRegions   bool
+	yes          bool
+	cachePath    string
+	outputFormat string
 }
 
 // NewCommand constructs the cleanup command with the specified logger
@@ -79,7 +82,13 @@ Examples:
   holodeck cleanup --region us-west-2 vpc-12345678
 
   # Clean up with custom timeout (per VPC)
-  holodeck cleanup --timeout 30m vpc-12345678`,
+  holodeck cleanup --timeout 30m vpc-12345678
+
+  # Show holodeck VPCs that no local instance or running GitHub job owns
+  holodeck cleanup --orphans --all-regions -o json
+
+  # Delete orphaned VPCs without prompting
+  holodeck cleanup --orphans --region us-west-2 --yes`,
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:        "region",
@@ -100,30 +109,78 @@ Examples:
 				Value:       defaultCleanupTimeout,
 				Destination: &m.timeout,
 			},
+			&cli.BoolFlag{
+				Name:        "orphans",
+				Usage:       "Find holodeck VPCs that no local instance or running GitHub job owns",
+				Destination: &m.orphans,
+			},
+			&cli.BoolFlag{
+				Name:        "all-regions",
+				Usage:       "With --orphans, search every enabled region",
+				Destination: &m.allRegions,
+			},
+			&cli.BoolFlag{
+				Name:        "yes",
+				Aliases:     []string{"y"},
+				Usage:       "With --orphans, delete orphaned VPCs without prompting",
+				Destination: &m.yes,
+			},
+			&cli.StringFlag{
+				Name:        "cachepath",
+				Aliases:     []string{"c"},
+				Usage:       "Path to the cache directory",
+				Destination: &m.cachePath,
+			},
+			&cli.StringFlag{
+				Name:        "output",
+				Aliases:     []string{"o"},
+				Usage:       "With --orphans, plan output format: table, json, yaml (default: table)",
+				Destination: &m.outputFormat,
+				Value:       "table",
+			},
 		},
-		Action: func(_ context.Context, cmd *cli.Command) error {
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if m.orphans {
+				if cmd.NArg() > 0 {
+					return fmt.Errorf("VPC IDs cannot be combined with --orphans")
+				}
+				if m.forceDelete {
+					return fmt.Errorf("--force cannot be combined with --orphans")
+				}
+				//nolint:contextcheck // cleanup.New is a top-level initializer with no ctx parameter
+				return m.runOrphans(ctx)
+			}
+			if m.allRegions || m.yes {
+				return fmt.Errorf("--all-regions and --yes require --orphans")
+			}
 			if cmd.NArg() == 0 {
 				return fmt.Errorf("at least one VPC ID is required")
 			}
 			//nolint:contextcheck // cleanup.New (pkg/cleanup) is a top-level initializer with no ctx parameter by design; threading requires a pkg/ signature change, out of scope here.
-			return m.run(cmd)
+			return m.run(ctx, cmd)
 		},
 	}
 
 	return &cleanup
 }
 
-func (m *command) run(cmd *cli.Command) error {
+// resolveRegion returns the --region flag, AWS_REGION or AWS_DEFAULT_REGION,
+// in that order.
+func (m *command) resolveRegion() string {
+	if m.region != "" {
+		return m.region
+	}
+	if region := os.Getenv("AWS_REGION"); region != "" {
+		return region
+	}
+	return os.Getenv("AWS_DEFAULT_REGION")
+}
+
+func (m *command) run(ctx context.Context, cmd *cli.Command) error {
 	// Determine the region
-	region := m.region
+	region := m.resolveRegion()
 	if region == "" {
-		region = os.Getenv("AWS_REGION")
-		if region == "" {
-			region = os.Getenv("AWS_DEFAULT_REGION")
-			if region == "" {
-				return fmt.Errorf("AWS region must be specified via --region flag or AWS_REGION environment variable")
-			}
-		}
+		return fmt.Errorf("AWS region must be specified via --region flag or AWS_REGION environment variable")
 	}
 
 	// Set default timeout if not specified
@@ -132,10 +189,6 @@ func (m *command) run(cmd *cli.Command) error {
 		timeout = defaultCleanupTimeout
 	}
 
-	// Create a context that is cancelled by SIGINT/SIGTERM
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
-	defer stop()
-
 	// Create the cleaner
 	cleaner, err := cleanup.New(m.log, region)
 	if err != nil {
@@ -209,4 +209,54 @@ var _ = Describe("Cleanup Command", func() {
 			Expect(err.Error()).NotTo(ContainSubstring("AWS region must be specified"))
 		})
 	})
+
+	Describe("Orphan discovery", func() {
+		run := func(args ...string) error {
+			app := &cli.Command{
+				Commands: []*cli.Command{cleanup.NewCommand(log)},
+			}
+			return app.Run(context.Background(), append([]string{"holodeck", "cleanup"}, args...))
+		}
+
+		It("should have orphan flags", func() {
+			cmd := cleanup.NewCommand(log)
+			flagNames := make(map[string]bool)
+			for _, flag := range cmd.Flags {
+				for _, name := range flag.Names() {
+					flagNames[name] = true
+				}
+			}
+			Expect(flagNames).To(HaveKey("orphans"))
+			Expect(flagNames).To(HaveKey("all-regions"))
+			Expect(flagNames).To(HaveKey("yes"))
+			Expect(flagNames).To(HaveKey("output"))
+		})
+
+		It("should reject VPC IDs with --orphans", func() {
+			err := run("--orphans", "vpc-12345")
+			Expect(err).To(MatchError(ContainSubstring("VPC IDs cannot be combined with --orphans")))
+		})
+
+		It("should reject --force with --orphans", func() {
+			err := run("--orphans", "--force")
+			Expect(err).To(MatchError(ContainSubstring("--force cannot be combined with --orphans")))
+		})
+
+		It("should require --orphans for --all-regions", func() {
+			err := run("--all-regions", "vpc-12345")
+			Expect(err).To(MatchError(ContainSubstring("require --orphans")))
+		})
+
+		It("should reject an unknown output format", func() {
+			err := run("--orphans", "-r", "us-west-2", "-o", "xml")
+			Expect(err).To(MatchError(ContainSubstring(`invalid output format "xml"`)))
+		})
+
+		It("should require a region for a single-region search", func() {
+			GinkgoT().Setenv("AWS_REGION", "")
+			GinkgoT().Setenv("AWS_DEFAULT_REGION", "")
+			err := run("--orphans")
+			Expect(err).To(MatchError(ContainSubstring("AWS region must be specified")))
+		})
+	})
 })
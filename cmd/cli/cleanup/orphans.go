@@ -0,0 +1,237 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cleanup
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/instances"
+	"github.com/NVIDIA/holodeck/pkg/cleanup"
+	"github.com/NVIDIA/holodeck/pkg/output"
+	"github.com/NVIDIA/holodeck/pkg/provider/aws"
+
+	"github.com/mattn/go-isatty"
+)
+
+// defaultDiscoveryRegion is used to list regions for --all-regions when no
+// region is configured.
+const defaultDiscoveryRegion = "us-east-1"
+
+// Actions in the orphan plan
+const (
+	actionDelete = "delete"
+	actionKeep   = "keep"
+)
+
+// OrphanPlan lists the holodeck VPCs found by --orphans and what will be
+// done with each
+type OrphanPlan struct {
+	VPCs []OrphanPlanEntry `json:"vpcs" yaml:"vpcs"`
+}
+
+// OrphanPlanEntry is a holodeck VPC, its owner and the planned action
+type OrphanPlanEntry struct {
+	Region     string `json:"region" yaml:"region"`
+	VpcID      string `json:"vpcId" yaml:"vpcId"`
+	Name       string `json:"name" yaml:"name"`
+	Repository string `json:"repository,omitempty" yaml:"repository,omitempty"`
+	RunID      string `json:"runId,omitempty" yaml:"runId,omitempty"`
+	ExpiresAt  string `json:"expiresAt,omitempty" yaml:"expiresAt,omitempty"`
+	Owner      string `json:"owner" yaml:"owner"`
+	Reason     string `json:"reason" yaml:"reason"`
+	Action     string `json:"action" yaml:"action"`
+}
+
+// Headers implements output.TableData
+func (p *OrphanPlan) Headers() []string {
+	return []string{"REGION", "VPC ID", "NAME", "OWNER", "ACTION", "REASON"}
+}
+
+// Rows implements output.TableData
+func (p *OrphanPlan) Rows() [][]string {
+	rows := make([][]string, 0, len(p.VPCs))
+	for _, v := range p.VPCs {
+		rows = append(rows, []string{v.Region, v.VpcID, v.Name, v.Owner, v.Action, v.Reason})
+	}
+	return rows
+}
+
+func (m *command) runOrphans(ctx context.Context) error {
+	formatter, err := output.NewFormatter(m.outputFormat)
+	if err != nil {
+		return fmt.Errorf("invalid output format %q, must be one of: %s", m.outputFormat, strings.Join(output.ValidFormats(), ", "))
+	}
+
+	region := m.resolveRegion()
+	if region == "" {
+		if !m.allRegions {
+			return fmt.Errorf("AWS region must be specified via --region flag or AWS_REGION environment variable")
+		}
+		region = defaultDiscoveryRegion
+	}
+
+	regions := []string{region}
+	if m.allRegions {
+		c, err := cleanup.New(m.log, region)
+		if err != nil {
+			return fmt.Errorf("failed to create cleaner: %w", err)
+		}
+		if regions, err = c.ListRegions(ctx); err != nil {
+			return err
+		}
+	}
+
+	owners, err := m.localOwners()
+	if err != nil {
+		return err
+	}
+
+	plan := &OrphanPlan{VPCs: []OrphanPlanEntry{}}
+	cleaners := make(map[string]*cleanup.Cleaner, len(regions))
+	for _, r := range regions {
+		c, err := cleanup.New(m.log, r)
+		if err != nil {
+			return fmt.Errorf("failed to create cleaner for %s: %w", r, err)
+		}
+		vpcs, err := c.FindOrphans(ctx, owners)
+		if err != nil {
+			if !m.allRegions {
+				return err
+			}
+			m.log.Warning("Skipping region %s: %v", r, err)
+			continue
+		}
+		cleaners[r] = c
+		for _, v := range vpcs {
+			action := actionKeep
+			if v.Orphaned() {
+				action = actionDelete
+			}
+			plan.VPCs = append(plan.VPCs, OrphanPlanEntry{
+				Region:     v.Region,
+				VpcID:      v.VpcID,
+				Name:       v.Name,
+				Repository: v.Repository,
+				RunID:      v.RunID,
+				ExpiresAt:  v.ExpiresAt,
+				Owner:      v.Owner,
+				Reason:     v.Reason,
+				Action:     action,
+			})
+		}
+	}
+
+	if err := formatter.Print(plan); err != nil {
+		return err
+	}
+
+	var orphans []OrphanPlanEntry
+	for _, v := range plan.VPCs {
+		if v.Action == actionDelete {
+			orphans = append(orphans, v)
+		}
+	}
+	if len(orphans) == 0 {
+		m.log.Info("No orphaned VPCs found")
+		return nil
+	}
+
+	if !m.yes {
+		if !m.interactive() {
+			m.log.Info("Found %d orphaned VPC(s); re-run with --yes to delete them", len(orphans))
+			return nil
+		}
+		if !confirm(fmt.Sprintf("Delete %d orphaned VPC(s)? [y/N]: ", len(orphans))) {
+			m.log.Info("Nothing deleted")
+			return nil
+		}
+	}
+
+	timeout := m.timeout
+	if timeout == 0 {
+		timeout = defaultCleanupTimeout
+	}
+
+	failCount := 0
+	for _, v := range orphans {
+		if ctx.Err() != nil {
+			m.log.Warning("Cleanup cancelled, skipping remaining VPCs")
+			break
+		}
+		m.log.Info("Deleting orphaned VPC %s in %s (%s)", v.VpcID, v.Region, v.Reason)
+		vpcCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := cleaners[v.Region].DeleteVPCResources(vpcCtx, v.VpcID)
+		cancel()
+		if err != nil {
+			m.log.Error(fmt.Errorf("failed to cleanup VPC %s: %w", v.VpcID, err))
+			failCount++
+		}
+	}
+
+	if failCount > 0 {
+		return fmt.Errorf("cleanup completed with errors: %d succeeded, %d failed", len(orphans)-failCount, failCount)
+	}
+	m.log.Info("Cleanup completed successfully: %d orphaned VPCs cleaned up", len(orphans))
+	return nil
+}
+
+// localOwners maps the VPC IDs recorded in local AWS cache files to their
+// instance IDs.
+func (m *command) localOwners() (map[string]string, error) {
+	cached, err := instances.NewManager(m.log, m.cachePath).ReadCache()
+	if err != nil {
+		return nil, err
+	}
+	owners := make(map[string]string)
+	for _, c := range cached {
+		if c.Environment.Spec.Provider != v1alpha1.ProviderAWS {
+			continue
+		}
+		for _, p := range c.Environment.Status.Properties {
+			if p.Name == aws.VpcID && p.Value != "" {
+				owners[p.Value] = c.ID
+			}
+		}
+	}
+	return owners, nil
+}
+
+// interactive reports whether the user can be prompted: a table plan on a
+// terminal outside CI.
+func (m *command) interactive() bool {
+	if os.Getenv("CI") == "true" || os.Getenv("HOLODECK_NONINTERACTIVE") == "true" {
+		return false
+	}
+	if output.Format(m.outputFormat) != output.FormatTable {
+		return false
+	}
+	return isatty.IsTerminal(os.Stdin.Fd())
+}
+
+func confirm(prompt string) bool {
+	fmt.Print(prompt)
+	response, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false
+	}
+	response = strings.ToLower(strings.TrimSpace(response))
+	return response == "y" || response == "yes"
+}
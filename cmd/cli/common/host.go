@@ -25,14 +25,16 @@ import (
 
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
 	"github.com/NVIDIA/holodeck/internal/logger"
-	"github.com/NVIDIA/holodeck/pkg/provider/aws"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+	_ "github.com/NVIDIA/holodeck/pkg/provider/all" // register built-in providers
 	"github.com/NVIDIA/holodeck/pkg/sshutil"
 )
 
 // GetHostURL resolves the SSH-reachable host URL for an environment.
 // If nodeName is set, it looks for that specific node.
 // If preferControlPlane is true and no nodeName is set, it prefers a control-plane node.
-// Falls back to the first available node, then single-node properties.
+// Falls back to the first available node. Single-node environments are
+// resolved through the HostAddress hook of their provider.
 func GetHostURL(env *v1alpha1.Environment, nodeName string, preferControlPlane bool) (string, error) {
 	// For multinode clusters, find the appropriate node
 	if env.Spec.Cluster != nil && env.Status.Cluster != nil && len(env.Status.Cluster.Nodes) > 0 {
@@ -57,19 +59,12 @@ func GetHostURL(env *v1alpha1.Environment, nodeName string, preferControlPlane b
 		return env.Status.Cluster.Nodes[0].PublicIP, nil
 	}
 
-	// Single node - get from properties
-	switch env.Spec.Provider {
-	case v1alpha1.ProviderAWS:
-		for _, p := range env.Status.Properties {
-			if p.Name == aws.PublicDnsName {
-				return p.Value, nil
-			}
-		}
-	case v1alpha1.ProviderSSH:
-		return env.Spec.HostUrl, nil
+	// Single node - resolved by the provider
+	hostUrl, err := provider.HostAddress(env)
+	if err != nil {
+		return "", fmt.Errorf("unable to determine host URL: %w", err)
 	}
-
-	return "", fmt.Errorf("unable to determine host URL")
+	return hostUrl, nil
 }
 
 // ConnectSSH establishes an SSH connection with retries.
@@ -77,7 +72,7 @@ func GetHostURL(env *v1alpha1.Environment, nodeName string, preferControlPlane b
 // The CLI keeps its historical 3x2s/30s-handshake envelope via an explicit
 // RetryPolicy — the Dialer's own default (20x1s/15s) is provisioner-tier,
 // not appropriate for a user-facing command that must fail fast.
-func ConnectSSH(log *logger.FunLogger, keyPath, userName, hostUrl string) (*ssh.Client, error) {
+func ConnectSSH(ctx context.Context, log *logger.FunLogger, keyPath, userName, hostUrl string) (*ssh.Client, error) {
 	d := &sshutil.Dialer{
 		Auth:     sshutil.AuthConfig{User: userName, KeyPath: keyPath},
 		HostKey:  sshutil.HostKeyPolicyAcceptNew,
@@ -85,5 +80,5 @@ func ConnectSSH(log *logger.FunLogger, keyPath, userName, hostUrl string) (*ssh.
 		Timeouts: sshutil.TimeoutConfig{Handshake: 30 * time.Second},
 		Log:      log,
 	}
-	return d.Dial(context.Background(), hostUrl, nil) //nolint:contextcheck // CLI action boundary; no ctx to thread yet
+	return d.Dial(ctx, hostUrl, nil)
 }
@@ -17,6 +17,7 @@
 package common
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
 	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/provider"
 	"github.com/NVIDIA/holodeck/pkg/provider/aws"
 	"github.com/NVIDIA/holodeck/pkg/sshutil/sshtest"
 )
@@ -37,7 +39,7 @@ func TestConnectSSH_Connects(t *testing.T) {
 	keyPath, pub := sshtest.GenerateKey(t)
 	srv := sshtest.NewServer(t, pub, sshtest.WithExecOutput("hi\n"))
 
-	client, err := ConnectSSH(logger.NewLogger(), keyPath, "tester", srv.Addr())
+	client, err := ConnectSSH(context.Background(), logger.NewLogger(), keyPath, "tester", srv.Addr())
 	require.NoError(t, err)
 	defer func() { _ = client.Close() }()
 	sess, err := client.NewSession()
@@ -51,7 +53,7 @@ func TestConnectSSH_FailsBoundedOnDeadPort(t *testing.T) {
 	t.Setenv("HOME", t.TempDir())
 	keyPath, _ := sshtest.GenerateKey(t)
 	start := time.Now()
-	_, err := ConnectSSH(logger.NewLogger(), keyPath, "tester", "127.0.0.1:1")
+	_, err := ConnectSSH(context.Background(), logger.NewLogger(), keyPath, "tester", "127.0.0.1:1")
 	require.Error(t, err)
 	assert.Less(t, time.Since(start), 15*time.Second, "3x2s envelope must not hang")
 }
@@ -83,7 +85,7 @@ func TestConnectSSH_RejectsChangedHostKey(t *testing.T) {
 	line := knownhosts.Line([]string{knownhosts.Normalize(addr)}, wrongPub)
 	require.NoError(t, os.WriteFile(knownHostsPath, []byte(line+"\n"), 0600))
 
-	_, err = ConnectSSH(logger.NewLogger(), keyPath, "tester", addr)
+	_, err = ConnectSSH(context.Background(), logger.NewLogger(), keyPath, "tester", addr)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "host key mismatch")
 }
@@ -128,6 +130,37 @@ func TestGetHostURL_SSH(t *testing.T) {
 	}
 }
 
+func TestGetHostURL_RegisteredProvider(t *testing.T) {
+	// A backend only reaches GetHostURL through its registration
+	provider.Register(provider.Registration{
+		Name: "host-url-test",
+		New: func(*logger.FunLogger, v1alpha1.Environment, string) (provider.Provider, error) {
+			return nil, nil
+		},
+		HostAddress: func(env *v1alpha1.Environment) string {
+			return env.Status.Properties[0].Value
+		},
+	})
+	env := &v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			Provider: "host-url-test",
+		},
+		Status: v1alpha1.EnvironmentStatus{
+			Properties: []v1alpha1.Properties{
+				{Name: "address", Value: "203.0.113.10"},
+			},
+		},
+	}
+
+	url, err := GetHostURL(env, "", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if url != "203.0.113.10" {
+		t.Errorf("expected 203.0.113.10, got %s", url)
+	}
+}
+
 func TestGetHostURL_NoProperties(t *testing.T) {
 	env := &v1alpha1.Environment{
 		Spec: v1alpha1.EnvironmentSpec{
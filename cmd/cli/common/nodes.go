@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/provisioner"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// joinFailedReason is the reason of the degraded condition set when nodes
+// added to a cluster fail to join it
+const joinFailedReason = "JoinFailed"
+
+// NodeInfos converts cluster node status entries to the node descriptions
+// the cluster provisioner works with.
+func NodeInfos(nodes []v1alpha1.NodeStatus) []provisioner.NodeInfo {
+	infos := make([]provisioner.NodeInfo, 0, len(nodes))
+	for _, node := range nodes {
+		infos = append(infos, provisioner.NodeInfo{
+			Name:        node.Name,
+			PublicIP:    node.PublicIP,
+			PrivateIP:   node.PrivateIP,
+			Role:        node.Role,
+			Pool:        node.Pool,
+			SSHUsername: node.SSHUsername,
+			InstanceID:  node.InstanceID,
+		})
+	}
+	return infos
+}
+
+// MarkNodesReady records that every node of a cluster was provisioned.
+func MarkNodesReady(cluster *v1alpha1.ClusterStatus) {
+	if cluster == nil {
+		return
+	}
+	for i := range cluster.Nodes {
+		cluster.Nodes[i].Phase = "Ready"
+	}
+	// #nosec G115 -- node count is bounded by the cluster spec, will never overflow int32
+	cluster.ReadyNodes = int32(len(cluster.Nodes))
+	cluster.Phase = "Ready"
+}
+
+// MarkNodesJoined records that the given nodes, added to a running cluster,
+// joined it.
+func MarkNodesJoined(cluster *v1alpha1.ClusterStatus, nodes []v1alpha1.NodeStatus) {
+	if cluster == nil {
+		return
+	}
+	var ready int32
+	for i := range cluster.Nodes {
+		if slices.ContainsFunc(nodes, func(n v1alpha1.NodeStatus) bool { return n.Name == cluster.Nodes[i].Name }) {
+			cluster.Nodes[i].Phase = "Ready"
+		}
+		if cluster.Nodes[i].Phase == "Ready" {
+			ready++
+		}
+	}
+	cluster.ReadyNodes = ready
+}
+
+// RecordJoin records in the cache file of a cluster whether the nodes just
+// added to it joined: they are marked Ready if joinErr is nil, and the
+// environment Degraded otherwise. It returns joinErr.
+func RecordJoin(cacheFile string, nodes []v1alpha1.NodeStatus, joinErr error) error {
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](cacheFile)
+	if err != nil {
+		return fmt.Errorf("failed to read environment: %w", err)
+	}
+	if joinErr != nil {
+		names := make([]string, 0, len(nodes))
+		for _, n := range nodes {
+			names = append(names, n.Name)
+		}
+		meta.SetStatusCondition(&env.Status.Conditions, metav1.Condition{
+			Type:    v1alpha1.ConditionDegraded,
+			Status:  metav1.ConditionTrue,
+			Reason:  joinFailedReason,
+			Message: fmt.Sprintf("Failed to join %s: %v", strings.Join(names, ", "), joinErr),
+		})
+	} else {
+		MarkNodesJoined(env.Status.Cluster, nodes)
+	}
+
+	data, err := jyaml.MarshalYAML(env)
+	if err != nil {
+		return fmt.Errorf("failed to marshal environment: %w", err)
+	}
+	if err := os.WriteFile(cacheFile, data, 0600); err != nil {
+		return fmt.Errorf("failed to update cache file: %w", err)
+	}
+	return joinErr
+}
+
+// FirstControlPlane returns the first control-plane node of a cluster
+// environment, which holds the admin kubeconfig and mints join tokens.
+func FirstControlPlane(env *v1alpha1.Environment) (provisioner.NodeInfo, error) {
+	if env.Status.Cluster != nil {
+		for _, n := range env.Status.Cluster.Nodes {
+			if n.Role == "control-plane" {
+				return NodeInfos([]v1alpha1.NodeStatus{n})[0], nil
+			}
+		}
+	}
+	return provisioner.NodeInfo{}, fmt.Errorf("no control-plane node found in cluster status")
+}
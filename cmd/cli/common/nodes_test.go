@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+)
+
+func TestFirstControlPlane(t *testing.T) {
+	env := &v1alpha1.Environment{
+		Status: v1alpha1.EnvironmentStatus{
+			Cluster: &v1alpha1.ClusterStatus{
+				Nodes: []v1alpha1.NodeStatus{
+					{Name: "worker-0", Role: "worker", Pool: "gpu", PrivateIP: "10.0.0.1"},
+					{Name: "cp-0", Role: "control-plane", PrivateIP: "10.0.0.2", InstanceID: "i-cp"},
+				},
+			},
+		},
+	}
+
+	cp, err := FirstControlPlane(env)
+	require.NoError(t, err)
+	assert.Equal(t, "cp-0", cp.Name)
+	assert.Equal(t, "10.0.0.2", cp.PrivateIP)
+	assert.Equal(t, "i-cp", cp.InstanceID)
+
+	infos := NodeInfos(env.Status.Cluster.Nodes)
+	require.Len(t, infos, 2)
+	assert.Equal(t, "gpu", infos[0].Pool)
+
+	_, err = FirstControlPlane(&v1alpha1.Environment{})
+	assert.EqualError(t, err, "no control-plane node found in cluster status")
+}
+
+func TestMarkNodesReady(t *testing.T) {
+	cluster := &v1alpha1.ClusterStatus{
+		Nodes: []v1alpha1.NodeStatus{
+			{Name: "cp-0", Role: "control-plane", Phase: "Pending"},
+			{Name: "worker-0", Role: "worker", Phase: "Pending"},
+		},
+		TotalNodes: 2,
+		Phase:      "Pending",
+	}
+	MarkNodesReady(cluster)
+
+	assert.Equal(t, "Ready", cluster.Phase)
+	assert.Equal(t, int32(2), cluster.ReadyNodes)
+	for _, n := range cluster.Nodes {
+		assert.Equal(t, "Ready", n.Phase)
+	}
+
+	MarkNodesReady(nil)
+}
+
+func TestMarkNodesJoined(t *testing.T) {
+	cluster := &v1alpha1.ClusterStatus{
+		Nodes: []v1alpha1.NodeStatus{
+			{Name: "cp-0", Role: "control-plane", Phase: "Ready"},
+			{Name: "worker-0", Role: "worker", Phase: "Pending"},
+			{Name: "worker-1", Role: "worker", Phase: "Pending"},
+		},
+		TotalNodes: 3,
+		ReadyNodes: 1,
+	}
+	MarkNodesJoined(cluster, []v1alpha1.NodeStatus{{Name: "worker-1"}})
+
+	assert.Equal(t, int32(2), cluster.ReadyNodes)
+	assert.Equal(t, "Pending", cluster.Nodes[1].Phase, "only the joined nodes become Ready")
+	assert.Equal(t, "Ready", cluster.Nodes[2].Phase)
+
+	MarkNodesJoined(nil, nil)
+}
+
+func TestRecordJoin(t *testing.T) {
+	writeCache := func(t *testing.T) string {
+		cacheFile := filepath.Join(t.TempDir(), "cache.yaml")
+		data, err := jyaml.MarshalYAML(v1alpha1.Environment{
+			Status: v1alpha1.EnvironmentStatus{
+				Cluster: &v1alpha1.ClusterStatus{
+					Nodes: []v1alpha1.NodeStatus{
+						{Name: "cp-0", Role: "control-plane", Phase: "Ready"},
+						{Name: "worker-0", Role: "worker", Phase: "Pending"},
+					},
+					TotalNodes: 2,
+					ReadyNodes: 1,
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(cacheFile, data, 0600))
+		return cacheFile
+	}
+	added := []v1alpha1.NodeStatus{{Name: "worker-0", Role: "worker"}}
+
+	t.Run("joined", func(t *testing.T) {
+		cacheFile := writeCache(t)
+		require.NoError(t, RecordJoin(cacheFile, added, nil))
+
+		env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](cacheFile)
+		require.NoError(t, err)
+		assert.Equal(t, "Ready", env.Status.Cluster.Nodes[1].Phase)
+		assert.Equal(t, int32(2), env.Status.Cluster.ReadyNodes)
+		assert.False(t, meta.IsStatusConditionTrue(env.Status.Conditions, v1alpha1.ConditionDegraded))
+	})
+
+	t.Run("join failed", func(t *testing.T) {
+		cacheFile := writeCache(t)
+		joinErr := errors.New("kubeadm join timed out")
+		assert.ErrorIs(t, RecordJoin(cacheFile, added, joinErr), joinErr)
+
+		env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](cacheFile)
+		require.NoError(t, err)
+		assert.Equal(t, "Pending", env.Status.Cluster.Nodes[1].Phase)
+		assert.Equal(t, int32(1), env.Status.Cluster.ReadyNodes)
+		degraded := meta.FindStatusCondition(env.Status.Conditions, v1alpha1.ConditionDegraded)
+		require.NotNil(t, degraded)
+		assert.Equal(t, "JoinFailed", degraded.Reason)
+		assert.Contains(t, degraded.Message, "Failed to join worker-0: kubeadm join timed out")
+	})
+}
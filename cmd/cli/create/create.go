@@ -23,13 +23,15 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/cmd/cli/common"
 	"github.com/NVIDIA/holodeck/internal/instances"
 	"github.com/NVIDIA/holodeck/internal/logger"
 	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/lock"
 	"github.com/NVIDIA/holodeck/pkg/provider"
-	"github.com/NVIDIA/holodeck/pkg/provider/aws"
 	"github.com/NVIDIA/holodeck/pkg/provisioner"
 	"github.com/NVIDIA/holodeck/pkg/utils"
 
@@ -43,6 +45,7 @@ type options struct {
 	cachePath      string
 	cacheFile      string
 	envFile        string
+	lockFile       string
 	kubeconfig     string
 
 	cfg   v1alpha1.Environment
@@ -103,6 +106,11 @@ func (m command) build() *cli.Command {
 				Usage:       "Path to the Environment file",
 				Destination: &opts.envFile,
 			},
+			&cli.StringFlag{
+				Name:        "lock",
+				Usage:       "Path to a lock file (see 'holodeck lock') pinning the environment's moving references",
+				Destination: &opts.lockFile,
+			},
 		},
 		Before: func(ctx context.Context, _ *cli.Command) (context.Context, error) {
 			// Read the config file
@@ -112,6 +120,11 @@ func (m command) build() *cli.Command {
 				return ctx, fmt.Errorf("error reading config file: %w", err)
 			}
 
+			// Reject a malformed bring-your-own host inventory up front.
+			if err := opts.cfg.Spec.ValidateHostInventory(); err != nil {
+				return ctx, fmt.Errorf("invalid cluster hosts in %s: %w", opts.envFile, err)
+			}
+
 			// Reject a malformed sshConfig up front, before any cloud/SSH action.
 			if err := opts.cfg.Spec.SSHConfig.Validate(); err != nil {
 				return ctx, fmt.Errorf("invalid sshConfig in %s: %w", opts.envFile, err)
@@ -124,6 +137,23 @@ func (m command) build() *cli.Command {
 				return ctx, err
 			}
 
+			if err := opts.cfg.Spec.ValidateExpiry(); err != nil {
+				return ctx, fmt.Errorf("invalid expiry in %s: %w", opts.envFile, err)
+			}
+
+			// Pin tracked branches, driver branches and images to the
+			// values recorded in the lock file
+			if opts.lockFile != "" {
+				l, err := lock.Load(opts.lockFile)
+				if err != nil {
+					return ctx, err
+				}
+				if err := lock.Apply(&opts.cfg, l); err != nil {
+					return ctx, fmt.Errorf("cannot apply %s: %w", opts.lockFile, err)
+				}
+				m.log.Info("Using versions locked in %s", opts.lockFile)
+			}
+
 			// if no containerruntime is specified, default to none
 			if opts.cfg.Spec.ContainerRuntime.Name == "" {
 				opts.cfg.Spec.ContainerRuntime.Name = v1alpha1.ContainerRuntimeNone
@@ -139,19 +169,15 @@ func (m command) build() *cli.Command {
 
 			return ctx, nil
 		},
-		Action: func(_ context.Context, _ *cli.Command) error {
-			//nolint:contextcheck // aws.New (pkg/provider/aws) has no ctx parameter by design; threading requires a pkg/ signature change, out of scope here.
-			return m.run(&opts)
+		Action: func(ctx context.Context, _ *cli.Command) error {
+			return m.run(ctx, &opts)
 		},
 	}
 
 	return &create
 }
 
-func (m command) run(opts *options) error {
-	var provider provider.Provider
-	var err error
-
+func (m command) run(ctx context.Context, opts *options) error {
 	// Create instance manager and generate unique ID
 	manager := instances.NewManager(m.log, opts.cachePath)
 	instanceID, err := manager.GenerateInstanceID()
@@ -170,37 +196,26 @@ func (m command) run(opts *options) error {
 	opts.cfg.Labels[instances.InstanceLabelKey] = instanceID
 	opts.cfg.Labels[instances.InstanceProvisionedLabelKey] = "false"
 
-	switch opts.cfg.Spec.Provider {
-	case v1alpha1.ProviderAWS:
-		if opts.cfg.Spec.Username == "" {
-			// TODO (ArangoGutierrez): This should be based on the OS
-			// Amazon Linux: ec2-user
-			// Ubuntu: ubuntu
-			// CentOS: centos
-			// Debian: admin
-			// RHEL: ec2-user
-			// Fedora: ec2-user
-			// SUSE: ec2-user
-			opts.cfg.Spec.Username = "ubuntu"
-		}
-		provider, err = aws.New(m.log, opts.cfg, opts.cacheFile)
-		if err != nil {
-			return err
-		}
+	opts.cfg.Spec.ResolveTTL(time.Now())
 
-	case v1alpha1.ProviderSSH:
-		if opts.cfg.Spec.Username == "" {
-			opts.cfg.Spec.Username = os.Getenv("USER")
-		}
-		m.log.Info("SSH infrastructure \u2601")
-		opts.cache = opts.cfg
+	// Resolve the provider backend from the registry
+	reg, err := provider.Lookup(string(opts.cfg.Spec.Provider))
+	if err != nil {
+		return err
+	}
+	if opts.cfg.Spec.Cluster != nil && !reg.Capabilities.Multinode {
+		return fmt.Errorf("provider %s does not support cluster mode", reg.Name)
+	}
+	if reg.Defaults != nil {
+		reg.Defaults(&opts.cfg)
+	}
+	p, err := reg.New(m.log, opts.cfg, opts.cacheFile)
+	if err != nil {
+		return err
 	}
 
-	if provider != nil {
-		err = provider.Create()
-		if err != nil {
-			return err
-		}
+	if err := p.Create(ctx); err != nil {
+		return err
 	}
 
 	// Read cache after creating the environment
@@ -210,10 +225,10 @@ func (m command) run(opts *options) error {
 	}
 
 	if opts.provision {
-		err := runProvision(m.log, opts)
+		err := runProvision(ctx, m.log, opts)
 		if err != nil {
 			// Handle provisioning failure with user interaction
-			return m.handleProvisionFailure(instanceID, opts.cacheFile, err)
+			return m.handleProvisionFailure(ctx, instanceID, opts.cacheFile, err)
 		}
 	}
 
@@ -233,23 +248,85 @@ func (m *command) showSuccessMessage(instanceID string, opts *options) {
 	}
 }
 
-func (m *command) showClusterSuccessMessage(instanceID string, opts *options) {
-	cluster := opts.cfg.Spec.Cluster
-	cpCount := cluster.ControlPlane.Count
+// clusterNodeCounts returns the number of control-plane and worker nodes,
+// and the number of nodes of each worker pool of spec.WorkerPoolList(). The
+// nodes recorded in status are counted when the provider reported them, the
+// spec otherwise.
+func clusterNodeCounts(spec *v1alpha1.ClusterSpec, status *v1alpha1.ClusterStatus) (int32, int32, []int32) {
+	pools := spec.WorkerPoolList()
+	poolCounts := make([]int32, len(pools))
+	if status != nil && len(status.Nodes) > 0 {
+		var cpCount, workerCount int32
+		for _, node := range status.Nodes {
+			switch node.Role {
+			case "control-plane":
+				cpCount++
+			case "worker":
+				workerCount++
+				for i, w := range pools {
+					if w.Name == node.Pool {
+						poolCounts[i]++
+						break
+					}
+				}
+			}
+		}
+		return cpCount, workerCount, poolCounts
+	}
+
+	cpCount := spec.ControlPlane.Count
+	// Bring-your-own host pools are sized by their inventory
+	// #nosec G115 -- host lists are user-provided and small, will never overflow int32
+	if n := int32(len(spec.ControlPlane.Hosts)); n > 0 {
+		cpCount = n
+	}
 	workerCount := int32(0)
-	if cluster.Workers != nil {
-		workerCount = cluster.Workers.Count
+	for i, w := range pools {
+		poolCounts[i] = w.Count
+		// #nosec G115 -- host lists are user-provided and small, will never overflow int32
+		if n := int32(len(w.Hosts)); n > 0 {
+			poolCounts[i] = n
+		}
+		workerCount += poolCounts[i]
+	}
+	return cpCount, workerCount, poolCounts
+}
+
+// instanceTypeSuffix formats the instance type of a node group for the
+// cluster summary, if the provider has one.
+func instanceTypeSuffix(instanceType string) string {
+	if instanceType == "" {
+		return ""
 	}
+	return fmt.Sprintf(" (%s)", instanceType)
+}
+
+func (m *command) showClusterSuccessMessage(instanceID string, opts *options) {
+	cluster := opts.cfg.Spec.Cluster
+	pools := cluster.WorkerPoolList()
+	cpCount, workerCount, poolCounts := clusterNodeCounts(cluster, opts.cache.Status.Cluster)
 	totalNodes := cpCount + workerCount
 
 	m.log.Info("\n✅ Successfully created cluster: %s\n", instanceID)
 
 	// Show cluster summary
 	m.log.Info("📊 Cluster Summary:")
-	m.log.Info("   Region: %s", cluster.Region)
-	m.log.Info("   Control Plane Nodes: %d (%s)", cpCount, cluster.ControlPlane.InstanceType)
-	if workerCount > 0 {
-		m.log.Info("   Worker Nodes: %d (%s)", workerCount, cluster.Workers.InstanceType)
+	if opts.cfg.Spec.Provider != "" {
+		m.log.Info("   Provider: %s", opts.cfg.Spec.Provider)
+	}
+	if cluster.Region != "" {
+		m.log.Info("   Region: %s", cluster.Region)
+	}
+	m.log.Info("   Control Plane Nodes: %d%s", cpCount, instanceTypeSuffix(cluster.ControlPlane.InstanceType))
+	if len(cluster.WorkerPools) > 0 {
+		m.log.Info("   Worker Nodes: %d", workerCount)
+		for i, w := range pools {
+			m.log.Info("     - %s: %d%s", w.Name, poolCounts[i], instanceTypeSuffix(w.InstanceType))
+		}
+	} else if len(pools) > 0 && workerCount > 0 {
+		m.log.Info("   Worker Nodes: %d%s", workerCount, instanceTypeSuffix(pools[0].InstanceType))
+	} else if workerCount > 0 {
+		m.log.Info("   Worker Nodes: %d", workerCount)
 	}
 	m.log.Info("   Total Nodes: %d\n", totalNodes)
 
@@ -303,18 +380,8 @@ func (m *command) showClusterSuccessMessage(instanceID string, opts *options) {
 func (m *command) showSingleNodeSuccessMessage(instanceID string, opts *options) {
 	m.log.Info("\n✅ Successfully created instance: %s\n", instanceID)
 
-	// Get public DNS name for AWS instances
-	var publicDnsName string
-	if opts.cfg.Spec.Provider == v1alpha1.ProviderAWS {
-		for _, p := range opts.cache.Status.Properties {
-			if p.Name == aws.PublicDnsName {
-				publicDnsName = p.Value
-				break
-			}
-		}
-	} else if opts.cfg.Spec.Provider == v1alpha1.ProviderSSH {
-		publicDnsName = opts.cfg.Spec.HostUrl
-	}
+	// Get the host address of the instance
+	publicDnsName, _ := singleNodeHostURL(opts)
 
 	// Show SSH connection instructions if we have a public DNS name
 	if publicDnsName != "" && opts.cfg.Spec.Username != "" && opts.cfg.Spec.PrivateKey != "" {
@@ -358,12 +425,14 @@ func (m *command) showSingleNodeSuccessMessage(instanceID string, opts *options)
 	m.log.Info("   - Delete instance: holodeck delete %s", instanceID)
 }
 
-func (m *command) handleProvisionFailure(instanceID, cacheFile string, provisionErr error) error {
+func (m *command) handleProvisionFailure(ctx context.Context, instanceID, cacheFile string, provisionErr error) error {
 	m.log.Info("\n❌ Provisioning failed: %v\n", provisionErr)
 
-	// Check if we're in a non-interactive environment
-	if os.Getenv("CI") == "true" || os.Getenv("HOLODECK_NONINTERACTIVE") == "true" {
-		m.log.Info("\n💡 To clean up the failed instance, run:")
+	// Check if we're in a non-interactive environment or were interrupted
+	if os.Getenv("CI") == "true" || os.Getenv("HOLODECK_NONINTERACTIVE") == "true" || ctx.Err() != nil {
+		m.log.Info("\n💡 To resume at the component that failed, run:")
+		m.log.Info("    holodeck provision %s --resume\n", instanceID)
+		m.log.Info("💡 To clean up the failed instance, run:")
 		m.log.Info("    holodeck delete %s\n", instanceID)
 		m.log.Info("💡 To list all instances:")
 		m.log.Info("    holodeck list\n")
@@ -387,7 +456,7 @@ func (m *command) handleProvisionFailure(instanceID, cacheFile string, provision
 		// Extract the directory path from the cache file path
 		cacheDir := filepath.Dir(cacheFile)
 		manager := instances.NewManager(m.log, cacheDir)
-		if err := manager.DeleteInstance(instanceID); err != nil {
+		if err := manager.DeleteInstance(ctx, instanceID); err != nil {
 			m.log.Info("Failed to delete instance: %v", err)
 			return m.provideCleanupInstructions(instanceID, provisionErr)
 		}
@@ -402,6 +471,8 @@ func (m *command) handleProvisionFailure(instanceID, cacheFile string, provision
 func (m *command) provideCleanupInstructions(instanceID string, provisionErr error) error {
 	m.log.Info("\n💡 The instance was created but provisioning failed.")
 	m.log.Info("   You can manually investigate or clean up using the following commands:\n")
+	m.log.Info("   To resume at the component that failed:")
+	m.log.Info("     holodeck provision %s --resume\n", instanceID)
 	m.log.Info("   To delete this specific instance:")
 	m.log.Info("     holodeck delete %s\n", instanceID)
 	m.log.Info("   To list all instances:")
@@ -417,7 +488,7 @@ func (m *command) provideCleanupInstructions(instanceID string, provisionErr err
 	return fmt.Errorf("provisioning failed: %w", provisionErr)
 }
 
-func runProvision(log *logger.FunLogger, opts *options) error {
+func runProvision(ctx context.Context, log *logger.FunLogger, opts *options) error {
 	log.Info("Provisioning \u2699")
 
 	// Copy cache status into the environment
@@ -425,35 +496,35 @@ func runProvision(log *logger.FunLogger, opts *options) error {
 
 	// Check if this is a multinode cluster
 	if opts.cfg.Spec.Cluster != nil && opts.cache.Status.Cluster != nil {
-		return runMultinodeProvision(log, opts)
+		return runMultinodeProvision(ctx, log, opts)
 	}
 
 	// Single-node provisioning
-	return runSingleNodeProvision(log, opts)
+	return runSingleNodeProvision(ctx, log, opts)
 }
 
-func runSingleNodeProvision(log *logger.FunLogger, opts *options) error {
-	var hostUrl string
+// singleNodeHostURL resolves the SSH-reachable address of a single-node
+// environment from the requested spec and the status recorded by the provider.
+func singleNodeHostURL(opts *options) (string, error) {
+	env := opts.cfg
+	env.Status = opts.cache.Status
+	return common.GetHostURL(&env, "", false)
+}
 
-	if opts.cfg.Spec.Provider == v1alpha1.ProviderAWS {
-		for _, p := range opts.cache.Status.Properties {
-			if p.Name == aws.PublicDnsName {
-				hostUrl = p.Value
-				break
-			}
-		}
-	} else if opts.cfg.Spec.Provider == v1alpha1.ProviderSSH {
-		hostUrl = opts.cfg.Spec.HostUrl
+func runSingleNodeProvision(ctx context.Context, log *logger.FunLogger, opts *options) error {
+	hostUrl, err := singleNodeHostURL(opts)
+	if err != nil {
+		return err
 	}
 
-	p, err := provisioner.New(log, opts.cfg.Spec.PrivateKey, opts.cfg.Spec.Username, hostUrl,
+	p, err := provisioner.New(ctx, log, opts.cfg.Spec.PrivateKey, opts.cfg.Spec.Username, hostUrl,
 		provisioner.WithSSHConfig(opts.cfg.Spec.SSHConfig))
 	if err != nil {
 		return err
 	}
 	defer p.Client.Close() // nolint: errcheck
 
-	componentsStatus, runErr := p.Run(opts.cfg)
+	componentsStatus, runErr := p.Run(ctx, opts.cfg)
 	if runErr != nil {
 		// Set degraded condition when provisioning fails
 		opts.cfg.Status.Conditions = []metav1.Condition{
@@ -493,14 +564,7 @@ func runSingleNodeProvision(log *logger.FunLogger, opts *options) error {
 			return nil
 		}
 
-		hostUrl := ""
-		for _, p := range opts.cache.Status.Properties {
-			if p.Name == aws.PublicDnsName {
-				hostUrl = p.Value
-				break
-			}
-		}
-		if err = utils.GetKubeConfig(log, &opts.cache, hostUrl, opts.kubeconfig); err != nil {
+		if err = utils.GetKubeConfig(ctx, log, &opts.cache, hostUrl, opts.kubeconfig); err != nil {
 			return fmt.Errorf("failed to get kubeconfig: %w", err)
 		}
 		if err := utils.ApplyRemoteAccess(&opts.cache, hostUrl, opts.kubeconfig); err != nil {
@@ -511,7 +575,7 @@ func runSingleNodeProvision(log *logger.FunLogger, opts *options) error {
 	return nil
 }
 
-func runMultinodeProvision(log *logger.FunLogger, opts *options) error {
+func runMultinodeProvision(ctx context.Context, log *logger.FunLogger, opts *options) error {
 	log.Info("Provisioning multinode cluster...")
 
 	// Build node list from cluster status, wiring SSM transport for private-subnet nodes
@@ -520,6 +584,8 @@ func runMultinodeProvision(log *logger.FunLogger, opts *options) error {
 		region = opts.cfg.Spec.Cluster.Region
 	}
 	nodes := buildClusterNodeInfoList(opts.cache.Status.Cluster.Nodes, region)
+	// Bring-your-own hosts carry their own key and bastion
+	nodes = provisioner.ApplyHostInventory(log, &opts.cfg.Spec, nodes)
 
 	if len(nodes) == 0 {
 		return fmt.Errorf("no nodes found in cluster status")
@@ -534,7 +600,7 @@ func runMultinodeProvision(log *logger.FunLogger, opts *options) error {
 	)
 
 	// Provision the cluster
-	if err := cp.ProvisionCluster(nodes); err != nil {
+	if err := cp.ProvisionCluster(ctx, nodes); err != nil {
 		// Set degraded condition when provisioning fails
 		opts.cfg.Status.Conditions = []metav1.Condition{
 			{
@@ -557,6 +623,7 @@ func runMultinodeProvision(log *logger.FunLogger, opts *options) error {
 
 	// Set provisioning status to true after successful provisioning
 	opts.cfg.Labels[instances.InstanceProvisionedLabelKey] = "true"
+	common.MarkNodesReady(opts.cfg.Status.Cluster)
 	data, err := jyaml.MarshalYAML(opts.cfg)
 	if err != nil {
 		return fmt.Errorf("failed to marshal environment: %w", err)
@@ -576,7 +643,7 @@ func runMultinodeProvision(log *logger.FunLogger, opts *options) error {
 			}
 		}
 		if hostUrl != "" {
-			if err := utils.GetKubeConfig(log, &opts.cache, hostUrl, opts.kubeconfig); err != nil {
+			if err := utils.GetKubeConfig(ctx, log, &opts.cache, hostUrl, opts.kubeconfig); err != nil {
 				return fmt.Errorf("failed to get kubeconfig: %w", err)
 			}
 			if err := utils.ApplyRemoteAccess(&opts.cache, hostUrl, opts.kubeconfig); err != nil {
@@ -599,6 +666,7 @@ func buildClusterNodeInfoList(statusNodes []v1alpha1.NodeStatus, region string)
 			PublicIP:    node.PublicIP,
 			PrivateIP:   node.PrivateIP,
 			Role:        node.Role,
+			Pool:        node.Pool,
 			SSHUsername: node.SSHUsername,
 			InstanceID:  node.InstanceID,
 		}
@@ -650,10 +718,7 @@ func shouldPromptForDedicatedCP(opts *options) bool {
 	}
 
 	// Calculate total node count
-	totalNodes := opts.cfg.Spec.Cluster.ControlPlane.Count
-	if opts.cfg.Spec.Cluster.Workers != nil {
-		totalNodes += opts.cfg.Spec.Cluster.Workers.Count
-	}
+	totalNodes := opts.cfg.Spec.Cluster.ControlPlane.Count + opts.cfg.Spec.Cluster.WorkerCount()
 
 	// Only prompt if we're at or above the threshold
 	return totalNodes >= dedicatedCPThreshold
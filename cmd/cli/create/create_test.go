@@ -171,6 +171,64 @@ func TestShowSuccessMessage(t *testing.T) {
 				"Kubeconfig saved to:",
 			},
 		},
+		{
+			name:       "AWS cluster from spec",
+			instanceID: "aws-cluster",
+			opts: &options{
+				cfg: v1alpha1.Environment{
+					Spec: v1alpha1.EnvironmentSpec{
+						Provider: v1alpha1.ProviderAWS,
+						Cluster: &v1alpha1.ClusterSpec{
+							Region:       "us-west-2",
+							ControlPlane: v1alpha1.ControlPlaneSpec{Count: 3, InstanceType: "m5.xlarge"},
+							Workers:      &v1alpha1.WorkerPoolSpec{Count: 2, InstanceType: "g4dn.xlarge"},
+						},
+					},
+				},
+			},
+			expectedOutput: []string{
+				"✅ Successfully created cluster: aws-cluster",
+				"Provider: aws",
+				"Region: us-west-2",
+				"Control Plane Nodes: 3 (m5.xlarge)",
+				"Worker Nodes: 2 (g4dn.xlarge)",
+				"Total Nodes: 5",
+			},
+		},
+		{
+			name:       "plugin cluster from status",
+			instanceID: "plugin-cluster",
+			opts: &options{
+				cfg: v1alpha1.Environment{
+					Spec: v1alpha1.EnvironmentSpec{
+						Provider: "lab",
+						Cluster:  &v1alpha1.ClusterSpec{},
+					},
+				},
+				cache: v1alpha1.Environment{
+					Status: v1alpha1.EnvironmentStatus{
+						Cluster: &v1alpha1.ClusterStatus{
+							Nodes: []v1alpha1.NodeStatus{
+								{Name: "cp-0", Role: "control-plane", PublicIP: "198.51.100.10"},
+								{Name: "worker-0", Role: "worker", PublicIP: "198.51.100.11"},
+								{Name: "worker-1", Role: "worker", PublicIP: "198.51.100.12"},
+							},
+						},
+					},
+				},
+			},
+			expectedOutput: []string{
+				"Provider: lab",
+				"Control Plane Nodes: 1\n",
+				"Worker Nodes: 2\n",
+				"Total Nodes: 3",
+				"- worker-0 (worker): 198.51.100.11",
+			},
+			notExpected: []string{
+				"Region:",
+				"()",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -229,6 +287,7 @@ func TestHandleProvisionFailure(t *testing.T) {
 			expectedError: "provisioning failed",
 			expectedOutput: []string{
 				"❌ Provisioning failed:",
+				"holodeck provision i-12345 --resume",
 				"💡 To clean up the failed instance, run:",
 				"holodeck delete i-12345",
 				"💡 To list all instances:",
@@ -276,7 +335,7 @@ func TestHandleProvisionFailure(t *testing.T) {
 			cmd := &command{log: log}
 
 			// Call the function
-			err := cmd.handleProvisionFailure(tt.instanceID, tt.cachePath, tt.provisionErr)
+			err := cmd.handleProvisionFailure(context.Background(), tt.instanceID, tt.cachePath, tt.provisionErr)
 
 			// Check error
 			require.Error(t, err)
@@ -312,6 +371,7 @@ func TestProvideCleanupInstructions(t *testing.T) {
 	expectedOutputs := []string{
 		"💡 The instance was created but provisioning failed",
 		"You can manually investigate or clean up using the following commands:",
+		"holodeck provision test-instance --resume",
 		"To delete this specific instance:",
 		"holodeck delete test-instance",
 		"To list all instances:",
@@ -421,7 +481,7 @@ func TestRunProvision(t *testing.T) {
 			log.Out = &buf
 
 			// Call the function
-			err := runProvision(log, tt.opts)
+			err := runProvision(context.Background(), log, tt.opts)
 
 			// Check error
 			if tt.expectedError != "" {
@@ -591,7 +651,7 @@ func TestCreateSSHProviderDoesNotPanic(t *testing.T) {
 
 	// Should not panic — should either succeed or return an error
 	assert.NotPanics(t, func() {
-		_ = cmd.run(opts)
+		_ = cmd.run(context.Background(), opts)
 	})
 }
 
@@ -17,6 +17,7 @@
 package create
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -68,7 +69,7 @@ func TestRunSingleNodeProvision_StrictPolicyReachesDialer(t *testing.T) {
 		},
 	}
 
-	err := runSingleNodeProvision(logger.NewLogger(), opts)
+	err := runSingleNodeProvision(context.Background(), logger.NewLogger(), opts)
 	require.Error(t, err, "strict policy against an unknown host must reject the dial")
 	assert.Contains(t, err.Error(), "strict",
 		"the strict host-key policy must reach the Dialer through the production "+
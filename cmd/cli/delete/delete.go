@@ -55,30 +55,30 @@ func (m command) build() *cli.Command {
 				Value:       filepath.Join(os.Getenv("HOME"), ".cache", "holodeck"),
 			},
 		},
-		Action: func(_ context.Context, cmd *cli.Command) error {
+		Action: func(ctx context.Context, cmd *cli.Command) error {
 			if cmd.NArg() == 0 {
 				return fmt.Errorf("at least one instance ID is required")
 			}
-			return m.run(cmd)
+			return m.run(ctx, cmd)
 		},
 	}
 
 	return &delete
 }
 
-func (m command) run(cmd *cli.Command) error {
+func (m command) run(ctx context.Context, cmd *cli.Command) error {
 	manager := instances.NewManager(m.log, m.cachePath)
 
 	// Process each instance ID provided as an argument
 	for _, instanceID := range cmd.Args().Slice() {
 		// First check if the instance exists
-		instance, err := manager.GetInstance(instanceID)
+		instance, err := manager.GetInstance(ctx, instanceID)
 		if err != nil {
 			return fmt.Errorf("failed to get instance %s: %w", instanceID, err)
 		}
 
 		// Delete the instance
-		if err := manager.DeleteInstance(instanceID); err != nil {
+		if err := manager.DeleteInstance(ctx, instanceID); err != nil {
 			return fmt.Errorf("failed to delete instance %s: %w", instanceID, err)
 		}
 
@@ -70,6 +70,7 @@ type ClusterInfo struct {
 	Region           string           `json:"region" yaml:"region"`
 	ControlPlane     ControlPlaneInfo `json:"controlPlane" yaml:"controlPlane"`
 	Workers          *WorkersInfo     `json:"workers,omitempty" yaml:"workers,omitempty"`
+	WorkerPools      []WorkersInfo    `json:"workerPools,omitempty" yaml:"workerPools,omitempty"`
 	HighAvailability *HAInfo          `json:"highAvailability,omitempty" yaml:"highAvailability,omitempty"`
 	Phase            string           `json:"phase,omitempty" yaml:"phase,omitempty"`
 	TotalNodes       int32            `json:"totalNodes,omitempty" yaml:"totalNodes,omitempty"`
@@ -88,6 +89,7 @@ type ControlPlaneInfo struct {
 
 // WorkersInfo contains worker pool configuration
 type WorkersInfo struct {
+	Name         string `json:"name,omitempty" yaml:"name,omitempty"`
 	Count        int32  `json:"count" yaml:"count"`
 	InstanceType string `json:"instanceType" yaml:"instanceType"`
 }
@@ -103,9 +105,11 @@ type HAInfo struct {
 type NodeInfo struct {
 	Name       string `json:"name" yaml:"name"`
 	Role       string `json:"role" yaml:"role"`
+	Pool       string `json:"pool,omitempty" yaml:"pool,omitempty"`
 	InstanceID string `json:"instanceId,omitempty" yaml:"instanceId,omitempty"`
 	PublicIP   string `json:"publicIP,omitempty" yaml:"publicIP,omitempty"`
 	PrivateIP  string `json:"privateIP,omitempty" yaml:"privateIP,omitempty"`
+	Market     string `json:"market,omitempty" yaml:"market,omitempty"`
 	Phase      string `json:"phase" yaml:"phase"`
 }
 
@@ -125,49 +129,53 @@ type KernelInfo struct {
 
 // NVIDIADriverInfo contains NVIDIA driver configuration
 type NVIDIADriverInfo struct {
-	Install bool   `json:"install" yaml:"install"`
-	Source  string `json:"source,omitempty" yaml:"source,omitempty"`
-	Branch  string `json:"branch,omitempty" yaml:"branch,omitempty"`
-	Version string `json:"version,omitempty" yaml:"version,omitempty"`
-	Repo    string `json:"repo,omitempty" yaml:"repo,omitempty"`
-	Ref     string `json:"ref,omitempty" yaml:"ref,omitempty"`
-	Commit  string `json:"commit,omitempty" yaml:"commit,omitempty"`
+	Install         bool   `json:"install" yaml:"install"`
+	Source          string `json:"source,omitempty" yaml:"source,omitempty"`
+	Branch          string `json:"branch,omitempty" yaml:"branch,omitempty"`
+	Version         string `json:"version,omitempty" yaml:"version,omitempty"`
+	Repo            string `json:"repo,omitempty" yaml:"repo,omitempty"`
+	Ref             string `json:"ref,omitempty" yaml:"ref,omitempty"`
+	Commit          string `json:"commit,omitempty" yaml:"commit,omitempty"`
+	ObservedVersion string `json:"observedVersion,omitempty" yaml:"observedVersion,omitempty"`
 }
 
 // ContainerRuntimeInfo contains container runtime configuration
 type ContainerRuntimeInfo struct {
-	Install bool   `json:"install" yaml:"install"`
-	Name    string `json:"name" yaml:"name"`
-	Source  string `json:"source,omitempty" yaml:"source,omitempty"`
-	Version string `json:"version,omitempty" yaml:"version,omitempty"`
-	Repo    string `json:"repo,omitempty" yaml:"repo,omitempty"`
-	Ref     string `json:"ref,omitempty" yaml:"ref,omitempty"`
-	Commit  string `json:"commit,omitempty" yaml:"commit,omitempty"`
-	Branch  string `json:"branch,omitempty" yaml:"branch,omitempty"`
+	Install         bool   `json:"install" yaml:"install"`
+	Name            string `json:"name" yaml:"name"`
+	Source          string `json:"source,omitempty" yaml:"source,omitempty"`
+	Version         string `json:"version,omitempty" yaml:"version,omitempty"`
+	Repo            string `json:"repo,omitempty" yaml:"repo,omitempty"`
+	Ref             string `json:"ref,omitempty" yaml:"ref,omitempty"`
+	Commit          string `json:"commit,omitempty" yaml:"commit,omitempty"`
+	Branch          string `json:"branch,omitempty" yaml:"branch,omitempty"`
+	ObservedVersion string `json:"observedVersion,omitempty" yaml:"observedVersion,omitempty"`
 }
 
 // ContainerToolkitInfo contains NVIDIA Container Toolkit configuration
 type ContainerToolkitInfo struct {
-	Install   bool   `json:"install" yaml:"install"`
-	Source    string `json:"source,omitempty" yaml:"source,omitempty"`
-	Version   string `json:"version,omitempty" yaml:"version,omitempty"`
-	EnableCDI bool   `json:"enableCDI" yaml:"enableCDI"`
-	Repo      string `json:"repo,omitempty" yaml:"repo,omitempty"`
-	Ref       string `json:"ref,omitempty" yaml:"ref,omitempty"`
-	Commit    string `json:"commit,omitempty" yaml:"commit,omitempty"`
-	Branch    string `json:"branch,omitempty" yaml:"branch,omitempty"`
+	Install         bool   `json:"install" yaml:"install"`
+	Source          string `json:"source,omitempty" yaml:"source,omitempty"`
+	Version         string `json:"version,omitempty" yaml:"version,omitempty"`
+	EnableCDI       bool   `json:"enableCDI" yaml:"enableCDI"`
+	Repo            string `json:"repo,omitempty" yaml:"repo,omitempty"`
+	Ref             string `json:"ref,omitempty" yaml:"ref,omitempty"`
+	Commit          string `json:"commit,omitempty" yaml:"commit,omitempty"`
+	Branch          string `json:"branch,omitempty" yaml:"branch,omitempty"`
+	ObservedVersion string `json:"observedVersion,omitempty" yaml:"observedVersion,omitempty"`
 }
 
 // KubernetesInfo contains Kubernetes configuration
 type KubernetesInfo struct {
-	Install   bool   `json:"install" yaml:"install"`
-	Installer string `json:"installer,omitempty" yaml:"installer,omitempty"`
-	Version   string `json:"version,omitempty" yaml:"version,omitempty"`
-	Source    string `json:"source,omitempty" yaml:"source,omitempty"`
-	Repo      string `json:"repo,omitempty" yaml:"repo,omitempty"`
-	Ref       string `json:"ref,omitempty" yaml:"ref,omitempty"`
-	Commit    string `json:"commit,omitempty" yaml:"commit,omitempty"`
-	Branch    string `json:"branch,omitempty" yaml:"branch,omitempty"`
+	Install         bool   `json:"install" yaml:"install"`
+	Installer       string `json:"installer,omitempty" yaml:"installer,omitempty"`
+	Version         string `json:"version,omitempty" yaml:"version,omitempty"`
+	Source          string `json:"source,omitempty" yaml:"source,omitempty"`
+	Repo            string `json:"repo,omitempty" yaml:"repo,omitempty"`
+	Ref             string `json:"ref,omitempty" yaml:"ref,omitempty"`
+	Commit          string `json:"commit,omitempty" yaml:"commit,omitempty"`
+	Branch          string `json:"branch,omitempty" yaml:"branch,omitempty"`
+	ObservedVersion string `json:"observedVersion,omitempty" yaml:"observedVersion,omitempty"`
 }
 
 // StatusInfo contains status and conditions
@@ -195,6 +203,8 @@ type AWSResourcesInfo struct {
 	SubnetID      string `json:"subnetId,omitempty" yaml:"subnetId,omitempty"`
 	SecurityGroup string `json:"securityGroup,omitempty" yaml:"securityGroup,omitempty"`
 	AMI           string `json:"ami,omitempty" yaml:"ami,omitempty"`
+	Market        string `json:"market,omitempty" yaml:"market,omitempty"`
+	Zone          string `json:"availabilityZone,omitempty" yaml:"availabilityZone,omitempty"`
 }
 
 // NewCommand constructs the describe command with the specified logger
@@ -241,21 +251,21 @@ Examples:
 				Value:       "table",
 			},
 		},
-		Action: func(_ context.Context, cmd *cli.Command) error {
+		Action: func(ctx context.Context, cmd *cli.Command) error {
 			if cmd.NArg() != 1 {
 				return fmt.Errorf("instance ID is required")
 			}
-			return m.run(cmd.Args().Get(0))
+			return m.run(ctx, cmd.Args().Get(0))
 		},
 	}
 
 	return &describeCmd
 }
 
-func (m command) run(instanceID string) error {
+func (m command) run(ctx context.Context, instanceID string) error {
 	// Get instance details
 	manager := instances.NewManager(m.log, m.cachePath)
-	instance, err := manager.GetInstance(instanceID)
+	instance, err := manager.GetInstance(ctx, instanceID)
 	if err != nil {
 		return fmt.Errorf("failed to get instance: %w", err)
 	}
@@ -326,6 +336,13 @@ func (m command) buildDescribeOutput(instance *instances.Instance, env *v1alpha1
 				InstanceType: env.Spec.Cluster.Workers.InstanceType,
 			}
 		}
+		for _, w := range env.Spec.Cluster.WorkerPools {
+			output.Cluster.WorkerPools = append(output.Cluster.WorkerPools, WorkersInfo{
+				Name:         w.Name,
+				Count:        w.Count,
+				InstanceType: w.InstanceType,
+			})
+		}
 
 		if env.Spec.Cluster.HighAvailability != nil && env.Spec.Cluster.HighAvailability.Enabled {
 			output.Cluster.HighAvailability = &HAInfo{
@@ -346,9 +363,11 @@ func (m command) buildDescribeOutput(instance *instances.Instance, env *v1alpha1
 				output.Cluster.Nodes = append(output.Cluster.Nodes, NodeInfo{
 					Name:       node.Name,
 					Role:       node.Role,
+					Pool:       node.Pool,
 					InstanceID: node.InstanceID,
 					PublicIP:   node.PublicIP,
 					PrivateIP:  node.PrivateIP,
+					Market:     string(node.Market),
 					Phase:      node.Phase,
 				})
 			}
@@ -373,6 +392,7 @@ func (m command) buildDescribeOutput(instance *instances.Instance, env *v1alpha1
 		if env.Status.Components != nil && env.Status.Components.Driver != nil {
 			p := env.Status.Components.Driver
 			info.Source = p.Source
+			info.ObservedVersion = p.ObservedVersion
 			if p.Repo != "" {
 				info.Repo = p.Repo
 			}
@@ -402,6 +422,7 @@ func (m command) buildDescribeOutput(instance *instances.Instance, env *v1alpha1
 		if env.Status.Components != nil && env.Status.Components.Runtime != nil {
 			p := env.Status.Components.Runtime
 			info.Source = p.Source
+			info.ObservedVersion = p.ObservedVersion
 			if p.Repo != "" {
 				info.Repo = p.Repo
 			}
@@ -430,6 +451,7 @@ func (m command) buildDescribeOutput(instance *instances.Instance, env *v1alpha1
 		}
 		if env.Status.Components != nil && env.Status.Components.Toolkit != nil {
 			p := env.Status.Components.Toolkit
+			info.ObservedVersion = p.ObservedVersion
 			if p.Repo != "" {
 				info.Repo = p.Repo
 			}
@@ -462,6 +484,7 @@ func (m command) buildDescribeOutput(instance *instances.Instance, env *v1alpha1
 		}
 		if env.Status.Components != nil && env.Status.Components.Kubernetes != nil {
 			p := env.Status.Components.Kubernetes
+			info.ObservedVersion = p.ObservedVersion
 			if p.Repo != "" {
 				info.Repo = p.Repo
 			}
@@ -513,6 +536,15 @@ func (m command) buildDescribeOutput(instance *instances.Instance, env *v1alpha1
 				awsRes.SubnetID = p.Value
 			case "SecurityGroupId":
 				awsRes.SecurityGroup = p.Value
+			case "instance-market":
+				awsRes.Market = p.Value
+			case "instance-type":
+				// The type that launched, possibly one of the fallbacks
+				if p.Value != "" {
+					awsRes.InstanceType = p.Value
+				}
+			case "availability-zone":
+				awsRes.Zone = p.Value
 			}
 		}
 		output.AWSResources = awsRes
@@ -539,6 +571,15 @@ func formatSourceDetail(source, ref, commit, branch string) string {
 	return " (" + parts + ")"
 }
 
+// formatObserved returns the version a component reported on the host,
+// to print after its requested version
+func formatObserved(observed string) string {
+	if observed == "" {
+		return ""
+	}
+	return ", installed " + observed
+}
+
 //nolint:errcheck // stdout writes
 func (m command) printTableFormat(d *DescribeOutput) error {
 	// Instance Information
@@ -574,6 +615,9 @@ func (m command) printTableFormat(d *DescribeOutput) error {
 			fmt.Printf("Worker Count:         %d\n", d.Cluster.Workers.Count)
 			fmt.Printf("Worker Type:          %s\n", d.Cluster.Workers.InstanceType)
 		}
+		for _, w := range d.Cluster.WorkerPools {
+			fmt.Printf("Worker Pool:          %s (%d x %s)\n", w.Name, w.Count, w.InstanceType)
+		}
 
 		if d.Cluster.HighAvailability != nil && d.Cluster.HighAvailability.Enabled {
 			fmt.Printf("High Availability:    Enabled\n")
@@ -600,6 +644,12 @@ func (m command) printTableFormat(d *DescribeOutput) error {
 				fmt.Printf("    Instance ID: %s\n", node.InstanceID)
 				fmt.Printf("    Public IP:   %s\n", node.PublicIP)
 				fmt.Printf("    Private IP:  %s\n", node.PrivateIP)
+				if node.Pool != "" {
+					fmt.Printf("    Pool:        %s\n", node.Pool)
+				}
+				if node.Market != "" {
+					fmt.Printf("    Market:      %s\n", node.Market)
+				}
 				fmt.Printf("    Phase:       %s\n", node.Phase)
 			}
 		}
@@ -620,7 +670,7 @@ func (m command) printTableFormat(d *DescribeOutput) error {
 			version = "latest"
 		}
 		detail := formatSourceDetail(di.Source, di.Ref, di.Commit, di.Branch)
-		fmt.Printf("NVIDIA Driver:       %s%s\n", version, detail)
+		fmt.Printf("NVIDIA Driver:       %s%s%s\n", version, detail, formatObserved(di.ObservedVersion))
 	}
 	if d.Components.ContainerRuntime != nil {
 		ri := d.Components.ContainerRuntime
@@ -629,7 +679,7 @@ func (m command) printTableFormat(d *DescribeOutput) error {
 			version = "latest"
 		}
 		detail := formatSourceDetail(ri.Source, ri.Ref, ri.Commit, ri.Branch)
-		fmt.Printf("Container Runtime:   %s %s%s\n", ri.Name, version, detail)
+		fmt.Printf("Container Runtime:   %s %s%s%s\n", ri.Name, version, detail, formatObserved(ri.ObservedVersion))
 	}
 	if d.Components.ContainerToolkit != nil {
 		ti := d.Components.ContainerToolkit
@@ -651,7 +701,7 @@ func (m command) printTableFormat(d *DescribeOutput) error {
 		} else if cdi != "" {
 			detail = " (" + ti.Source + cdi + ")"
 		}
-		fmt.Printf("Container Toolkit:   %s%s\n", version, detail)
+		fmt.Printf("Container Toolkit:   %s%s%s\n", version, detail, formatObserved(ti.ObservedVersion))
 	}
 	if d.Components.Kubernetes != nil {
 		ki := d.Components.Kubernetes
@@ -666,7 +716,7 @@ func (m command) printTableFormat(d *DescribeOutput) error {
 			// Insert installer into detail
 			detail = fmt.Sprintf(" (%s, %s", ki.Installer, detail[2:])
 		}
-		fmt.Printf("Kubernetes:          %s%s\n", version, detail)
+		fmt.Printf("Kubernetes:          %s%s%s\n", version, detail, formatObserved(ki.ObservedVersion))
 	}
 
 	// AWS Resources
@@ -681,6 +731,12 @@ func (m command) printTableFormat(d *DescribeOutput) error {
 		if d.AWSResources.AMI != "" {
 			fmt.Printf("AMI:             %s\n", d.AWSResources.AMI)
 		}
+		if d.AWSResources.Market != "" {
+			fmt.Printf("Market:          %s\n", d.AWSResources.Market)
+		}
+		if d.AWSResources.Zone != "" {
+			fmt.Printf("Zone:            %s\n", d.AWSResources.Zone)
+		}
 		if d.AWSResources.PublicDNS != "" {
 			fmt.Printf("Public DNS:      %s\n", d.AWSResources.PublicDNS)
 		}
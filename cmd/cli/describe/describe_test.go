@@ -19,6 +19,9 @@ package describe
 import (
 	"testing"
 	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/instances"
 )
 
 func TestDescribeOutput_InstanceInfo(t *testing.T) {
@@ -198,3 +201,33 @@ func TestAWSResourcesInfo(t *testing.T) {
 		t.Errorf("expected vpc-123, got %s", output.AWSResources.VpcID)
 	}
 }
+
+func TestBuildDescribeOutput_ObservedVersions(t *testing.T) {
+	env := &v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			NVIDIADriver: v1alpha1.NVIDIADriver{Install: true, Branch: "575"},
+			Kubernetes:   v1alpha1.Kubernetes{Install: true, KubernetesInstaller: "kubeadm", KubernetesVersion: "v1.33.1"},
+		},
+		Status: v1alpha1.EnvironmentStatus{
+			Components: &v1alpha1.ComponentsStatus{
+				Driver:     &v1alpha1.ComponentProvenance{Source: "package", Branch: "575", ObservedVersion: "575.57.08"},
+				Kubernetes: &v1alpha1.ComponentProvenance{Source: "release", Version: "v1.33.1", ObservedVersion: "v1.33.1"},
+			},
+		},
+	}
+
+	output := command{}.buildDescribeOutput(&instances.Instance{ID: "a1b2c3d4"}, env, time.Minute)
+
+	if got := output.Components.NVIDIADriver; got.Branch != "575" || got.ObservedVersion != "575.57.08" {
+		t.Errorf("driver = branch %q, observed %q; want branch 575, observed 575.57.08", got.Branch, got.ObservedVersion)
+	}
+	if got := output.Components.Kubernetes; got.Version != "v1.33.1" || got.ObservedVersion != "v1.33.1" {
+		t.Errorf("kubernetes = version %q, observed %q; want v1.33.1 for both", got.Version, got.ObservedVersion)
+	}
+	if got := formatObserved("575.57.08"); got != ", installed 575.57.08" {
+		t.Errorf("formatObserved() = %q", got)
+	}
+	if got := formatObserved(""); got != "" {
+		t.Errorf("formatObserved(\"\") = %q, want empty", got)
+	}
+}
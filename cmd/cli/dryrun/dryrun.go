@@ -25,7 +25,9 @@ import (
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
 	"github.com/NVIDIA/holodeck/internal/logger"
 	"github.com/NVIDIA/holodeck/pkg/jyaml"
-	"github.com/NVIDIA/holodeck/pkg/provider/aws"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+	_ "github.com/NVIDIA/holodeck/pkg/provider/all" // register built-in providers
+	"github.com/NVIDIA/holodeck/pkg/provider/ssh"
 	"github.com/NVIDIA/holodeck/pkg/provisioner"
 	"github.com/NVIDIA/holodeck/pkg/sshutil"
 
@@ -73,6 +75,11 @@ func (m command) build() *cli.Command {
 				return ctx, fmt.Errorf("failed to read config file %s: %w", opts.envFile, err)
 			}
 
+			// Reject a malformed bring-your-own host inventory up front.
+			if err := opts.cfg.Spec.ValidateHostInventory(); err != nil {
+				return ctx, fmt.Errorf("invalid cluster hosts in %s: %w", opts.envFile, err)
+			}
+
 			// Reject a malformed sshConfig up front, before any SSH action.
 			if err := opts.cfg.Spec.SSHConfig.Validate(); err != nil {
 				return ctx, fmt.Errorf("invalid sshConfig in %s: %w", opts.envFile, err)
@@ -87,35 +94,40 @@ func (m command) build() *cli.Command {
 
 			return ctx, nil
 		},
-		Action: func(_ context.Context, _ *cli.Command) error {
+		Action: func(ctx context.Context, _ *cli.Command) error {
 			//nolint:contextcheck // provisioner.Dryrun -> logger.Loading (pkg/provisioner, internal/logger) have no ctx parameter by design; threading requires a signature change outside cmd/cli, out of scope here.
-			return m.run(&opts)
+			return m.run(ctx, &opts)
 		},
 	}
 
 	return &dryrun
 }
 
-func (m command) run(opts *options) error {
+func (m command) run(ctx context.Context, opts *options) error {
 	m.log.Info("Dryrun environment %s \U0001f50d", opts.cfg.Name)
 
 	// Check Provider
-	switch opts.cfg.Spec.Provider {
-	case v1alpha1.ProviderAWS:
-		err := validateAWS(m.log, opts)
-		if err != nil {
-			return err
-		}
-	case v1alpha1.ProviderSSH:
+	client, err := provider.New(m.log, opts.cfg, opts.envFile)
+	if err != nil {
+		return err
+	}
+	if err := client.DryRun(ctx); err != nil {
+		return err
+	}
+
+	// Bring-your-own hosts must also be reachable over SSH
+	if opts.cfg.Spec.Provider == v1alpha1.ProviderSSH {
 		// if username is not provided, use the current user
 		if opts.cfg.Spec.Username == "" {
 			opts.cfg.Spec.Username = os.Getenv("USER")
 		}
-		if err := connectOrDie(opts.cfg.Spec.PrivateKey, opts.cfg.Spec.Username, opts.cfg.Spec.HostUrl); err != nil {
+		if opts.cfg.Spec.Cluster != nil {
+			if err := connectInventory(ctx, m.log, opts); err != nil {
+				return err
+			}
+		} else if err := connectOrDie(ctx, opts.cfg.Spec.PrivateKey, opts.cfg.Spec.Username, opts.cfg.Spec.HostUrl); err != nil {
 			return err
 		}
-	default:
-		return fmt.Errorf("unknown provider %s", opts.cfg.Spec.Provider)
 	}
 
 	// Check Provisioner
@@ -128,19 +140,6 @@ func (m command) run(opts *options) error {
 	return nil
 }
 
-func validateAWS(log *logger.FunLogger, opts *options) error {
-	client, err := aws.New(log, opts.cfg, opts.envFile)
-	if err != nil {
-		return err
-	}
-
-	if err = client.DryRun(); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // dryrunDialer builds the sshutil.Dialer used by connectOrDie. Extracted so
 // it is independently testable: it must carry a non-zero handshake timeout
 // (the reported bug — dryrun previously set none and could block forever on
@@ -156,11 +155,49 @@ func dryrunDialer(keyPath, userName string, log *logger.FunLogger) *sshutil.Dial
 }
 
 // connectOrDie creates a ssh client, and retries if it fails to connect.
-func connectOrDie(keyPath, userName, hostUrl string) error {
-	client, err := dryrunDialer(keyPath, userName, logger.NewLogger()).Dial(context.Background(), hostUrl, nil) //nolint:contextcheck // CLI action boundary; no ctx to thread yet
+func connectOrDie(ctx context.Context, keyPath, userName, hostUrl string) error {
+	client, err := dryrunDialer(keyPath, userName, logger.NewLogger()).Dial(ctx, hostUrl, nil)
 	if err != nil {
 		return err
 	}
 	_ = client.Close()
 	return nil
 }
+
+// connectInventory checks SSH connectivity to every host of a bring-your-own
+// cluster inventory, honoring per-host credentials and bastions.
+func connectInventory(ctx context.Context, log *logger.FunLogger, opts *options) error {
+	status := ssh.ClusterStatusFromInventory(opts.cfg.Spec.Cluster, opts.cfg.Spec.Username)
+	nodes := make([]provisioner.NodeInfo, 0, len(status.Nodes))
+	for _, n := range status.Nodes {
+		nodes = append(nodes, provisioner.NodeInfo{
+			Name:        n.Name,
+			PublicIP:    n.PublicIP,
+			PrivateIP:   n.PrivateIP,
+			Role:        n.Role,
+			Pool:        n.Pool,
+			SSHUsername: n.SSHUsername,
+		})
+	}
+
+	for _, node := range provisioner.ApplyHostInventory(log, &opts.cfg.Spec, nodes) {
+		keyPath := node.KeyPath
+		if keyPath == "" {
+			keyPath = opts.cfg.Spec.PrivateKey
+		}
+		host := node.PublicIP
+		if node.Transport != nil {
+			host = node.PrivateIP
+		}
+		log.Info("Checking SSH connectivity to %s (%s)", node.Name, node.Role)
+		client, err := dryrunDialer(keyPath, node.SSHUsername, log).Dial(ctx, host, node.Transport)
+		if node.Transport != nil {
+			_ = node.Transport.Close()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to connect to host %s: %w", node.Name, err)
+		}
+		_ = client.Close()
+	}
+	return nil
+}
@@ -20,6 +20,7 @@
 package dryrun
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -39,5 +40,5 @@ func TestDryrunConnect_Succeeds(t *testing.T) {
 	t.Setenv("HOME", t.TempDir())
 	keyPath, pub := sshtest.GenerateKey(t)
 	srv := sshtest.NewServer(t, pub)
-	require.NoError(t, connectOrDie(keyPath, "tester", srv.Addr()))
+	require.NoError(t, connectOrDie(context.Background(), keyPath, "tester", srv.Addr()))
 }
@@ -265,7 +265,7 @@ var _ = Describe("Dryrun Command", func() {
 			}
 
 			// This will fail due to AWS credentials/config issues
-			// but covers the validateAWS path
+			// but covers the AWS provider dry-run path
 			err = app.Run(context.Background(), []string{"holodeck", "dryrun", "-f", envFile})
 			Expect(err).To(HaveOccurred())
 		})
@@ -97,12 +97,11 @@ Examples:
 				Destination: &m.node,
 			},
 		},
-		Action: func(_ context.Context, cmd *cli.Command) error {
+		Action: func(ctx context.Context, cmd *cli.Command) error {
 			if cmd.NArg() != 1 {
 				return fmt.Errorf("instance ID is required")
 			}
-			//nolint:contextcheck // runKubeconfig -> GetKubeConfig -> provisioner.New has no ctx parameter (adoption boundary, follow-up per #851); threading requires New's signature change outside cmd/cli, out of scope here.
-			return m.runKubeconfig(cmd.Args().Get(0))
+			return m.runKubeconfig(ctx, cmd.Args().Get(0))
 		},
 	}
 }
@@ -134,19 +133,19 @@ Examples:
 				Destination: &m.node,
 			},
 		},
-		Action: func(_ context.Context, cmd *cli.Command) error {
+		Action: func(ctx context.Context, cmd *cli.Command) error {
 			if cmd.NArg() != 1 {
 				return fmt.Errorf("instance ID is required")
 			}
-			return m.runSSHConfig(cmd.Args().Get(0))
+			return m.runSSHConfig(ctx, cmd.Args().Get(0))
 		},
 	}
 }
 
-func (m command) runKubeconfig(instanceID string) error {
+func (m command) runKubeconfig(ctx context.Context, instanceID string) error {
 	// Get instance details
 	manager := instances.NewManager(m.log, m.cachePath)
-	instance, err := manager.GetInstance(instanceID)
+	instance, err := manager.GetInstance(ctx, instanceID)
 	if err != nil {
 		return fmt.Errorf("failed to get instance: %w", err)
 	}
@@ -183,7 +182,7 @@ func (m command) runKubeconfig(instanceID string) error {
 	}
 
 	// Download kubeconfig
-	if err := utils.GetKubeConfig(m.log, &env, hostUrl, outputPath); err != nil {
+	if err := utils.GetKubeConfig(ctx, m.log, &env, hostUrl, outputPath); err != nil {
 		return fmt.Errorf("failed to download kubeconfig: %w", err)
 	}
 	if err := utils.ApplyRemoteAccess(&env, hostUrl, outputPath); err != nil {
@@ -197,10 +196,10 @@ func (m command) runKubeconfig(instanceID string) error {
 }
 
 //nolint:errcheck // stdout writes for SSH config output
-func (m command) runSSHConfig(instanceID string) error {
+func (m command) runSSHConfig(ctx context.Context, instanceID string) error {
 	// Get instance details
 	manager := instances.NewManager(m.log, m.cachePath)
-	instance, err := manager.GetInstance(instanceID)
+	instance, err := manager.GetInstance(ctx, instanceID)
 	if err != nil {
 		return fmt.Errorf("failed to get instance: %w", err)
 	}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/provisioner"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+type options struct {
+	envFile string
+
+	cfg v1alpha1.Environment
+}
+
+type command struct {
+	log *logger.FunLogger
+}
+
+// NewCommand constructs the lint command with the specified logger
+func NewCommand(log *logger.FunLogger) *cli.Command {
+	c := command{
+		log: log,
+	}
+	return c.build()
+}
+
+func (m command) build() *cli.Command {
+	opts := options{}
+
+	return &cli.Command{
+		Name:  "lint",
+		Usage: "Check the syntax of the provisioning scripts of an environment",
+		Description: `Render the provisioning scripts of an environment, as 'holodeck render'
+does, and check that bash can parse each of them. Syntax errors, e.g. in a
+custom template, are reported before any instance is created.
+
+Examples:
+  holodeck lint -f env.yaml`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "envFile",
+				Aliases:     []string{"f"},
+				Usage:       "Path to the Environment file",
+				Destination: &opts.envFile,
+			},
+		},
+		Before: func(ctx context.Context, _ *cli.Command) (context.Context, error) {
+			var err error
+			opts.cfg, err = jyaml.UnmarshalFromFile[v1alpha1.Environment](opts.envFile)
+			if err != nil {
+				return ctx, fmt.Errorf("error reading config file: %w", err)
+			}
+			return ctx, nil
+		},
+		Action: func(_ context.Context, _ *cli.Command) error {
+			return m.run(&opts)
+		},
+	}
+}
+
+func (m command) run(opts *options) error {
+	checked, err := provisioner.Lint(opts.cfg, provisioner.RenderOptions{})
+	if err != nil {
+		return fmt.Errorf("%s has invalid provisioning scripts:\n%w", opts.envFile, err)
+	}
+	m.log.Info("Checked %d scripts of %s, no syntax errors found", checked, opts.envFile)
+	return nil
+}
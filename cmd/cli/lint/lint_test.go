@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+func TestNewCommand(t *testing.T) {
+	cmd := NewCommand(logger.NewLogger())
+	assert.Equal(t, "lint", cmd.Name)
+
+	names := map[string]bool{}
+	for _, f := range cmd.Flags {
+		for _, n := range f.Names() {
+			names[n] = true
+		}
+	}
+	for _, want := range []string{"envFile", "f"} {
+		assert.True(t, names[want], "missing flag %s", want)
+	}
+}
+
+func TestRun(t *testing.T) {
+	opts := &options{
+		envFile: "env.yaml",
+		cfg: v1alpha1.Environment{
+			Spec: v1alpha1.EnvironmentSpec{
+				CustomTemplates: []v1alpha1.CustomTemplate{
+					{Name: "unbalanced", Inline: "echo 'missing quote"},
+				},
+			},
+		},
+	}
+
+	err := command{log: logger.NewLogger()}.run(opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "env.yaml has invalid provisioning scripts")
+	assert.Contains(t, err.Error(), "01-custom-unbalanced.sh")
+}
@@ -130,9 +130,9 @@ func (m *command) build() *cli.Command {
 	return &list
 }
 
-func (m *command) run(_ context.Context, _ *cli.Command) error {
+func (m *command) run(ctx context.Context, _ *cli.Command) error {
 	manager := instances.NewManager(m.log, m.cachePath)
-	instList, err := manager.ListInstances()
+	instList, err := manager.ListInstances(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to list instances: %w", err)
 	}
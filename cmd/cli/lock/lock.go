@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lock
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/ami"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/gitref"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/lock"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	cli "github.com/urfave/cli/v3"
+)
+
+type options struct {
+	envFile  string
+	lockFile string
+
+	cfg v1alpha1.Environment
+}
+
+type command struct {
+	log *logger.FunLogger
+}
+
+// NewCommand constructs the lock command with the specified logger
+func NewCommand(log *logger.FunLogger) *cli.Command {
+	c := command{
+		log: log,
+	}
+	return c.build()
+}
+
+func (m command) build() *cli.Command {
+	opts := options{}
+
+	return &cli.Command{
+		Name:  "lock",
+		Usage: "Resolve the moving references of an environment into a lock file",
+		Description: `Resolve every reference of the environment that changes over time into
+a lock file: git refs and tracked branches to commits, driver package
+branches to driver versions and operating systems to AMI IDs. A container
+runtime or toolkit installed from a package must name its version.
+
+Create the environment with 'holodeck create --lock' to install exactly the
+locked values, e.g. to reproduce a failing CI run later.
+
+Examples:
+  holodeck lock -f env.yaml
+  holodeck create -f env.yaml --lock holodeck.lock --provision`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "envFile",
+				Aliases:     []string{"f"},
+				Usage:       "Path to the Environment file",
+				Destination: &opts.envFile,
+			},
+			&cli.StringFlag{
+				Name:        "output",
+				Aliases:     []string{"o"},
+				Usage:       "Path to write the lock file to",
+				Destination: &opts.lockFile,
+				Value:       lock.DefaultFile,
+			},
+		},
+		Before: func(ctx context.Context, _ *cli.Command) (context.Context, error) {
+			var err error
+			opts.cfg, err = jyaml.UnmarshalFromFile[v1alpha1.Environment](opts.envFile)
+			if err != nil {
+				return ctx, fmt.Errorf("error reading config file: %w", err)
+			}
+			return ctx, nil
+		},
+		Action: func(ctx context.Context, _ *cli.Command) error {
+			return m.run(ctx, &opts)
+		},
+	}
+}
+
+func (m command) run(ctx context.Context, opts *options) error {
+	cancel := m.log.Loading("Resolving %s", opts.envFile)
+	l, err := lock.Resolve(ctx, opts.cfg, lock.Resolvers{
+		Git:     gitref.NewGitHubResolver(),
+		Drivers: lock.NewCUDARepoResolver(),
+		Images:  awsImages{},
+	})
+	if err != nil {
+		cancel(logger.ErrLoadingFailed)
+		return err
+	}
+	cancel(nil)
+
+	if err := l.Write(opts.lockFile); err != nil {
+		return err
+	}
+
+	for _, line := range summary(l) {
+		m.log.Info("  %s", line)
+	}
+	m.log.Info("Wrote %s", opts.lockFile)
+	return nil
+}
+
+// summary describes the resolved values of l, one per line.
+func summary(l *lock.Lock) []string {
+	var lines []string
+	for _, c := range []struct {
+		name string
+		*lock.Component
+	}{
+		{"driver", l.Components.Driver},
+		{"runtime", l.Components.Runtime},
+		{"toolkit", l.Components.Toolkit},
+		{"kubernetes", l.Components.Kubernetes},
+	} {
+		switch {
+		case c.Component == nil:
+		case c.Commit != "":
+			lines = append(lines, fmt.Sprintf("%s: %s@%s -> %s", c.name, c.Repo, c.Ref, c.Commit))
+		case c.ResolvedVersion != "":
+			lines = append(lines, fmt.Sprintf("%s: branch %s -> %s", c.name, c.Branch, c.ResolvedVersion))
+		}
+	}
+	for _, img := range l.Images {
+		lines = append(lines, fmt.Sprintf("%s image: %s (%s, %s) -> %s", img.Node, img.OS, img.Arch, img.Region, img.ImageID))
+	}
+	return lines
+}
+
+// awsImages resolves OS images with the AMI resolver of each region.
+type awsImages struct{}
+
+func (awsImages) ResolveImage(ctx context.Context, region, osID, arch, instanceType string) (string, string, error) {
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	ec2Client := ec2.NewFromConfig(cfg)
+
+	if arch == "" {
+		if arch, err = instanceTypeArch(ctx, ec2Client, instanceType); err != nil {
+			return "", "", err
+		}
+	}
+	arch = ami.NormalizeArch(arch)
+
+	resolved, err := ami.NewResolver(ec2Client, ssm.NewFromConfig(cfg), region).Resolve(ctx, osID, arch)
+	if err != nil {
+		return "", "", err
+	}
+	return resolved.ImageID, arch, nil
+}
+
+// instanceTypeArch returns the architecture nodes of instanceType boot,
+// arm64 for Graviton types and x86_64 otherwise, as the AWS provider does.
+func instanceTypeArch(ctx context.Context, client *ec2.Client, instanceType string) (string, error) {
+	if instanceType == "" {
+		return "x86_64", nil
+	}
+	out, err := client.DescribeInstanceTypes(ctx, &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: []types.InstanceType{types.InstanceType(instanceType)},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to describe instance type %s: %w", instanceType, err)
+	}
+	if len(out.InstanceTypes) == 0 || out.InstanceTypes[0].ProcessorInfo == nil {
+		return "", fmt.Errorf("instance type %s not found", instanceType)
+	}
+	hasX86, hasArm := false, false
+	for _, a := range out.InstanceTypes[0].ProcessorInfo.SupportedArchitectures {
+		switch {
+		case strings.HasPrefix(string(a), "x86_64"):
+			hasX86 = true
+		case strings.HasPrefix(string(a), "arm64"):
+			hasArm = true
+		}
+	}
+	if hasArm && !hasX86 {
+		return "arm64", nil
+	}
+	return "x86_64", nil
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/lock"
+)
+
+func TestNewCommand(t *testing.T) {
+	cmd := NewCommand(logger.NewLogger())
+	assert.Equal(t, "lock", cmd.Name)
+
+	names := map[string]bool{}
+	for _, f := range cmd.Flags {
+		for _, n := range f.Names() {
+			names[n] = true
+		}
+	}
+	for _, want := range []string{"envFile", "f", "output", "o"} {
+		assert.True(t, names[want], "missing flag %s", want)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	l := &lock.Lock{
+		Components: lock.Components{
+			Driver:     &lock.Component{Source: "package", Branch: "580", ResolvedVersion: "580.95.05"},
+			Runtime:    &lock.Component{Source: "latest", Repo: "https://github.com/containerd/containerd.git", Ref: "main", Commit: "abc123"},
+			Kubernetes: &lock.Component{Source: "release", Version: "v1.33.1"},
+		},
+		Images: []lock.Image{
+			{Node: "worker/gpu", Region: "us-west-2", OS: "ubuntu-24.04", Arch: "x86_64", ImageID: "ami-123"},
+		},
+	}
+	assert.Equal(t, []string{
+		"driver: branch 580 -> 580.95.05",
+		"runtime: https://github.com/containerd/containerd.git@main -> abc123",
+		"worker/gpu image: ubuntu-24.04 (x86_64, us-west-2) -> ami-123",
+	}, summary(l))
+}
@@ -19,6 +19,8 @@ package main
 import (
 	"context"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/NVIDIA/holodeck/cmd/cli/cleanup"
 	"github.com/NVIDIA/holodeck/cmd/cli/create"
@@ -26,12 +28,22 @@ import (
 	"github.com/NVIDIA/holodeck/cmd/cli/describe"
 	"github.com/NVIDIA/holodeck/cmd/cli/dryrun"
 	"github.com/NVIDIA/holodeck/cmd/cli/get"
+	"github.com/NVIDIA/holodeck/cmd/cli/lint"
 	"github.com/NVIDIA/holodeck/cmd/cli/list"
+	"github.com/NVIDIA/holodeck/cmd/cli/lock"
+	"github.com/NVIDIA/holodeck/cmd/cli/node"
 	oscmd "github.com/NVIDIA/holodeck/cmd/cli/os"
+	"github.com/NVIDIA/holodeck/cmd/cli/provision"
+	"github.com/NVIDIA/holodeck/cmd/cli/reap"
+	"github.com/NVIDIA/holodeck/cmd/cli/render"
+	"github.com/NVIDIA/holodeck/cmd/cli/scale"
 	"github.com/NVIDIA/holodeck/cmd/cli/scp"
 	"github.com/NVIDIA/holodeck/cmd/cli/skill"
+	"github.com/NVIDIA/holodeck/cmd/cli/snapshot"
 	"github.com/NVIDIA/holodeck/cmd/cli/ssh"
+	"github.com/NVIDIA/holodeck/cmd/cli/start"
 	"github.com/NVIDIA/holodeck/cmd/cli/status"
+	"github.com/NVIDIA/holodeck/cmd/cli/stop"
 	"github.com/NVIDIA/holodeck/cmd/cli/update"
 	"github.com/NVIDIA/holodeck/internal/logger"
 
@@ -91,12 +103,25 @@ Examples:
   holodeck scp ./local-file.txt <instance-id>:/remote/path/
   holodeck scp <instance-id>:/remote/file.log ./local/
 
+  # Resume a provisioning that failed part-way
+  holodeck provision <instance-id> --resume
+
+  # Stop an environment between test sessions, then bring it back
+  holodeck stop <instance-id>
+  holodeck start <instance-id>
+
+  # Bake a golden image from a provisioned environment
+  holodeck snapshot <instance-id> --name my-golden-image
+
   # Delete an environment
   holodeck delete <instance-id>
 
   # Clean up AWS VPC resources
   holodeck cleanup vpc-12345678
 
+  # Tear down environments whose ttl has expired
+  holodeck reap --region us-west-2
+
   # Use a custom cache directory
   holodeck create -f env.yaml --cachepath /path/to/cache`
 	c.Version = ProgramVersion
@@ -147,12 +172,22 @@ Examples:
 		describe.NewCommand(log),
 		dryrun.NewCommand(log),
 		get.NewCommand(log),
+		lint.NewCommand(log),
 		list.NewCommand(log),
+		lock.NewCommand(log),
+		node.NewCommand(log),
 		oscmd.NewCommand(log),
+		provision.NewCommand(log),
+		reap.NewCommand(log),
+		render.NewCommand(log),
+		scale.NewCommand(log),
 		scp.NewCommand(log),
 		skill.NewCommand(log),
+		snapshot.NewCommand(log),
 		ssh.NewCommand(log),
+		start.NewCommand(log),
 		status.NewCommand(log),
+		stop.NewCommand(log),
 		update.NewCommand(log),
 	}
 
@@ -218,7 +253,11 @@ For more information about a command, run:
    {{.Name}} help <command>
 `
 
-	err := c.Run(context.Background(), os.Args)
+	// Cancel in-flight provider calls and SSH sessions on Ctrl-C/SIGTERM so
+	// commands can roll back what they created before exiting.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	err := c.Run(ctx, os.Args)
+	stop()
 	if err != nil {
 		log.Error(err)
 		log.Exit(1)
@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package node
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/cmd/cli/common"
+	"github.com/NVIDIA/holodeck/internal/instances"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/provisioner"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+type command struct {
+	log       *logger.FunLogger
+	cachePath string
+}
+
+// NewCommand constructs the node command with the specified logger
+func NewCommand(log *logger.FunLogger) *cli.Command {
+	c := &command{
+		log: log,
+	}
+	return c.build()
+}
+
+func (m *command) build() *cli.Command {
+	return &cli.Command{
+		Name:  "node",
+		Usage: "Repair a single node of a Holodeck cluster",
+		Description: `Commands for repairing one node of a running cluster without recreating
+the whole cluster, e.g. when a driver install wedged on a worker.`,
+		Commands: []*cli.Command{
+			m.buildReplaceCommand(),
+			m.buildReprovisionCommand(),
+		},
+	}
+}
+
+func (m *command) cachePathFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:        "cachepath",
+		Aliases:     []string{"c"},
+		Usage:       "Path to the cache directory",
+		Destination: &m.cachePath,
+		Value:       filepath.Join(os.Getenv("HOME"), ".cache", "holodeck"),
+	}
+}
+
+func (m *command) buildReplaceCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "replace",
+		Usage: "Replace a worker node with a freshly launched instance",
+		Description: `Drain and remove the worker from the cluster, terminate its instance and
+launch a new one with the same name and worker pool. The new instance is
+provisioned and joined to the cluster with a fresh kubeadm join token.`,
+		ArgsUsage: "<instance-id> <node-name>",
+		Flags:     []cli.Flag{m.cachePathFlag()},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.NArg() != 2 {
+				return fmt.Errorf("instance ID and node name are required")
+			}
+			return m.replace(ctx, cmd.Args().Get(0), cmd.Args().Get(1))
+		},
+	}
+}
+
+func (m *command) buildReprovisionCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "reprovision",
+		Usage: "Rerun provisioning on a single cluster node",
+		Description: `Rerun the provisioner against one node of the cluster. Components that
+are already installed are skipped, so only the missing ones are retried.`,
+		ArgsUsage: "<instance-id> <node-name>",
+		Flags:     []cli.Flag{m.cachePathFlag()},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.NArg() != 2 {
+				return fmt.Errorf("instance ID and node name are required")
+			}
+			return m.reprovision(ctx, cmd.Args().Get(0), cmd.Args().Get(1))
+		},
+	}
+}
+
+func (m *command) replace(ctx context.Context, instanceID, nodeName string) error {
+	manager := instances.NewManager(m.log, m.cachePath)
+	instance, env, node, err := m.lookup(ctx, manager, instanceID, nodeName)
+	if err != nil {
+		return err
+	}
+	if node.Role != "worker" {
+		return fmt.Errorf("node %s is a %s node, only workers can be replaced", node.Name, node.Role)
+	}
+	// Fail before draining the node if the provider cannot relaunch it
+	if err := manager.ValidateScaling(instanceID); err != nil {
+		return err
+	}
+
+	// Only a provisioned Kubernetes cluster knows about the node
+	joined := env.Spec.Kubernetes.Install && instance.Provisioned
+	var firstCP provisioner.NodeInfo
+	if joined {
+		if firstCP, err = common.FirstControlPlane(env); err != nil {
+			return err
+		}
+		cp := provisioner.NewClusterProvisioner(m.log, env.Spec.PrivateKey, env.Spec.Username, env)
+		if err := cp.RemoveWorkers(ctx, firstCP, common.NodeInfos([]v1alpha1.NodeStatus{node})); err != nil {
+			return fmt.Errorf("failed to drain node %s: %w", node.Name, err)
+		}
+	}
+
+	replaced, err := manager.ReplaceWorker(ctx, instanceID, node.Name)
+	if err != nil {
+		return fmt.Errorf("failed to replace node %s: %w", node.Name, err)
+	}
+
+	var joinErr error
+	if joined {
+		cp := provisioner.NewClusterProvisioner(m.log, env.Spec.PrivateKey, env.Spec.Username, env)
+		if err := cp.JoinWorkers(ctx, firstCP, common.NodeInfos([]v1alpha1.NodeStatus{*replaced})); err != nil {
+			joinErr = fmt.Errorf("failed to rejoin node %s: %w", node.Name, err)
+		}
+	}
+	if err := common.RecordJoin(instance.CacheFile, []v1alpha1.NodeStatus{*replaced}, joinErr); err != nil {
+		return err
+	}
+
+	m.log.Info("Successfully replaced node %s of instance %s (%s)", node.Name, instanceID, instance.Name)
+	return nil
+}
+
+func (m *command) reprovision(ctx context.Context, instanceID, nodeName string) error {
+	manager := instances.NewManager(m.log, m.cachePath)
+	instance, env, node, err := m.lookup(ctx, manager, instanceID, nodeName)
+	if err != nil {
+		return err
+	}
+
+	cp := provisioner.NewClusterProvisioner(m.log, env.Spec.PrivateKey, env.Spec.Username, env)
+	if err := cp.ReprovisionNode(ctx, common.NodeInfos([]v1alpha1.NodeStatus{node})[0]); err != nil {
+		return fmt.Errorf("failed to reprovision node %s: %w", node.Name, err)
+	}
+
+	m.log.Info("Successfully reprovisioned node %s of instance %s (%s)", node.Name, instanceID, instance.Name)
+	return nil
+}
+
+// lookup returns the instance, its cached environment and the status of the
+// named cluster node
+func (m *command) lookup(ctx context.Context, manager *instances.Manager, instanceID, nodeName string) (*instances.Instance, *v1alpha1.Environment, v1alpha1.NodeStatus, error) {
+	instance, err := manager.GetInstance(ctx, instanceID)
+	if err != nil {
+		return nil, nil, v1alpha1.NodeStatus{}, fmt.Errorf("failed to get instance %s: %w", instanceID, err)
+	}
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](instance.CacheFile)
+	if err != nil {
+		return nil, nil, v1alpha1.NodeStatus{}, fmt.Errorf("failed to read environment: %w", err)
+	}
+	if env.Spec.Cluster == nil || env.Status.Cluster == nil {
+		return nil, nil, v1alpha1.NodeStatus{}, fmt.Errorf("instance %s is not a cluster", instanceID)
+	}
+	i := slices.IndexFunc(env.Status.Cluster.Nodes, func(n v1alpha1.NodeStatus) bool { return n.Name == nodeName })
+	if i < 0 {
+		return nil, nil, v1alpha1.NodeStatus{}, fmt.Errorf("node %s not found in instance %s", nodeName, instanceID)
+	}
+	return instance, &env, env.Status.Cluster.Nodes[i], nil
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package node_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cli "github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/holodeck/cmd/cli/node"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+func TestNode(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Node Command Suite")
+}
+
+// clusterCacheYAML returns a cache YAML for a cluster with a control-plane,
+// a worker and a dedicated etcd node on the given provider.
+func clusterCacheYAML(instanceID, provider string) string {
+	return `apiVersion: holodeck.nvidia.com/v1alpha1
+kind: Environment
+metadata:
+  name: node-test
+  labels:
+    holodeck-instance-id: ` + instanceID + `
+spec:
+  provider: ` + provider + `
+  auth:
+    keyName: test-key
+    privateKey: /path/to/key.pem
+    username: ubuntu
+  cluster:
+    controlPlane:
+      count: 1
+      hosts:
+        - hostUrl: 192.168.1.10
+    workers:
+      count: 1
+      hosts:
+        - hostUrl: 192.168.1.11
+status:
+  cluster:
+    totalNodes: 3
+    nodes:
+      - name: cp-0
+        role: control-plane
+        publicIp: 192.168.1.10
+      - name: worker-0
+        role: worker
+        publicIp: 192.168.1.11
+      - name: etcd-0
+        role: etcd
+        publicIp: 192.168.1.12
+`
+}
+
+var _ = Describe("Node Command", func() {
+	var (
+		log     *logger.FunLogger
+		buf     bytes.Buffer
+		tempDir string
+	)
+
+	BeforeEach(func() {
+		log = logger.NewLogger()
+		log.Out = &buf
+		buf.Reset()
+
+		tempDir = GinkgoT().TempDir()
+		cacheFile := filepath.Join(tempDir, "a1b2c3d4.yaml")
+		Expect(os.WriteFile(cacheFile, []byte(clusterCacheYAML("a1b2c3d4", "ssh")), 0600)).To(Succeed())
+	})
+
+	run := func(args ...string) error {
+		app := &cli.Command{
+			Commands: []*cli.Command{node.NewCommand(log)},
+		}
+		return app.Run(context.Background(), append([]string{"holodeck", "node"}, args...))
+	}
+
+	Describe("NewCommand", func() {
+		It("should create a command with replace and reprovision subcommands", func() {
+			cmd := node.NewCommand(log)
+			Expect(cmd).NotTo(BeNil())
+			Expect(cmd.Name).To(Equal("node"))
+
+			var names []string
+			for _, sub := range cmd.Commands {
+				names = append(names, sub.Name)
+			}
+			Expect(names).To(ConsistOf("replace", "reprovision"))
+		})
+	})
+
+	Describe("replace", func() {
+		It("should require an instance ID and a node name", func() {
+			err := run("replace", "a1b2c3d4")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("instance ID and node name are required"))
+		})
+
+		It("should fail when the node does not exist", func() {
+			err := run("replace", "--cachepath", tempDir, "a1b2c3d4", "worker-9")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("node worker-9 not found"))
+		})
+
+		It("should only replace workers", func() {
+			err := run("replace", "--cachepath", tempDir, "a1b2c3d4", "cp-0")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("only workers can be replaced"))
+		})
+
+		It("should reject providers that cannot relaunch instances", func() {
+			err := run("replace", "--cachepath", tempDir, "a1b2c3d4", "worker-0")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does not support scaling"))
+		})
+	})
+
+	Describe("reprovision", func() {
+		It("should require an instance ID and a node name", func() {
+			err := run("reprovision")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("instance ID and node name are required"))
+		})
+
+		It("should fail when instance does not exist", func() {
+			err := run("reprovision", "--cachepath", tempDir, "nonexistent", "worker-0")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to get instance"))
+		})
+
+		It("should not reprovision etcd nodes", func() {
+			err := run("reprovision", "--cachepath", tempDir, "a1b2c3d4", "etcd-0")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("only control-plane and worker nodes can be reprovisioned"))
+		})
+	})
+})
@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provision
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/cmd/cli/common"
+	"github.com/NVIDIA/holodeck/internal/instances"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/provisioner"
+
+	cli "github.com/urfave/cli/v3"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// provisioningFailedReason is the reason of the degraded condition set when
+// provisioning fails
+const provisioningFailedReason = "ProvisioningFailed"
+
+type command struct {
+	log       *logger.FunLogger
+	cachePath string
+	resume    bool
+}
+
+// NewCommand constructs the provision command with the specified logger
+func NewCommand(log *logger.FunLogger) *cli.Command {
+	c := &command{
+		log: log,
+	}
+	return c.build()
+}
+
+func (m *command) build() *cli.Command {
+	return &cli.Command{
+		Name:  "provision",
+		Usage: "Provision an existing Holodeck instance",
+		Description: `Run the provisioner against an instance that was created without
+--provision, or whose provisioning failed.
+
+With --resume, the components that a previous run completed are skipped
+and provisioning starts at the component that failed. Completed components
+are read from the state files each host records in /var/lib/holodeck/state.
+For a cluster, each node resumes on its own, including the Kubernetes
+prerequisites and the kubeadm init and join steps.
+
+Examples:
+  holodeck provision abc123
+  holodeck provision abc123 --resume`,
+		ArgsUsage: "<instance-id>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "cachepath",
+				Aliases:     []string{"c"},
+				Usage:       "Path to the cache directory",
+				Destination: &m.cachePath,
+				Value:       filepath.Join(os.Getenv("HOME"), ".cache", "holodeck"),
+			},
+			&cli.BoolFlag{
+				Name:        "resume",
+				Usage:       "Skip the components a previous run completed and resume at the one that failed",
+				Destination: &m.resume,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.NArg() != 1 {
+				return fmt.Errorf("instance ID is required")
+			}
+			return m.run(ctx, cmd.Args().Get(0))
+		},
+	}
+}
+
+func (m *command) run(ctx context.Context, instanceID string) error {
+	manager := instances.NewManager(m.log, m.cachePath)
+	instance, err := manager.GetInstance(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get instance: %w", err)
+	}
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](instance.CacheFile)
+	if err != nil {
+		return fmt.Errorf("failed to read environment: %w", err)
+	}
+
+	if m.resume {
+		m.log.Info("Resuming provisioning of instance %s (%s)", instanceID, instance.Name)
+	} else {
+		m.log.Info("Provisioning instance %s (%s)", instanceID, instance.Name)
+	}
+
+	if provisionErr := m.provision(ctx, &env); provisionErr != nil {
+		meta.SetStatusCondition(&env.Status.Conditions, metav1.Condition{
+			Type:    v1alpha1.ConditionDegraded,
+			Status:  metav1.ConditionTrue,
+			Reason:  provisioningFailedReason,
+			Message: fmt.Sprintf("Failed to provision environment: %v", provisionErr),
+		})
+		if err := writeCache(instance.CacheFile, &env); err != nil {
+			return err
+		}
+		m.log.Info("\n💡 To resume at the component that failed, run:")
+		m.log.Info("    holodeck provision %s --resume\n", instanceID)
+		return fmt.Errorf("provisioning failed: %w", provisionErr)
+	}
+
+	if c := meta.FindStatusCondition(env.Status.Conditions, v1alpha1.ConditionDegraded); c != nil && c.Reason == provisioningFailedReason {
+		meta.RemoveStatusCondition(&env.Status.Conditions, v1alpha1.ConditionDegraded)
+	}
+	if env.Labels == nil {
+		env.Labels = make(map[string]string)
+	}
+	env.Labels[instances.InstanceProvisionedLabelKey] = "true"
+	if err := writeCache(instance.CacheFile, &env); err != nil {
+		return err
+	}
+
+	m.log.Info("Successfully provisioned instance %s (%s)", instanceID, instance.Name)
+	return nil
+}
+
+// provision runs the provisioner on the instance, or on each node of a
+// cluster
+func (m *command) provision(ctx context.Context, env *v1alpha1.Environment) error {
+	if env.Spec.Cluster != nil {
+		if env.Status.Cluster == nil || len(env.Status.Cluster.Nodes) == 0 {
+			return fmt.Errorf("no nodes found in cluster status")
+		}
+		nodes := provisioner.ApplyHostInventory(m.log, &env.Spec, common.NodeInfos(env.Status.Cluster.Nodes))
+		cp := provisioner.NewClusterProvisioner(m.log, env.Spec.PrivateKey, env.Spec.Username, env)
+		cp.Resume = m.resume
+		if err := cp.ProvisionCluster(ctx, nodes); err != nil {
+			return err
+		}
+		common.MarkNodesReady(env.Status.Cluster)
+		return nil
+	}
+
+	hostUrl, err := common.GetHostURL(env, "", false)
+	if err != nil {
+		return fmt.Errorf("failed to determine host URL: %w", err)
+	}
+
+	opts := []provisioner.Option{provisioner.WithSSHConfig(env.Spec.SSHConfig)}
+	if m.resume {
+		opts = append(opts, provisioner.WithResume())
+	}
+	p, err := provisioner.New(ctx, m.log, env.Spec.PrivateKey, env.Spec.Username, hostUrl, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create provisioner: %w", err)
+	}
+	defer p.Client.Close() //nolint:errcheck
+
+	componentsStatus, err := p.Run(ctx, *env)
+	if err != nil {
+		return err
+	}
+	env.Status.Components = componentsStatus
+	return nil
+}
+
+func writeCache(cacheFile string, env *v1alpha1.Environment) error {
+	data, err := jyaml.MarshalYAML(env)
+	if err != nil {
+		return fmt.Errorf("failed to marshal environment: %w", err)
+	}
+	if err := os.WriteFile(cacheFile, data, 0600); err != nil {
+		return fmt.Errorf("failed to update cache file: %w", err)
+	}
+	return nil
+}
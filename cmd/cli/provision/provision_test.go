@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provision_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cli "github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/holodeck/cmd/cli/provision"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+func TestProvision(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Provision Command Suite")
+}
+
+// clusterCacheYAML is the cache of a cluster whose nodes are not known yet.
+const clusterCacheYAML = `apiVersion: holodeck.nvidia.com/v1alpha1
+kind: Environment
+metadata:
+  name: provision-test
+  labels:
+    holodeck-instance-id: a1b2c3d4
+spec:
+  provider: ssh
+  auth:
+    keyName: test-key
+    privateKey: /path/to/key.pem
+    username: ubuntu
+  cluster:
+    controlPlane:
+      count: 1
+      hosts:
+        - hostUrl: 192.168.1.10
+`
+
+var _ = Describe("Provision Command", func() {
+	var (
+		log       *logger.FunLogger
+		buf       bytes.Buffer
+		tempDir   string
+		cacheFile string
+	)
+
+	BeforeEach(func() {
+		log = logger.NewLogger()
+		log.Out = &buf
+		buf.Reset()
+
+		tempDir = GinkgoT().TempDir()
+		cacheFile = filepath.Join(tempDir, "a1b2c3d4.yaml")
+		Expect(os.WriteFile(cacheFile, []byte(clusterCacheYAML), 0600)).To(Succeed())
+	})
+
+	run := func(args ...string) error {
+		app := &cli.Command{
+			Commands: []*cli.Command{provision.NewCommand(log)},
+		}
+		return app.Run(context.Background(), append([]string{"holodeck", "provision"}, args...))
+	}
+
+	Describe("NewCommand", func() {
+		It("should create a command with a resume flag", func() {
+			cmd := provision.NewCommand(log)
+			Expect(cmd).NotTo(BeNil())
+			Expect(cmd.Name).To(Equal("provision"))
+
+			var names []string
+			for _, flag := range cmd.Flags {
+				names = append(names, flag.Names()...)
+			}
+			Expect(names).To(ContainElements("resume", "cachepath"))
+		})
+	})
+
+	It("should require an instance ID", func() {
+		err := run()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("instance ID is required"))
+	})
+
+	It("should fail when the instance does not exist", func() {
+		err := run("--cachepath", tempDir, "nonexistent")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("failed to get instance"))
+	})
+
+	It("should record the failure and suggest resuming", func() {
+		err := run("--cachepath", tempDir, "--resume", "a1b2c3d4")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("no nodes found in cluster status"))
+		Expect(buf.String()).To(ContainSubstring("holodeck provision a1b2c3d4 --resume"))
+
+		data, err := os.ReadFile(cacheFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring("ProvisioningFailed"))
+	})
+})
@@ -0,0 +1,298 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package reap
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/instances"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/cleanup"
+	"github.com/NVIDIA/holodeck/pkg/output"
+	"github.com/NVIDIA/holodeck/pkg/provider/aws"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+// Default timeout for reaping a single resource
+const defaultReapTimeout = 15 * time.Minute
+
+// Status values reported for each resource
+const (
+	statusExpired = "expired"
+	statusDeleted = "deleted"
+	statusFailed  = "failed"
+)
+
+// kindCache marks a local cache file in the report
+const kindCache = "cache"
+
+type command struct {
+	log          *logger.FunLogger
+	region       string
+	cachePath    string
+	dryRun       bool
+	timeout      time.Duration
+	outputFormat string
+}
+
+// ReapList is the report of a reap run
+type ReapList struct {
+	Resources []ReapedResource `json:"resources" yaml:"resources"`
+}
+
+// ReapedResource describes one expired resource and what was done with it
+type ReapedResource struct {
+	Kind      string    `json:"kind" yaml:"kind"`
+	ID        string    `json:"id" yaml:"id"`
+	Name      string    `json:"name" yaml:"name"`
+	ExpiresAt time.Time `json:"expiresAt" yaml:"expiresAt"`
+	Status    string    `json:"status" yaml:"status"`
+	Error     string    `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Headers implements output.TableData
+func (l *ReapList) Headers() []string {
+	return []string{"KIND", "ID", "NAME", "EXPIRES AT", "STATUS"}
+}
+
+// Rows implements output.TableData
+func (l *ReapList) Rows() [][]string {
+	rows := make([][]string, 0, len(l.Resources))
+	for _, r := range l.Resources {
+		rows = append(rows, []string{
+			r.Kind,
+			r.ID,
+			r.Name,
+			r.ExpiresAt.UTC().Format(time.RFC3339),
+			r.Status,
+		})
+	}
+	return rows
+}
+
+// NewCommand constructs the reap command with the specified logger
+func NewCommand(log *logger.FunLogger) *cli.Command {
+	c := &command{
+		log: log,
+	}
+	return c.build()
+}
+
+func (m *command) build() *cli.Command {
+	// Create the 'reap' command
+	reap := cli.Command{
+		Name:  "reap",
+		Usage: "Tear down expired AWS environments",
+		Description: `Find holodeck AWS resources whose ExpiresAt tag has passed and delete them.
+
+The ExpiresAt tag is written on every resource of an environment that sets
+spec.ttl or spec.expiresAt. Expired VPCs are deleted together with everything
+in them; expired instances in a VPC holodeck does not own are terminated.
+Resources are found by their tags, so environments created on another
+machine, or whose cache file was lost, are reaped too. Local cache files of
+reaped environments are removed.
+
+Examples:
+  # Show what would be deleted
+  holodeck reap --region us-west-2 --dry-run
+
+  # Delete expired environments and report as JSON
+  holodeck reap --region us-west-2 -o json`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "region",
+				Aliases:     []string{"r"},
+				Usage:       "AWS region (overrides AWS_REGION env var)",
+				Destination: &m.region,
+			},
+			&cli.StringFlag{
+				Name:        "cachepath",
+				Aliases:     []string{"c"},
+				Usage:       "Path to the cache directory",
+				Destination: &m.cachePath,
+			},
+			&cli.BoolFlag{
+				Name:        "dry-run",
+				Usage:       "Only report expired resources, do not delete them",
+				Destination: &m.dryRun,
+			},
+			&cli.DurationFlag{
+				Name:        "timeout",
+				Aliases:     []string{"t"},
+				Usage:       "Timeout per resource (default: 15m)",
+				Value:       defaultReapTimeout,
+				Destination: &m.timeout,
+			},
+			&cli.StringFlag{
+				Name:        "output",
+				Aliases:     []string{"o"},
+				Usage:       "Output format: table, json, yaml (default: table)",
+				Destination: &m.outputFormat,
+				Value:       "table",
+			},
+		},
+		Action: func(ctx context.Context, _ *cli.Command) error {
+			return m.run(ctx)
+		},
+	}
+
+	return &reap
+}
+
+func (m *command) run(ctx context.Context) error {
+	formatter, err := output.NewFormatter(m.outputFormat)
+	if err != nil {
+		return fmt.Errorf("invalid output format %q, must be one of: %s", m.outputFormat, strings.Join(output.ValidFormats(), ", "))
+	}
+
+	// Determine the region
+	region := m.region
+	if region == "" {
+		region = os.Getenv("AWS_REGION")
+		if region == "" {
+			region = os.Getenv("AWS_DEFAULT_REGION")
+			if region == "" {
+				return fmt.Errorf("AWS region must be specified via --region flag or AWS_REGION environment variable")
+			}
+		}
+	}
+
+	timeout := m.timeout
+	if timeout == 0 {
+		timeout = defaultReapTimeout
+	}
+
+	//nolint:contextcheck // cleanup.New is a top-level initializer without a ctx parameter
+	cleaner, err := cleanup.New(m.log, region)
+	if err != nil {
+		return fmt.Errorf("failed to create cleaner: %w", err)
+	}
+
+	now := time.Now()
+	expired, err := cleaner.FindExpired(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to find expired resources: %w", err)
+	}
+
+	report := &ReapList{Resources: make([]ReapedResource, 0, len(expired))}
+	reaped := make(map[string]bool)
+	failCount := 0
+
+	for _, r := range expired {
+		entry := ReapedResource{Kind: r.Kind, ID: r.ID, Name: r.Name, ExpiresAt: r.ExpiresAt, Status: statusExpired}
+		if !m.dryRun {
+			if ctx.Err() != nil {
+				m.log.Warning("Reap cancelled, skipping remaining resources")
+				break
+			}
+			m.log.Info("Reaping %s %s (%s), expired at %s", r.Kind, r.ID, r.Name, r.ExpiresAt.UTC().Format(time.RFC3339))
+			reapCtx, cancel := context.WithTimeout(ctx, timeout)
+			err := cleaner.Reap(reapCtx, r)
+			cancel()
+			if err != nil {
+				m.log.Error(fmt.Errorf("failed to reap %s %s: %w", r.Kind, r.ID, err))
+				entry.Status = statusFailed
+				entry.Error = err.Error()
+				failCount++
+			} else {
+				entry.Status = statusDeleted
+			}
+		}
+		if entry.Status != statusFailed {
+			reaped[r.ID] = true
+		}
+		report.Resources = append(report.Resources, entry)
+	}
+
+	report.Resources = append(report.Resources, m.pruneCache(reaped, now)...)
+
+	if len(report.Resources) == 0 {
+		m.log.Info("No expired resources found")
+		return nil
+	}
+	if err := formatter.Print(report); err != nil {
+		return err
+	}
+
+	if failCount > 0 {
+		return fmt.Errorf("reap completed with errors: %d of %d resources failed", failCount, len(expired))
+	}
+	return nil
+}
+
+// pruneCache removes the cache files of expired AWS environments whose VPC
+// or instances were reaped, so that "holodeck list" stops showing them.
+func (m *command) pruneCache(reaped map[string]bool, now time.Time) []ReapedResource {
+	cached, err := instances.NewManager(m.log, m.cachePath).ReadCache()
+	if err != nil {
+		m.log.Warning("Failed to read local cache: %v", err)
+		return nil
+	}
+
+	var pruned []ReapedResource
+	for _, c := range cached {
+		env := c.Environment
+		if env.Spec.Provider != v1alpha1.ProviderAWS || env.Spec.ExpiresAt == nil || !env.Spec.ExpiresAt.Time.Before(now) {
+			continue
+		}
+		if !ownsReaped(env, reaped) {
+			continue
+		}
+
+		entry := ReapedResource{
+			Kind:      kindCache,
+			ID:        c.ID,
+			Name:      env.Name,
+			ExpiresAt: env.Spec.ExpiresAt.Time,
+			Status:    statusExpired,
+		}
+		if !m.dryRun {
+			if err := os.Remove(c.CacheFile); err != nil {
+				m.log.Warning("Failed to remove cache file %s: %v", c.CacheFile, err)
+				entry.Status = statusFailed
+				entry.Error = err.Error()
+			} else {
+				entry.Status = statusDeleted
+			}
+		}
+		pruned = append(pruned, entry)
+	}
+	return pruned
+}
+
+// ownsReaped reports whether the cached environment's VPC or any of its
+// instances is among the reaped resource IDs.
+func ownsReaped(env v1alpha1.Environment, reaped map[string]bool) bool {
+	for _, p := range env.Status.Properties {
+		if (p.Name == aws.VpcID || p.Name == aws.InstanceID) && reaped[p.Value] {
+			return true
+		}
+	}
+	if env.Status.Cluster != nil {
+		for _, node := range env.Status.Cluster.Nodes {
+			if reaped[node.InstanceID] {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package reap_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cli "github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/holodeck/cmd/cli/reap"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+func TestReap(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Reap Command Suite")
+}
+
+var _ = Describe("Reap Command", func() {
+	var (
+		log *logger.FunLogger
+		buf bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		log = logger.NewLogger()
+		log.Out = &buf
+	})
+
+	Describe("NewCommand", func() {
+		It("should create a valid command", func() {
+			cmd := reap.NewCommand(log)
+			Expect(cmd).NotTo(BeNil())
+			Expect(cmd.Name).To(Equal("reap"))
+			Expect(cmd.Action).NotTo(BeNil())
+			Expect(cmd.Description).To(ContainSubstring("ExpiresAt"))
+		})
+
+		It("should have region, dry-run and output flags", func() {
+			cmd := reap.NewCommand(log)
+			flagNames := make(map[string]bool)
+			for _, flag := range cmd.Flags {
+				for _, name := range flag.Names() {
+					flagNames[name] = true
+				}
+			}
+			Expect(flagNames).To(HaveKey("region"))
+			Expect(flagNames).To(HaveKey("r"))
+			Expect(flagNames).To(HaveKey("dry-run"))
+			Expect(flagNames).To(HaveKey("output"))
+			Expect(flagNames).To(HaveKey("o"))
+		})
+	})
+
+	Describe("Command action", func() {
+		It("should reject an unknown output format", func() {
+			app := &cli.Command{Commands: []*cli.Command{reap.NewCommand(log)}}
+			err := app.Run(context.Background(), []string{"holodeck", "reap", "-r", "us-west-2", "-o", "xml"})
+			Expect(err).To(MatchError(ContainSubstring(`invalid output format "xml"`)))
+		})
+
+		It("should require a region", func() {
+			GinkgoT().Setenv("AWS_REGION", "")
+			GinkgoT().Setenv("AWS_DEFAULT_REGION", "")
+
+			app := &cli.Command{Commands: []*cli.Command{reap.NewCommand(log)}}
+			err := app.Run(context.Background(), []string{"holodeck", "reap", "--dry-run"})
+			Expect(err).To(MatchError(ContainSubstring("AWS region must be specified")))
+		})
+	})
+})
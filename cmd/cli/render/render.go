@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package render
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/cmd/cli/common"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/provider/ssh"
+	"github.com/NVIDIA/holodeck/pkg/provisioner"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+// defaultOutputDir is the directory the files are written to by default
+const defaultOutputDir = "rendered"
+
+type options struct {
+	envFile   string
+	node      string
+	outputDir string
+
+	cfg v1alpha1.Environment
+}
+
+type command struct {
+	log *logger.FunLogger
+}
+
+// NewCommand constructs the render command with the specified logger
+func NewCommand(log *logger.FunLogger) *cli.Command {
+	c := command{
+		log: log,
+	}
+	return c.build()
+}
+
+func (m command) build() *cli.Command {
+	opts := options{}
+
+	return &cli.Command{
+		Name:  "render",
+		Usage: "Write the provisioning scripts of an environment to disk",
+		Description: `Write the provisioning scripts and configuration files of an environment
+to a directory without connecting to any host, to review or debug them.
+
+Scripts are numbered in the order they run. For a cluster, each node gets a
+directory with its own scripts, including the kubeadm init and join and
+etcd bootstrap scripts. Values only known once the environment exists, such
+as node addresses and join credentials, are filled in from the environment
+status when available and with placeholders otherwise.
+
+Examples:
+  holodeck render -f env.yaml
+  holodeck render -f cluster.yaml --node demo-worker-0 -o /tmp/scripts`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "envFile",
+				Aliases:     []string{"f"},
+				Usage:       "Path to the Environment file",
+				Destination: &opts.envFile,
+			},
+			&cli.StringFlag{
+				Name:        "node",
+				Usage:       "Only render the files of the named cluster node",
+				Destination: &opts.node,
+			},
+			&cli.StringFlag{
+				Name:        "output",
+				Aliases:     []string{"o"},
+				Usage:       "Directory to write the files to",
+				Destination: &opts.outputDir,
+				Value:       defaultOutputDir,
+			},
+		},
+		Before: func(ctx context.Context, _ *cli.Command) (context.Context, error) {
+			var err error
+			opts.cfg, err = jyaml.UnmarshalFromFile[v1alpha1.Environment](opts.envFile)
+			if err != nil {
+				return ctx, fmt.Errorf("error reading config file: %w", err)
+			}
+			return ctx, nil
+		},
+		Action: func(_ context.Context, _ *cli.Command) error {
+			return m.run(&opts)
+		},
+	}
+}
+
+func (m command) run(opts *options) error {
+	renderOpts := provisioner.RenderOptions{
+		Nodes: clusterNodes(&opts.cfg),
+		Node:  opts.node,
+	}
+	if opts.cfg.Spec.Cluster == nil {
+		if host, err := common.GetHostURL(&opts.cfg, "", false); err == nil {
+			renderOpts.Host = host
+		}
+	}
+
+	files, err := provisioner.Render(opts.cfg, renderOpts)
+	if err != nil {
+		return fmt.Errorf("failed to render %s: %w", opts.envFile, err)
+	}
+
+	for _, f := range files {
+		path := filepath.Join(opts.outputDir, f.Path)
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", path, err)
+		}
+		// Scripts may embed etcd private keys
+		if err := os.WriteFile(path, f.Content, 0600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		m.log.Info("  %s", path)
+	}
+	m.log.Info("Rendered %d files to %s", len(files), opts.outputDir)
+	return nil
+}
+
+// clusterNodes returns the known nodes of a cluster environment: those of
+// its status, or the host inventory of the ssh provider. It returns nil
+// when the nodes are not known yet.
+func clusterNodes(env *v1alpha1.Environment) []provisioner.NodeInfo {
+	if env.Spec.Cluster == nil {
+		return nil
+	}
+	if env.Status.Cluster != nil && len(env.Status.Cluster.Nodes) > 0 {
+		return common.NodeInfos(env.Status.Cluster.Nodes)
+	}
+	if env.Spec.Provider == v1alpha1.ProviderSSH {
+		return common.NodeInfos(ssh.ClusterStatusFromInventory(env.Spec.Cluster, env.Spec.Username).Nodes)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package render
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+func TestNewCommand(t *testing.T) {
+	cmd := NewCommand(logger.NewLogger())
+	assert.Equal(t, "render", cmd.Name)
+
+	names := map[string]bool{}
+	for _, f := range cmd.Flags {
+		for _, n := range f.Names() {
+			names[n] = true
+		}
+	}
+	for _, want := range []string{"envFile", "f", "node", "output", "o"} {
+		assert.True(t, names[want], "missing flag %s", want)
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	opts := &options{
+		outputDir: dir,
+		cfg: v1alpha1.Environment{
+			Spec: v1alpha1.EnvironmentSpec{
+				Provider: v1alpha1.ProviderSSH,
+				Instance: v1alpha1.Instance{HostUrl: "10.0.0.5"},
+				ContainerRuntime: v1alpha1.ContainerRuntime{
+					Install: true,
+					Name:    v1alpha1.ContainerRuntimeContainerd,
+				},
+				Kubernetes: v1alpha1.Kubernetes{
+					Install:             true,
+					KubernetesInstaller: "kubeadm",
+				},
+			},
+		},
+	}
+
+	require.NoError(t, command{log: logger.NewLogger()}.run(opts))
+
+	config, err := os.ReadFile(filepath.Join(dir, "kubeadm-config.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(config), "10.0.0.5")
+	for _, name := range []string{"01-containerd.sh", "02-kubeadm.sh"} {
+		info, err := os.Stat(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+}
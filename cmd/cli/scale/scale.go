@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package scale
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/cmd/cli/common"
+	"github.com/NVIDIA/holodeck/internal/instances"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/provisioner"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+type command struct {
+	log       *logger.FunLogger
+	cachePath string
+	workers   int
+	pool      string
+}
+
+// NewCommand constructs the scale command with the specified logger
+func NewCommand(log *logger.FunLogger) *cli.Command {
+	c := command{
+		log: log,
+	}
+	return c.build()
+}
+
+func (m command) build() *cli.Command {
+	// Create the 'scale' command
+	scale := cli.Command{
+		Name:  "scale",
+		Usage: "Change the number of workers of a running Holodeck cluster",
+		Description: `Scale a worker pool of a running cluster to the given number of workers.
+New workers are launched into the cluster's subnet and security groups,
+provisioned and joined with a fresh kubeadm join token. Removed workers are
+cordoned, drained and deleted from the cluster before their instances are
+terminated; the most recently added workers are removed first.`,
+		ArgsUsage: "<instance-id>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "cachepath",
+				Aliases:     []string{"c"},
+				Usage:       "Path to the cache directory",
+				Destination: &m.cachePath,
+				Value:       filepath.Join(os.Getenv("HOME"), ".cache", "holodeck"),
+			},
+			&cli.IntFlag{
+				Name:        "workers",
+				Aliases:     []string{"w"},
+				Usage:       "Number of workers the pool should have",
+				Destination: &m.workers,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "pool",
+				Aliases:     []string{"p"},
+				Usage:       "Name of the worker pool to scale (required for clusters with workerPools)",
+				Destination: &m.pool,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.NArg() != 1 {
+				return fmt.Errorf("exactly one instance ID is required")
+			}
+			if m.workers < 0 {
+				return fmt.Errorf("--workers cannot be negative, got %d", m.workers)
+			}
+			return m.run(ctx, cmd.Args().First())
+		},
+	}
+
+	return &scale
+}
+
+func (m command) run(ctx context.Context, instanceID string) error {
+	manager := instances.NewManager(m.log, m.cachePath)
+
+	instance, err := manager.GetInstance(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get instance %s: %w", instanceID, err)
+	}
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](instance.CacheFile)
+	if err != nil {
+		return fmt.Errorf("failed to read environment: %w", err)
+	}
+	if env.Spec.Cluster == nil || env.Status.Cluster == nil {
+		return fmt.Errorf("instance %s is not a cluster", instanceID)
+	}
+	if env.Spec.Cluster.WorkerPool(m.pool) == nil {
+		if m.pool == "" && len(env.Spec.Cluster.WorkerPools) > 0 {
+			return fmt.Errorf("cluster has worker pools, select one with --pool")
+		}
+		return fmt.Errorf("cluster has no worker pool %q", m.pool)
+	}
+
+	workers := poolWorkers(env.Status.Cluster.Nodes, m.pool)
+	current := len(workers)
+	if m.workers == current {
+		m.log.Info("Instance %s already has %d worker(s)", instanceID, current)
+		return nil
+	}
+	// Fail before draining any node if the provider cannot scale
+	if err := manager.ValidateScaling(instanceID); err != nil {
+		return err
+	}
+
+	if m.workers > current {
+		err = m.scaleUp(ctx, manager, instance, &env, m.workers-current)
+	} else {
+		err = m.scaleDown(ctx, manager, instance, &env, workers[m.workers:])
+	}
+	if err != nil {
+		return err
+	}
+
+	m.log.Info("Successfully scaled instance %s (%s) from %d to %d worker(s)", instanceID, instance.Name, current, m.workers)
+	return nil
+}
+
+// scaleUp launches count workers and, on a provisioned Kubernetes cluster,
+// joins them to it. The workers are Pending until they joined, and the
+// environment is marked Degraded if they fail to.
+func (m command) scaleUp(ctx context.Context, manager *instances.Manager, instance *instances.Instance, env *v1alpha1.Environment, count int) error {
+	added, err := manager.AddWorkers(ctx, instance.ID, m.pool, count)
+	if err != nil {
+		return fmt.Errorf("failed to add workers: %w", err)
+	}
+	return common.RecordJoin(instance.CacheFile, added, m.join(ctx, instance, env, added))
+}
+
+// join joins workers to a provisioned Kubernetes cluster
+func (m command) join(ctx context.Context, instance *instances.Instance, env *v1alpha1.Environment, workers []v1alpha1.NodeStatus) error {
+	if !joinsKubernetes(instance, env) {
+		return nil
+	}
+	firstCP, err := common.FirstControlPlane(env)
+	if err != nil {
+		return err
+	}
+	cp := provisioner.NewClusterProvisioner(m.log, env.Spec.PrivateKey, env.Spec.Username, env)
+	if err := cp.JoinWorkers(ctx, firstCP, common.NodeInfos(workers)); err != nil {
+		return fmt.Errorf("failed to join workers: %w", err)
+	}
+	return nil
+}
+
+// scaleDown drains the workers from a provisioned Kubernetes cluster and
+// terminates them
+func (m command) scaleDown(ctx context.Context, manager *instances.Manager, instance *instances.Instance, env *v1alpha1.Environment, workers []v1alpha1.NodeStatus) error {
+	if joinsKubernetes(instance, env) {
+		firstCP, err := common.FirstControlPlane(env)
+		if err != nil {
+			return err
+		}
+		cp := provisioner.NewClusterProvisioner(m.log, env.Spec.PrivateKey, env.Spec.Username, env)
+		if err := cp.RemoveWorkers(ctx, firstCP, common.NodeInfos(workers)); err != nil {
+			return fmt.Errorf("failed to drain workers: %w", err)
+		}
+	}
+
+	names := make([]string, 0, len(workers))
+	for _, w := range workers {
+		names = append(names, w.Name)
+	}
+	if err := manager.RemoveWorkers(ctx, instance.ID, names); err != nil {
+		return fmt.Errorf("failed to remove workers: %w", err)
+	}
+	return nil
+}
+
+// joinsKubernetes returns true if workers are part of a Kubernetes cluster:
+// one was requested and provisioning it has completed
+func joinsKubernetes(instance *instances.Instance, env *v1alpha1.Environment) bool {
+	return env.Spec.Kubernetes.Install && instance.Provisioned
+}
+
+// poolWorkers returns the worker nodes of pool, oldest first
+func poolWorkers(nodes []v1alpha1.NodeStatus, pool string) []v1alpha1.NodeStatus {
+	var workers []v1alpha1.NodeStatus
+	for _, n := range nodes {
+		if n.Role == "worker" && n.Pool == pool {
+			workers = append(workers, n)
+		}
+	}
+	// Node names only differ in their index suffix, so a shorter name has
+	// a lower index
+	slices.SortFunc(workers, func(a, b v1alpha1.NodeStatus) int {
+		return cmp.Or(cmp.Compare(len(a.Name), len(b.Name)), strings.Compare(a.Name, b.Name))
+	})
+	return workers
+}
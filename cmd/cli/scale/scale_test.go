@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package scale
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cli "github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+func TestScale(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Scale Command Suite")
+}
+
+// sshClusterCacheYAML returns a cache YAML for an SSH provider cluster,
+// which does not support scaling.
+func sshClusterCacheYAML(instanceID, name string) string {
+	return `apiVersion: holodeck.nvidia.com/v1alpha1
+kind: Environment
+metadata:
+  name: ` + name + `
+  labels:
+    holodeck-instance-id: ` + instanceID + `
+spec:
+  provider: ssh
+  auth:
+    keyName: test-key
+    privateKey: /path/to/key.pem
+    username: ubuntu
+  cluster:
+    controlPlane:
+      count: 1
+      hosts:
+        - hostUrl: 192.168.1.10
+    workers:
+      count: 1
+      hosts:
+        - hostUrl: 192.168.1.11
+status:
+  cluster:
+    totalNodes: 2
+    nodes:
+      - name: cp-0
+        role: control-plane
+        publicIp: 192.168.1.10
+      - name: worker-0
+        role: worker
+        publicIp: 192.168.1.11
+`
+}
+
+var _ = Describe("Scale Command", func() {
+	var (
+		log *logger.FunLogger
+		buf bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		log = logger.NewLogger()
+		log.Out = &buf
+		buf.Reset()
+	})
+
+	Describe("NewCommand", func() {
+		It("should create a valid command", func() {
+			cmd := NewCommand(log)
+			Expect(cmd).NotTo(BeNil())
+			Expect(cmd.Name).To(Equal("scale"))
+			Expect(cmd.Usage).To(ContainSubstring("workers"))
+		})
+
+		It("should have cachepath, workers and pool flags", func() {
+			cmd := NewCommand(log)
+			flagNames := make(map[string]bool)
+			for _, flag := range cmd.Flags {
+				for _, name := range flag.Names() {
+					flagNames[name] = true
+				}
+			}
+			for _, name := range []string{"cachepath", "c", "workers", "w", "pool", "p"} {
+				Expect(flagNames).To(HaveKey(name))
+			}
+		})
+	})
+
+	Describe("Command action", func() {
+		run := func(args ...string) error {
+			app := &cli.Command{
+				Commands: []*cli.Command{NewCommand(log)},
+			}
+			return app.Run(context.Background(), append([]string{"holodeck", "scale"}, args...))
+		}
+
+		It("should require exactly one instance ID", func() {
+			err := run("--workers", "2")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exactly one instance ID is required"))
+		})
+
+		It("should reject a negative worker count", func() {
+			err := run("--workers", "-1", "a1b2c3d4")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("cannot be negative"))
+		})
+
+		It("should fail when instance does not exist", func() {
+			err := run("--cachepath", GinkgoT().TempDir(), "--workers", "2", "nonexistent")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to get instance"))
+		})
+
+		It("should reject an unknown worker pool", func() {
+			tempDir := GinkgoT().TempDir()
+			cacheFile := filepath.Join(tempDir, "a1b2c3d4.yaml")
+			Expect(os.WriteFile(cacheFile, []byte(sshClusterCacheYAML("a1b2c3d4", "ssh-scale-test")), 0600)).To(Succeed())
+
+			err := run("--cachepath", tempDir, "--workers", "2", "--pool", "gpu", "a1b2c3d4")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`no worker pool "gpu"`))
+		})
+
+		It("should reject providers without scaling support", func() {
+			tempDir := GinkgoT().TempDir()
+			cacheFile := filepath.Join(tempDir, "a1b2c3d4.yaml")
+			Expect(os.WriteFile(cacheFile, []byte(sshClusterCacheYAML("a1b2c3d4", "ssh-scale-test")), 0600)).To(Succeed())
+
+			err := run("--cachepath", tempDir, "--workers", "2", "a1b2c3d4")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does not support scaling"))
+		})
+
+		It("should do nothing when the pool already has the requested size", func() {
+			tempDir := GinkgoT().TempDir()
+			cacheFile := filepath.Join(tempDir, "a1b2c3d4.yaml")
+			Expect(os.WriteFile(cacheFile, []byte(sshClusterCacheYAML("a1b2c3d4", "ssh-scale-test")), 0600)).To(Succeed())
+
+			Expect(run("--cachepath", tempDir, "--workers", "1", "a1b2c3d4")).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("already has 1 worker(s)"))
+		})
+	})
+
+	Describe("poolWorkers", func() {
+		It("should return the workers of a pool ordered by index", func() {
+			nodes := []v1alpha1.NodeStatus{
+				{Name: "env-worker-gpu-10", Role: "worker", Pool: "gpu"},
+				{Name: "env-cp-0", Role: "control-plane"},
+				{Name: "env-worker-gpu-2", Role: "worker", Pool: "gpu"},
+				{Name: "env-worker-cpu-0", Role: "worker", Pool: "cpu"},
+				{Name: "env-worker-gpu-0", Role: "worker", Pool: "gpu"},
+			}
+			var names []string
+			for _, n := range poolWorkers(nodes, "gpu") {
+				names = append(names, n.Name)
+			}
+			Expect(names).To(Equal([]string{"env-worker-gpu-0", "env-worker-gpu-2", "env-worker-gpu-10"}))
+		})
+	})
+})
@@ -93,12 +93,11 @@ Examples:
 				Destination: &m.recursive,
 			},
 		},
-		Action: func(_ context.Context, cmd *cli.Command) error {
+		Action: func(ctx context.Context, cmd *cli.Command) error {
 			if cmd.NArg() != 2 {
 				return fmt.Errorf("source and destination are required")
 			}
-			//nolint:contextcheck // run -> common.ConnectSSH is a CLI action boundary with no ctx parameter by design (public signature is locked); threading requires a signature change out of scope here.
-			return m.run(cmd.Args().Get(0), cmd.Args().Get(1))
+			return m.run(ctx, cmd.Args().Get(0), cmd.Args().Get(1))
 		},
 	}
 
@@ -128,7 +127,7 @@ func parsePath(path string) pathSpec {
 	return pathSpec{path: path, isRemote: false}
 }
 
-func (m command) run(src, dst string) error {
+func (m command) run(ctx context.Context, src, dst string) error {
 	srcSpec := parsePath(src)
 	dstSpec := parsePath(dst)
 
@@ -150,7 +149,7 @@ func (m command) run(src, dst string) error {
 
 	// Get instance details
 	manager := instances.NewManager(m.log, m.cachePath)
-	instance, err := manager.GetInstance(instanceID)
+	instance, err := manager.GetInstance(ctx, instanceID)
 	if err != nil {
 		return fmt.Errorf("failed to get instance: %w", err)
 	}
@@ -175,7 +174,7 @@ func (m command) run(src, dst string) error {
 	}
 
 	// Create SSH and SFTP clients
-	sshClient, err := common.ConnectSSH(m.log, keyPath, userName, hostUrl)
+	sshClient, err := common.ConnectSSH(ctx, m.log, keyPath, userName, hostUrl)
 	if err != nil {
 		return fmt.Errorf("failed to connect: %w", err)
 	}
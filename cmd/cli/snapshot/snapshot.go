@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/holodeck/internal/instances"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+type command struct {
+	log       *logger.FunLogger
+	cachePath string
+	name      string
+	noReboot  bool
+}
+
+// NewCommand constructs the snapshot command with the specified logger
+func NewCommand(log *logger.FunLogger) *cli.Command {
+	c := command{
+		log: log,
+	}
+	return c.build()
+}
+
+func (m command) build() *cli.Command {
+	// Create the 'snapshot' command
+	snapshot := cli.Command{
+		Name:  "snapshot",
+		Usage: "Create a golden image from a provisioned Holodeck environment",
+		Description: `Create a machine image (an AMI on AWS) from the instance of a provisioned
+environment. The provenance of the installed components is recorded on the
+image, so environments created from it with instance.image.imageId skip
+installing components that already match their spec.`,
+		ArgsUsage: "<instance-id>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "cachepath",
+				Aliases:     []string{"c"},
+				Usage:       "Path to the cache directory",
+				Destination: &m.cachePath,
+				Value:       filepath.Join(os.Getenv("HOME"), ".cache", "holodeck"),
+			},
+			&cli.StringFlag{
+				Name:        "name",
+				Aliases:     []string{"n"},
+				Usage:       "Name of the image to create",
+				Destination: &m.name,
+				Required:    true,
+			},
+			&cli.BoolFlag{
+				Name:        "no-reboot",
+				Usage:       "Do not reboot the instance before imaging it (file system integrity is not guaranteed)",
+				Destination: &m.noReboot,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.NArg() != 1 {
+				return fmt.Errorf("exactly one instance ID is required")
+			}
+			return m.run(ctx, cmd.Args().First())
+		},
+	}
+
+	return &snapshot
+}
+
+func (m command) run(ctx context.Context, instanceID string) error {
+	manager := instances.NewManager(m.log, m.cachePath)
+
+	instance, err := manager.GetInstance(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get instance %s: %w", instanceID, err)
+	}
+	if !instance.Provisioned {
+		return fmt.Errorf("instance %s is not provisioned", instanceID)
+	}
+
+	imageID, err := manager.SnapshotInstance(ctx, instanceID, provider.SnapshotOptions{
+		Name:     m.name,
+		NoReboot: m.noReboot,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to snapshot instance %s: %w", instanceID, err)
+	}
+
+	m.log.Info("Created image %s from instance %s (%s)", imageID, instanceID, instance.Name)
+	fmt.Println(imageID)
+
+	return nil
+}
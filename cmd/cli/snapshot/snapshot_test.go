@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cli "github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/holodeck/cmd/cli/snapshot"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+func TestSnapshot(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Snapshot Command Suite")
+}
+
+// sshCacheYAML returns a cache YAML for an SSH provider instance, which
+// does not support snapshots.
+func sshCacheYAML(instanceID, provisioned string) string {
+	return `apiVersion: holodeck.nvidia.com/v1alpha1
+kind: Environment
+metadata:
+  name: ssh-snapshot-test
+  labels:
+    holodeck-instance-id: ` + instanceID + `
+    holodeck-instance-provisioned: "` + provisioned + `"
+spec:
+  provider: ssh
+  instance:
+    hostUrl: 192.168.1.100
+  auth:
+    keyName: test-key
+    privateKey: /path/to/key.pem
+    username: ubuntu
+`
+}
+
+var _ = Describe("Snapshot Command", func() {
+	var (
+		log *logger.FunLogger
+		buf bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		log = logger.NewLogger()
+		log.Out = &buf
+		buf.Reset()
+	})
+
+	run := func(args ...string) error {
+		app := &cli.Command{
+			Commands: []*cli.Command{snapshot.NewCommand(log)},
+		}
+		return app.Run(context.Background(), append([]string{"holodeck", "snapshot"}, args...))
+	}
+
+	Describe("NewCommand", func() {
+		It("should create a valid command", func() {
+			cmd := snapshot.NewCommand(log)
+			Expect(cmd).NotTo(BeNil())
+			Expect(cmd.Name).To(Equal("snapshot"))
+			Expect(cmd.Usage).To(ContainSubstring("golden image"))
+		})
+
+		It("should have name, no-reboot and cachepath flags", func() {
+			cmd := snapshot.NewCommand(log)
+			flagNames := make(map[string]bool)
+			for _, flag := range cmd.Flags {
+				for _, name := range flag.Names() {
+					flagNames[name] = true
+				}
+			}
+			Expect(flagNames).To(HaveKey("name"))
+			Expect(flagNames).To(HaveKey("no-reboot"))
+			Expect(flagNames).To(HaveKey("cachepath"))
+		})
+	})
+
+	Describe("Command action", func() {
+		It("should require the image name", func() {
+			err := run("a1b2c3d4")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("name"))
+		})
+
+		It("should require exactly one instance ID", func() {
+			err := run("--name", "golden")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exactly one instance ID is required"))
+
+			err = run("--name", "golden", "a1b2c3d4", "e5f6a7b8")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exactly one instance ID is required"))
+		})
+
+		It("should fail when instance does not exist", func() {
+			tempDir := GinkgoT().TempDir()
+			err := run("--cachepath", tempDir, "--name", "golden", "nonexistent")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to get instance"))
+		})
+
+		It("should refuse instances that are not provisioned", func() {
+			tempDir := GinkgoT().TempDir()
+			cacheFile := filepath.Join(tempDir, "a1b2c3d4.yaml")
+			Expect(os.WriteFile(cacheFile, []byte(sshCacheYAML("a1b2c3d4", "false")), 0600)).To(Succeed())
+
+			err := run("--cachepath", tempDir, "--name", "golden", "a1b2c3d4")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not provisioned"))
+		})
+
+		It("should reject providers without snapshot support", func() {
+			tempDir := GinkgoT().TempDir()
+			cacheFile := filepath.Join(tempDir, "a1b2c3d4.yaml")
+			Expect(os.WriteFile(cacheFile, []byte(sshCacheYAML("a1b2c3d4", "true")), 0600)).To(Succeed())
+
+			err := run("--cachepath", tempDir, "--name", "golden", "a1b2c3d4")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does not support snapshots"))
+		})
+	})
+})
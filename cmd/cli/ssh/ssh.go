@@ -86,14 +86,13 @@ If you need special quoting, wrap the entire command in single quotes:
 				Destination: &m.node,
 			},
 		},
-		Action: func(_ context.Context, cmd *cli.Command) error {
+		Action: func(ctx context.Context, cmd *cli.Command) error {
 			if cmd.NArg() < 1 {
 				return fmt.Errorf("instance ID is required")
 			}
 			instanceID := cmd.Args().Get(0)
 
-			//nolint:contextcheck // run -> common.ConnectSSH is a CLI action boundary with no ctx parameter by design (public signature is locked); threading requires a signature change out of scope here.
-			return m.run(instanceID, remoteCommand(cmd.Args()))
+			return m.run(ctx, instanceID, remoteCommand(cmd.Args()))
 		},
 	}
 
@@ -111,10 +110,10 @@ func remoteCommand(args cli.Args) []string {
 	return args.Tail()
 }
 
-func (m command) run(instanceID string, remoteCmd []string) error {
+func (m command) run(ctx context.Context, instanceID string, remoteCmd []string) error {
 	// Get instance details
 	manager := instances.NewManager(m.log, m.cachePath)
-	instance, err := manager.GetInstance(instanceID)
+	instance, err := manager.GetInstance(ctx, instanceID)
 	if err != nil {
 		return fmt.Errorf("failed to get instance: %w", err)
 	}
@@ -144,7 +143,7 @@ func (m command) run(instanceID string, remoteCmd []string) error {
 	}
 
 	// For command execution, use Go SSH library
-	client, err := common.ConnectSSH(m.log, keyPath, userName, hostUrl)
+	client, err := common.ConnectSSH(ctx, m.log, keyPath, userName, hostUrl)
 	if err != nil {
 		return fmt.Errorf("failed to connect: %w", err)
 	}
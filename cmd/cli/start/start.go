@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package start
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/cmd/cli/common"
+	"github.com/NVIDIA/holodeck/internal/instances"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/utils"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+type command struct {
+	log        *logger.FunLogger
+	cachePath  string
+	kubeconfig string
+}
+
+// NewCommand constructs the start command with the specified logger
+func NewCommand(log *logger.FunLogger) *cli.Command {
+	c := command{
+		log: log,
+	}
+	return c.build()
+}
+
+func (m command) build() *cli.Command {
+	// Create the 'start' command
+	start := cli.Command{
+		Name:  "start",
+		Usage: "Start the instances of one or more stopped Holodeck environments",
+		Description: `Start the instances of environments stopped with "holodeck stop". EC2
+assigns new public addresses on restart; they are recorded in the cache and,
+for environments with Kubernetes remote access, written to the local
+kubeconfig.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "cachepath",
+				Aliases:     []string{"c"},
+				Usage:       "Path to the cache directory",
+				Destination: &m.cachePath,
+				Value:       filepath.Join(os.Getenv("HOME"), ".cache", "holodeck"),
+			},
+			&cli.StringFlag{
+				Name:        "kubeconfig",
+				Aliases:     []string{"k"},
+				Usage:       "Path to the local kubeconfig to update (default: spec.kubernetes.kubeConfig)",
+				Destination: &m.kubeconfig,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.NArg() == 0 {
+				return fmt.Errorf("at least one instance ID is required")
+			}
+			return m.run(ctx, cmd)
+		},
+	}
+
+	return &start
+}
+
+func (m command) run(ctx context.Context, cmd *cli.Command) error {
+	manager := instances.NewManager(m.log, m.cachePath)
+
+	for _, instanceID := range cmd.Args().Slice() {
+		instance, err := manager.GetInstance(ctx, instanceID)
+		if err != nil {
+			return fmt.Errorf("failed to get instance %s: %w", instanceID, err)
+		}
+
+		if err := manager.StartInstance(ctx, instanceID); err != nil {
+			return fmt.Errorf("failed to start instance %s: %w", instanceID, err)
+		}
+
+		// Reload the environment to pick up the refreshed addresses
+		env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](instance.CacheFile)
+		if err != nil {
+			return fmt.Errorf("failed to read environment: %w", err)
+		}
+		if err := m.updateKubeConfig(&env); err != nil {
+			return fmt.Errorf("failed to update kubeconfig of instance %s: %w", instanceID, err)
+		}
+
+		m.log.Info("Successfully started instance %s (%s)", instanceID, instance.Name)
+	}
+
+	return nil
+}
+
+// updateKubeConfig points an existing local kubeconfig at the new address
+// of the control plane. Kubeconfigs that were never downloaded are skipped.
+func (m command) updateKubeConfig(env *v1alpha1.Environment) error {
+	if !env.Spec.Kubernetes.Install {
+		return nil
+	}
+	path := m.kubeconfig
+	if path == "" {
+		path = env.Spec.Kubernetes.KubeConfig
+	}
+	if path == "" {
+		return nil
+	}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			m.log.Info("No kubeconfig at %s, skipping server update", path)
+			return nil
+		}
+		return err
+	}
+
+	hostUrl, err := common.GetHostURL(env, "", true)
+	if err != nil {
+		return fmt.Errorf("failed to get host URL: %w", err)
+	}
+	if err := utils.ApplyRemoteAccess(env, hostUrl, path); err != nil {
+		return fmt.Errorf("applying kubeconfig remote-access settings: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package start_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cli "github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/holodeck/cmd/cli/start"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+func TestStart(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Start Command Suite")
+}
+
+// sshCacheYAML returns a cache YAML for an SSH provider instance, which
+// does not support stop/start.
+func sshCacheYAML(instanceID, name string) string {
+	return `apiVersion: holodeck.nvidia.com/v1alpha1
+kind: Environment
+metadata:
+  name: ` + name + `
+  labels:
+    holodeck-instance-id: ` + instanceID + `
+spec:
+  provider: ssh
+  instance:
+    hostUrl: 192.168.1.100
+  auth:
+    keyName: test-key
+    privateKey: /path/to/key.pem
+    username: ubuntu
+`
+}
+
+var _ = Describe("Start Command", func() {
+	var (
+		log *logger.FunLogger
+		buf bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		log = logger.NewLogger()
+		log.Out = &buf
+		buf.Reset()
+	})
+
+	Describe("NewCommand", func() {
+		It("should create a valid command", func() {
+			cmd := start.NewCommand(log)
+			Expect(cmd).NotTo(BeNil())
+			Expect(cmd.Name).To(Equal("start"))
+			Expect(cmd.Usage).To(ContainSubstring("Start"))
+		})
+
+		It("should have cachepath flag", func() {
+			cmd := start.NewCommand(log)
+			flagNames := make(map[string]bool)
+			for _, flag := range cmd.Flags {
+				for _, name := range flag.Names() {
+					flagNames[name] = true
+				}
+			}
+			Expect(flagNames).To(HaveKey("cachepath"))
+			Expect(flagNames).To(HaveKey("c"))
+		})
+	})
+
+	Describe("Command action", func() {
+		It("should require at least one instance ID", func() {
+			app := &cli.Command{
+				Commands: []*cli.Command{start.NewCommand(log)},
+			}
+
+			err := app.Run(context.Background(), []string{"holodeck", "start"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("at least one instance ID is required"))
+		})
+
+		It("should fail when instance does not exist", func() {
+			tempDir := GinkgoT().TempDir()
+			app := &cli.Command{
+				Commands: []*cli.Command{start.NewCommand(log)},
+			}
+
+			err := app.Run(context.Background(), []string{"holodeck", "start", "--cachepath", tempDir, "nonexistent"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to get instance"))
+		})
+
+		It("should reject providers without stop/start support", func() {
+			tempDir := GinkgoT().TempDir()
+			cacheFile := filepath.Join(tempDir, "a1b2c3d4.yaml")
+			Expect(os.WriteFile(cacheFile, []byte(sshCacheYAML("a1b2c3d4", "ssh-start-test")), 0600)).To(Succeed())
+
+			app := &cli.Command{
+				Commands: []*cli.Command{start.NewCommand(log)},
+			}
+
+			err := app.Run(context.Background(), []string{"holodeck", "start", "--cachepath", tempDir, "a1b2c3d4"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does not support stop/start"))
+
+			// The cache is left untouched
+			_, err = os.Stat(cacheFile)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
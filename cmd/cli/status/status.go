@@ -19,6 +19,7 @@ package status
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -140,28 +141,27 @@ func (m command) build() *cli.Command {
 				Value:       "table",
 			},
 		},
-		Action: func(_ context.Context, cmd *cli.Command) error {
+		Action: func(ctx context.Context, cmd *cli.Command) error {
 			if cmd.NArg() != 1 {
 				return fmt.Errorf("instance ID is required")
 			}
-			//nolint:contextcheck // run -> GetClusterHealthFromEnv -> GetClusterHealth -> provisioner.New has no ctx parameter (adoption boundary, follow-up per #851); threading requires New's signature change outside cmd/cli, out of scope here.
-			return m.run(cmd.Args().Get(0))
+			return m.run(ctx, cmd.Args().Get(0))
 		},
 	}
 
 	return &status
 }
 
-func (m command) run(instanceID string) error {
+func (m command) run(ctx context.Context, instanceID string) error {
 	manager := instances.NewManager(m.log, m.cachePath)
-	instance, err := manager.GetInstance(instanceID)
+	instance, err := manager.GetInstance(ctx, instanceID)
 	if err != nil {
 		// Check if this is an old cache file
 		if instanceID == "" {
 			return fmt.Errorf("invalid instance ID")
 		}
 		// Try to get the instance by filename (for old cache files)
-		instance, err = manager.GetInstanceByFilename(instanceID)
+		instance, err = manager.GetInstanceByFilename(ctx, instanceID)
 		if err != nil {
 			return fmt.Errorf("failed to get instance: %w", err)
 		}
@@ -195,10 +195,14 @@ func (m command) run(instanceID string) error {
 			ControlPlaneMode:  cpMode,
 		}
 
-		if env.Spec.Cluster.Workers != nil {
-			statusOutput.Cluster.WorkerCount = env.Spec.Cluster.Workers.Count
-			statusOutput.Cluster.WorkerType = env.Spec.Cluster.Workers.InstanceType
+		statusOutput.Cluster.WorkerCount = env.Spec.Cluster.WorkerCount()
+		var workerTypes []string
+		for _, w := range env.Spec.Cluster.WorkerPoolList() {
+			if w.InstanceType != "" && !slices.Contains(workerTypes, w.InstanceType) {
+				workerTypes = append(workerTypes, w.InstanceType)
+			}
 		}
+		statusOutput.Cluster.WorkerType = strings.Join(workerTypes, ", ")
 
 		if env.Spec.Cluster.HighAvailability != nil && env.Spec.Cluster.HighAvailability.Enabled {
 			statusOutput.Cluster.HighAvailability = &HAOutput{
@@ -232,7 +236,7 @@ func (m command) run(instanceID string) error {
 
 		// Get live cluster health if requested
 		if m.live {
-			health, err := provisioner.GetClusterHealthFromEnv(m.log, &env)
+			health, err := provisioner.GetClusterHealthFromEnv(ctx, m.log, &env)
 			if err == nil {
 				statusOutput.LiveHealth = &LiveHealthOutput{
 					Healthy:         health.Healthy,
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package stop
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/holodeck/internal/instances"
+	"github.com/NVIDIA/holodeck/internal/logger"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+type command struct {
+	log       *logger.FunLogger
+	cachePath string
+}
+
+// NewCommand constructs the stop command with the specified logger
+func NewCommand(log *logger.FunLogger) *cli.Command {
+	c := command{
+		log: log,
+	}
+	return c.build()
+}
+
+func (m command) build() *cli.Command {
+	// Create the 'stop' command
+	stop := cli.Command{
+		Name:  "stop",
+		Usage: "Stop the instances of one or more Holodeck environments",
+		Description: `Stop the instances of an environment without deleting it. Disks, networking
+and the cache are kept, so the environment can be brought back with
+"holodeck start". Environments with spot instances cannot be stopped.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "cachepath",
+				Aliases:     []string{"c"},
+				Usage:       "Path to the cache directory",
+				Destination: &m.cachePath,
+				Value:       filepath.Join(os.Getenv("HOME"), ".cache", "holodeck"),
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.NArg() == 0 {
+				return fmt.Errorf("at least one instance ID is required")
+			}
+			return m.run(ctx, cmd)
+		},
+	}
+
+	return &stop
+}
+
+func (m command) run(ctx context.Context, cmd *cli.Command) error {
+	manager := instances.NewManager(m.log, m.cachePath)
+
+	for _, instanceID := range cmd.Args().Slice() {
+		instance, err := manager.GetInstance(ctx, instanceID)
+		if err != nil {
+			return fmt.Errorf("failed to get instance %s: %w", instanceID, err)
+		}
+
+		if err := manager.StopInstance(ctx, instanceID); err != nil {
+			return fmt.Errorf("failed to stop instance %s: %w", instanceID, err)
+		}
+
+		m.log.Info("Successfully stopped instance %s (%s)", instanceID, instance.Name)
+	}
+
+	return nil
+}
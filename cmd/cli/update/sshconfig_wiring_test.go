@@ -17,6 +17,7 @@
 package update
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -67,7 +68,7 @@ func TestRunProvision_StrictPolicyReachesDialer(t *testing.T) {
 	}
 
 	m := &command{log: logger.NewLogger()}
-	err := m.runProvision(env)
+	err := m.runProvision(context.Background(), env)
 	require.Error(t, err, "strict policy against an unknown host must reject the dial")
 	assert.Contains(t, err.Error(), "strict",
 		"the strict host-key policy must reach the Dialer through the production "+
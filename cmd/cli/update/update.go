@@ -27,7 +27,7 @@ import (
 	"github.com/NVIDIA/holodeck/internal/instances"
 	"github.com/NVIDIA/holodeck/internal/logger"
 	"github.com/NVIDIA/holodeck/pkg/jyaml"
-	"github.com/NVIDIA/holodeck/pkg/provider/aws"
+	"github.com/NVIDIA/holodeck/pkg/provider"
 	"github.com/NVIDIA/holodeck/pkg/provisioner"
 
 	cli "github.com/urfave/cli/v3"
@@ -184,22 +184,21 @@ Examples:
 				Destination: &m.reprovision,
 			},
 		},
-		Action: func(_ context.Context, cmd *cli.Command) error {
+		Action: func(ctx context.Context, cmd *cli.Command) error {
 			if cmd.NArg() != 1 {
 				return fmt.Errorf("instance ID is required")
 			}
-			//nolint:contextcheck // aws.New (pkg/provider/aws) has no ctx parameter by design; threading requires a pkg/ signature change, out of scope here.
-			return m.run(cmd, cmd.Args().Get(0))
+			return m.run(ctx, cmd, cmd.Args().Get(0))
 		},
 	}
 
 	return &updateCmd
 }
 
-func (m *command) run(cmd *cli.Command, instanceID string) error {
+func (m *command) run(ctx context.Context, cmd *cli.Command, instanceID string) error {
 	// Get instance details
 	manager := instances.NewManager(m.log, m.cachePath)
-	instance, err := manager.GetInstance(instanceID)
+	instance, err := manager.GetInstance(ctx, instanceID)
 	if err != nil {
 		return fmt.Errorf("failed to get instance: %w", err)
 	}
@@ -299,11 +298,9 @@ func (m *command) run(cmd *cli.Command, instanceID string) error {
 			configChanged = true
 		}
 
-		// Update AWS resource tags if applicable
-		if env.Spec.Provider == v1alpha1.ProviderAWS {
-			if err := m.updateAWSTags(&env, labels); err != nil {
-				m.log.Warning("Failed to update AWS tags: %v", err)
-			}
+		// Update provider resource tags if applicable
+		if err := m.updateResourceTags(ctx, &env, labels); err != nil {
+			m.log.Warning("Failed to update %s tags: %v", env.Spec.Provider, err)
 		}
 	}
 
@@ -319,7 +316,7 @@ func (m *command) run(cmd *cli.Command, instanceID string) error {
 		}
 
 		m.log.Info("Running provisioning...")
-		if err := m.runProvision(&env); err != nil {
+		if err := m.runProvision(ctx, &env); err != nil {
 			return fmt.Errorf("provisioning failed: %w", err)
 		}
 
@@ -356,9 +353,9 @@ func (m *command) run(cmd *cli.Command, instanceID string) error {
 	return nil
 }
 
-func (m *command) runProvision(env *v1alpha1.Environment) error {
+func (m *command) runProvision(ctx context.Context, env *v1alpha1.Environment) error {
 	if env.Spec.Cluster != nil && env.Status.Cluster != nil && len(env.Status.Cluster.Nodes) > 0 {
-		return m.runClusterProvision(env)
+		return m.runClusterProvision(ctx, env)
 	}
 
 	// Single node - use shared host URL resolution
@@ -367,14 +364,14 @@ func (m *command) runProvision(env *v1alpha1.Environment) error {
 		return fmt.Errorf("failed to determine host URL: %w", err)
 	}
 
-	p, err := provisioner.New(m.log, env.Spec.PrivateKey, env.Spec.Username, hostUrl,
+	p, err := provisioner.New(ctx, m.log, env.Spec.PrivateKey, env.Spec.Username, hostUrl,
 		provisioner.WithSSHConfig(env.Spec.SSHConfig))
 	if err != nil {
 		return fmt.Errorf("failed to create provisioner: %w", err)
 	}
 	defer p.Client.Close() //nolint:errcheck
 
-	componentsStatus, err := p.Run(*env)
+	componentsStatus, err := p.Run(ctx, *env)
 	if err != nil {
 		return err
 	}
@@ -382,7 +379,7 @@ func (m *command) runProvision(env *v1alpha1.Environment) error {
 	return nil
 }
 
-func (m *command) runClusterProvision(env *v1alpha1.Environment) error {
+func (m *command) runClusterProvision(ctx context.Context, env *v1alpha1.Environment) error {
 	// Build node list from cluster status
 	var nodes []provisioner.NodeInfo
 	for _, node := range env.Status.Cluster.Nodes {
@@ -391,6 +388,7 @@ func (m *command) runClusterProvision(env *v1alpha1.Environment) error {
 			PublicIP:    node.PublicIP,
 			PrivateIP:   node.PrivateIP,
 			Role:        node.Role,
+			Pool:        node.Pool,
 			SSHUsername: node.SSHUsername,
 		})
 	}
@@ -407,10 +405,27 @@ func (m *command) runClusterProvision(env *v1alpha1.Environment) error {
 		env,
 	)
 
-	return cp.ProvisionCluster(nodes)
+	if err := cp.ProvisionCluster(ctx, nodes); err != nil {
+		return err
+	}
+	common.MarkNodesReady(env.Status.Cluster)
+	return nil
 }
 
-func (m *command) updateAWSTags(env *v1alpha1.Environment, labels []string) error {
+func (m *command) updateResourceTags(ctx context.Context, env *v1alpha1.Environment, labels []string) error {
+	// Get instance ID from properties. Providers that record no instance
+	// (e.g. bring-your-own SSH hosts) have nothing to tag.
+	var instanceID string
+	for _, p := range env.Status.Properties {
+		if p.Name == "InstanceId" {
+			instanceID = p.Value
+			break
+		}
+	}
+	if instanceID == "" {
+		return nil
+	}
+
 	// Convert labels to tags map
 	tags := make(map[string]string)
 	for _, label := range labels {
@@ -420,24 +435,11 @@ func (m *command) updateAWSTags(env *v1alpha1.Environment, labels []string) erro
 		}
 	}
 
-	// Create AWS provider and update tags
-	client, err := aws.New(m.log, *env, "")
+	// Resolve the provider and update tags
+	client, err := provider.New(m.log, *env, "")
 	if err != nil {
 		return err
 	}
 
-	// Get instance ID from properties
-	var instanceID string
-	for _, p := range env.Status.Properties {
-		if p.Name == "InstanceId" {
-			instanceID = p.Value
-			break
-		}
-	}
-
-	if instanceID == "" {
-		return fmt.Errorf("instance ID not found in status")
-	}
-
-	return client.UpdateResourcesTags(tags, instanceID)
+	return client.UpdateResourcesTags(ctx, tags, instanceID)
 }
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/pkg/provisioner"
 )
 
 func TestLabelParsing(t *testing.T) {
@@ -184,3 +185,24 @@ func TestProvisionedLabel_NilLabelsMap(t *testing.T) {
 		t.Error("expected provisioned label to be set")
 	}
 }
+
+func TestReprovision_ReinstallsProvisionedComponents(t *testing.T) {
+	// A cache after create and provisioning records the installed components
+	env := &v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			NVIDIADriver:           v1alpha1.NVIDIADriver{Install: true, Branch: "575"},
+			ContainerRuntime:       v1alpha1.ContainerRuntime{Install: true, Name: v1alpha1.ContainerRuntimeContainerd},
+			NVIDIAContainerToolkit: v1alpha1.NVIDIAContainerToolkit{Install: true},
+		},
+	}
+	env.Status.Components = provisioner.BuildComponentsStatus(*env)
+
+	// update --reprovision runs the provisioner on that cache
+	d := provisioner.NewDependencies(env)
+	if deps := d.Resolve(); len(deps) != 3 {
+		t.Errorf("expected 3 components to be installed, got %d", len(deps))
+	}
+	if skipped := d.Skipped(); len(skipped) != 0 {
+		t.Errorf("expected no component to be skipped, got %v", skipped)
+	}
+}
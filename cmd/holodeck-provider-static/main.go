@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Command holodeck-provider-static is the reference provider plugin. It
+// creates nothing: the hosts come from spec.plugin, which makes it a
+// minimal, self-contained example of the plugin protocol.
+//
+//	spec:
+//	  provider: static
+//	  plugin:
+//	    host: 203.0.113.10                # single-node
+//	    controlPlaneHosts: 203.0.113.10   # cluster mode, comma-separated
+//	    workerHosts: 203.0.113.11,203.0.113.12
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/pkg/provider/plugin"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Settings read from spec.plugin
+const (
+	keyHost              = "host"
+	keyControlPlaneHosts = "controlPlaneHosts"
+	keyWorkerHosts       = "workerHosts"
+)
+
+// propHostCount is the status property recording how many hosts were handed out.
+const propHostCount = "static-host-count"
+
+type static struct{}
+
+func main() {
+	plugin.Serve(static{})
+}
+
+func (static) Info() plugin.Capabilities {
+	return plugin.Capabilities{Multinode: true}
+}
+
+func (static) Create(_ context.Context, env *v1alpha1.Environment) ([]plugin.Host, []v1alpha1.Properties, error) {
+	hosts, err := hostsFor(env)
+	if err != nil {
+		return nil, nil, err
+	}
+	props := []v1alpha1.Properties{{Name: propHostCount, Value: strconv.Itoa(len(hosts))}}
+	return hosts, props, nil
+}
+
+// Delete is a no-op: the hosts are not owned by the plugin.
+func (static) Delete(_ context.Context, _ *v1alpha1.Environment) error {
+	return nil
+}
+
+func (static) DryRun(_ context.Context, env *v1alpha1.Environment) error {
+	_, err := hostsFor(env)
+	return err
+}
+
+// Status reports the conditions recorded by holodeck.
+func (static) Status(_ context.Context, _ *v1alpha1.Environment) ([]metav1.Condition, error) {
+	return nil, nil
+}
+
+// UpdateResourcesTags is a no-op: static hosts carry no tags.
+func (static) UpdateResourcesTags(_ context.Context, _ *v1alpha1.Environment, _ map[string]string, _ ...string) error {
+	return nil
+}
+
+// hostsFor reads the host list for env from spec.plugin.
+func hostsFor(env *v1alpha1.Environment) ([]plugin.Host, error) {
+	cfg := env.Spec.Plugin
+	if env.Spec.Cluster == nil {
+		if cfg[keyHost] == "" {
+			return nil, fmt.Errorf("spec.plugin.%s is required", keyHost)
+		}
+		return []plugin.Host{{Address: cfg[keyHost]}}, nil
+	}
+
+	var hosts []plugin.Host
+	for _, addr := range splitList(cfg[keyControlPlaneHosts]) {
+		hosts = append(hosts, plugin.Host{Address: addr, Role: "control-plane"})
+	}
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("spec.plugin.%s is required in cluster mode", keyControlPlaneHosts)
+	}
+	for _, addr := range splitList(cfg[keyWorkerHosts]) {
+		hosts = append(hosts, plugin.Host{Address: addr, Role: "worker"})
+	}
+	return hosts, nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/pkg/provider/plugin"
+	"github.com/NVIDIA/holodeck/pkg/provider/plugin/conformance"
+)
+
+// servePluginEnv makes the test binary act as the plugin itself, so the
+// conformance harness can exec it without a separate build step.
+const servePluginEnv = "HOLODECK_TEST_SERVE_STATIC"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(servePluginEnv) != "" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// pluginPath installs the test binary as holodeck-provider-static.
+func pluginPath(t *testing.T) string {
+	t.Helper()
+	self, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), plugin.BinaryPrefix+"static")
+	if err := os.Symlink(self, path); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(servePluginEnv, "1")
+	return path
+}
+
+func TestConformance_SingleNode(t *testing.T) {
+	env := v1alpha1.Environment{Spec: v1alpha1.EnvironmentSpec{Provider: "static"}}
+	env.Name = "static-single"
+	env.Spec.Username = "ubuntu"
+	env.Spec.Plugin = map[string]string{keyHost: "203.0.113.10"}
+
+	conformance.Run(t, pluginPath(t), env)
+}
+
+func TestConformance_Cluster(t *testing.T) {
+	env := v1alpha1.Environment{Spec: v1alpha1.EnvironmentSpec{Provider: "static"}}
+	env.Name = "static-cluster"
+	env.Spec.Username = "ubuntu"
+	env.Spec.Cluster = &v1alpha1.ClusterSpec{
+		ControlPlane: v1alpha1.ControlPlaneSpec{Count: 1},
+		Workers:      &v1alpha1.WorkerPoolSpec{Count: 2},
+	}
+	env.Spec.Plugin = map[string]string{
+		keyControlPlaneHosts: "203.0.113.10",
+		keyWorkerHosts:       "203.0.113.11, 203.0.113.12",
+	}
+
+	conformance.Run(t, pluginPath(t), env)
+}
+
+func TestHostsFor_MissingHost(t *testing.T) {
+	env := &v1alpha1.Environment{}
+	if _, err := hostsFor(env); err == nil {
+		t.Fatal("expected error for missing spec.plugin.host")
+	}
+}
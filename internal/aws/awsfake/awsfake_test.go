@@ -676,6 +676,60 @@ func TestElasticIPRoundTrip(t *testing.T) {
 	}
 }
 
+// TestDescribeAddressesTagFilters backs the cleaner's stray Elastic IP lookup:
+// DescribeAddresses must honour tag filters and report NAT-held addresses as
+// associated.
+func TestDescribeAddressesTagFilters(t *testing.T) {
+	f := New()
+	allocate := func(name string) string {
+		out, err := f.EC2.AllocateAddress(ctx, &ec2.AllocateAddressInput{
+			TagSpecifications: []ec2types.TagSpecification{{
+				Tags: []ec2types.Tag{{Key: aws.String("Name"), Value: aws.String(name)}},
+			}},
+		})
+		if err != nil {
+			t.Fatalf("AllocateAddress: %v", err)
+		}
+		return aws.ToString(out.AllocationId)
+	}
+	mine := allocate("env-a")
+	allocate("env-b")
+
+	out, err := f.EC2.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+		Filters: []ec2types.Filter{{Name: aws.String("tag:Name"), Values: []string{"env-a"}}},
+	})
+	if err != nil {
+		t.Fatalf("DescribeAddresses: %v", err)
+	}
+	if len(out.Addresses) != 1 || aws.ToString(out.Addresses[0].AllocationId) != mine {
+		t.Fatalf("DescribeAddresses(tag:Name=env-a) = %+v, want only %s", out.Addresses, mine)
+	}
+	if out.Addresses[0].AssociationId != nil {
+		t.Fatal("a fresh address must not be associated")
+	}
+
+	vpc, _ := f.EC2.CreateVpc(ctx, &ec2.CreateVpcInput{CidrBlock: aws.String("10.0.0.0/16")})
+	sub, _ := f.EC2.CreateSubnet(ctx, &ec2.CreateSubnetInput{VpcId: vpc.Vpc.VpcId, CidrBlock: aws.String("10.0.1.0/24")})
+	nat, _ := f.EC2.CreateNatGateway(ctx, &ec2.CreateNatGatewayInput{SubnetId: sub.Subnet.SubnetId, AllocationId: aws.String(mine)})
+	if aws.ToString(nat.NatGateway.VpcId) != aws.ToString(vpc.Vpc.VpcId) {
+		t.Fatalf("NAT gateway VpcId = %q, want the subnet's VPC", aws.ToString(nat.NatGateway.VpcId))
+	}
+	if len(nat.NatGateway.NatGatewayAddresses) != 1 || aws.ToString(nat.NatGateway.NatGatewayAddresses[0].AllocationId) != mine {
+		t.Fatalf("NAT gateway addresses = %+v, want %s", nat.NatGateway.NatGatewayAddresses, mine)
+	}
+	assoc, _ := f.EC2.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{AllocationIds: []string{mine}})
+	if len(assoc.Addresses) != 1 || assoc.Addresses[0].AssociationId == nil {
+		t.Fatalf("address held by a NAT gateway must be associated, got %+v", assoc.Addresses)
+	}
+
+	byVPC, _ := f.EC2.DescribeNatGateways(ctx, &ec2.DescribeNatGatewaysInput{
+		Filter: []ec2types.Filter{{Name: aws.String("vpc-id"), Values: []string{"vpc-other"}}},
+	})
+	if len(byVPC.NatGateways) != 0 {
+		t.Fatalf("DescribeNatGateways(vpc-id=vpc-other) = %+v, want none", byVPC.NatGateways)
+	}
+}
+
 // TestSecurityGroupRulesRoundTrip backs revokeSecurityGroupRules: authorized
 // ingress must be readable via DescribeSecurityGroups, and DescribeSecurityGroups
 // by absent id must error (so securityGroupExists returns false).
@@ -944,3 +998,150 @@ func TestSetInstanceTypeCatalog(t *testing.T) {
 		t.Fatalf("empty catalog must return no types, got %+v", empty.InstanceTypes)
 	}
 }
+
+// TestInterruptNextSpot covers the spot launch path: spot instances report the
+// spot lifecycle, and an interrupted one is terminated with the spot state
+// reason the provider's on-demand fallback keys on.
+func TestInterruptNextSpot(t *testing.T) {
+	f := New()
+	spot := &ec2types.InstanceMarketOptionsRequest{MarketType: ec2types.MarketTypeSpot}
+
+	f.Store.InterruptNextSpot()
+	out, err := f.EC2.RunInstances(ctx, &ec2.RunInstancesInput{InstanceMarketOptions: spot})
+	if err != nil {
+		t.Fatalf("RunInstances: %v", err)
+	}
+	inst := out.Instances[0]
+	if inst.InstanceLifecycle != ec2types.InstanceLifecycleTypeSpot {
+		t.Errorf("InstanceLifecycle = %q, want spot", inst.InstanceLifecycle)
+	}
+	if inst.State.Name != ec2types.InstanceStateNameTerminated {
+		t.Errorf("interrupted instance state = %q, want terminated", inst.State.Name)
+	}
+	if got := aws.ToString(inst.StateReason.Code); got != "Server.SpotInstanceTermination" {
+		t.Errorf("StateReason.Code = %q, want Server.SpotInstanceTermination", got)
+	}
+	if !f.Store.Empty() {
+		t.Errorf("interrupted instance must not leave live resources: %v", f.Store.ResourceCounts())
+	}
+
+	// The interruption is one-shot
+	out, err = f.EC2.RunInstances(ctx, &ec2.RunInstancesInput{InstanceMarketOptions: spot})
+	if err != nil {
+		t.Fatalf("RunInstances: %v", err)
+	}
+	if out.Instances[0].State.Name != ec2types.InstanceStateNameRunning {
+		t.Errorf("second spot instance state = %q, want running", out.Instances[0].State.Name)
+	}
+}
+
+// TestStopStartInstances checks that a stop/start cycle drops and then
+// reassigns the public address, like EC2 does.
+func TestStopStartInstances(t *testing.T) {
+	f := New()
+	out, err := f.EC2.RunInstances(ctx, &ec2.RunInstancesInput{})
+	if err != nil {
+		t.Fatalf("RunInstances: %v", err)
+	}
+	id := aws.ToString(out.Instances[0].InstanceId)
+	oldDNS := aws.ToString(out.Instances[0].PublicDnsName)
+
+	if _, err := f.EC2.StopInstances(ctx, &ec2.StopInstancesInput{InstanceIds: []string{id}}); err != nil {
+		t.Fatalf("StopInstances: %v", err)
+	}
+	inst := f.Store.Instances[id]
+	if inst.State.Name != ec2types.InstanceStateNameStopped {
+		t.Errorf("state after stop = %q, want stopped", inst.State.Name)
+	}
+	if inst.PublicIpAddress != nil || aws.ToString(inst.PublicDnsName) != "" {
+		t.Errorf("stopped instance kept its public address: %v %v", aws.ToString(inst.PublicIpAddress), aws.ToString(inst.PublicDnsName))
+	}
+
+	if _, err := f.EC2.StartInstances(ctx, &ec2.StartInstancesInput{InstanceIds: []string{id}}); err != nil {
+		t.Fatalf("StartInstances: %v", err)
+	}
+	if inst.State.Name != ec2types.InstanceStateNameRunning {
+		t.Errorf("state after start = %q, want running", inst.State.Name)
+	}
+	if dns := aws.ToString(inst.PublicDnsName); dns == "" || dns == oldDNS {
+		t.Errorf("PublicDnsName after start = %q, want a new name (was %q)", dns, oldDNS)
+	}
+
+	if _, err := f.EC2.StopInstances(ctx, &ec2.StopInstancesInput{InstanceIds: []string{"i-missing"}}); err == nil {
+		t.Error("StopInstances on unknown instance: want error")
+	}
+}
+
+// TestPlacementGroupLifecycle covers placement group creation, launches into
+// the group, and the in-use check that makes deletion wait for termination.
+func TestPlacementGroupLifecycle(t *testing.T) {
+	f := New()
+	name := aws.String("pg")
+
+	if _, err := f.EC2.CreatePlacementGroup(ctx, &ec2.CreatePlacementGroupInput{GroupName: name, Strategy: ec2types.PlacementStrategyCluster}); err != nil {
+		t.Fatalf("CreatePlacementGroup: %v", err)
+	}
+	if _, err := f.EC2.CreatePlacementGroup(ctx, &ec2.CreatePlacementGroupInput{GroupName: name}); err == nil || !strings.Contains(err.Error(), "InvalidPlacementGroup.Duplicate") {
+		t.Errorf("duplicate CreatePlacementGroup error = %v, want InvalidPlacementGroup.Duplicate", err)
+	}
+	if _, err := f.EC2.RunInstances(ctx, &ec2.RunInstancesInput{Placement: &ec2types.Placement{GroupName: aws.String("other")}}); err == nil || !strings.Contains(err.Error(), "InvalidPlacementGroup.Unknown") {
+		t.Errorf("RunInstances into an unknown group error = %v, want InvalidPlacementGroup.Unknown", err)
+	}
+
+	out, err := f.EC2.RunInstances(ctx, &ec2.RunInstancesInput{Placement: &ec2types.Placement{GroupName: name}})
+	if err != nil {
+		t.Fatalf("RunInstances: %v", err)
+	}
+	inst := out.Instances[0]
+	if got := aws.ToString(inst.Placement.GroupName); got != "pg" {
+		t.Errorf("instance placement group = %q, want pg", got)
+	}
+	if _, err := f.EC2.DeletePlacementGroup(ctx, &ec2.DeletePlacementGroupInput{GroupName: name}); err == nil || !strings.Contains(err.Error(), "InvalidPlacementGroup.InUse") {
+		t.Errorf("DeletePlacementGroup of a used group error = %v, want InvalidPlacementGroup.InUse", err)
+	}
+
+	if _, err := f.EC2.TerminateInstances(ctx, &ec2.TerminateInstancesInput{InstanceIds: []string{aws.ToString(inst.InstanceId)}}); err != nil {
+		t.Fatalf("TerminateInstances: %v", err)
+	}
+	if _, err := f.EC2.DeletePlacementGroup(ctx, &ec2.DeletePlacementGroupInput{GroupName: name}); err != nil {
+		t.Fatalf("DeletePlacementGroup: %v", err)
+	}
+	if _, err := f.EC2.DescribePlacementGroups(ctx, &ec2.DescribePlacementGroupsInput{GroupNames: []string{"pg"}}); err == nil {
+		t.Error("DescribePlacementGroups found the deleted group")
+	}
+	if !f.Store.Empty() {
+		t.Errorf("resources left: %v", f.Store.ResourceCounts())
+	}
+}
+
+// TestCapacityReservation covers launches into a seeded reservation: the
+// type must match and the available count is consumed.
+func TestCapacityReservation(t *testing.T) {
+	f := New()
+	id := f.Store.SeedCapacityReservation("p5.48xlarge", "us-east-1a", 1)
+	target := &ec2types.CapacityReservationSpecification{
+		CapacityReservationTarget: &ec2types.CapacityReservationTarget{CapacityReservationId: aws.String(id)},
+	}
+
+	desc, err := f.EC2.DescribeCapacityReservations(ctx, &ec2.DescribeCapacityReservationsInput{CapacityReservationIds: []string{id}})
+	if err != nil {
+		t.Fatalf("DescribeCapacityReservations: %v", err)
+	}
+	if got := aws.ToString(desc.CapacityReservations[0].AvailabilityZone); got != "us-east-1a" {
+		t.Errorf("reservation zone = %q, want us-east-1a", got)
+	}
+
+	if _, err := f.EC2.RunInstances(ctx, &ec2.RunInstancesInput{InstanceType: "g5.xlarge", CapacityReservationSpecification: target}); err == nil || !strings.Contains(err.Error(), "InvalidParameterCombination") {
+		t.Errorf("RunInstances with another type error = %v, want InvalidParameterCombination", err)
+	}
+	out, err := f.EC2.RunInstances(ctx, &ec2.RunInstancesInput{InstanceType: "p5.48xlarge", CapacityReservationSpecification: target})
+	if err != nil {
+		t.Fatalf("RunInstances: %v", err)
+	}
+	if got := aws.ToString(out.Instances[0].CapacityReservationId); got != id {
+		t.Errorf("instance reservation = %q, want %q", got, id)
+	}
+	if _, err := f.EC2.RunInstances(ctx, &ec2.RunInstancesInput{InstanceType: "p5.48xlarge", CapacityReservationSpecification: target}); err == nil || !strings.Contains(err.Error(), "ReservationCapacityExceeded") {
+		t.Errorf("RunInstances past the reservation error = %v, want ReservationCapacityExceeded", err)
+	}
+}
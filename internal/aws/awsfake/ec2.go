@@ -19,6 +19,8 @@ package awsfake
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -40,7 +42,7 @@ func (f *FakeEC2) CreateVpc(ctx context.Context, params *ec2.CreateVpcInput, opt
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("CreateVpc", params)
-	if err := f.store.failure("CreateVpc"); err != nil {
+	if err := f.store.failure(ctx, "CreateVpc"); err != nil {
 		return nil, err
 	}
 	id := f.store.nextID("vpc")
@@ -58,7 +60,7 @@ func (f *FakeEC2) ModifyVpcAttribute(ctx context.Context, params *ec2.ModifyVpcA
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("ModifyVpcAttribute", params)
-	if err := f.store.failure("ModifyVpcAttribute"); err != nil {
+	if err := f.store.failure(ctx, "ModifyVpcAttribute"); err != nil {
 		return nil, err
 	}
 	return &ec2.ModifyVpcAttributeOutput{}, nil
@@ -68,7 +70,7 @@ func (f *FakeEC2) DeleteVpc(ctx context.Context, params *ec2.DeleteVpcInput, opt
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DeleteVpc", params)
-	if err := f.store.failure("DeleteVpc"); err != nil {
+	if err := f.store.failure(ctx, "DeleteVpc"); err != nil {
 		return nil, err
 	}
 	id := aws.ToString(params.VpcId)
@@ -83,7 +85,7 @@ func (f *FakeEC2) DescribeVpcs(ctx context.Context, params *ec2.DescribeVpcsInpu
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeVpcs", params)
-	if err := f.store.failure("DescribeVpcs"); err != nil {
+	if err := f.store.failure(ctx, "DescribeVpcs"); err != nil {
 		return nil, err
 	}
 	var out []ec2types.Vpc
@@ -109,16 +111,21 @@ func (f *FakeEC2) CreateSubnet(ctx context.Context, params *ec2.CreateSubnetInpu
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("CreateSubnet", params)
-	if err := f.store.failure("CreateSubnet"); err != nil {
+	if err := f.store.failure(ctx, "CreateSubnet"); err != nil {
 		return nil, err
 	}
 	id := f.store.nextID("subnet")
+	zone := params.AvailabilityZone
+	if zone == nil && len(f.store.Zones) > 0 {
+		zone = aws.String(f.store.Zones[0])
+	}
 	sn := ec2types.Subnet{
-		SubnetId:  aws.String(id),
-		VpcId:     params.VpcId,
-		CidrBlock: params.CidrBlock,
-		State:     ec2types.SubnetStateAvailable,
-		Tags:      tagsFromSpecs(params.TagSpecifications),
+		SubnetId:         aws.String(id),
+		VpcId:            params.VpcId,
+		CidrBlock:        params.CidrBlock,
+		AvailabilityZone: zone,
+		State:            ec2types.SubnetStateAvailable,
+		Tags:             tagsFromSpecs(params.TagSpecifications),
 	}
 	f.store.Subnets[id] = &sn
 	return &ec2.CreateSubnetOutput{Subnet: &sn}, nil
@@ -128,7 +135,7 @@ func (f *FakeEC2) DeleteSubnet(ctx context.Context, params *ec2.DeleteSubnetInpu
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DeleteSubnet", params)
-	if err := f.store.failure("DeleteSubnet"); err != nil {
+	if err := f.store.failure(ctx, "DeleteSubnet"); err != nil {
 		return nil, err
 	}
 	id := aws.ToString(params.SubnetId)
@@ -143,7 +150,7 @@ func (f *FakeEC2) DescribeSubnets(ctx context.Context, params *ec2.DescribeSubne
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeSubnets", params)
-	if err := f.store.failure("DescribeSubnets"); err != nil {
+	if err := f.store.failure(ctx, "DescribeSubnets"); err != nil {
 		return nil, err
 	}
 	var out []ec2types.Subnet
@@ -177,7 +184,7 @@ func (f *FakeEC2) CreateInternetGateway(ctx context.Context, params *ec2.CreateI
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("CreateInternetGateway", params)
-	if err := f.store.failure("CreateInternetGateway"); err != nil {
+	if err := f.store.failure(ctx, "CreateInternetGateway"); err != nil {
 		return nil, err
 	}
 	id := f.store.nextID("igw")
@@ -193,7 +200,7 @@ func (f *FakeEC2) AttachInternetGateway(ctx context.Context, params *ec2.AttachI
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("AttachInternetGateway", params)
-	if err := f.store.failure("AttachInternetGateway"); err != nil {
+	if err := f.store.failure(ctx, "AttachInternetGateway"); err != nil {
 		return nil, err
 	}
 	id := aws.ToString(params.InternetGatewayId)
@@ -210,7 +217,7 @@ func (f *FakeEC2) DetachInternetGateway(ctx context.Context, params *ec2.DetachI
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DetachInternetGateway", params)
-	if err := f.store.failure("DetachInternetGateway"); err != nil {
+	if err := f.store.failure(ctx, "DetachInternetGateway"); err != nil {
 		return nil, err
 	}
 	id := aws.ToString(params.InternetGatewayId)
@@ -229,7 +236,7 @@ func (f *FakeEC2) DeleteInternetGateway(ctx context.Context, params *ec2.DeleteI
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DeleteInternetGateway", params)
-	if err := f.store.failure("DeleteInternetGateway"); err != nil {
+	if err := f.store.failure(ctx, "DeleteInternetGateway"); err != nil {
 		return nil, err
 	}
 	id := aws.ToString(params.InternetGatewayId)
@@ -244,7 +251,7 @@ func (f *FakeEC2) DescribeInternetGateways(ctx context.Context, params *ec2.Desc
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeInternetGateways", params)
-	if err := f.store.failure("DescribeInternetGateways"); err != nil {
+	if err := f.store.failure(ctx, "DescribeInternetGateways"); err != nil {
 		return nil, err
 	}
 	var out []ec2types.InternetGateway
@@ -270,7 +277,7 @@ func (f *FakeEC2) CreateRouteTable(ctx context.Context, params *ec2.CreateRouteT
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("CreateRouteTable", params)
-	if err := f.store.failure("CreateRouteTable"); err != nil {
+	if err := f.store.failure(ctx, "CreateRouteTable"); err != nil {
 		return nil, err
 	}
 	id := f.store.nextID("rtb")
@@ -287,7 +294,7 @@ func (f *FakeEC2) AssociateRouteTable(ctx context.Context, params *ec2.Associate
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("AssociateRouteTable", params)
-	if err := f.store.failure("AssociateRouteTable"); err != nil {
+	if err := f.store.failure(ctx, "AssociateRouteTable"); err != nil {
 		return nil, err
 	}
 	assocID := f.store.nextID("rtbassoc")
@@ -306,7 +313,7 @@ func (f *FakeEC2) CreateRoute(ctx context.Context, params *ec2.CreateRouteInput,
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("CreateRoute", params)
-	if err := f.store.failure("CreateRoute"); err != nil {
+	if err := f.store.failure(ctx, "CreateRoute"); err != nil {
 		return nil, err
 	}
 	return &ec2.CreateRouteOutput{Return: aws.Bool(true)}, nil
@@ -316,7 +323,7 @@ func (f *FakeEC2) DeleteRouteTable(ctx context.Context, params *ec2.DeleteRouteT
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DeleteRouteTable", params)
-	if err := f.store.failure("DeleteRouteTable"); err != nil {
+	if err := f.store.failure(ctx, "DeleteRouteTable"); err != nil {
 		return nil, err
 	}
 	id := aws.ToString(params.RouteTableId)
@@ -331,7 +338,7 @@ func (f *FakeEC2) DescribeRouteTables(ctx context.Context, params *ec2.DescribeR
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeRouteTables", params)
-	if err := f.store.failure("DescribeRouteTables"); err != nil {
+	if err := f.store.failure(ctx, "DescribeRouteTables"); err != nil {
 		return nil, err
 	}
 	var out []ec2types.RouteTable
@@ -355,7 +362,7 @@ func (f *FakeEC2) ReplaceRouteTableAssociation(ctx context.Context, params *ec2.
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("ReplaceRouteTableAssociation", params)
-	if err := f.store.failure("ReplaceRouteTableAssociation"); err != nil {
+	if err := f.store.failure(ctx, "ReplaceRouteTableAssociation"); err != nil {
 		return nil, err
 	}
 	return &ec2.ReplaceRouteTableAssociationOutput{NewAssociationId: aws.String(f.store.nextID("rtbassoc"))}, nil
@@ -367,7 +374,7 @@ func (f *FakeEC2) CreateSecurityGroup(ctx context.Context, params *ec2.CreateSec
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("CreateSecurityGroup", params)
-	if err := f.store.failure("CreateSecurityGroup"); err != nil {
+	if err := f.store.failure(ctx, "CreateSecurityGroup"); err != nil {
 		return nil, err
 	}
 	id := f.store.nextID("sg")
@@ -386,7 +393,7 @@ func (f *FakeEC2) AuthorizeSecurityGroupIngress(ctx context.Context, params *ec2
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("AuthorizeSecurityGroupIngress", params)
-	if err := f.store.failure("AuthorizeSecurityGroupIngress"); err != nil {
+	if err := f.store.failure(ctx, "AuthorizeSecurityGroupIngress"); err != nil {
 		return nil, err
 	}
 	id := aws.ToString(params.GroupId)
@@ -400,7 +407,7 @@ func (f *FakeEC2) DeleteSecurityGroup(ctx context.Context, params *ec2.DeleteSec
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DeleteSecurityGroup", params)
-	if err := f.store.failure("DeleteSecurityGroup"); err != nil {
+	if err := f.store.failure(ctx, "DeleteSecurityGroup"); err != nil {
 		return nil, err
 	}
 	id := aws.ToString(params.GroupId)
@@ -415,7 +422,7 @@ func (f *FakeEC2) DescribeSecurityGroups(ctx context.Context, params *ec2.Descri
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeSecurityGroups", params)
-	if err := f.store.failure("DescribeSecurityGroups"); err != nil {
+	if err := f.store.failure(ctx, "DescribeSecurityGroups"); err != nil {
 		return nil, err
 	}
 	var out []ec2types.SecurityGroup
@@ -447,7 +454,7 @@ func (f *FakeEC2) RevokeSecurityGroupIngress(ctx context.Context, params *ec2.Re
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("RevokeSecurityGroupIngress", params)
-	if err := f.store.failure("RevokeSecurityGroupIngress"); err != nil {
+	if err := f.store.failure(ctx, "RevokeSecurityGroupIngress"); err != nil {
 		return nil, err
 	}
 	if sg, ok := f.store.SecurityGroups[aws.ToString(params.GroupId)]; ok {
@@ -460,7 +467,7 @@ func (f *FakeEC2) RevokeSecurityGroupEgress(ctx context.Context, params *ec2.Rev
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("RevokeSecurityGroupEgress", params)
-	if err := f.store.failure("RevokeSecurityGroupEgress"); err != nil {
+	if err := f.store.failure(ctx, "RevokeSecurityGroupEgress"); err != nil {
 		return nil, err
 	}
 	return &ec2.RevokeSecurityGroupEgressOutput{Return: aws.Bool(true)}, nil
@@ -472,7 +479,7 @@ func (f *FakeEC2) RunInstances(ctx context.Context, params *ec2.RunInstancesInpu
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("RunInstances", params)
-	if err := f.store.failure("RunInstances"); err != nil {
+	if err := f.store.failure(ctx, "RunInstances"); err != nil {
 		return nil, err
 	}
 
@@ -482,14 +489,36 @@ func (f *FakeEC2) RunInstances(ctx context.Context, params *ec2.RunInstancesInpu
 	}
 
 	subnetID := ""
+	var groups []ec2types.GroupIdentifier
 	if len(params.NetworkInterfaces) > 0 {
 		subnetID = aws.ToString(params.NetworkInterfaces[0].SubnetId)
+		for _, id := range params.NetworkInterfaces[0].Groups {
+			groups = append(groups, ec2types.GroupIdentifier{GroupId: aws.String(id)})
+		}
 	} else if params.SubnetId != nil {
 		subnetID = aws.ToString(params.SubnetId)
 	}
 	vpcID := ""
+	var placement *ec2types.Placement
 	if sn, ok := f.store.Subnets[subnetID]; ok {
 		vpcID = aws.ToString(sn.VpcId)
+		if sn.AvailabilityZone != nil {
+			placement = &ec2types.Placement{AvailabilityZone: sn.AvailabilityZone}
+		}
+	}
+	if params.Placement != nil && params.Placement.GroupName != nil {
+		name := aws.ToString(params.Placement.GroupName)
+		if _, ok := f.store.PlacementGroups[name]; !ok {
+			return nil, notFound("InvalidPlacementGroup.Unknown", name)
+		}
+		if placement == nil {
+			placement = &ec2types.Placement{}
+		}
+		placement.GroupName = aws.String(name)
+	}
+	reservationID, err := f.reserveCapacity(params, placement, count)
+	if err != nil {
+		return nil, err
 	}
 	tags := tagsFromSpecs(params.TagSpecifications)
 
@@ -502,35 +531,80 @@ func (f *FakeEC2) RunInstances(ctx context.Context, params *ec2.RunInstancesInpu
 			NetworkInterfaceId: aws.String(eniID),
 			VpcId:              aws.String(vpcID),
 			SubnetId:           aws.String(subnetID),
+			Groups:             groups,
 			Status:             ec2types.NetworkInterfaceStatusInUse,
 		}
 
 		inst := ec2types.Instance{
-			InstanceId:       aws.String(instID),
-			ImageId:          params.ImageId,
-			InstanceType:     params.InstanceType,
-			State:            &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning, Code: aws.Int32(16)},
-			PublicDnsName:    aws.String(fmt.Sprintf("ec2-%s.compute.amazonaws.com", instID)),
-			PublicIpAddress:  aws.String(fmt.Sprintf("203.0.113.%d", f.store.counter%254+1)),
-			PrivateIpAddress: aws.String(fmt.Sprintf("10.0.0.%d", f.store.counter%254+1)),
-			SubnetId:         aws.String(subnetID),
-			VpcId:            aws.String(vpcID),
+			InstanceId:            aws.String(instID),
+			ImageId:               params.ImageId,
+			InstanceType:          params.InstanceType,
+			State:                 &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning, Code: aws.Int32(16)},
+			PublicDnsName:         aws.String(fmt.Sprintf("ec2-%s.compute.amazonaws.com", instID)),
+			PublicIpAddress:       aws.String(fmt.Sprintf("203.0.113.%d", f.store.counter%254+1)),
+			PrivateIpAddress:      aws.String(fmt.Sprintf("10.0.0.%d", f.store.counter%254+1)),
+			SubnetId:              aws.String(subnetID),
+			VpcId:                 aws.String(vpcID),
+			Placement:             placement,
+			CapacityReservationId: reservationID,
 			NetworkInterfaces: []ec2types.InstanceNetworkInterface{
 				{NetworkInterfaceId: aws.String(eniID), SubnetId: aws.String(subnetID)},
 			},
 			Tags: tags,
 		}
+		if params.InstanceMarketOptions != nil && params.InstanceMarketOptions.MarketType == ec2types.MarketTypeSpot {
+			inst.InstanceLifecycle = ec2types.InstanceLifecycleTypeSpot
+			if f.store.spotInterruptions > 0 {
+				f.store.spotInterruptions--
+				delete(f.store.NetworkInterfaces, eniID)
+				inst.State = &ec2types.InstanceState{Name: ec2types.InstanceStateNameTerminated, Code: aws.Int32(48)}
+				inst.StateReason = &ec2types.StateReason{
+					Code:    aws.String("Server.SpotInstanceTermination"),
+					Message: aws.String("Server.SpotInstanceTermination: Spot instance termination"),
+				}
+			}
+		}
 		f.store.Instances[instID] = &inst
 		instances = append(instances, inst)
 	}
 	return &ec2.RunInstancesOutput{Instances: instances}, nil
 }
 
+// reserveCapacity consumes count instances of the capacity reservation
+// targeted by ID in params, checking its instance type and zone like EC2
+// does. Reservations targeted through a resource group are accepted as-is.
+// It returns the reservation ID, or nil without a targeted ID. Callers must
+// hold mu.
+func (f *FakeEC2) reserveCapacity(params *ec2.RunInstancesInput, placement *ec2types.Placement, count int32) (*string, error) {
+	spec := params.CapacityReservationSpecification
+	if spec == nil || spec.CapacityReservationTarget == nil || spec.CapacityReservationTarget.CapacityReservationId == nil {
+		return nil, nil
+	}
+	id := aws.ToString(spec.CapacityReservationTarget.CapacityReservationId)
+	cr, ok := f.store.CapacityReservations[id]
+	if !ok {
+		return nil, notFound("InvalidCapacityReservationId.NotFound", id)
+	}
+	if aws.ToString(cr.InstanceType) != string(params.InstanceType) {
+		return nil, fmt.Errorf("InvalidParameterCombination: capacity reservation %s is for %s, not %s",
+			id, aws.ToString(cr.InstanceType), params.InstanceType)
+	}
+	if placement != nil && placement.AvailabilityZone != nil && aws.ToString(placement.AvailabilityZone) != aws.ToString(cr.AvailabilityZone) {
+		return nil, fmt.Errorf("InvalidParameterCombination: capacity reservation %s is in %s, not %s",
+			id, aws.ToString(cr.AvailabilityZone), aws.ToString(placement.AvailabilityZone))
+	}
+	if aws.ToInt32(cr.AvailableInstanceCount) < count {
+		return nil, fmt.Errorf("ReservationCapacityExceeded: capacity reservation %s has insufficient capacity", id)
+	}
+	cr.AvailableInstanceCount = aws.Int32(aws.ToInt32(cr.AvailableInstanceCount) - count)
+	return aws.String(id), nil
+}
+
 func (f *FakeEC2) TerminateInstances(ctx context.Context, params *ec2.TerminateInstancesInput, optFns ...func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error) {
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("TerminateInstances", params)
-	if err := f.store.failure("TerminateInstances"); err != nil {
+	if err := f.store.failure(ctx, "TerminateInstances"); err != nil {
 		return nil, err
 	}
 	var changes []ec2types.InstanceStateChange
@@ -556,11 +630,77 @@ func (f *FakeEC2) TerminateInstances(ctx context.Context, params *ec2.TerminateI
 	return &ec2.TerminateInstancesOutput{TerminatingInstances: changes}, nil
 }
 
+// StopInstances moves running instances to stopped. Like EC2, a stopped
+// instance loses its public IP address and DNS name.
+func (f *FakeEC2) StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+	f.store.record("StopInstances", params)
+	if err := f.store.failure(ctx, "StopInstances"); err != nil {
+		return nil, err
+	}
+	var changes []ec2types.InstanceStateChange
+	for _, id := range params.InstanceIds {
+		inst, ok := f.store.Instances[id]
+		if !ok {
+			return nil, notFound("InvalidInstanceID.NotFound", id)
+		}
+		if inst.State.Name == ec2types.InstanceStateNameTerminated {
+			return nil, fmt.Errorf("IncorrectInstanceState: instance %s is terminated", id)
+		}
+		previous := inst.State
+		inst.State = &ec2types.InstanceState{Name: ec2types.InstanceStateNameStopped, Code: aws.Int32(80)}
+		inst.PublicIpAddress = nil
+		inst.PublicDnsName = aws.String("")
+		changes = append(changes, ec2types.InstanceStateChange{
+			InstanceId:    aws.String(id),
+			CurrentState:  inst.State,
+			PreviousState: previous,
+		})
+	}
+	return &ec2.StopInstancesOutput{StoppingInstances: changes}, nil
+}
+
+// StartInstances moves stopped instances back to running, assigning each a
+// new public IP address and DNS name.
+func (f *FakeEC2) StartInstances(ctx context.Context, params *ec2.StartInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+	f.store.record("StartInstances", params)
+	if err := f.store.failure(ctx, "StartInstances"); err != nil {
+		return nil, err
+	}
+	var changes []ec2types.InstanceStateChange
+	for _, id := range params.InstanceIds {
+		inst, ok := f.store.Instances[id]
+		if !ok {
+			return nil, notFound("InvalidInstanceID.NotFound", id)
+		}
+		if inst.State.Name == ec2types.InstanceStateNameTerminated {
+			return nil, fmt.Errorf("IncorrectInstanceState: instance %s is terminated", id)
+		}
+		previous := inst.State
+		if inst.State.Name != ec2types.InstanceStateNameRunning {
+			f.store.counter++
+			ip := fmt.Sprintf("203.0.113.%d", f.store.counter%254+1)
+			inst.PublicIpAddress = aws.String(ip)
+			inst.PublicDnsName = aws.String(fmt.Sprintf("ec2-%s.compute.amazonaws.com", strings.ReplaceAll(ip, ".", "-")))
+		}
+		inst.State = &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning, Code: aws.Int32(16)}
+		changes = append(changes, ec2types.InstanceStateChange{
+			InstanceId:    aws.String(id),
+			CurrentState:  inst.State,
+			PreviousState: previous,
+		})
+	}
+	return &ec2.StartInstancesOutput{StartingInstances: changes}, nil
+}
+
 func (f *FakeEC2) DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeInstances", params)
-	if err := f.store.failure("DescribeInstances"); err != nil {
+	if err := f.store.failure(ctx, "DescribeInstances"); err != nil {
 		return nil, err
 	}
 	var insts []ec2types.Instance
@@ -589,7 +729,7 @@ func (f *FakeEC2) DescribeInstanceTypes(ctx context.Context, params *ec2.Describ
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeInstanceTypes", params)
-	if err := f.store.failure("DescribeInstanceTypes"); err != nil {
+	if err := f.store.failure(ctx, "DescribeInstanceTypes"); err != nil {
 		return nil, err
 	}
 	var infos []ec2types.InstanceTypeInfo
@@ -633,7 +773,7 @@ func (f *FakeEC2) DescribeImages(ctx context.Context, params *ec2.DescribeImages
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeImages", params)
-	if err := f.store.failure("DescribeImages"); err != nil {
+	if err := f.store.failure(ctx, "DescribeImages"); err != nil {
 		return nil, err
 	}
 	var out []ec2types.Image
@@ -654,13 +794,56 @@ func (f *FakeEC2) DescribeImages(ctx context.Context, params *ec2.DescribeImages
 	return &ec2.DescribeImagesOutput{Images: out, NextToken: nil}, nil
 }
 
+// CreateImage registers an available image of the instance in the image
+// catalog, tagged from the image tag specifications.
+func (f *FakeEC2) CreateImage(ctx context.Context, params *ec2.CreateImageInput, optFns ...func(*ec2.Options)) (*ec2.CreateImageOutput, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+	f.store.record("CreateImage", params)
+	if err := f.store.failure(ctx, "CreateImage"); err != nil {
+		return nil, err
+	}
+	id := aws.ToString(params.InstanceId)
+	inst, ok := f.store.Instances[id]
+	if !ok {
+		return nil, notFound("InvalidInstanceID.NotFound", id)
+	}
+	var tags []ec2types.Tag
+	for _, spec := range params.TagSpecifications {
+		if spec.ResourceType == ec2types.ResourceTypeImage {
+			tags = append(tags, spec.Tags...)
+		}
+	}
+	// The image inherits the architecture and root device of the image the
+	// instance was launched from, when that image is seeded.
+	image := ec2types.Image{
+		Architecture:   ec2types.ArchitectureValuesX8664,
+		RootDeviceName: aws.String("/dev/sda1"),
+	}
+	for _, src := range f.store.Images {
+		if aws.ToString(src.ImageId) == aws.ToString(inst.ImageId) {
+			image.Architecture = src.Architecture
+			image.RootDeviceName = src.RootDeviceName
+		}
+	}
+	imageID := f.store.nextID("ami")
+	image.ImageId = aws.String(imageID)
+	image.Name = params.Name
+	image.Description = params.Description
+	image.State = ec2types.ImageStateAvailable
+	image.CreationDate = aws.String("2026-01-01T00:00:00.000Z")
+	image.Tags = tags
+	f.store.Images = append(f.store.Images, image)
+	return &ec2.CreateImageOutput{ImageId: aws.String(imageID)}, nil
+}
+
 // ---- Network Interfaces ----
 
 func (f *FakeEC2) DescribeNetworkInterfaces(ctx context.Context, params *ec2.DescribeNetworkInterfacesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error) {
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeNetworkInterfaces", params)
-	if err := f.store.failure("DescribeNetworkInterfaces"); err != nil {
+	if err := f.store.failure(ctx, "DescribeNetworkInterfaces"); err != nil {
 		return nil, err
 	}
 	var out []ec2types.NetworkInterface
@@ -673,8 +856,9 @@ func (f *FakeEC2) DescribeNetworkInterfaces(ctx context.Context, params *ec2.Des
 		}
 	case len(params.Filters) > 0:
 		vpcID := filterValue(params.Filters, "vpc-id")
+		groupIDs := filterValues(params.Filters, "group-id")
 		for id, ni := range f.store.NetworkInterfaces {
-			if vpcID == "" || aws.ToString(ni.VpcId) == vpcID {
+			if (vpcID == "" || aws.ToString(ni.VpcId) == vpcID) && inGroups(ni.Groups, groupIDs) {
 				out = append(out, *ni)
 				f.advanceENIDrain(id)
 			}
@@ -708,7 +892,7 @@ func (f *FakeEC2) ModifyNetworkInterfaceAttribute(ctx context.Context, params *e
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("ModifyNetworkInterfaceAttribute", params)
-	if err := f.store.failure("ModifyNetworkInterfaceAttribute"); err != nil {
+	if err := f.store.failure(ctx, "ModifyNetworkInterfaceAttribute"); err != nil {
 		return nil, err
 	}
 	return &ec2.ModifyNetworkInterfaceAttributeOutput{}, nil
@@ -720,7 +904,7 @@ func (f *FakeEC2) CreateTags(ctx context.Context, params *ec2.CreateTagsInput, o
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("CreateTags", params)
-	if err := f.store.failure("CreateTags"); err != nil {
+	if err := f.store.failure(ctx, "CreateTags"); err != nil {
 		return nil, err
 	}
 	for _, id := range params.Resources {
@@ -739,7 +923,7 @@ func (f *FakeEC2) DescribeTags(ctx context.Context, params *ec2.DescribeTagsInpu
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeTags", params)
-	if err := f.store.failure("DescribeTags"); err != nil {
+	if err := f.store.failure(ctx, "DescribeTags"); err != nil {
 		return nil, err
 	}
 	var descs []ec2types.TagDescription
@@ -761,7 +945,7 @@ func (f *FakeEC2) AllocateAddress(ctx context.Context, params *ec2.AllocateAddre
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("AllocateAddress", params)
-	if err := f.store.failure("AllocateAddress"); err != nil {
+	if err := f.store.failure(ctx, "AllocateAddress"); err != nil {
 		return nil, err
 	}
 	id := f.store.nextID("eipalloc")
@@ -770,6 +954,7 @@ func (f *FakeEC2) AllocateAddress(ctx context.Context, params *ec2.AllocateAddre
 		AllocationId: aws.String(id),
 		PublicIp:     aws.String(ip),
 		Domain:       ec2types.DomainTypeVpc,
+		Tags:         tagsFromSpecs(params.TagSpecifications),
 	}
 	return &ec2.AllocateAddressOutput{AllocationId: aws.String(id), PublicIp: aws.String(ip)}, nil
 }
@@ -778,7 +963,7 @@ func (f *FakeEC2) ReleaseAddress(ctx context.Context, params *ec2.ReleaseAddress
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("ReleaseAddress", params)
-	if err := f.store.failure("ReleaseAddress"); err != nil {
+	if err := f.store.failure(ctx, "ReleaseAddress"); err != nil {
 		return nil, err
 	}
 	id := aws.ToString(params.AllocationId)
@@ -789,13 +974,39 @@ func (f *FakeEC2) ReleaseAddress(ctx context.Context, params *ec2.ReleaseAddress
 	return &ec2.ReleaseAddressOutput{}, nil
 }
 
+func (f *FakeEC2) DescribeAddresses(ctx context.Context, params *ec2.DescribeAddressesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+	f.store.record("DescribeAddresses", params)
+	if err := f.store.failure(ctx, "DescribeAddresses"); err != nil {
+		return nil, err
+	}
+	var out []ec2types.Address
+	if len(params.AllocationIds) > 0 {
+		for _, id := range params.AllocationIds {
+			addr, ok := f.store.Addresses[id]
+			if !ok {
+				return nil, notFound("InvalidAllocationID.NotFound", id)
+			}
+			out = append(out, *addr)
+		}
+	} else {
+		for _, addr := range f.store.Addresses {
+			if matchesTagFilters(addr.Tags, params.Filters) {
+				out = append(out, *addr)
+			}
+		}
+	}
+	return &ec2.DescribeAddressesOutput{Addresses: out}, nil
+}
+
 // ---- NAT Gateway ----
 
 func (f *FakeEC2) CreateNatGateway(ctx context.Context, params *ec2.CreateNatGatewayInput, optFns ...func(*ec2.Options)) (*ec2.CreateNatGatewayOutput, error) {
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("CreateNatGateway", params)
-	if err := f.store.failure("CreateNatGateway"); err != nil {
+	if err := f.store.failure(ctx, "CreateNatGateway"); err != nil {
 		return nil, err
 	}
 	id := f.store.nextID("nat")
@@ -819,6 +1030,15 @@ func (f *FakeEC2) CreateNatGateway(ctx context.Context, params *ec2.CreateNatGat
 		State:        state,
 		Tags:         tagsFromSpecs(params.TagSpecifications),
 	}
+	if subnet, ok := f.store.Subnets[aws.ToString(params.SubnetId)]; ok {
+		nat.VpcId = subnet.VpcId
+	}
+	if params.AllocationId != nil {
+		nat.NatGatewayAddresses = []ec2types.NatGatewayAddress{{AllocationId: params.AllocationId}}
+		if addr, ok := f.store.Addresses[aws.ToString(params.AllocationId)]; ok {
+			addr.AssociationId = aws.String(f.store.nextID("eipassoc"))
+		}
+	}
 	f.store.NatGateways[id] = &nat
 	return &ec2.CreateNatGatewayOutput{NatGateway: &nat}, nil
 }
@@ -827,7 +1047,7 @@ func (f *FakeEC2) DeleteNatGateway(ctx context.Context, params *ec2.DeleteNatGat
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DeleteNatGateway", params)
-	if err := f.store.failure("DeleteNatGateway"); err != nil {
+	if err := f.store.failure(ctx, "DeleteNatGateway"); err != nil {
 		return nil, err
 	}
 	id := aws.ToString(params.NatGatewayId)
@@ -852,7 +1072,7 @@ func (f *FakeEC2) DescribeNatGateways(ctx context.Context, params *ec2.DescribeN
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeNatGateways", params)
-	if err := f.store.failure("DescribeNatGateways"); err != nil {
+	if err := f.store.failure(ctx, "DescribeNatGateways"); err != nil {
 		return nil, err
 	}
 	var out []ec2types.NatGateway
@@ -863,7 +1083,15 @@ func (f *FakeEC2) DescribeNatGateways(ctx context.Context, params *ec2.DescribeN
 			}
 		}
 	} else {
+		vpcID := filterValue(params.Filter, "vpc-id")
+		states := filterValues(params.Filter, "state")
 		for id, nat := range f.store.NatGateways {
+			if vpcID != "" && aws.ToString(nat.VpcId) != vpcID {
+				continue
+			}
+			if len(states) > 0 && !slices.Contains(states, string(nat.State)) {
+				continue
+			}
 			out = append(out, f.advanceNatState(id, nat))
 		}
 	}
@@ -908,12 +1136,197 @@ func (f *FakeEC2) ModifySubnetAttribute(ctx context.Context, params *ec2.ModifyS
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("ModifySubnetAttribute", params)
-	if err := f.store.failure("ModifySubnetAttribute"); err != nil {
+	if err := f.store.failure(ctx, "ModifySubnetAttribute"); err != nil {
 		return nil, err
 	}
 	return &ec2.ModifySubnetAttributeOutput{}, nil
 }
 
+// ---- Placement Group ----
+
+func (f *FakeEC2) CreatePlacementGroup(ctx context.Context, params *ec2.CreatePlacementGroupInput, optFns ...func(*ec2.Options)) (*ec2.CreatePlacementGroupOutput, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+	f.store.record("CreatePlacementGroup", params)
+	if err := f.store.failure(ctx, "CreatePlacementGroup"); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.GroupName)
+	if _, ok := f.store.PlacementGroups[name]; ok {
+		return nil, fmt.Errorf("InvalidPlacementGroup.Duplicate: placement group %s already exists", name)
+	}
+	pg := ec2types.PlacementGroup{
+		GroupId:        aws.String(f.store.nextID("pg")),
+		GroupName:      aws.String(name),
+		Strategy:       params.Strategy,
+		PartitionCount: params.PartitionCount,
+		State:          ec2types.PlacementGroupStateAvailable,
+		Tags:           tagsFromSpecs(params.TagSpecifications),
+	}
+	f.store.PlacementGroups[name] = &pg
+	return &ec2.CreatePlacementGroupOutput{PlacementGroup: &pg}, nil
+}
+
+func (f *FakeEC2) DeletePlacementGroup(ctx context.Context, params *ec2.DeletePlacementGroupInput, optFns ...func(*ec2.Options)) (*ec2.DeletePlacementGroupOutput, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+	f.store.record("DeletePlacementGroup", params)
+	if err := f.store.failure(ctx, "DeletePlacementGroup"); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.GroupName)
+	if _, ok := f.store.PlacementGroups[name]; !ok {
+		return nil, notFound("InvalidPlacementGroup.Unknown", name)
+	}
+	for _, inst := range f.store.Instances {
+		if inst.State != nil && inst.State.Name == ec2types.InstanceStateNameTerminated {
+			continue
+		}
+		if inst.Placement != nil && aws.ToString(inst.Placement.GroupName) == name {
+			return nil, fmt.Errorf("InvalidPlacementGroup.InUse: placement group %s is in use", name)
+		}
+	}
+	delete(f.store.PlacementGroups, name)
+	return &ec2.DeletePlacementGroupOutput{}, nil
+}
+
+func (f *FakeEC2) DescribePlacementGroups(ctx context.Context, params *ec2.DescribePlacementGroupsInput, optFns ...func(*ec2.Options)) (*ec2.DescribePlacementGroupsOutput, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+	f.store.record("DescribePlacementGroups", params)
+	if err := f.store.failure(ctx, "DescribePlacementGroups"); err != nil {
+		return nil, err
+	}
+	var out []ec2types.PlacementGroup
+	if len(params.GroupNames) > 0 {
+		for _, name := range params.GroupNames {
+			pg, ok := f.store.PlacementGroups[name]
+			if !ok {
+				return nil, notFound("InvalidPlacementGroup.Unknown", name)
+			}
+			out = append(out, *pg)
+		}
+	} else {
+		for _, pg := range f.store.PlacementGroups {
+			out = append(out, *pg)
+		}
+	}
+	return &ec2.DescribePlacementGroupsOutput{PlacementGroups: out}, nil
+}
+
+// ---- Capacity Reservation ----
+
+func (f *FakeEC2) DescribeCapacityReservations(ctx context.Context, params *ec2.DescribeCapacityReservationsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeCapacityReservationsOutput, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+	f.store.record("DescribeCapacityReservations", params)
+	if err := f.store.failure(ctx, "DescribeCapacityReservations"); err != nil {
+		return nil, err
+	}
+	var out []ec2types.CapacityReservation
+	if len(params.CapacityReservationIds) > 0 {
+		for _, id := range params.CapacityReservationIds {
+			cr, ok := f.store.CapacityReservations[id]
+			if !ok {
+				return nil, notFound("InvalidCapacityReservationId.NotFound", id)
+			}
+			out = append(out, *cr)
+		}
+	} else {
+		for _, cr := range f.store.CapacityReservations {
+			out = append(out, *cr)
+		}
+	}
+	return &ec2.DescribeCapacityReservationsOutput{CapacityReservations: out}, nil
+}
+
+// ---- Region ----
+
+func (f *FakeEC2) DescribeRegions(ctx context.Context, params *ec2.DescribeRegionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+	f.store.record("DescribeRegions", params)
+	if err := f.store.failure(ctx, "DescribeRegions"); err != nil {
+		return nil, err
+	}
+	out := make([]ec2types.Region, 0, len(f.store.Regions))
+	for _, name := range f.store.Regions {
+		out = append(out, ec2types.Region{
+			RegionName:  aws.String(name),
+			OptInStatus: aws.String("opt-in-not-required"),
+		})
+	}
+	return &ec2.DescribeRegionsOutput{Regions: out}, nil
+}
+
+func (f *FakeEC2) DescribeAvailabilityZones(ctx context.Context, params *ec2.DescribeAvailabilityZonesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAvailabilityZonesOutput, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+	f.store.record("DescribeAvailabilityZones", params)
+	if err := f.store.failure(ctx, "DescribeAvailabilityZones"); err != nil {
+		return nil, err
+	}
+	out := make([]ec2types.AvailabilityZone, 0, len(f.store.Zones))
+	for _, name := range f.store.Zones {
+		out = append(out, ec2types.AvailabilityZone{
+			ZoneName: aws.String(name),
+			ZoneType: aws.String("availability-zone"),
+			State:    ec2types.AvailabilityZoneStateAvailable,
+		})
+	}
+	return &ec2.DescribeAvailabilityZonesOutput{AvailabilityZones: out}, nil
+}
+
+// filterValues returns all values of the named filter, or nil if absent.
+func filterValues(filters []ec2types.Filter, name string) []string {
+	for _, filter := range filters {
+		if aws.ToString(filter.Name) == name {
+			return filter.Values
+		}
+	}
+	return nil
+}
+
+// inGroups reports whether any of groups is in ids; an empty ids matches
+// everything.
+func inGroups(groups []ec2types.GroupIdentifier, ids []string) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	for _, g := range groups {
+		for _, id := range ids {
+			if aws.ToString(g.GroupId) == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesTagFilters reports whether tags satisfy every "tag:<key>" and
+// "tag-key" filter; other filter names are ignored.
+func matchesTagFilters(tags []ec2types.Tag, filters []ec2types.Filter) bool {
+	for _, filter := range filters {
+		name := aws.ToString(filter.Name)
+		switch {
+		case name == "tag-key":
+			if !slices.ContainsFunc(tags, func(t ec2types.Tag) bool {
+				return slices.Contains(filter.Values, aws.ToString(t.Key))
+			}) {
+				return false
+			}
+		case strings.HasPrefix(name, "tag:"):
+			key := strings.TrimPrefix(name, "tag:")
+			if !slices.ContainsFunc(tags, func(t ec2types.Tag) bool {
+				return aws.ToString(t.Key) == key && slices.Contains(filter.Values, aws.ToString(t.Value))
+			}) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // filterValue returns the first value of the named filter, or "" if absent.
 func filterValue(filters []ec2types.Filter, name string) string {
 	for _, filter := range filters {
@@ -19,7 +19,9 @@ package awsfake
 import (
 	"context"
 	"fmt"
+	"maps"
 	"slices"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
@@ -43,18 +45,37 @@ func (f *FakeELBv2) CreateLoadBalancer(ctx context.Context, params *elasticloadb
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("CreateLoadBalancer", params)
-	if err := f.store.failure("CreateLoadBalancer"); err != nil {
+	if err := f.store.failure(ctx, "CreateLoadBalancer"); err != nil {
 		return nil, err
 	}
+	// Like AWS, an ALB must span subnets in two availability zones, and the
+	// load balancer belongs to the VPC of its subnets
+	var vpcID *string
+	zones := map[string]bool{}
+	for _, subnetID := range params.Subnets {
+		if sn, ok := f.store.Subnets[subnetID]; ok {
+			vpcID = sn.VpcId
+			zones[aws.ToString(sn.AvailabilityZone)] = true
+		}
+	}
+	kind := "net"
+	if params.Type == elbv2types.LoadBalancerTypeEnumApplication {
+		kind = "app"
+		if len(zones) < 2 {
+			return nil, fmt.Errorf("ValidationError: At least two subnets in two different Availability Zones must be specified")
+		}
+	}
 	name := aws.ToString(params.Name)
 	id := f.store.nextID("lb")
-	arn := fmt.Sprintf("%s:loadbalancer/net/%s/%s", elbv2ARNPrefix, name, id)
+	arn := fmt.Sprintf("%s:loadbalancer/%s/%s/%s", elbv2ARNPrefix, kind, name, id)
 	lb := elbv2types.LoadBalancer{
 		LoadBalancerArn:  aws.String(arn),
 		LoadBalancerName: params.Name,
 		DNSName:          aws.String(fmt.Sprintf("%s-%s.elb.amazonaws.com", name, id)),
 		Type:             params.Type,
 		Scheme:           params.Scheme,
+		VpcId:            vpcID,
+		SecurityGroups:   params.SecurityGroups,
 		State:            &elbv2types.LoadBalancerState{Code: elbv2types.LoadBalancerStateEnumActive},
 	}
 	f.store.LoadBalancers[arn] = &lb
@@ -65,7 +86,7 @@ func (f *FakeELBv2) DescribeLoadBalancers(ctx context.Context, params *elasticlo
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeLoadBalancers", params)
-	if err := f.store.failure("DescribeLoadBalancers"); err != nil {
+	if err := f.store.failure(ctx, "DescribeLoadBalancers"); err != nil {
 		return nil, err
 	}
 	var out []elbv2types.LoadBalancer
@@ -103,7 +124,7 @@ func (f *FakeELBv2) DeleteLoadBalancer(ctx context.Context, params *elasticloadb
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DeleteLoadBalancer", params)
-	if err := f.store.failure("DeleteLoadBalancer"); err != nil {
+	if err := f.store.failure(ctx, "DeleteLoadBalancer"); err != nil {
 		return nil, err
 	}
 	arn := aws.ToString(params.LoadBalancerArn)
@@ -120,7 +141,7 @@ func (f *FakeELBv2) CreateTargetGroup(ctx context.Context, params *elasticloadba
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("CreateTargetGroup", params)
-	if err := f.store.failure("CreateTargetGroup"); err != nil {
+	if err := f.store.failure(ctx, "CreateTargetGroup"); err != nil {
 		return nil, err
 	}
 	name := aws.ToString(params.Name)
@@ -141,7 +162,7 @@ func (f *FakeELBv2) DescribeTargetGroups(ctx context.Context, params *elasticloa
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeTargetGroups", params)
-	if err := f.store.failure("DescribeTargetGroups"); err != nil {
+	if err := f.store.failure(ctx, "DescribeTargetGroups"); err != nil {
 		return nil, err
 	}
 	var out []elbv2types.TargetGroup
@@ -165,8 +186,20 @@ func (f *FakeELBv2) DescribeTargetGroups(ctx context.Context, params *elasticloa
 			}
 		}
 	default:
-		for _, tg := range f.store.TargetGroups {
-			out = append(out, *tg)
+		arns := slices.Sorted(maps.Keys(f.store.TargetGroups))
+		start, _ := strconv.Atoi(aws.ToString(params.Marker))
+		end := len(arns)
+		if size := f.store.TargetGroupPageSize; size > 0 && start+size < end {
+			end = start + size
+		}
+		for _, arn := range arns[start:end] {
+			out = append(out, *f.store.TargetGroups[arn])
+		}
+		if end < len(arns) {
+			return &elasticloadbalancingv2.DescribeTargetGroupsOutput{
+				TargetGroups: out,
+				NextMarker:   aws.String(strconv.Itoa(end)),
+			}, nil
 		}
 	}
 	return &elasticloadbalancingv2.DescribeTargetGroupsOutput{TargetGroups: out}, nil
@@ -176,7 +209,7 @@ func (f *FakeELBv2) DescribeTargetHealth(ctx context.Context, params *elasticloa
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeTargetHealth", params)
-	if err := f.store.failure("DescribeTargetHealth"); err != nil {
+	if err := f.store.failure(ctx, "DescribeTargetHealth"); err != nil {
 		return nil, err
 	}
 	arn := aws.ToString(params.TargetGroupArn)
@@ -197,7 +230,7 @@ func (f *FakeELBv2) DeleteTargetGroup(ctx context.Context, params *elasticloadba
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DeleteTargetGroup", params)
-	if err := f.store.failure("DeleteTargetGroup"); err != nil {
+	if err := f.store.failure(ctx, "DeleteTargetGroup"); err != nil {
 		return nil, err
 	}
 	arn := aws.ToString(params.TargetGroupArn)
@@ -215,7 +248,7 @@ func (f *FakeELBv2) RegisterTargets(ctx context.Context, params *elasticloadbala
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("RegisterTargets", params)
-	if err := f.store.failure("RegisterTargets"); err != nil {
+	if err := f.store.failure(ctx, "RegisterTargets"); err != nil {
 		return nil, err
 	}
 	arn := aws.ToString(params.TargetGroupArn)
@@ -227,7 +260,7 @@ func (f *FakeELBv2) DeregisterTargets(ctx context.Context, params *elasticloadba
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DeregisterTargets", params)
-	if err := f.store.failure("DeregisterTargets"); err != nil {
+	if err := f.store.failure(ctx, "DeregisterTargets"); err != nil {
 		return nil, err
 	}
 	arn := aws.ToString(params.TargetGroupArn)
@@ -251,7 +284,7 @@ func (f *FakeELBv2) CreateListener(ctx context.Context, params *elasticloadbalan
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("CreateListener", params)
-	if err := f.store.failure("CreateListener"); err != nil {
+	if err := f.store.failure(ctx, "CreateListener"); err != nil {
 		return nil, err
 	}
 	id := f.store.nextID("listener")
@@ -292,7 +325,7 @@ func (f *FakeELBv2) DescribeListeners(ctx context.Context, params *elasticloadba
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DescribeListeners", params)
-	if err := f.store.failure("DescribeListeners"); err != nil {
+	if err := f.store.failure(ctx, "DescribeListeners"); err != nil {
 		return nil, err
 	}
 	var out []elbv2types.Listener
@@ -309,7 +342,7 @@ func (f *FakeELBv2) DeleteListener(ctx context.Context, params *elasticloadbalan
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("DeleteListener", params)
-	if err := f.store.failure("DeleteListener"); err != nil {
+	if err := f.store.failure(ctx, "DeleteListener"); err != nil {
 		return nil, err
 	}
 	arn := aws.ToString(params.ListenerArn)
@@ -326,7 +359,7 @@ func (f *FakeELBv2) AddTags(ctx context.Context, params *elasticloadbalancingv2.
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("AddTags", params)
-	if err := f.store.failure("AddTags"); err != nil {
+	if err := f.store.failure(ctx, "AddTags"); err != nil {
 		return nil, err
 	}
 	return &elasticloadbalancingv2.AddTagsOutput{}, nil
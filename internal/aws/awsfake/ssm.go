@@ -39,7 +39,7 @@ func (f *FakeSSM) GetParameter(ctx context.Context, params *ssm.GetParameterInpu
 	f.store.mu.Lock()
 	defer f.store.mu.Unlock()
 	f.store.record("GetParameter", params)
-	if err := f.store.failure("GetParameter"); err != nil {
+	if err := f.store.failure(ctx, "GetParameter"); err != nil {
 		return nil, err
 	}
 	name := aws.ToString(params.Name)
@@ -26,6 +26,7 @@
 package awsfake
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -74,6 +75,7 @@ type Store struct {
 	NatGateways       map[string]*ec2types.NatGateway
 	Addresses         map[string]*ec2types.Address
 	NetworkInterfaces map[string]*ec2types.NetworkInterface
+	PlacementGroups   map[string]*ec2types.PlacementGroup // keyed by name
 
 	// ELBv2 resources (load balancers/target groups/listeners keyed by ARN).
 	LoadBalancers     map[string]*elbv2types.LoadBalancer
@@ -88,6 +90,23 @@ type Store struct {
 	Images        []ec2types.Image
 	InstanceTypes map[string][]ec2types.ArchitectureType
 
+	// CapacityReservations are seeded On-Demand Capacity Reservations keyed
+	// by ID. RunInstances consumes their available instance count.
+	CapacityReservations map[string]*ec2types.CapacityReservation
+
+	// Regions are the region names returned by DescribeRegions.
+	Regions []string
+
+	// TargetGroupPageSize, when set, splits the unfiltered
+	// DescribeTargetGroups listing into pages of that many target groups,
+	// ordered by ARN, as AWS pages large accounts.
+	TargetGroupPageSize int
+
+	// Zones are the availability zone names returned by
+	// DescribeAvailabilityZones. Subnets created without a zone are placed
+	// in the first one.
+	Zones []string
+
 	// Per-instance-type overrides for filtered DescribeInstanceTypes queries:
 	// explicit architectures (bypassing the prefix heuristic) and types marked
 	// as not offered (so a filtered query returns no results).
@@ -115,6 +134,10 @@ type Store struct {
 	natDeleteScript *int
 	natDeleting     map[string]int
 	eniDraining     map[string]int
+
+	// spotInterruptions is the number of upcoming spot launches that the
+	// spot service reclaims before they start.
+	spotInterruptions int
 }
 
 // natScript is a one-shot directive for the next CreateNatGateway.
@@ -125,30 +148,32 @@ type natScript struct {
 
 func newStore() *Store {
 	s := &Store{
-		Vpcs:                map[string]*ec2types.Vpc{},
-		Subnets:             map[string]*ec2types.Subnet{},
-		InternetGateways:    map[string]*ec2types.InternetGateway{},
-		RouteTables:         map[string]*ec2types.RouteTable{},
-		SecurityGroups:      map[string]*ec2types.SecurityGroup{},
-		Instances:           map[string]*ec2types.Instance{},
-		NatGateways:         map[string]*ec2types.NatGateway{},
-		Addresses:           map[string]*ec2types.Address{},
-		NetworkInterfaces:   map[string]*ec2types.NetworkInterface{},
-		LoadBalancers:       map[string]*elbv2types.LoadBalancer{},
-		TargetGroups:        map[string]*elbv2types.TargetGroup{},
-		Listeners:           map[string]*elbv2types.Listener{},
-		RegisteredTargets:   map[string][]elbv2types.TargetDescription{},
-		Parameters:          map[string]string{},
-		InstanceTypes:       map[string][]ec2types.ArchitectureType{},
-		instanceTypeArchs:   map[string][]ec2types.ArchitectureType{},
-		absentInstanceTypes: map[string]bool{},
-		calls:               map[string]int{},
-		inputs:              map[string][]any{},
-		failures:            map[string][]error{},
-		natPending:          map[string]int{},
-		natFinal:            map[string]ec2types.NatGatewayState{},
-		natDeleting:         map[string]int{},
-		eniDraining:         map[string]int{},
+		Vpcs:                 map[string]*ec2types.Vpc{},
+		Subnets:              map[string]*ec2types.Subnet{},
+		InternetGateways:     map[string]*ec2types.InternetGateway{},
+		RouteTables:          map[string]*ec2types.RouteTable{},
+		SecurityGroups:       map[string]*ec2types.SecurityGroup{},
+		Instances:            map[string]*ec2types.Instance{},
+		NatGateways:          map[string]*ec2types.NatGateway{},
+		Addresses:            map[string]*ec2types.Address{},
+		NetworkInterfaces:    map[string]*ec2types.NetworkInterface{},
+		PlacementGroups:      map[string]*ec2types.PlacementGroup{},
+		LoadBalancers:        map[string]*elbv2types.LoadBalancer{},
+		TargetGroups:         map[string]*elbv2types.TargetGroup{},
+		Listeners:            map[string]*elbv2types.Listener{},
+		RegisteredTargets:    map[string][]elbv2types.TargetDescription{},
+		Parameters:           map[string]string{},
+		InstanceTypes:        map[string][]ec2types.ArchitectureType{},
+		CapacityReservations: map[string]*ec2types.CapacityReservation{},
+		instanceTypeArchs:    map[string][]ec2types.ArchitectureType{},
+		absentInstanceTypes:  map[string]bool{},
+		calls:                map[string]int{},
+		inputs:               map[string][]any{},
+		failures:             map[string][]error{},
+		natPending:           map[string]int{},
+		natFinal:             map[string]ec2types.NatGatewayState{},
+		natDeleting:          map[string]int{},
+		eniDraining:          map[string]int{},
 	}
 	s.seed()
 	return s
@@ -203,6 +228,9 @@ func (s *Store) seed() {
 	} {
 		s.InstanceTypes[t] = archsFor(t)
 	}
+
+	s.Regions = []string{"us-east-1", "us-west-2"}
+	s.Zones = []string{"us-west-2a", "us-west-2b", "us-west-2c"}
 }
 
 // nextID returns a unique, deterministic id for the given prefix
@@ -262,6 +290,15 @@ func (s *Store) SeedNextNatGatewayDeleteState(deletingPolls int) {
 	s.natDeleteScript = &polls
 }
 
+// InterruptNextSpot makes the next spot RunInstances launch an instance that
+// is reclaimed before it starts: it is reported terminated with the
+// Server.SpotInstanceTermination state reason, failing the running-waiter.
+func (s *Store) InterruptNextSpot() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.spotInterruptions++
+}
+
 // SeedDrainingENI adds an in-use network interface to vpcID that
 // DescribeNetworkInterfaces reports as in-use (blocking) for the first
 // blockingPolls observations, then removes — driving waitForENIsDrained's
@@ -279,9 +316,13 @@ func (s *Store) SeedDrainingENI(vpcID string, blockingPolls int) string {
 	return id
 }
 
-// failure pops and returns the next injected error for method (FIFO), or nil.
-// Callers must hold mu.
-func (s *Store) failure(method string) error {
+// failure returns ctx's error if it is done, like the real clients do, and
+// otherwise pops and returns the next injected error for method (FIFO), or
+// nil. Callers must hold mu.
+func (s *Store) failure(ctx context.Context, method string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	q := s.failures[method]
 	if len(q) == 0 {
 		return nil
@@ -328,6 +369,25 @@ func (s *Store) SeedInstanceType(name string) {
 	s.InstanceTypes[name] = archsFor(name)
 }
 
+// SeedCapacityReservation adds an active On-Demand Capacity Reservation for
+// available instances of instanceType in zone and returns its ID.
+// RunInstances targeting it must match the type and zone, and fails with
+// ReservationCapacityExceeded once the available count is used up.
+func (s *Store) SeedCapacityReservation(instanceType, zone string, available int32) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := s.nextID("cr")
+	s.CapacityReservations[id] = &ec2types.CapacityReservation{
+		CapacityReservationId:  aws.String(id),
+		InstanceType:           aws.String(instanceType),
+		AvailabilityZone:       aws.String(zone),
+		AvailableInstanceCount: aws.Int32(available),
+		TotalInstanceCount:     aws.Int32(available),
+		State:                  ec2types.CapacityReservationStateActive,
+	}
+	return id
+}
+
 // SeedInstanceTypeArchs registers explicit supported architectures for an
 // instance type, overriding the prefix heuristic in DescribeInstanceTypes'
 // filtered (InstanceTypes) responses. It models types whose architecture the
@@ -406,6 +466,7 @@ func (s *Store) countsLocked() map[string]int {
 		"natgateways":       len(s.NatGateways),
 		"addresses":         len(s.Addresses),
 		"networkinterfaces": len(s.NetworkInterfaces),
+		"placementgroups":   len(s.PlacementGroups),
 		"loadbalancers":     len(s.LoadBalancers),
 		"targetgroups":      len(s.TargetGroups),
 		"listeners":         len(s.Listeners),
@@ -112,6 +112,10 @@ type EC2Client interface {
 		optFns ...func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error)
 	DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput,
 		optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
+	StopInstances(ctx context.Context, params *ec2.StopInstancesInput,
+		optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
+	StartInstances(ctx context.Context, params *ec2.StartInstancesInput,
+		optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
 	DescribeInstanceTypes(ctx context.Context,
 		params *ec2.DescribeInstanceTypesInput,
 		optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceTypesOutput, error)
@@ -119,6 +123,8 @@ type EC2Client interface {
 	// Image operations
 	DescribeImages(ctx context.Context, params *ec2.DescribeImagesInput,
 		optFns ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
+	CreateImage(ctx context.Context, params *ec2.CreateImageInput,
+		optFns ...func(*ec2.Options)) (*ec2.CreateImageOutput, error)
 
 	// Network Interface operations
 	DescribeNetworkInterfaces(ctx context.Context,
@@ -140,6 +146,8 @@ type EC2Client interface {
 		optFns ...func(*ec2.Options)) (*ec2.AllocateAddressOutput, error)
 	ReleaseAddress(ctx context.Context, params *ec2.ReleaseAddressInput,
 		optFns ...func(*ec2.Options)) (*ec2.ReleaseAddressOutput, error)
+	DescribeAddresses(ctx context.Context, params *ec2.DescribeAddressesInput,
+		optFns ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error)
 
 	// NAT Gateway operations
 	CreateNatGateway(ctx context.Context, params *ec2.CreateNatGatewayInput,
@@ -152,6 +160,29 @@ type EC2Client interface {
 	// Subnet attribute operations
 	ModifySubnetAttribute(ctx context.Context, params *ec2.ModifySubnetAttributeInput,
 		optFns ...func(*ec2.Options)) (*ec2.ModifySubnetAttributeOutput, error)
+
+	// Placement Group operations
+	CreatePlacementGroup(ctx context.Context, params *ec2.CreatePlacementGroupInput,
+		optFns ...func(*ec2.Options)) (*ec2.CreatePlacementGroupOutput, error)
+	DeletePlacementGroup(ctx context.Context, params *ec2.DeletePlacementGroupInput,
+		optFns ...func(*ec2.Options)) (*ec2.DeletePlacementGroupOutput, error)
+	DescribePlacementGroups(ctx context.Context,
+		params *ec2.DescribePlacementGroupsInput,
+		optFns ...func(*ec2.Options)) (*ec2.DescribePlacementGroupsOutput, error)
+
+	// Capacity Reservation operations
+	DescribeCapacityReservations(ctx context.Context,
+		params *ec2.DescribeCapacityReservationsInput,
+		optFns ...func(*ec2.Options)) (*ec2.DescribeCapacityReservationsOutput,
+		error)
+
+	// Region operations
+	DescribeRegions(ctx context.Context, params *ec2.DescribeRegionsInput,
+		optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error)
+	DescribeAvailabilityZones(ctx context.Context,
+		params *ec2.DescribeAvailabilityZonesInput,
+		optFns ...func(*ec2.Options)) (*ec2.DescribeAvailabilityZonesOutput,
+		error)
 }
 
 // Ensure *ec2.Client implements EC2Client at compile time.
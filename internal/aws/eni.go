@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/holodeck/internal/logger"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+const (
+	// ENIPollInterval is the delay between ENI drain checks.
+	ENIPollInterval = 10 * time.Second
+	// ENIPollTimeout bounds how long WaitForENIsDrained waits.
+	ENIPollTimeout = 5 * time.Minute
+	// eniDescribeTimeout bounds each DescribeNetworkInterfaces call.
+	eniDescribeTimeout = 30 * time.Second
+)
+
+// WaitForENIsDrained polls DescribeNetworkInterfaces until every ENI matching
+// filter is "available" (detached) or gone. In-use ENIs left behind by
+// terminated instances, load balancers and NAT gateways block security group,
+// subnet and VPC deletion with DependencyViolation. scope names the matched
+// set in log messages; sleep is injectable so tests need not wait.
+func WaitForENIsDrained(ctx context.Context, client EC2Client, log *logger.FunLogger,
+	sleep func(time.Duration), filter types.Filter, scope string) error {
+	deadline := time.Now().Add(ENIPollTimeout)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("cancelled waiting for ENIs to drain in %s: %w", scope, err)
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, eniDescribeTimeout)
+		result, err := client.DescribeNetworkInterfaces(callCtx, &ec2.DescribeNetworkInterfacesInput{
+			Filters: []types.Filter{filter},
+		})
+		cancel()
+
+		if err != nil {
+			log.Warning("Error checking ENIs in %s: %v", scope, err)
+		} else {
+			// Count non-available ENIs (in-use ENIs block SG deletion)
+			var blocking int
+			for _, eni := range result.NetworkInterfaces {
+				if eni.Status != types.NetworkInterfaceStatusAvailable {
+					blocking++
+				}
+			}
+			if blocking == 0 {
+				log.Info("All ENIs in %s are drained", scope)
+				return nil
+			}
+			log.Info("Waiting for %d in-use ENI(s) in %s to detach...", blocking, scope)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for ENIs to drain in %s", scope)
+		}
+
+		sleep(ENIPollInterval)
+	}
+}
@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aws
+
+import "time"
+
+// ExpiresAtTag is the tag key holodeck writes on every AWS resource of an
+// environment that sets spec.ttl or spec.expiresAt. Its value is an RFC 3339
+// timestamp in UTC; "holodeck reap" tears down resources whose timestamp has
+// passed.
+const ExpiresAtTag = "ExpiresAt"
+
+// FormatExpiresAt formats t as an ExpiresAtTag value.
+func FormatExpiresAt(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// ParseExpiresAt parses an ExpiresAtTag value.
+func ParseExpiresAt(value string) (time.Time, error) {
+	return time.Parse(time.RFC3339, value)
+}
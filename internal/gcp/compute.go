@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package gcp provides the Compute Engine client used by the holodeck GCP
+// provider. This package is internal and not intended for external consumption.
+package gcp
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned when a resource does not exist.
+var ErrNotFound = errors.New("not found")
+
+// The types below mirror the subset of the Compute Engine v1 REST resources
+// used by holodeck so they can be sent to the API as-is.
+
+// Network is a VPC network.
+type Network struct {
+	Name                  string `json:"name"`
+	Description           string `json:"description,omitempty"`
+	AutoCreateSubnetworks bool   `json:"autoCreateSubnetworks"`
+	SelfLink              string `json:"selfLink,omitempty"`
+}
+
+// Subnetwork is a regional subnet of a Network.
+type Subnetwork struct {
+	Name        string `json:"name"`
+	Network     string `json:"network"`
+	IPCidrRange string `json:"ipCidrRange"`
+	SelfLink    string `json:"selfLink,omitempty"`
+}
+
+// Firewall is a VPC firewall rule.
+type Firewall struct {
+	Name         string            `json:"name"`
+	Network      string            `json:"network"`
+	Direction    string            `json:"direction,omitempty"`
+	SourceRanges []string          `json:"sourceRanges,omitempty"`
+	TargetTags   []string          `json:"targetTags,omitempty"`
+	Allowed      []FirewallAllowed `json:"allowed"`
+}
+
+// FirewallAllowed is a protocol and port set allowed by a Firewall.
+type FirewallAllowed struct {
+	IPProtocol string   `json:"IPProtocol"`
+	Ports      []string `json:"ports,omitempty"`
+}
+
+// Instance is a Compute Engine VM instance.
+type Instance struct {
+	Name              string              `json:"name"`
+	MachineType       string              `json:"machineType"`
+	Status            string              `json:"status,omitempty"`
+	Labels            map[string]string   `json:"labels,omitempty"`
+	Tags              *Tags               `json:"tags,omitempty"`
+	Disks             []AttachedDisk      `json:"disks,omitempty"`
+	NetworkInterfaces []NetworkInterface  `json:"networkInterfaces,omitempty"`
+	GuestAccelerators []AcceleratorConfig `json:"guestAccelerators,omitempty"`
+	Scheduling        *Scheduling         `json:"scheduling,omitempty"`
+	Metadata          *Metadata           `json:"metadata,omitempty"`
+}
+
+// Tags are network tags used to target firewall rules.
+type Tags struct {
+	Items []string `json:"items,omitempty"`
+}
+
+// AttachedDisk is a disk attached to an Instance.
+type AttachedDisk struct {
+	Boot             bool                    `json:"boot,omitempty"`
+	AutoDelete       bool                    `json:"autoDelete,omitempty"`
+	InitializeParams *AttachedDiskInitParams `json:"initializeParams,omitempty"`
+}
+
+// AttachedDiskInitParams describes a disk created with its Instance.
+type AttachedDiskInitParams struct {
+	SourceImage string `json:"sourceImage,omitempty"`
+	DiskSizeGb  int64  `json:"diskSizeGb,omitempty,string"`
+}
+
+// NetworkInterface attaches an Instance to a Subnetwork.
+type NetworkInterface struct {
+	Network       string         `json:"network,omitempty"`
+	Subnetwork    string         `json:"subnetwork,omitempty"`
+	NetworkIP     string         `json:"networkIP,omitempty"`
+	AccessConfigs []AccessConfig `json:"accessConfigs,omitempty"`
+}
+
+// AccessConfig gives a NetworkInterface an external IP address.
+type AccessConfig struct {
+	Name  string `json:"name,omitempty"`
+	Type  string `json:"type,omitempty"`
+	NatIP string `json:"natIP,omitempty"`
+}
+
+// AcceleratorConfig attaches accelerators to an Instance.
+type AcceleratorConfig struct {
+	AcceleratorType  string `json:"acceleratorType"`
+	AcceleratorCount int32  `json:"acceleratorCount"`
+}
+
+// Scheduling controls the maintenance behavior of an Instance.
+type Scheduling struct {
+	OnHostMaintenance string `json:"onHostMaintenance,omitempty"`
+	AutomaticRestart  *bool  `json:"automaticRestart,omitempty"`
+}
+
+// Metadata is the key/value metadata of an Instance.
+type Metadata struct {
+	Items []MetadataItem `json:"items,omitempty"`
+}
+
+// MetadataItem is one metadata entry.
+type MetadataItem struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// PublicIP returns the external IP address of the first network interface.
+func (i *Instance) PublicIP() string {
+	for _, nic := range i.NetworkInterfaces {
+		for _, ac := range nic.AccessConfigs {
+			if ac.NatIP != "" {
+				return ac.NatIP
+			}
+		}
+	}
+	return ""
+}
+
+// PrivateIP returns the internal IP address of the first network interface.
+func (i *Instance) PrivateIP() string {
+	if len(i.NetworkInterfaces) == 0 {
+		return ""
+	}
+	return i.NetworkInterfaces[0].NetworkIP
+}
+
+// ComputeClient defines the Compute Engine operations used by the GCP
+// provider, bound to a single project. Insert and delete calls return once
+// the operation is done. This interface enables dependency injection and
+// facilitates unit testing by allowing fakes to be substituted for the real
+// REST client.
+type ComputeClient interface {
+	InsertNetwork(ctx context.Context, network *Network) error
+	DeleteNetwork(ctx context.Context, name string) error
+
+	InsertSubnetwork(ctx context.Context, region string, subnetwork *Subnetwork) error
+	DeleteSubnetwork(ctx context.Context, region, name string) error
+
+	InsertFirewall(ctx context.Context, firewall *Firewall) error
+	DeleteFirewall(ctx context.Context, name string) error
+
+	InsertInstance(ctx context.Context, zone string, instance *Instance) error
+	GetInstance(ctx context.Context, zone, name string) (*Instance, error)
+	DeleteInstance(ctx context.Context, zone, name string) error
+}
@@ -0,0 +1,328 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package gcpfake provides a stateful, in-memory fake of the Compute Engine
+// client used by the holodeck GCP provider. It implements the
+// internal/gcp.ComputeClient interface so it can be injected via the
+// provider's WithClient option, letting the real create/delete code run
+// credential-free against an observable project.
+package gcpfake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/holodeck/internal/gcp"
+)
+
+// Fake is the in-memory project. All access is guarded by mu; the client
+// methods lock it at entry, so the unexported helpers assume it is held.
+// Like Compute Engine, it refuses to delete a network or subnetwork that is
+// still in use, which makes teardown ordering bugs observable.
+type Fake struct {
+	mu sync.Mutex
+
+	Networks    map[string]*gcp.Network
+	Subnetworks map[string]*gcp.Subnetwork
+	Firewalls   map[string]*gcp.Firewall
+	// Instances keyed by name
+	Instances map[string]*gcp.Instance
+	// Zones records the zone of each instance
+	Zones map[string]string
+
+	// bootPolls holds, per instance, the remaining GetInstance observations
+	// that report PROVISIONING after insert.
+	bootPolls     map[string]int
+	nextBootPolls int
+
+	calls    map[string]int
+	failures map[string][]error
+	counter  int
+}
+
+// Compile-time assertion that Fake implements gcp.ComputeClient.
+var _ gcp.ComputeClient = (*Fake)(nil)
+
+// New returns an empty fake project.
+func New() *Fake {
+	return &Fake{
+		Networks:    map[string]*gcp.Network{},
+		Subnetworks: map[string]*gcp.Subnetwork{},
+		Firewalls:   map[string]*gcp.Firewall{},
+		Instances:   map[string]*gcp.Instance{},
+		Zones:       map[string]string{},
+		bootPolls:   map[string]int{},
+		calls:       map[string]int{},
+		failures:    map[string][]error{},
+	}
+}
+
+// SeedInstance adds a running instance to zone, e.g. to simulate a name
+// clash.
+func (f *Fake) SeedInstance(zone, name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Instances[name] = &gcp.Instance{Name: name, Status: "RUNNING"}
+	f.Zones[name] = zone
+}
+
+// SeedBootDelay makes instances inserted from now on report PROVISIONING for
+// the first polls GetInstance observations, driving the provider's wait loop.
+func (f *Fake) SeedBootDelay(polls int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextBootPolls = polls
+}
+
+// FailNext queues a one-shot error for the next call to method (e.g.
+// "InsertInstance"). Multiple calls queue FIFO.
+func (f *Fake) FailNext(method string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures[method] = append(f.failures[method], err)
+}
+
+// CallsTo returns how many times method has been invoked on the fake.
+func (f *Fake) CallsTo(method string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[method]
+}
+
+// ResourceCount returns the total number of networks, subnetworks,
+// firewalls and instances in the project.
+func (f *Fake) ResourceCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.Networks) + len(f.Subnetworks) + len(f.Firewalls) + len(f.Instances)
+}
+
+// begin records a call to method and pops its next injected error.
+// Callers must hold mu.
+func (f *Fake) begin(method string) error {
+	f.calls[method]++
+	q := f.failures[method]
+	if len(q) == 0 {
+		return nil
+	}
+	f.failures[method] = q[1:]
+	return q[0]
+}
+
+// InsertNetwork implements gcp.ComputeClient.
+func (f *Fake) InsertNetwork(_ context.Context, network *gcp.Network) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("InsertNetwork"); err != nil {
+		return err
+	}
+	if _, ok := f.Networks[network.Name]; ok {
+		return fmt.Errorf("network %q already exists", network.Name)
+	}
+	n := *network
+	n.SelfLink = "global/networks/" + n.Name
+	f.Networks[n.Name] = &n
+	return nil
+}
+
+// DeleteNetwork implements gcp.ComputeClient.
+func (f *Fake) DeleteNetwork(_ context.Context, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("DeleteNetwork"); err != nil {
+		return err
+	}
+	n, ok := f.Networks[name]
+	if !ok {
+		return gcp.ErrNotFound
+	}
+	for _, s := range f.Subnetworks {
+		if s.Network == n.SelfLink {
+			return fmt.Errorf("network %q is in use by subnetwork %q", name, s.Name)
+		}
+	}
+	for _, fw := range f.Firewalls {
+		if fw.Network == n.SelfLink {
+			return fmt.Errorf("network %q is in use by firewall %q", name, fw.Name)
+		}
+	}
+	delete(f.Networks, name)
+	return nil
+}
+
+// InsertSubnetwork implements gcp.ComputeClient.
+func (f *Fake) InsertSubnetwork(_ context.Context, region string, subnetwork *gcp.Subnetwork) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("InsertSubnetwork"); err != nil {
+		return err
+	}
+	if _, ok := f.Subnetworks[subnetwork.Name]; ok {
+		return fmt.Errorf("subnetwork %q already exists", subnetwork.Name)
+	}
+	if !f.hasNetwork(subnetwork.Network) {
+		return fmt.Errorf("network %q: %w", subnetwork.Network, gcp.ErrNotFound)
+	}
+	s := *subnetwork
+	s.SelfLink = "regions/" + region + "/subnetworks/" + s.Name
+	f.Subnetworks[s.Name] = &s
+	return nil
+}
+
+// DeleteSubnetwork implements gcp.ComputeClient.
+func (f *Fake) DeleteSubnetwork(_ context.Context, _, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("DeleteSubnetwork"); err != nil {
+		return err
+	}
+	s, ok := f.Subnetworks[name]
+	if !ok {
+		return gcp.ErrNotFound
+	}
+	for _, inst := range f.Instances {
+		for _, nic := range inst.NetworkInterfaces {
+			if nic.Subnetwork == s.SelfLink {
+				return fmt.Errorf("subnetwork %q is in use by instance %q", name, inst.Name)
+			}
+		}
+	}
+	delete(f.Subnetworks, name)
+	return nil
+}
+
+// InsertFirewall implements gcp.ComputeClient.
+func (f *Fake) InsertFirewall(_ context.Context, firewall *gcp.Firewall) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("InsertFirewall"); err != nil {
+		return err
+	}
+	if _, ok := f.Firewalls[firewall.Name]; ok {
+		return fmt.Errorf("firewall %q already exists", firewall.Name)
+	}
+	if !f.hasNetwork(firewall.Network) {
+		return fmt.Errorf("network %q: %w", firewall.Network, gcp.ErrNotFound)
+	}
+	fw := *firewall
+	f.Firewalls[fw.Name] = &fw
+	return nil
+}
+
+// DeleteFirewall implements gcp.ComputeClient.
+func (f *Fake) DeleteFirewall(_ context.Context, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("DeleteFirewall"); err != nil {
+		return err
+	}
+	if _, ok := f.Firewalls[name]; !ok {
+		return gcp.ErrNotFound
+	}
+	delete(f.Firewalls, name)
+	return nil
+}
+
+// InsertInstance implements gcp.ComputeClient. Instances get deterministic
+// internal and external addresses.
+func (f *Fake) InsertInstance(_ context.Context, zone string, instance *gcp.Instance) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("InsertInstance"); err != nil {
+		return err
+	}
+	if _, ok := f.Instances[instance.Name]; ok {
+		return fmt.Errorf("instance %q already exists", instance.Name)
+	}
+	inst := *instance
+	inst.NetworkInterfaces = append([]gcp.NetworkInterface(nil), instance.NetworkInterfaces...)
+	f.counter++
+	for i := range inst.NetworkInterfaces {
+		nic := &inst.NetworkInterfaces[i]
+		if _, ok := f.Subnetworks[subnetName(nic.Subnetwork)]; nic.Subnetwork != "" && !ok {
+			return fmt.Errorf("subnetwork %q: %w", nic.Subnetwork, gcp.ErrNotFound)
+		}
+		nic.NetworkIP = fmt.Sprintf("10.0.0.%d", f.counter+1)
+		nic.AccessConfigs = append([]gcp.AccessConfig(nil), nic.AccessConfigs...)
+		for j := range nic.AccessConfigs {
+			nic.AccessConfigs[j].NatIP = fmt.Sprintf("203.0.113.%d", f.counter)
+		}
+	}
+	inst.Status = "PROVISIONING"
+	f.Instances[inst.Name] = &inst
+	f.Zones[inst.Name] = zone
+	f.bootPolls[inst.Name] = f.nextBootPolls
+	return nil
+}
+
+// GetInstance implements gcp.ComputeClient.
+func (f *Fake) GetInstance(_ context.Context, zone, name string) (*gcp.Instance, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("GetInstance"); err != nil {
+		return nil, err
+	}
+	inst, ok := f.Instances[name]
+	if !ok || f.Zones[name] != zone {
+		return nil, gcp.ErrNotFound
+	}
+	if inst.Status == "PROVISIONING" {
+		if f.bootPolls[name] > 0 {
+			f.bootPolls[name]--
+		} else {
+			inst.Status = "RUNNING"
+		}
+	}
+	out := *inst
+	return &out, nil
+}
+
+// DeleteInstance implements gcp.ComputeClient.
+func (f *Fake) DeleteInstance(_ context.Context, zone, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("DeleteInstance"); err != nil {
+		return err
+	}
+	if _, ok := f.Instances[name]; !ok || f.Zones[name] != zone {
+		return gcp.ErrNotFound
+	}
+	delete(f.Instances, name)
+	delete(f.Zones, name)
+	delete(f.bootPolls, name)
+	return nil
+}
+
+// hasNetwork reports whether link names an existing network.
+// Callers must hold mu.
+func (f *Fake) hasNetwork(link string) bool {
+	for _, n := range f.Networks {
+		if n.SelfLink == link || n.Name == link {
+			return true
+		}
+	}
+	return false
+}
+
+// subnetName returns the last path element of a subnetwork link.
+func subnetName(link string) string {
+	for i := len(link) - 1; i >= 0; i-- {
+		if link[i] == '/' {
+			return link[i+1:]
+		}
+	}
+	return link
+}
@@ -0,0 +1,309 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultEndpoint is the Compute Engine v1 API root
+	DefaultEndpoint = "https://compute.googleapis.com/compute/v1"
+	// requestTimeout bounds a single Compute Engine API call
+	requestTimeout = 2 * time.Minute
+
+	// EnvAccessToken overrides how the OAuth2 access token is obtained
+	EnvAccessToken = "HOLODECK_GCP_ACCESS_TOKEN"
+	// envGoogleAccessToken is the variable honored by gcloud and terraform
+	envGoogleAccessToken = "GOOGLE_OAUTH_ACCESS_TOKEN"
+	// metadataTokenURL serves tokens for the attached service account on GCE
+	metadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+)
+
+// TokenSource returns an OAuth2 access token for the Compute Engine API.
+type TokenSource func(ctx context.Context) (string, error)
+
+// RESTClient implements ComputeClient against the Compute Engine v1 REST API.
+type RESTClient struct {
+	endpoint *url.URL
+	project  string
+	token    TokenSource
+	http     *http.Client
+}
+
+// Compile-time assertion that RESTClient implements ComputeClient.
+var _ ComputeClient = (*RESTClient)(nil)
+
+// NewRESTClient returns a client for project. An empty endpoint selects
+// DefaultEndpoint and a nil token source selects DefaultTokenSource.
+func NewRESTClient(project, endpoint string, token TokenSource) (*RESTClient, error) {
+	if project == "" {
+		return nil, fmt.Errorf("gcp project is required")
+	}
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gcp endpoint %q: %w", endpoint, err)
+	}
+	if token == nil {
+		token = DefaultTokenSource()
+	}
+	return &RESTClient{
+		endpoint: u,
+		project:  project,
+		token:    token,
+		http:     &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// DefaultTokenSource obtains an access token from, in order: the
+// HOLODECK_GCP_ACCESS_TOKEN or GOOGLE_OAUTH_ACCESS_TOKEN environment
+// variables, the GCE metadata server, and `gcloud auth print-access-token`.
+// Tokens from the metadata server and gcloud are cached until shortly
+// before they expire.
+func DefaultTokenSource() TokenSource {
+	var (
+		mu      sync.Mutex
+		cached  string
+		expires time.Time
+	)
+	return func(ctx context.Context) (string, error) {
+		for _, env := range []string{EnvAccessToken, envGoogleAccessToken} {
+			if t := os.Getenv(env); t != "" {
+				return t, nil
+			}
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if cached != "" && time.Now().Before(expires) {
+			return cached, nil
+		}
+
+		token, ttl, err := metadataToken(ctx)
+		if err != nil {
+			token, ttl, err = gcloudToken(ctx)
+		}
+		if err != nil {
+			return "", fmt.Errorf("no gcp credentials found: set %s or run 'gcloud auth login': %w", EnvAccessToken, err)
+		}
+		cached, expires = token, time.Now().Add(ttl-time.Minute)
+		return cached, nil
+	}
+}
+
+func metadataToken(ctx context.Context) (string, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataTokenURL, nil)
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("metadata server returned %d", resp.StatusCode)
+	}
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return "", 0, fmt.Errorf("failed to decode metadata token: %w", err)
+	}
+	return tok.AccessToken, time.Duration(tok.ExpiresIn) * time.Second, nil
+}
+
+func gcloudToken(ctx context.Context) (string, time.Duration, error) {
+	out, err := exec.CommandContext(ctx, "gcloud", "auth", "print-access-token").Output()
+	if err != nil {
+		return "", 0, fmt.Errorf("gcloud auth print-access-token: %w", err)
+	}
+	// gcloud tokens are valid for an hour; refresh well before that
+	return strings.TrimSpace(string(out)), 30 * time.Minute, nil
+}
+
+// apiError is a non-2xx response from Compute Engine.
+type apiError struct {
+	status int
+	body   string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("compute engine returned %d: %s", e.status, e.body)
+}
+
+// operation is a long-running Compute Engine operation.
+type operation struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	SelfLink string `json:"selfLink"`
+	Error    *struct {
+		Errors []struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	} `json:"error,omitempty"`
+}
+
+func (op *operation) err() error {
+	if op.Error == nil || len(op.Error.Errors) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(op.Error.Errors))
+	for _, e := range op.Error.Errors {
+		msgs = append(msgs, e.Code+": "+e.Message)
+	}
+	return fmt.Errorf("operation %s failed: %s", op.Name, strings.Join(msgs, "; "))
+}
+
+// do performs an authenticated API call against rawURL, decoding the
+// response into out when it is non-nil.
+func (c *RESTClient) do(ctx context.Context, method, rawURL string, in, out any) error {
+	token, err := c.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, _ := io.ReadAll(resp.Body)
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode/100 != 2:
+		return &apiError{status: resp.StatusCode, body: string(data)}
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("failed to decode %s response: %w", rawURL, err)
+		}
+	}
+	return nil
+}
+
+// path returns the URL of a resource below the project.
+func (c *RESTClient) path(elem ...string) string {
+	parts := []string{"projects", url.PathEscape(c.project)}
+	for _, e := range elem {
+		parts = append(parts, url.PathEscape(e))
+	}
+	return c.endpoint.JoinPath(parts...).String()
+}
+
+// mutate issues a call that returns an operation and waits for it to finish.
+func (c *RESTClient) mutate(ctx context.Context, method, rawURL string, in any) error {
+	var op operation
+	if err := c.do(ctx, method, rawURL, in, &op); err != nil {
+		return err
+	}
+	for op.Status != "DONE" {
+		if op.SelfLink == "" {
+			return fmt.Errorf("operation %s has no selfLink", op.Name)
+		}
+		// wait returns when the operation is done or after about two minutes
+		if err := c.do(ctx, http.MethodPost, op.SelfLink+"/wait", nil, &op); err != nil {
+			return fmt.Errorf("failed waiting for operation %s: %w", op.Name, err)
+		}
+	}
+	return op.err()
+}
+
+// InsertNetwork creates a VPC network.
+func (c *RESTClient) InsertNetwork(ctx context.Context, network *Network) error {
+	return c.mutate(ctx, http.MethodPost, c.path("global", "networks"), network)
+}
+
+// DeleteNetwork deletes a VPC network.
+func (c *RESTClient) DeleteNetwork(ctx context.Context, name string) error {
+	return c.mutate(ctx, http.MethodDelete, c.path("global", "networks", name), nil)
+}
+
+// InsertSubnetwork creates a subnetwork in region.
+func (c *RESTClient) InsertSubnetwork(ctx context.Context, region string, subnetwork *Subnetwork) error {
+	return c.mutate(ctx, http.MethodPost, c.path("regions", region, "subnetworks"), subnetwork)
+}
+
+// DeleteSubnetwork deletes a subnetwork in region.
+func (c *RESTClient) DeleteSubnetwork(ctx context.Context, region, name string) error {
+	return c.mutate(ctx, http.MethodDelete, c.path("regions", region, "subnetworks", name), nil)
+}
+
+// InsertFirewall creates a firewall rule.
+func (c *RESTClient) InsertFirewall(ctx context.Context, firewall *Firewall) error {
+	return c.mutate(ctx, http.MethodPost, c.path("global", "firewalls"), firewall)
+}
+
+// DeleteFirewall deletes a firewall rule.
+func (c *RESTClient) DeleteFirewall(ctx context.Context, name string) error {
+	return c.mutate(ctx, http.MethodDelete, c.path("global", "firewalls", name), nil)
+}
+
+// InsertInstance creates an instance in zone.
+func (c *RESTClient) InsertInstance(ctx context.Context, zone string, instance *Instance) error {
+	return c.mutate(ctx, http.MethodPost, c.path("zones", zone, "instances"), instance)
+}
+
+// GetInstance returns an instance in zone.
+func (c *RESTClient) GetInstance(ctx context.Context, zone, name string) (*Instance, error) {
+	var instance Instance
+	if err := c.do(ctx, http.MethodGet, c.path("zones", zone, "instances", name), nil, &instance); err != nil {
+		return nil, err
+	}
+	return &instance, nil
+}
+
+// DeleteInstance deletes an instance in zone.
+func (c *RESTClient) DeleteInstance(ctx context.Context, zone, name string) error {
+	return c.mutate(ctx, http.MethodDelete, c.path("zones", zone, "instances", name), nil)
+}
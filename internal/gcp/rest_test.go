@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCompute serves the subset of the Compute Engine API used by
+// RESTClient. Inserts return a pending operation that completes on wait.
+func fakeCompute(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	var srv *httptest.Server
+	reply := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	authed := func(h http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			h(w, r)
+		}
+	}
+
+	mux.HandleFunc("POST /projects/proj/global/networks", authed(func(w http.ResponseWriter, r *http.Request) {
+		var n Network
+		if json.NewDecoder(r.Body).Decode(&n) != nil || n.Name != "net" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		reply(w, map[string]string{"name": "op-1", "status": "RUNNING", "selfLink": srv.URL + "/projects/proj/global/operations/op-1"})
+	}))
+	mux.HandleFunc("POST /projects/proj/global/operations/op-1/wait", authed(func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]string{"name": "op-1", "status": "DONE"})
+	}))
+	mux.HandleFunc("POST /projects/proj/zones/us-central1-a/instances", authed(func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]any{"name": "op-2", "status": "DONE", "error": map[string]any{
+			"errors": []map[string]string{{"code": "ZONE_RESOURCE_POOL_EXHAUSTED", "message": "no capacity"}},
+		}})
+	}))
+	mux.HandleFunc("GET /projects/proj/zones/us-central1-a/instances/vm", authed(func(w http.ResponseWriter, r *http.Request) {
+		reply(w, map[string]any{
+			"name":   "vm",
+			"status": "RUNNING",
+			"networkInterfaces": []map[string]any{{
+				"networkIP":     "10.0.0.2",
+				"accessConfigs": []map[string]string{{"natIP": "203.0.113.10"}},
+			}},
+		})
+	}))
+	mux.HandleFunc("DELETE /projects/proj/zones/us-central1-a/instances/missing", authed(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	srv = httptest.NewServer(mux)
+	return srv
+}
+
+func staticToken(token string) TokenSource {
+	return func(context.Context) (string, error) { return token, nil }
+}
+
+func TestRESTClient(t *testing.T) {
+	srv := fakeCompute(t)
+	defer srv.Close()
+
+	c, err := NewRESTClient("proj", srv.URL, staticToken("token"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, c.InsertNetwork(ctx, &Network{Name: "net"}), "operation should be awaited until DONE")
+
+	err = c.InsertInstance(ctx, "us-central1-a", &Instance{Name: "vm"})
+	assert.ErrorContains(t, err, "ZONE_RESOURCE_POOL_EXHAUSTED")
+
+	inst, err := c.GetInstance(ctx, "us-central1-a", "vm")
+	require.NoError(t, err)
+	assert.Equal(t, "RUNNING", inst.Status)
+	assert.Equal(t, "203.0.113.10", inst.PublicIP())
+	assert.Equal(t, "10.0.0.2", inst.PrivateIP())
+
+	assert.True(t, errors.Is(c.DeleteInstance(ctx, "us-central1-a", "missing"), ErrNotFound))
+}
+
+func TestRESTClientUnauthorized(t *testing.T) {
+	srv := fakeCompute(t)
+	defer srv.Close()
+
+	c, err := NewRESTClient("proj", srv.URL, func(context.Context) (string, error) { return "wrong", nil })
+	require.NoError(t, err)
+	_, err = c.GetInstance(context.Background(), "us-central1-a", "vm")
+	var apiErr *apiError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnauthorized, apiErr.status)
+}
+
+func TestDefaultTokenSourceFromEnv(t *testing.T) {
+	t.Setenv(EnvAccessToken, "from-env")
+	token, err := DefaultTokenSource()(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", token)
+}
@@ -17,6 +17,7 @@
 package instances
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
@@ -28,7 +29,8 @@ import (
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
 	"github.com/NVIDIA/holodeck/internal/logger"
 	"github.com/NVIDIA/holodeck/pkg/jyaml"
-	"github.com/NVIDIA/holodeck/pkg/provider/aws"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+	_ "github.com/NVIDIA/holodeck/pkg/provider/all" // register built-in providers
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -108,50 +110,53 @@ func (m *Manager) GetInstanceCacheFile(instanceID string) (string, error) {
 }
 
 // getProviderStatus retrieves the status of an instance from its provider
-func (m *Manager) getProviderStatus(env v1alpha1.Environment, cacheFile string) string {
+func (m *Manager) getProviderStatus(ctx context.Context, env v1alpha1.Environment, cacheFile string) string {
 	status := "unknown"
-	if env.Spec.Provider == v1alpha1.ProviderAWS {
-		client, err := aws.New(m.log, env, cacheFile)
-		if err != nil {
-			m.log.Warning("Failed to create AWS provider for status check: %v", err)
-			return status
-		}
-		conditions, err := client.Status()
-		if err != nil {
-			m.log.Warning("Failed to get instance status: %v", err)
-			return status
+	client, err := provider.New(m.log, env, cacheFile)
+	if err != nil {
+		m.log.Warning("Failed to create %s provider for status check: %v", env.Spec.Provider, err)
+		return status
+	}
+	conditions, err := client.Status(ctx)
+	if err != nil {
+		m.log.Warning("Failed to get instance status: %v", err)
+		return status
+	}
+	// Check conditions in order of priority
+	statusFound := false
+	for _, condition := range conditions {
+		if statusFound {
+			break
 		}
-		// Check conditions in order of priority
-		statusFound := false
-		for _, condition := range conditions {
-			if statusFound {
-				break
+		switch condition.Type {
+		case v1alpha1.ConditionTerminated:
+			if condition.Status == metav1.ConditionTrue {
+				status = "terminated"
+				statusFound = true
 			}
-			switch condition.Type {
-			case v1alpha1.ConditionTerminated:
-				if condition.Status == metav1.ConditionTrue {
-					status = "terminated"
-					statusFound = true
-				}
-			case v1alpha1.ConditionDegraded:
-				if condition.Status == metav1.ConditionTrue {
-					if condition.Reason != "" {
-						status = fmt.Sprintf("degraded (%s)", condition.Reason)
-					} else {
-						status = "degraded"
-					}
-					statusFound = true
-				}
-			case v1alpha1.ConditionProgressing:
-				if condition.Status == metav1.ConditionTrue {
-					status = "progressing"
-					statusFound = true
-				}
-			case v1alpha1.ConditionAvailable:
-				if condition.Status == metav1.ConditionTrue {
-					status = "running"
-					statusFound = true
+		case v1alpha1.ConditionDegraded:
+			if condition.Status == metav1.ConditionTrue {
+				if condition.Reason != "" {
+					status = fmt.Sprintf("degraded (%s)", condition.Reason)
+				} else {
+					status = "degraded"
 				}
+				statusFound = true
+			}
+		case v1alpha1.ConditionProgressing:
+			if condition.Status == metav1.ConditionTrue {
+				status = "progressing"
+				statusFound = true
+			}
+		case v1alpha1.ConditionAvailable:
+			if condition.Status == metav1.ConditionTrue {
+				status = "running"
+				statusFound = true
+			}
+		case v1alpha1.ConditionStopped:
+			if condition.Status == metav1.ConditionTrue {
+				status = "stopped"
+				statusFound = true
 			}
 		}
 	}
@@ -159,7 +164,7 @@ func (m *Manager) getProviderStatus(env v1alpha1.Environment, cacheFile string)
 }
 
 // ListInstances returns all running instances
-func (m *Manager) ListInstances() ([]Instance, error) {
+func (m *Manager) ListInstances(ctx context.Context) ([]Instance, error) {
 	var instances []Instance
 
 	// Read all cache files
@@ -183,7 +188,7 @@ func (m *Manager) ListInstances() ([]Instance, error) {
 		}
 
 		// Get instance status from provider
-		status := m.getProviderStatus(env, cacheFile)
+		status := m.getProviderStatus(ctx, env, cacheFile)
 
 		// Get file info for creation time
 		fileInfo, err := os.Stat(cacheFile)
@@ -222,9 +227,7 @@ func (m *Manager) ListInstances() ([]Instance, error) {
 				Region:            env.Spec.Cluster.Region,
 				ControlPlaneCount: env.Spec.Cluster.ControlPlane.Count,
 			}
-			if env.Spec.Cluster.Workers != nil {
-				instance.ClusterInfo.WorkerCount = env.Spec.Cluster.Workers.Count
-			}
+			instance.ClusterInfo.WorkerCount = env.Spec.Cluster.WorkerCount()
 			if env.Spec.Cluster.HighAvailability != nil {
 				instance.ClusterInfo.HAEnabled = env.Spec.Cluster.HighAvailability.Enabled
 			}
@@ -241,8 +244,48 @@ func (m *Manager) ListInstances() ([]Instance, error) {
 	return instances, nil
 }
 
+// CachedEnvironment is an environment read from its cache file, without
+// querying the provider.
+type CachedEnvironment struct {
+	ID          string
+	CacheFile   string
+	Environment v1alpha1.Environment
+}
+
+// ReadCache returns every environment in the cache directory. Unreadable
+// cache files are skipped, and a missing cache directory yields no
+// environments.
+func (m *Manager) ReadCache() ([]CachedEnvironment, error) {
+	files, err := os.ReadDir(m.cachePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read cache directory: %w", err)
+	}
+
+	var cached []CachedEnvironment
+	for _, file := range files {
+		if filepath.Ext(file.Name()) != ".yaml" {
+			continue
+		}
+		cacheFile := filepath.Join(m.cachePath, file.Name())
+		env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](cacheFile)
+		if err != nil {
+			m.log.Warning("Failed to read cache file %s: %v", cacheFile, err)
+			continue
+		}
+		cached = append(cached, CachedEnvironment{
+			ID:          file.Name()[:len(file.Name())-5],
+			CacheFile:   cacheFile,
+			Environment: env,
+		})
+	}
+	return cached, nil
+}
+
 // GetInstance returns details for a specific instance
-func (m *Manager) GetInstance(instanceID string) (*Instance, error) {
+func (m *Manager) GetInstance(ctx context.Context, instanceID string) (*Instance, error) {
 	cacheFile, err := m.GetInstanceCacheFile(instanceID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid instance ID: %w", err)
@@ -259,7 +302,7 @@ func (m *Manager) GetInstance(instanceID string) (*Instance, error) {
 	}
 
 	// Get instance status from provider
-	status := m.getProviderStatus(env, cacheFile)
+	status := m.getProviderStatus(ctx, env, cacheFile)
 
 	instance := &Instance{
 		ID:          instanceID,
@@ -278,9 +321,7 @@ func (m *Manager) GetInstance(instanceID string) (*Instance, error) {
 			Region:            env.Spec.Cluster.Region,
 			ControlPlaneCount: env.Spec.Cluster.ControlPlane.Count,
 		}
-		if env.Spec.Cluster.Workers != nil {
-			instance.ClusterInfo.WorkerCount = env.Spec.Cluster.Workers.Count
-		}
+		instance.ClusterInfo.WorkerCount = env.Spec.Cluster.WorkerCount()
 		if env.Spec.Cluster.HighAvailability != nil {
 			instance.ClusterInfo.HAEnabled = env.Spec.Cluster.HighAvailability.Enabled
 		}
@@ -295,7 +336,7 @@ func (m *Manager) GetInstance(instanceID string) (*Instance, error) {
 }
 
 // DeleteInstance removes an instance
-func (m *Manager) DeleteInstance(instanceID string) error {
+func (m *Manager) DeleteInstance(ctx context.Context, instanceID string) error {
 	cacheFile, err := m.GetInstanceCacheFile(instanceID)
 	if err != nil {
 		return fmt.Errorf("invalid instance ID: %w", err)
@@ -306,18 +347,13 @@ func (m *Manager) DeleteInstance(instanceID string) error {
 		return fmt.Errorf("failed to read cache file: %w", err)
 	}
 
-	// Delete resources based on provider
-	switch env.Spec.Provider {
-	case v1alpha1.ProviderAWS:
-		client, err := aws.New(m.log, env, cacheFile)
-		if err != nil {
-			return fmt.Errorf("failed to create AWS provider: %w", err)
-		}
-		if err := client.Delete(); err != nil {
-			return fmt.Errorf("failed to delete AWS resources: %w", err)
-		}
-	case v1alpha1.ProviderSSH:
-		m.log.Info("SSH infrastructure cleanup not implemented")
+	// Delete resources through the provider backend
+	client, err := provider.New(m.log, env, cacheFile)
+	if err != nil {
+		return fmt.Errorf("failed to create %s provider: %w", env.Spec.Provider, err)
+	}
+	if err := client.Delete(ctx); err != nil {
+		return fmt.Errorf("failed to delete %s resources: %w", env.Spec.Provider, err)
 	}
 
 	// Remove cache file
@@ -328,8 +364,151 @@ func (m *Manager) DeleteInstance(instanceID string) error {
 	return nil
 }
 
+// StopInstance stops the instances of an environment, keeping its
+// resources so that StartInstance can bring it back
+func (m *Manager) StopInstance(ctx context.Context, instanceID string) error {
+	client, env, err := m.stopper(instanceID)
+	if err != nil {
+		return err
+	}
+	if err := client.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop %s resources: %w", env.Spec.Provider, err)
+	}
+	return nil
+}
+
+// StartInstance starts the instances of a stopped environment
+func (m *Manager) StartInstance(ctx context.Context, instanceID string) error {
+	client, env, err := m.stopper(instanceID)
+	if err != nil {
+		return err
+	}
+	if err := client.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start %s resources: %w", env.Spec.Provider, err)
+	}
+	return nil
+}
+
+// SnapshotInstance captures a machine image of a provisioned environment
+// and returns the image ID
+func (m *Manager) SnapshotInstance(ctx context.Context, instanceID string, opts provider.SnapshotOptions) (string, error) {
+	client, env, err := m.capableProvider(instanceID, "snapshots", func(c provider.Capabilities) bool { return c.Snapshot })
+	if err != nil {
+		return "", err
+	}
+	s, ok := client.(provider.Snapshotter)
+	if !ok {
+		return "", fmt.Errorf("provider %s does not support snapshots", env.Spec.Provider)
+	}
+	imageID, err := s.Snapshot(ctx, opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to snapshot %s resources: %w", env.Spec.Provider, err)
+	}
+	return imageID, nil
+}
+
+// AddWorkers launches count workers into a worker pool of a cluster
+// environment and returns their node status
+func (m *Manager) AddWorkers(ctx context.Context, instanceID, pool string, count int) ([]v1alpha1.NodeStatus, error) {
+	client, env, err := m.scaler(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := client.AddWorkers(ctx, pool, count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add %s workers: %w", env.Spec.Provider, err)
+	}
+	return nodes, nil
+}
+
+// RemoveWorkers terminates the named worker nodes of a cluster environment
+func (m *Manager) RemoveWorkers(ctx context.Context, instanceID string, names []string) error {
+	client, env, err := m.scaler(instanceID)
+	if err != nil {
+		return err
+	}
+	if err := client.RemoveWorkers(ctx, names); err != nil {
+		return fmt.Errorf("failed to remove %s workers: %w", env.Spec.Provider, err)
+	}
+	return nil
+}
+
+// ReplaceWorker terminates a worker node of a cluster environment and
+// launches a new instance with the same name and pool in its place
+func (m *Manager) ReplaceWorker(ctx context.Context, instanceID, name string) (*v1alpha1.NodeStatus, error) {
+	client, env, err := m.scaler(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	node, err := client.ReplaceWorker(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replace %s worker: %w", env.Spec.Provider, err)
+	}
+	return node, nil
+}
+
+// ValidateScaling returns an error if the provider of an instance cannot
+// add, remove or replace workers
+func (m *Manager) ValidateScaling(instanceID string) error {
+	_, _, err := m.scaler(instanceID)
+	return err
+}
+
+// scaler returns the provider of an instance if it supports scaling
+func (m *Manager) scaler(instanceID string) (provider.Scaler, *v1alpha1.Environment, error) {
+	client, env, err := m.capableProvider(instanceID, "scaling", func(c provider.Capabilities) bool { return c.Scale })
+	if err != nil {
+		return nil, nil, err
+	}
+	s, ok := client.(provider.Scaler)
+	if !ok {
+		return nil, nil, fmt.Errorf("provider %s does not support scaling", env.Spec.Provider)
+	}
+	return s, env, nil
+}
+
+// stopper returns the provider of an instance if it supports stop/start
+func (m *Manager) stopper(instanceID string) (provider.Stopper, *v1alpha1.Environment, error) {
+	client, env, err := m.capableProvider(instanceID, "stop/start", func(c provider.Capabilities) bool { return c.Stop })
+	if err != nil {
+		return nil, nil, err
+	}
+	s, ok := client.(provider.Stopper)
+	if !ok {
+		return nil, nil, fmt.Errorf("provider %s does not support stop/start", env.Spec.Provider)
+	}
+	return s, env, nil
+}
+
+// capableProvider returns the provider of an instance, failing with
+// "does not support <feature>" when its registered capabilities lack it
+func (m *Manager) capableProvider(instanceID, feature string, supported func(provider.Capabilities) bool) (provider.Provider, *v1alpha1.Environment, error) {
+	cacheFile, err := m.GetInstanceCacheFile(instanceID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid instance ID: %w", err)
+	}
+
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](cacheFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read cache file: %w", err)
+	}
+
+	r, err := provider.Lookup(string(env.Spec.Provider))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !supported(r.Capabilities) {
+		return nil, nil, fmt.Errorf("provider %s does not support %s", env.Spec.Provider, feature)
+	}
+	client, err := r.New(m.log, env, cacheFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create %s provider: %w", env.Spec.Provider, err)
+	}
+	return client, &env, nil
+}
+
 // GetInstanceByFilename returns details for a specific instance by its filename
-func (m *Manager) GetInstanceByFilename(filename string) (*Instance, error) {
+func (m *Manager) GetInstanceByFilename(ctx context.Context, filename string) (*Instance, error) {
 	cacheFile, err := m.GetInstanceCacheFile(filename)
 	if err != nil {
 		return nil, fmt.Errorf("invalid instance ID: %w", err)
@@ -346,7 +525,7 @@ func (m *Manager) GetInstanceByFilename(filename string) (*Instance, error) {
 	}
 
 	// Get instance status from provider
-	status := m.getProviderStatus(env, cacheFile)
+	status := m.getProviderStatus(ctx, env, cacheFile)
 
 	return &Instance{
 		ID:        filename,
@@ -1,12 +1,14 @@
 package instances
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
 	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/provider"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -123,7 +125,7 @@ spec:
 	require.NoError(t, err)
 
 	// Test listing instances
-	instances, err := manager.ListInstances()
+	instances, err := manager.ListInstances(context.Background())
 	require.NoError(t, err)
 	require.Len(t, instances, 1)
 	assert.Equal(t, instanceID, instances[0].ID)
@@ -162,14 +164,14 @@ spec:
 	require.NoError(t, err)
 
 	// Test getting instance
-	instance, err := manager.GetInstance(instanceID)
+	instance, err := manager.GetInstance(context.Background(), instanceID)
 	require.NoError(t, err)
 	assert.Equal(t, instanceID, instance.ID)
 	assert.Equal(t, "test-instance", instance.Name)
 	assert.Equal(t, v1alpha1.ProviderAWS, instance.Provider)
 
 	// Test getting instance with invalid ID format
-	_, err = manager.GetInstance("nonexistent")
+	_, err = manager.GetInstance(context.Background(), "nonexistent")
 	assert.Error(t, err)
 }
 
@@ -204,7 +206,7 @@ spec:
 	require.NoError(t, err)
 
 	// Test deleting instance
-	err = manager.DeleteInstance(instanceID)
+	err = manager.DeleteInstance(context.Background(), instanceID)
 	require.NoError(t, err)
 
 	// Verify file is deleted
@@ -212,10 +214,131 @@ spec:
 	assert.True(t, os.IsNotExist(err))
 
 	// Test deleting instance with invalid ID format
-	err = manager.DeleteInstance("nonexistent")
+	err = manager.DeleteInstance(context.Background(), "nonexistent")
 	assert.Error(t, err)
 }
 
+func TestGetInstance_Stopped(t *testing.T) {
+	tempDir := t.TempDir()
+	manager := NewManager(logger.NewLogger(), tempDir)
+
+	instanceID := "a1b2c3d4"
+	cacheFile, err := manager.GetInstanceCacheFile(instanceID)
+	require.NoError(t, err)
+	err = os.WriteFile(cacheFile, []byte(`apiVersion: holodeck.nvidia.com/v1alpha1
+kind: Environment
+metadata:
+  name: test-instance
+spec:
+  provider: aws
+status:
+  conditions:
+  - type: Available
+    status: "False"
+    reason: ""
+    message: ""
+    lastTransitionTime: "2026-01-01T00:00:00Z"
+  - type: Terminated
+    status: "False"
+    reason: ""
+    message: ""
+    lastTransitionTime: "2026-01-01T00:00:00Z"
+  - type: Stopped
+    status: "True"
+    reason: v1alpha1.Stopped
+    message: EC2 instances are stopped
+    lastTransitionTime: "2026-01-01T00:00:00Z"
+`), 0600)
+	require.NoError(t, err)
+
+	instance, err := manager.GetInstance(context.Background(), instanceID)
+	require.NoError(t, err)
+	assert.Equal(t, "stopped", instance.Status)
+}
+
+func TestStopInstance_Unsupported(t *testing.T) {
+	tempDir := t.TempDir()
+	manager := NewManager(logger.NewLogger(), tempDir)
+
+	instanceID := "a1b2c3d4"
+	cacheFile, err := manager.GetInstanceCacheFile(instanceID)
+	require.NoError(t, err)
+	err = os.WriteFile(cacheFile, []byte(`apiVersion: holodeck.nvidia.com/v1alpha1
+kind: Environment
+metadata:
+  name: test-instance
+spec:
+  provider: ssh
+`), 0600)
+	require.NoError(t, err)
+
+	err = manager.StopInstance(context.Background(), instanceID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support stop/start")
+
+	err = manager.StartInstance(context.Background(), instanceID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support stop/start")
+
+	// Test stopping instance with invalid ID format
+	err = manager.StopInstance(context.Background(), "nonexistent")
+	assert.Error(t, err)
+}
+
+func TestSnapshotInstance_Unsupported(t *testing.T) {
+	tempDir := t.TempDir()
+	manager := NewManager(logger.NewLogger(), tempDir)
+
+	instanceID := "a1b2c3d4"
+	cacheFile, err := manager.GetInstanceCacheFile(instanceID)
+	require.NoError(t, err)
+	err = os.WriteFile(cacheFile, []byte(`apiVersion: holodeck.nvidia.com/v1alpha1
+kind: Environment
+metadata:
+  name: test-instance
+spec:
+  provider: ssh
+`), 0600)
+	require.NoError(t, err)
+
+	_, err = manager.SnapshotInstance(context.Background(), instanceID, provider.SnapshotOptions{Name: "golden"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support snapshots")
+}
+
+func TestScaleInstance_Unsupported(t *testing.T) {
+	tempDir := t.TempDir()
+	manager := NewManager(logger.NewLogger(), tempDir)
+
+	instanceID := "a1b2c3d4"
+	cacheFile, err := manager.GetInstanceCacheFile(instanceID)
+	require.NoError(t, err)
+	err = os.WriteFile(cacheFile, []byte(`apiVersion: holodeck.nvidia.com/v1alpha1
+kind: Environment
+metadata:
+  name: test-instance
+spec:
+  provider: ssh
+`), 0600)
+	require.NoError(t, err)
+
+	_, err = manager.AddWorkers(context.Background(), instanceID, "", 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support scaling")
+
+	err = manager.RemoveWorkers(context.Background(), instanceID, []string{"worker-0"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support scaling")
+
+	_, err = manager.ReplaceWorker(context.Background(), instanceID, "worker-0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support scaling")
+
+	err = manager.ValidateScaling(instanceID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support scaling")
+}
+
 func TestGetInstanceByFilename(t *testing.T) {
 	// Create a temporary directory for testing
 	tempDir, err := os.MkdirTemp("", "holodeck-test-*")
@@ -247,13 +370,44 @@ spec:
 	require.NoError(t, err)
 
 	// Test getting instance by filename
-	instance, err := manager.GetInstanceByFilename(instanceID)
+	instance, err := manager.GetInstanceByFilename(context.Background(), instanceID)
 	require.NoError(t, err)
 	assert.Equal(t, instanceID, instance.ID)
 	assert.Equal(t, "test-instance", instance.Name)
 	assert.Equal(t, v1alpha1.ProviderAWS, instance.Provider)
 
 	// Test getting instance with invalid filename
-	_, err = manager.GetInstanceByFilename("invalid")
+	_, err = manager.GetInstanceByFilename(context.Background(), "invalid")
 	assert.Error(t, err)
 }
+
+func TestReadCache(t *testing.T) {
+	tempDir := t.TempDir()
+	manager := NewManager(logger.NewLogger(), tempDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "a1b2c3d4.yaml"), []byte(`apiVersion: holodeck.nvidia.com/v1alpha1
+kind: Environment
+metadata:
+  name: cached
+spec:
+  provider: aws
+status:
+  properties:
+  - name: vpc-id
+    value: vpc-123
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "notes.txt"), []byte("ignored"), 0600))
+
+	cached, err := manager.ReadCache()
+	require.NoError(t, err)
+	require.Len(t, cached, 1)
+	assert.Equal(t, "a1b2c3d4", cached[0].ID)
+	assert.Equal(t, filepath.Join(tempDir, "a1b2c3d4.yaml"), cached[0].CacheFile)
+	assert.Equal(t, "cached", cached[0].Environment.Name)
+	assert.Equal(t, "vpc-123", cached[0].Environment.Status.Properties[0].Value)
+
+	missing := NewManager(logger.NewLogger(), filepath.Join(tempDir, "missing"))
+	cached, err = missing.ReadCache()
+	require.NoError(t, err)
+	assert.Empty(t, cached)
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package vsphere provides the vCenter client used by the holodeck vSphere
+// provider. This package is internal and not intended for external consumption.
+package vsphere
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned when a named inventory object does not exist.
+var ErrNotFound = errors.New("not found")
+
+// CloneSpec describes a VM clone. Inventory objects are referenced by name
+// and resolved by the client; empty placement fields inherit from the
+// template.
+type CloneSpec struct {
+	// Name of the new VM
+	Name string
+	// Template is the name of the VM template to clone
+	Template     string
+	Datacenter   string
+	Cluster      string
+	ResourcePool string
+	Datastore    string
+	Folder       string
+}
+
+// Client defines the vCenter operations used by the vSphere provider.
+// This interface enables dependency injection and facilitates unit testing
+// by allowing fakes to be substituted for the real REST client.
+type Client interface {
+	// FindVM returns the managed object ID of the named VM or template,
+	// or ErrNotFound.
+	FindVM(ctx context.Context, datacenter, name string) (string, error)
+	// CloneVM clones a template and returns the ID of the new VM. The VM
+	// is left powered off.
+	CloneVM(ctx context.Context, spec CloneSpec) (string, error)
+	// PowerOn powers on a VM.
+	PowerOn(ctx context.Context, vmID string) error
+	// PowerOff powers off a VM. Powering off a stopped VM is not an error.
+	PowerOff(ctx context.Context, vmID string) error
+	// GuestIP returns the primary IP address reported by VMware Tools, or
+	// an empty string while the guest has not reported one yet.
+	GuestIP(ctx context.Context, vmID string) (string, error)
+	// DeleteVM deletes a powered off VM. Deleting a missing VM returns
+	// ErrNotFound.
+	DeleteVM(ctx context.Context, vmID string) error
+}
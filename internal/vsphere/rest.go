@@ -0,0 +1,289 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vsphere
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// sessionHeader carries the vCenter API session token
+	sessionHeader = "vmware-api-session-id"
+	// requestTimeout bounds a single vCenter API call
+	requestTimeout = 2 * time.Minute
+)
+
+// RESTClient implements Client against the vSphere Automation REST API
+// (vCenter 7.0 U2 and later). A session is created lazily on first use.
+type RESTClient struct {
+	server   *url.URL
+	username string
+	password string
+	http     *http.Client
+
+	mu      sync.Mutex
+	session string
+}
+
+// Compile-time assertion that RESTClient implements Client.
+var _ Client = (*RESTClient)(nil)
+
+// NewRESTClient returns a client for the vCenter at server, which may be a
+// host name or a URL.
+func NewRESTClient(server, username, password string, insecureSkipVerify bool) (*RESTClient, error) {
+	if server == "" {
+		return nil, fmt.Errorf("vcenter server is required")
+	}
+	if !strings.Contains(server, "://") {
+		server = "https://" + server
+	}
+	u, err := url.Parse(server)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vcenter server %q: %w", server, err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // G402: opt-in via vsphere.insecureSkipVerify
+	}
+
+	return &RESTClient{
+		server:   u,
+		username: username,
+		password: password,
+		http:     &http.Client{Transport: transport, Timeout: requestTimeout},
+	}, nil
+}
+
+// apiError is a non-2xx response from vCenter.
+type apiError struct {
+	status int
+	body   string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("vcenter returned %d: %s", e.status, e.body)
+}
+
+// login creates an API session if there is none.
+func (c *RESTClient) login(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.session != "" {
+		return c.session, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.server.JoinPath("/api/session").String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to log in to vcenter: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return "", fmt.Errorf("failed to log in to vcenter: %w", &apiError{status: resp.StatusCode, body: string(body)})
+	}
+	if err := json.Unmarshal(body, &c.session); err != nil {
+		return "", fmt.Errorf("failed to decode vcenter session: %w", err)
+	}
+	return c.session, nil
+}
+
+// do performs an authenticated API call, decoding the response into out
+// when it is non-nil.
+func (c *RESTClient) do(ctx context.Context, method, path string, query url.Values, in, out any) error {
+	session, err := c.login(ctx)
+	if err != nil {
+		return err
+	}
+
+	u := c.server.JoinPath(path)
+	u.RawQuery = query.Encode()
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(sessionHeader, session)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, _ := io.ReadAll(resp.Body)
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode/100 != 2:
+		return &apiError{status: resp.StatusCode, body: string(data)}
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("failed to decode %s response: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// resolve returns the ID of the named inventory object of the given kind
+// ("datacenter", "cluster", "resource-pool", "datastore", "folder" or "vm").
+func (c *RESTClient) resolve(ctx context.Context, kind, name string, filter url.Values) (string, error) {
+	query := url.Values{"names": {name}}
+	for k, v := range filter {
+		query[k] = v
+	}
+	var summaries []map[string]any
+	if err := c.do(ctx, http.MethodGet, "/api/vcenter/"+kind, query, nil, &summaries); err != nil {
+		return "", fmt.Errorf("failed to look up %s %q: %w", kind, name, err)
+	}
+	if len(summaries) == 0 {
+		return "", fmt.Errorf("%s %q: %w", kind, name, ErrNotFound)
+	}
+	// Summaries key their ID by the kind, e.g. {"resource_pool": "resgroup-8"}
+	id, _ := summaries[0][strings.ReplaceAll(kind, "-", "_")].(string)
+	if id == "" {
+		return "", fmt.Errorf("%s %q: vcenter returned no identifier", kind, name)
+	}
+	return id, nil
+}
+
+// FindVM returns the ID of the named VM or template in datacenter.
+func (c *RESTClient) FindVM(ctx context.Context, datacenter, name string) (string, error) {
+	dc, err := c.resolve(ctx, "datacenter", datacenter, nil)
+	if err != nil {
+		return "", err
+	}
+	return c.resolve(ctx, "vm", name, url.Values{"datacenters": {dc}})
+}
+
+// CloneVM clones spec.Template into a new, powered off VM.
+func (c *RESTClient) CloneVM(ctx context.Context, spec CloneSpec) (string, error) {
+	dc, err := c.resolve(ctx, "datacenter", spec.Datacenter, nil)
+	if err != nil {
+		return "", err
+	}
+	inDC := url.Values{"datacenters": {dc}}
+
+	source, err := c.resolve(ctx, "vm", spec.Template, inDC)
+	if err != nil {
+		return "", err
+	}
+
+	placement := map[string]string{}
+	for _, p := range []struct{ kind, field, name string }{
+		{"cluster", "cluster", spec.Cluster},
+		{"resource-pool", "resource_pool", spec.ResourcePool},
+		{"datastore", "datastore", spec.Datastore},
+		{"folder", "folder", spec.Folder},
+	} {
+		if p.name == "" {
+			continue
+		}
+		id, err := c.resolve(ctx, p.kind, p.name, inDC)
+		if err != nil {
+			return "", err
+		}
+		placement[p.field] = id
+	}
+
+	req := map[string]any{
+		"name":     spec.Name,
+		"source":   source,
+		"power_on": false,
+	}
+	if len(placement) > 0 {
+		req["placement"] = placement
+	}
+
+	var vmID string
+	if err := c.do(ctx, http.MethodPost, "/api/vcenter/vm", url.Values{"action": {"clone"}}, req, &vmID); err != nil {
+		return "", fmt.Errorf("failed to clone %q: %w", spec.Template, err)
+	}
+	return vmID, nil
+}
+
+// PowerOn powers on a VM.
+func (c *RESTClient) PowerOn(ctx context.Context, vmID string) error {
+	return c.power(ctx, vmID, "start", "POWERED_ON")
+}
+
+// PowerOff powers off a VM.
+func (c *RESTClient) PowerOff(ctx context.Context, vmID string) error {
+	return c.power(ctx, vmID, "stop", "POWERED_OFF")
+}
+
+func (c *RESTClient) power(ctx context.Context, vmID, action, want string) error {
+	path := "/api/vcenter/vm/" + url.PathEscape(vmID) + "/power"
+	var state struct {
+		State string `json:"state"`
+	}
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &state); err != nil {
+		return err
+	}
+	if state.State == want {
+		return nil
+	}
+	return c.do(ctx, http.MethodPost, path, url.Values{"action": {action}}, nil, nil)
+}
+
+// GuestIP returns the IP address reported by VMware Tools.
+func (c *RESTClient) GuestIP(ctx context.Context, vmID string) (string, error) {
+	var identity struct {
+		IPAddress string `json:"ip_address"`
+	}
+	err := c.do(ctx, http.MethodGet, "/api/vcenter/vm/"+url.PathEscape(vmID)+"/guest/identity", nil, nil, &identity)
+	if err != nil {
+		// VMware Tools not running yet
+		var apiErr *apiError
+		if errors.As(err, &apiErr) && apiErr.status == http.StatusServiceUnavailable {
+			return "", nil
+		}
+		return "", err
+	}
+	return identity.IPAddress, nil
+}
+
+// DeleteVM deletes a VM.
+func (c *RESTClient) DeleteVM(ctx context.Context, vmID string) error {
+	return c.do(ctx, http.MethodDelete, "/api/vcenter/vm/"+url.PathEscape(vmID), nil, nil, nil)
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vsphere
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVCenter serves the subset of the vSphere Automation API used by
+// RESTClient.
+func fakeVCenter(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	reply := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	authed := func(h http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get(sessionHeader) != "token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			h(w, r)
+		}
+	}
+
+	mux.HandleFunc("POST /api/session", func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != "admin" || p != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		reply(w, "token")
+	})
+	mux.HandleFunc("GET /api/vcenter/datacenter", authed(func(w http.ResponseWriter, r *http.Request) {
+		reply(w, []map[string]string{{"datacenter": "datacenter-2", "name": r.URL.Query().Get("names")}})
+	}))
+	mux.HandleFunc("GET /api/vcenter/resource-pool", authed(func(w http.ResponseWriter, r *http.Request) {
+		reply(w, []map[string]string{{"resource_pool": "resgroup-8"}})
+	}))
+	mux.HandleFunc("GET /api/vcenter/vm", authed(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("names") != "template" || r.URL.Query().Get("datacenters") != "datacenter-2" {
+			reply(w, []map[string]string{})
+			return
+		}
+		reply(w, []map[string]string{{"vm": "vm-42"}})
+	}))
+	mux.HandleFunc("POST /api/vcenter/vm", authed(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Source    string            `json:"source"`
+			Placement map[string]string `json:"placement"`
+		}
+		if r.URL.Query().Get("action") != "clone" || json.NewDecoder(r.Body).Decode(&req) != nil ||
+			req.Source != "vm-42" || req.Placement["resource_pool"] != "resgroup-8" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		reply(w, "vm-101")
+	}))
+	mux.HandleFunc("GET /api/vcenter/vm/vm-101/guest/identity", authed(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	mux.HandleFunc("DELETE /api/vcenter/vm/vm-404", authed(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	return httptest.NewTLSServer(mux)
+}
+
+func TestRESTClient(t *testing.T) {
+	srv := fakeVCenter(t)
+	defer srv.Close()
+
+	c, err := NewRESTClient(srv.URL, "admin", "secret", true)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	id, err := c.FindVM(ctx, "dc1", "template")
+	require.NoError(t, err)
+	assert.Equal(t, "vm-42", id)
+
+	_, err = c.FindVM(ctx, "dc1", "missing")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	vmID, err := c.CloneVM(ctx, CloneSpec{Name: "vm", Template: "template", Datacenter: "dc1", ResourcePool: "pool"})
+	require.NoError(t, err)
+	assert.Equal(t, "vm-101", vmID)
+
+	ip, err := c.GuestIP(ctx, vmID)
+	require.NoError(t, err, "tools not running is not an error")
+	assert.Empty(t, ip)
+
+	assert.True(t, errors.Is(c.DeleteVM(ctx, "vm-404"), ErrNotFound))
+}
+
+func TestRESTClientLoginFailure(t *testing.T) {
+	srv := fakeVCenter(t)
+	defer srv.Close()
+
+	c, err := NewRESTClient(srv.URL, "admin", "wrong", true)
+	require.NoError(t, err)
+	_, err = c.FindVM(context.Background(), "dc1", "template")
+	assert.ErrorContains(t, err, "failed to log in to vcenter")
+}
@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package vspherefake provides a stateful, in-memory fake of the vCenter
+// client used by the holodeck vSphere provider. It implements the
+// internal/vsphere.Client interface so it can be injected via the provider's
+// WithClient option, letting the real create/delete code run credential-free
+// against an observable inventory.
+package vspherefake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/holodeck/internal/vsphere"
+)
+
+// VM is a fake virtual machine or template.
+type VM struct {
+	ID         string
+	Name       string
+	Datacenter string
+	Template   bool
+	PoweredOn  bool
+	IP         string
+}
+
+// Fake is the in-memory vCenter. All access is guarded by mu; the client
+// methods lock it at entry, so the unexported helpers assume it is held.
+type Fake struct {
+	mu sync.Mutex
+
+	// VMs keyed by ID
+	VMs map[string]*VM
+
+	// ipPolls holds, per VM ID, the remaining GuestIP observations that
+	// report no address after power-on.
+	ipPolls     map[string]int
+	nextIPPolls int
+
+	calls    map[string]int
+	failures map[string][]error
+	counter  int
+}
+
+// Compile-time assertion that Fake implements vsphere.Client.
+var _ vsphere.Client = (*Fake)(nil)
+
+// New returns an empty fake inventory.
+func New() *Fake {
+	return &Fake{
+		VMs:      map[string]*VM{},
+		ipPolls:  map[string]int{},
+		calls:    map[string]int{},
+		failures: map[string][]error{},
+	}
+}
+
+// SeedTemplate adds a VM template to datacenter and returns its ID.
+func (f *Fake) SeedTemplate(datacenter, name string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := f.nextID()
+	f.VMs[id] = &VM{ID: id, Name: name, Datacenter: datacenter, Template: true}
+	return id
+}
+
+// SeedIPDelay makes VMs powered on from now on report no guest IP for the
+// first polls GuestIP observations, driving the provider's wait loop.
+func (f *Fake) SeedIPDelay(polls int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextIPPolls = polls
+}
+
+// FailNext queues a one-shot error for the next call to method (e.g.
+// "CloneVM"). Multiple calls queue FIFO.
+func (f *Fake) FailNext(method string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures[method] = append(f.failures[method], err)
+}
+
+// CallsTo returns how many times method has been invoked on the fake.
+func (f *Fake) CallsTo(method string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[method]
+}
+
+// VMCount returns the number of non-template VMs in the inventory.
+func (f *Fake) VMCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, vm := range f.VMs {
+		if !vm.Template {
+			n++
+		}
+	}
+	return n
+}
+
+// nextID returns a unique, deterministic managed object ID.
+// Callers must hold mu.
+func (f *Fake) nextID() string {
+	f.counter++
+	return fmt.Sprintf("vm-fake%04d", f.counter)
+}
+
+// begin records a call to method and pops its next injected error.
+// Callers must hold mu.
+func (f *Fake) begin(method string) error {
+	f.calls[method]++
+	q := f.failures[method]
+	if len(q) == 0 {
+		return nil
+	}
+	f.failures[method] = q[1:]
+	return q[0]
+}
+
+// FindVM implements vsphere.Client.
+func (f *Fake) FindVM(_ context.Context, datacenter, name string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("FindVM"); err != nil {
+		return "", err
+	}
+	for _, vm := range f.VMs {
+		if vm.Name == name && vm.Datacenter == datacenter {
+			return vm.ID, nil
+		}
+	}
+	return "", fmt.Errorf("vm %q: %w", name, vsphere.ErrNotFound)
+}
+
+// CloneVM implements vsphere.Client.
+func (f *Fake) CloneVM(_ context.Context, spec vsphere.CloneSpec) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("CloneVM"); err != nil {
+		return "", err
+	}
+	var source *VM
+	for _, vm := range f.VMs {
+		if vm.Name == spec.Template && vm.Datacenter == spec.Datacenter {
+			source = vm
+		}
+		if vm.Name == spec.Name && vm.Datacenter == spec.Datacenter {
+			return "", fmt.Errorf("vm %q already exists", spec.Name)
+		}
+	}
+	if source == nil {
+		return "", fmt.Errorf("vm %q: %w", spec.Template, vsphere.ErrNotFound)
+	}
+	id := f.nextID()
+	f.VMs[id] = &VM{ID: id, Name: spec.Name, Datacenter: spec.Datacenter}
+	return id, nil
+}
+
+// PowerOn implements vsphere.Client. Powered on VMs get a deterministic
+// guest IP once any seeded IP delay has elapsed.
+func (f *Fake) PowerOn(_ context.Context, vmID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("PowerOn"); err != nil {
+		return err
+	}
+	vm, ok := f.VMs[vmID]
+	if !ok {
+		return vsphere.ErrNotFound
+	}
+	vm.PoweredOn = true
+	vm.IP = fmt.Sprintf("192.0.2.%d", len(f.VMs))
+	f.ipPolls[vmID] = f.nextIPPolls
+	return nil
+}
+
+// PowerOff implements vsphere.Client.
+func (f *Fake) PowerOff(_ context.Context, vmID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("PowerOff"); err != nil {
+		return err
+	}
+	vm, ok := f.VMs[vmID]
+	if !ok {
+		return vsphere.ErrNotFound
+	}
+	vm.PoweredOn = false
+	return nil
+}
+
+// GuestIP implements vsphere.Client.
+func (f *Fake) GuestIP(_ context.Context, vmID string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("GuestIP"); err != nil {
+		return "", err
+	}
+	vm, ok := f.VMs[vmID]
+	if !ok {
+		return "", vsphere.ErrNotFound
+	}
+	if !vm.PoweredOn {
+		return "", nil
+	}
+	if f.ipPolls[vmID] > 0 {
+		f.ipPolls[vmID]--
+		return "", nil
+	}
+	return vm.IP, nil
+}
+
+// DeleteVM implements vsphere.Client.
+func (f *Fake) DeleteVM(_ context.Context, vmID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.begin("DeleteVM"); err != nil {
+		return err
+	}
+	vm, ok := f.VMs[vmID]
+	if !ok {
+		return vsphere.ErrNotFound
+	}
+	if vm.PoweredOn {
+		return fmt.Errorf("vm %q is powered on", vm.Name)
+	}
+	delete(f.VMs, vmID)
+	return nil
+}
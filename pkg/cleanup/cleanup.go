@@ -50,6 +50,10 @@ const (
 	DefaultInstanceTerminationTimeout = 10 * time.Minute
 	// DefaultVPCDeleteRetryDelay is the delay between VPC deletion retries
 	DefaultVPCDeleteRetryDelay = 30 * time.Second
+	// DefaultNATGatewayDeleteTimeout is the timeout for waiting for NAT gateways to be deleted
+	DefaultNATGatewayDeleteTimeout = 3 * time.Minute
+	// DefaultNATGatewayPollInterval is the delay between NAT gateway state checks
+	DefaultNATGatewayPollInterval = 5 * time.Second
 )
 
 // safeString safely dereferences a string pointer, returning "<nil>" if the pointer is nil
@@ -62,9 +66,11 @@ func safeString(s *string) string {
 
 // Cleaner handles cleanup of AWS resources
 type Cleaner struct {
-	ec2   internalaws.EC2Client
-	elbv2 internalaws.ELBv2Client
-	log   *logger.FunLogger
+	ec2    internalaws.EC2Client
+	elbv2  internalaws.ELBv2Client
+	log    *logger.FunLogger
+	region string
+	sleep  func(time.Duration)
 }
 
 // CleanerOption is a functional option for configuring the Cleaner.
@@ -86,13 +92,23 @@ func WithELBv2Client(client internalaws.ELBv2Client) CleanerOption {
 	}
 }
 
+// WithSleep sets the function used to wait between polls of NAT gateway and
+// ENI state. This is primarily used for testing to avoid real delays.
+func WithSleep(sleep func(time.Duration)) CleanerOption {
+	return func(c *Cleaner) {
+		c.sleep = sleep
+	}
+}
+
 // New creates a new AWS resource cleaner.
 // Optional functional options can be provided to customize the cleaner,
 // such as injecting a mock EC2 client for testing.
 func New(log *logger.FunLogger, region string,
 	opts ...CleanerOption) (*Cleaner, error) {
 	c := &Cleaner{
-		log: log,
+		log:    log,
+		region: region,
+		sleep:  time.Sleep,
 	}
 
 	// Apply functional options
@@ -274,6 +290,29 @@ func (c *Cleaner) DeleteVPCResources(ctx context.Context, vpcID string) error {
 		return fmt.Errorf("cleanup cancelled after instance deletion: %w", err)
 	}
 
+	// Delete NAT gateways, then release their Elastic IPs
+	allocationIDs, err := c.deleteNATGateways(ctx, vpcID)
+	if err != nil {
+		return fmt.Errorf("failed to delete NAT gateways: %w", err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("cleanup cancelled after NAT gateway deletion: %w", err)
+	}
+
+	c.releaseElasticIPs(ctx, vpcID, allocationIDs)
+
+	// Wait for ENIs left by instances, load balancers and NAT gateways to
+	// detach; in-use ENIs block security group and subnet deletion
+	eniFilter := types.Filter{Name: aws.String("vpc-id"), Values: []string{vpcID}}
+	if err := internalaws.WaitForENIsDrained(ctx, c.ec2, c.log, c.sleep, eniFilter, "VPC "+vpcID); err != nil {
+		c.log.Warning("Failed waiting for ENIs to drain: %v", err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("cleanup cancelled after ENI drain: %w", err)
+	}
+
 	// Delete security groups
 	if err := c.deleteSecurityGroups(ctx, vpcID); err != nil {
 		return fmt.Errorf("failed to delete security groups: %w", err)
@@ -351,12 +390,18 @@ func (c *Cleaner) deleteInstances(ctx context.Context, vpcID string) error {
 		return nil
 	}
 
+	return c.TerminateInstances(ctx, instanceIDs)
+}
+
+// TerminateInstances terminates the given instances and waits for them to
+// reach the terminated state.
+func (c *Cleaner) TerminateInstances(ctx context.Context, instanceIDs []string) error {
 	// Terminate instances
 	terminateInput := &ec2.TerminateInstancesInput{
 		InstanceIds: instanceIDs,
 	}
 
-	_, err = c.ec2.TerminateInstances(ctx, terminateInput)
+	_, err := c.ec2.TerminateInstances(ctx, terminateInput)
 	if err != nil {
 		return fmt.Errorf("failed to terminate instances: %w", err)
 	}
@@ -375,6 +420,203 @@ func (c *Cleaner) deleteInstances(ctx context.Context, vpcID string) error {
 	return nil
 }
 
+// deleteNATGateways deletes every NAT gateway in the VPC and waits for them to
+// reach the deleted state. It returns the allocation IDs of the Elastic IPs
+// the gateways held so they can be released once the gateways are gone.
+func (c *Cleaner) deleteNATGateways(ctx context.Context, vpcID string) ([]string, error) {
+	input := &ec2.DescribeNatGatewaysInput{
+		Filter: []types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{vpcID},
+			},
+			{
+				Name:   aws.String("state"),
+				Values: []string{"pending", "available", "deleting", "failed"},
+			},
+		},
+	}
+
+	result, err := c.ec2.DescribeNatGateways(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe NAT gateways: %w", err)
+	}
+
+	var allocationIDs, natIDs []string
+	for _, nat := range result.NatGateways {
+		for _, addr := range nat.NatGatewayAddresses {
+			if addr.AllocationId != nil {
+				allocationIDs = append(allocationIDs, *addr.AllocationId)
+			}
+		}
+
+		// Gateways already being deleted only need to be waited on
+		if nat.State != types.NatGatewayStateDeleting {
+			_, err := c.ec2.DeleteNatGateway(ctx, &ec2.DeleteNatGatewayInput{
+				NatGatewayId: nat.NatGatewayId,
+			})
+			if err != nil {
+				if !strings.Contains(err.Error(), "NatGatewayNotFound") {
+					c.log.Warning("Failed to delete NAT gateway %s: %v", safeString(nat.NatGatewayId), err)
+				}
+				continue
+			}
+		}
+		natIDs = append(natIDs, aws.ToString(nat.NatGatewayId))
+	}
+
+	if len(natIDs) == 0 {
+		c.log.Info("No NAT gateways found to delete")
+		return allocationIDs, nil
+	}
+
+	c.log.Info("Deleting %d NAT gateway(s), waiting for deletion", len(natIDs))
+	if err := c.waitForNATGatewaysDeleted(ctx, natIDs); err != nil {
+		return allocationIDs, err
+	}
+
+	c.log.Info("Deleted %d NAT gateway(s)", len(natIDs))
+	return allocationIDs, nil
+}
+
+// waitForNATGatewaysDeleted polls until none of natIDs is still tearing down.
+// NAT gateway deletion is asynchronous, and a gateway's Elastic IP and ENI
+// are only freed once it reaches the deleted state.
+func (c *Cleaner) waitForNATGatewaysDeleted(ctx context.Context, natIDs []string) error {
+	deadline := time.Now().Add(DefaultNATGatewayDeleteTimeout)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("cancelled waiting for NAT gateways to delete: %w", err)
+		}
+
+		result, err := c.ec2.DescribeNatGateways(ctx, &ec2.DescribeNatGatewaysInput{
+			NatGatewayIds: natIDs,
+		})
+		if err != nil {
+			if strings.Contains(err.Error(), "NatGatewayNotFound") {
+				return nil
+			}
+			c.log.Warning("Error checking NAT gateway state: %v", err)
+		} else {
+			var remaining int
+			for _, nat := range result.NatGateways {
+				if nat.State != types.NatGatewayStateDeleted {
+					remaining++
+				}
+			}
+			if remaining == 0 {
+				return nil
+			}
+			c.log.Info("Waiting for %d NAT gateway(s) to finish deleting...", remaining)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for NAT gateways %s to delete", strings.Join(natIDs, ", "))
+		}
+
+		c.sleep(DefaultNATGatewayPollInterval)
+	}
+}
+
+// releaseElasticIPs releases the given Elastic IPs together with any
+// unassociated holodeck-tagged Elastic IP carrying the VPC's Name (and, for
+// CI environments, GitHubRunId) tag. The latter covers addresses allocated
+// for a NAT gateway whose creation failed. Failures are logged, not returned:
+// a leaked Elastic IP does not block VPC deletion.
+func (c *Cleaner) releaseElasticIPs(ctx context.Context, vpcID string, allocationIDs []string) {
+	seen := make(map[string]bool)
+	var toRelease []string
+	for _, id := range allocationIDs {
+		if !seen[id] {
+			seen[id] = true
+			toRelease = append(toRelease, id)
+		}
+	}
+
+	stray, err := c.findStrayElasticIPs(ctx, vpcID)
+	if err != nil {
+		c.log.Warning("Failed to look up Elastic IPs for VPC %s: %v", vpcID, err)
+	}
+	for _, id := range stray {
+		if !seen[id] {
+			seen[id] = true
+			toRelease = append(toRelease, id)
+		}
+	}
+
+	var released int
+	for _, id := range toRelease {
+		_, err := c.ec2.ReleaseAddress(ctx, &ec2.ReleaseAddressInput{
+			AllocationId: aws.String(id),
+		})
+		if err != nil {
+			if !strings.Contains(err.Error(), "InvalidAllocationID.NotFound") {
+				c.log.Warning("Failed to release Elastic IP %s: %v", id, err)
+			}
+			continue
+		}
+		released++
+	}
+
+	c.log.Info("Released %d Elastic IP(s)", released)
+}
+
+// findStrayElasticIPs returns the allocation IDs of unassociated Elastic IPs
+// tagged as belonging to the same holodeck environment as the VPC.
+func (c *Cleaner) findStrayElasticIPs(ctx context.Context, vpcID string) ([]string, error) {
+	vpcs, err := c.ec2.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+		VpcIds: []string{vpcID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe VPC: %w", err)
+	}
+	if len(vpcs.Vpcs) == 0 {
+		return nil, nil
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range vpcs.Vpcs[0].Tags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	if tags["Project"] != "holodeck" || tags["Name"] == "" {
+		return nil, nil
+	}
+
+	filters := []types.Filter{
+		{
+			Name:   aws.String("tag:Project"),
+			Values: []string{"holodeck"},
+		},
+		{
+			Name:   aws.String("tag:Name"),
+			Values: []string{tags["Name"]},
+		},
+	}
+	if runID := tags["GitHubRunId"]; runID != "" {
+		filters = append(filters, types.Filter{
+			Name:   aws.String("tag:GitHubRunId"),
+			Values: []string{runID},
+		})
+	}
+
+	result, err := c.ec2.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+		Filters: filters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe Elastic IPs: %w", err)
+	}
+
+	var ids []string
+	for _, addr := range result.Addresses {
+		if addr.AllocationId == nil || addr.AssociationId != nil {
+			continue
+		}
+		ids = append(ids, *addr.AllocationId)
+	}
+	return ids, nil
+}
+
 func (c *Cleaner) deleteSecurityGroups(ctx context.Context, vpcID string) error {
 	// Describe security groups
 	input := &ec2.DescribeSecurityGroupsInput{
@@ -732,16 +974,47 @@ func (c *Cleaner) deleteLoadBalancers(ctx context.Context, vpcID string) error {
 	}
 
 	if count > 0 {
-		c.log.Info("Deleted %d load balancer(s), waiting for ENIs to detach", count)
-		// NLBs take time to fully decommission and release ENIs.
-		// Wait briefly to allow ENI cleanup before subnet deletion.
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("cancelled waiting for LB decommission: %w", ctx.Err())
-		case <-time.After(30 * time.Second):
+		// NLBs take time to fully decommission and release ENIs; the
+		// VPC-wide ENI drain in DeleteVPCResources waits for them.
+		c.log.Info("Deleted %d load balancer(s)", count)
+	}
+
+	// A target group left behind by a failed create, before its listener
+	// attached it to a load balancer, is only found through its VPC
+	if err := c.deleteVPCTargetGroups(ctx, vpcID); err != nil {
+		c.log.Warning("Failed to delete target groups: %v", err)
+	}
+
+	return nil
+}
+
+func (c *Cleaner) deleteVPCTargetGroups(ctx context.Context, vpcID string) error {
+	// Target groups cannot be filtered by VPC, so every page of the
+	// region is scanned. The pages are collected first, so that deleting
+	// does not shift the markers of the pages still to come.
+	var owned []*string
+	pages := elasticloadbalancingv2.NewDescribeTargetGroupsPaginator(c.elbv2, &elasticloadbalancingv2.DescribeTargetGroupsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to describe target groups: %w", err)
+		}
+		for _, tg := range page.TargetGroups {
+			if aws.ToString(tg.VpcId) == vpcID {
+				owned = append(owned, tg.TargetGroupArn)
+			}
 		}
 	}
 
+	for _, arn := range owned {
+		c.log.Info("Deleting target group %s", safeString(arn))
+		_, err := c.elbv2.DeleteTargetGroup(ctx, &elasticloadbalancingv2.DeleteTargetGroupInput{
+			TargetGroupArn: arn,
+		})
+		if err != nil {
+			c.log.Warning("Failed to delete target group %s: %v", safeString(arn), err)
+		}
+	}
 	return nil
 }
 
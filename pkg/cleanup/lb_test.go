@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cleanup
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/NVIDIA/holodeck/internal/aws/awsfake"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+var _ = Describe("Load balancer cleanup", func() {
+	var (
+		ctx     context.Context
+		buf     bytes.Buffer
+		fake    *awsfake.Fake
+		cleaner *Cleaner
+		vpcID   string
+		subnets []string
+	)
+
+	targetGroup := func(name string) string {
+		out, err := fake.ELBv2.CreateTargetGroup(ctx, &elasticloadbalancingv2.CreateTargetGroupInput{
+			Name:     aws.String(name),
+			Protocol: elbv2types.ProtocolEnumHttps,
+			Port:     aws.Int32(6443),
+			VpcId:    aws.String(vpcID),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return aws.ToString(out.TargetGroups[0].TargetGroupArn)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		buf.Reset()
+		log := logger.NewLogger()
+		log.Out = &buf
+		fake = awsfake.New()
+
+		var err error
+		cleaner, err = New(log, "us-west-2",
+			WithEC2Client(fake.EC2),
+			WithELBv2Client(fake.ELBv2),
+			WithSleep(func(time.Duration) {}))
+		Expect(err).NotTo(HaveOccurred())
+
+		vpc, err := fake.EC2.CreateVpc(ctx, &ec2.CreateVpcInput{
+			CidrBlock:         aws.String("10.0.0.0/16"),
+			TagSpecifications: holodeckTags("test-env"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		vpcID = aws.ToString(vpc.Vpc.VpcId)
+
+		// The private and public subnets of a cluster
+		subnets = nil
+		for _, cidr := range []string{"10.0.0.0/24", "10.0.1.0/24"} {
+			sn, err := fake.EC2.CreateSubnet(ctx, &ec2.CreateSubnetInput{
+				VpcId:            aws.String(vpcID),
+				CidrBlock:        aws.String(cidr),
+				AvailabilityZone: aws.String("us-west-2a"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			subnets = append(subnets, aws.ToString(sn.Subnet.SubnetId))
+		}
+	})
+
+	It("should delete an internal NLB in the private subnet with its listener and target group", func() {
+		lb, err := fake.ELBv2.CreateLoadBalancer(ctx, &elasticloadbalancingv2.CreateLoadBalancerInput{
+			Name:    aws.String("test-env-nlb"),
+			Type:    elbv2types.LoadBalancerTypeEnumNetwork,
+			Scheme:  elbv2types.LoadBalancerSchemeEnumInternal,
+			Subnets: subnets[:1],
+		})
+		Expect(err).NotTo(HaveOccurred())
+		tgArn := targetGroup("test-env-k8s-tg")
+		_, err = fake.ELBv2.CreateListener(ctx, &elasticloadbalancingv2.CreateListenerInput{
+			LoadBalancerArn: lb.LoadBalancers[0].LoadBalancerArn,
+			Protocol:        elbv2types.ProtocolEnumTcp,
+			Port:            aws.Int32(6443),
+			DefaultActions: []elbv2types.Action{{
+				Type:           elbv2types.ActionTypeEnumForward,
+				TargetGroupArn: aws.String(tgArn),
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(cleaner.DeleteVPCResources(ctx, vpcID)).To(Succeed())
+
+		counts := fake.Store.ResourceCounts()
+		Expect(counts["loadbalancers"]).To(Equal(0))
+		Expect(counts["listeners"]).To(Equal(0))
+		Expect(counts["targetgroups"]).To(Equal(0))
+		Expect(counts["subnets"]).To(Equal(0))
+		Expect(counts["vpcs"]).To(Equal(0))
+	})
+
+	It("should delete target groups no load balancer references", func() {
+		orphan := targetGroup("test-env-k8s-tg")
+
+		// A target group of another VPC is left alone
+		other, err := fake.ELBv2.CreateTargetGroup(ctx, &elasticloadbalancingv2.CreateTargetGroupInput{
+			Name:  aws.String("other-env-k8s-tg"),
+			VpcId: aws.String("vpc-other"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(cleaner.DeleteVPCResources(ctx, vpcID)).To(Succeed())
+
+		Expect(fake.Store.TargetGroups).NotTo(HaveKey(orphan))
+		Expect(fake.Store.TargetGroups).To(HaveKey(aws.ToString(other.TargetGroups[0].TargetGroupArn)))
+	})
+
+	It("should find orphaned target groups beyond the first page", func() {
+		fake.Store.TargetGroupPageSize = 2
+		for _, name := range []string{"other-a", "other-b", "other-c", "other-d"} {
+			_, err := fake.ELBv2.CreateTargetGroup(ctx, &elasticloadbalancingv2.CreateTargetGroupInput{
+				Name:  aws.String(name),
+				VpcId: aws.String("vpc-other"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		// Created last, so its ARN sorts onto the last page
+		orphan := targetGroup("test-env-k8s-tg")
+
+		Expect(cleaner.DeleteVPCResources(ctx, vpcID)).To(Succeed())
+
+		Expect(fake.Store.CallsTo("DescribeTargetGroups")).To(BeNumerically(">=", 3))
+		Expect(fake.Store.TargetGroups).NotTo(HaveKey(orphan))
+		Expect(fake.Store.TargetGroups).To(HaveLen(4))
+	})
+})
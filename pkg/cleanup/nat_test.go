@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cleanup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/NVIDIA/holodeck/internal/aws/awsfake"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+func holodeckTags(name string) []types.TagSpecification {
+	return []types.TagSpecification{{
+		Tags: []types.Tag{
+			{Key: aws.String("Project"), Value: aws.String("holodeck")},
+			{Key: aws.String("Name"), Value: aws.String(name)},
+		},
+	}}
+}
+
+var _ = Describe("NAT gateway and Elastic IP cleanup", func() {
+	var (
+		ctx     context.Context
+		buf     bytes.Buffer
+		fake    *awsfake.Fake
+		cleaner *Cleaner
+		sleeps  int
+		vpcID   string
+	)
+
+	// allocate allocates an Elastic IP tagged as belonging to name.
+	allocate := func(name string) string {
+		out, err := fake.EC2.AllocateAddress(ctx, &ec2.AllocateAddressInput{
+			Domain:            types.DomainTypeVpc,
+			TagSpecifications: holodeckTags(name),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return aws.ToString(out.AllocationId)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		buf.Reset()
+		log := logger.NewLogger()
+		log.Out = &buf
+		fake = awsfake.New()
+		sleeps = 0
+
+		var err error
+		cleaner, err = New(log, "us-west-2",
+			WithEC2Client(fake.EC2),
+			WithELBv2Client(fake.ELBv2),
+			WithSleep(func(time.Duration) { sleeps++ }))
+		Expect(err).NotTo(HaveOccurred())
+
+		vpc, err := fake.EC2.CreateVpc(ctx, &ec2.CreateVpcInput{
+			CidrBlock:         aws.String("10.0.0.0/16"),
+			TagSpecifications: holodeckTags("test-env"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		vpcID = aws.ToString(vpc.Vpc.VpcId)
+	})
+
+	It("should delete NAT gateways and release their Elastic IPs", func() {
+		subnet, err := fake.EC2.CreateSubnet(ctx, &ec2.CreateSubnetInput{
+			VpcId:     aws.String(vpcID),
+			CidrBlock: aws.String("10.0.0.0/24"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		allocID := allocate("test-env")
+		_, err = fake.EC2.CreateNatGateway(ctx, &ec2.CreateNatGatewayInput{
+			SubnetId:     subnet.Subnet.SubnetId,
+			AllocationId: aws.String(allocID),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		fake.Store.SeedNextNatGatewayDeleteState(2)
+
+		Expect(cleaner.DeleteVPCResources(ctx, vpcID)).To(Succeed())
+
+		counts := fake.Store.ResourceCounts()
+		Expect(counts["natgateways"]).To(Equal(0))
+		Expect(counts["addresses"]).To(Equal(0))
+		Expect(counts["vpcs"]).To(Equal(0))
+		Expect(fake.Store.CallsTo("DeleteNatGateway")).To(Equal(1))
+		Expect(sleeps).To(BeNumerically(">=", 2))
+	})
+
+	It("should release stray Elastic IPs tagged for the environment only", func() {
+		stray := allocate("test-env")
+		other := allocate("other-env")
+
+		Expect(cleaner.DeleteVPCResources(ctx, vpcID)).To(Succeed())
+
+		Expect(fake.Store.Addresses).NotTo(HaveKey(stray))
+		Expect(fake.Store.Addresses).To(HaveKey(other))
+	})
+
+	It("should wait for in-use ENIs to drain before deleting the VPC", func() {
+		eniID := fake.Store.SeedDrainingENI(vpcID, 2)
+
+		Expect(cleaner.DeleteVPCResources(ctx, vpcID)).To(Succeed())
+
+		Expect(fake.Store.NetworkInterfaces).NotTo(HaveKey(eniID))
+		Expect(sleeps).To(Equal(2))
+		Expect(fake.Store.ResourceCounts()["vpcs"]).To(Equal(0))
+	})
+
+	It("should not touch NAT gateways or Elastic IPs when there are none", func() {
+		Expect(cleaner.DeleteVPCResources(ctx, vpcID)).To(Succeed())
+
+		Expect(fake.Store.CallsTo("DeleteNatGateway")).To(Equal(0))
+		Expect(fake.Store.CallsTo("ReleaseAddress")).To(Equal(0))
+		Expect(sleeps).To(Equal(0))
+	})
+
+	It("should fail before deleting the VPC when NAT gateways cannot be listed", func() {
+		fake.Store.FailNext("DescribeNatGateways", errors.New("UnauthorizedOperation"))
+
+		err := cleaner.DeleteVPCResources(ctx, vpcID)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("failed to delete NAT gateways"))
+		Expect(fake.Store.CallsTo("DeleteVpc")).To(Equal(0))
+	})
+})
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	internalaws "github.com/NVIDIA/holodeck/internal/aws"
+)
+
+// Owners of a holodeck VPC, as reported by FindOrphans.
+const (
+	// OwnerLocalCache means a local cache file references the VPC.
+	OwnerLocalCache = "local-cache"
+	// OwnerTTL means the VPC has an ExpiresAt tag that has not passed yet;
+	// "holodeck reap" deletes it once it expires.
+	OwnerTTL = "ttl"
+	// OwnerGitHubRun means the GitHub Actions run that created the VPC is
+	// still in progress.
+	OwnerGitHubRun = "github-run"
+	// OwnerUnknown means the owner could not be determined; the VPC is kept.
+	OwnerUnknown = "unknown"
+	// OwnerNone means nothing owns the VPC any more, because its ttl has
+	// expired or its GitHub run has completed: it is an orphan.
+	OwnerNone = "none"
+)
+
+// HolodeckVPC is a VPC carrying holodeck tags and what owns it.
+type HolodeckVPC struct {
+	Region     string
+	VpcID      string
+	Name       string
+	Repository string
+	RunID      string
+	ExpiresAt  string
+	Owner      string
+	Reason     string
+}
+
+// Orphaned reports whether nothing owns the VPC.
+func (v HolodeckVPC) Orphaned() bool {
+	return v.Owner == OwnerNone
+}
+
+// FindOrphans lists the holodeck VPCs in the cleaner's region and works out
+// who owns each one. localOwners maps the VPC IDs referenced by local cache
+// files to the owning instance ID. A VPC that is not in localOwners is owned
+// by its ttl until its ExpiresAt tag passes, and otherwise by the GitHub
+// Actions run recorded in its tags while that run is in progress. Only a
+// passed ExpiresAt tag or a completed run make the VPC an orphan. Without
+// either, or when the run cannot be checked because GITHUB_TOKEN is not set
+// or the check fails, the owner is unknown and the VPC is kept.
+func (c *Cleaner) FindOrphans(ctx context.Context, localOwners map[string]string) ([]HolodeckVPC, error) {
+	token := os.Getenv("GITHUB_TOKEN")
+
+	var vpcs []HolodeckVPC
+	pages := ec2.NewDescribeVpcsPaginator(c.ec2, &ec2.DescribeVpcsInput{
+		Filters: []types.Filter{
+			{Name: aws.String("tag:Project"), Values: []string{"holodeck"}},
+		},
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe vpcs: %w", err)
+		}
+		for _, vpc := range page.Vpcs {
+			v := HolodeckVPC{Region: c.region, VpcID: aws.ToString(vpc.VpcId)}
+			for _, tag := range vpc.Tags {
+				switch aws.ToString(tag.Key) {
+				case "Name":
+					v.Name = aws.ToString(tag.Value)
+				case "GitHubRepository":
+					v.Repository = aws.ToString(tag.Value)
+				case "GitHubRunId":
+					v.RunID = aws.ToString(tag.Value)
+				case internalaws.ExpiresAtTag:
+					v.ExpiresAt = aws.ToString(tag.Value)
+				}
+			}
+			c.resolveOwner(ctx, &v, localOwners, token)
+			vpcs = append(vpcs, v)
+		}
+	}
+	return vpcs, nil
+}
+
+func (c *Cleaner) resolveOwner(ctx context.Context, v *HolodeckVPC, localOwners map[string]string, token string) {
+	if id, ok := localOwners[v.VpcID]; ok {
+		v.Owner = OwnerLocalCache
+		v.Reason = fmt.Sprintf("referenced by local instance %s", id)
+		return
+	}
+	expiresAt, expiryErr := internalaws.ParseExpiresAt(v.ExpiresAt)
+	if expiryErr == nil && time.Now().Before(expiresAt) {
+		v.Owner = OwnerTTL
+		v.Reason = fmt.Sprintf("expires at %s", v.ExpiresAt)
+		return
+	}
+	if v.Repository == "" || v.RunID == "" {
+		// The VPC may belong to an environment created on another machine,
+		// so only a passed ttl proves that nothing owns it
+		if expiryErr == nil {
+			v.Owner = OwnerNone
+			v.Reason = fmt.Sprintf("expired at %s", v.ExpiresAt)
+			return
+		}
+		v.Owner = OwnerUnknown
+		v.Reason = "no local cache file, ttl or GitHub run"
+		return
+	}
+
+	run := fmt.Sprintf("%s run %s", v.Repository, v.RunID)
+	if token == "" {
+		v.Owner = OwnerUnknown
+		v.Reason = fmt.Sprintf("GITHUB_TOKEN not set, cannot check %s", run)
+		return
+	}
+	completed, err := c.CheckGitHubJobsCompleted(ctx, v.Repository, v.RunID, token)
+	switch {
+	case err != nil:
+		v.Owner = OwnerUnknown
+		v.Reason = fmt.Sprintf("failed to check %s: %v", run, err)
+	case !completed:
+		v.Owner = OwnerGitHubRun
+		v.Reason = fmt.Sprintf("%s is still in progress", run)
+	default:
+		v.Owner = OwnerNone
+		v.Reason = fmt.Sprintf("%s has completed", run)
+	}
+}
+
+// ListRegions returns the names of the regions enabled for the account,
+// sorted.
+func (c *Cleaner) ListRegions(ctx context.Context) ([]string, error) {
+	result, err := c.ec2.DescribeRegions(ctx, &ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe regions: %w", err)
+	}
+	regions := make([]string, 0, len(result.Regions))
+	for _, r := range result.Regions {
+		regions = append(regions, aws.ToString(r.RegionName))
+	}
+	sort.Strings(regions)
+	return regions, nil
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cleanup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	internalaws "github.com/NVIDIA/holodeck/internal/aws"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/testutil/mocks"
+)
+
+func vpcWithTags(id string, tags map[string]string) types.Vpc {
+	vpc := types.Vpc{VpcId: aws.String(id)}
+	for k, v := range tags {
+		vpc.Tags = append(vpc.Tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return vpc
+}
+
+var _ = Describe("Orphans", func() {
+	var (
+		log     *logger.FunLogger
+		buf     bytes.Buffer
+		mockEC  *mocks.MockEC2Client
+		cleaner *Cleaner
+	)
+
+	BeforeEach(func() {
+		log = logger.NewLogger()
+		log.Out = &buf
+		mockEC = &mocks.MockEC2Client{}
+
+		var err error
+		cleaner, err = New(log, "us-west-2", WithEC2Client(mockEC))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("FindOrphans", func() {
+		var vpcInput *ec2.DescribeVpcsInput
+
+		BeforeEach(func() {
+			GinkgoT().Setenv("GITHUB_TOKEN", "")
+			mockEC.DescribeVpcsFunc = func(ctx context.Context,
+				params *ec2.DescribeVpcsInput,
+				optFns ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+				vpcInput = params
+				return &ec2.DescribeVpcsOutput{
+					Vpcs: []types.Vpc{
+						vpcWithTags("vpc-local", map[string]string{"Name": "mine", "Project": "holodeck"}),
+						vpcWithTags("vpc-lost", map[string]string{"Name": "lost", "Project": "holodeck", "GitHubRepository": ""}),
+						vpcWithTags("vpc-expired", map[string]string{
+							"Name": "expired", "Project": "holodeck",
+							internalaws.ExpiresAtTag: internalaws.FormatExpiresAt(time.Now().Add(-time.Hour)),
+						}),
+						vpcWithTags("vpc-ttl", map[string]string{
+							"Name": "ttl", "Project": "holodeck",
+							internalaws.ExpiresAtTag: internalaws.FormatExpiresAt(time.Now().Add(time.Hour)),
+						}),
+						vpcWithTags("vpc-ci", map[string]string{
+							"Name": "ci", "Project": "holodeck",
+							"GitHubRepository": "NVIDIA/holodeck", "GitHubRunId": "42",
+						}),
+					},
+				}, nil
+			}
+		})
+
+		It("should filter on the holodeck project tag", func() {
+			_, err := cleaner.FindOrphans(context.Background(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vpcInput.Filters).To(ConsistOf(types.Filter{
+				Name: aws.String("tag:Project"), Values: []string{"holodeck"},
+			}))
+		})
+
+		It("should resolve the owner of each VPC", func() {
+			vpcs, err := cleaner.FindOrphans(context.Background(), map[string]string{"vpc-local": "a1b2c3d4"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vpcs).To(HaveLen(5))
+
+			Expect(vpcs[0].Owner).To(Equal(OwnerLocalCache))
+			Expect(vpcs[0].Reason).To(ContainSubstring("a1b2c3d4"))
+			Expect(vpcs[0].Orphaned()).To(BeFalse())
+
+			// No owner evidence: it may belong to another machine
+			Expect(vpcs[1].Owner).To(Equal(OwnerUnknown))
+			Expect(vpcs[1].Orphaned()).To(BeFalse())
+			Expect(vpcs[1].Region).To(Equal("us-west-2"))
+			Expect(vpcs[1].Name).To(Equal("lost"))
+
+			Expect(vpcs[2].Owner).To(Equal(OwnerNone))
+			Expect(vpcs[2].Reason).To(ContainSubstring("expired at"))
+			Expect(vpcs[2].Orphaned()).To(BeTrue())
+
+			Expect(vpcs[3].Owner).To(Equal(OwnerTTL))
+			Expect(vpcs[3].Orphaned()).To(BeFalse())
+
+			Expect(vpcs[4].Owner).To(Equal(OwnerUnknown))
+			Expect(vpcs[4].Reason).To(ContainSubstring("GITHUB_TOKEN not set"))
+			Expect(vpcs[4].Orphaned()).To(BeFalse())
+		})
+
+		It("should fail when DescribeVpcs fails", func() {
+			mockEC.DescribeVpcsFunc = func(ctx context.Context,
+				params *ec2.DescribeVpcsInput,
+				optFns ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+				return nil, errors.New("denied")
+			}
+			_, err := cleaner.FindOrphans(context.Background(), nil)
+			Expect(err).To(MatchError(ContainSubstring("failed to describe vpcs")))
+		})
+	})
+
+	Describe("ListRegions", func() {
+		It("should return sorted region names", func() {
+			mockEC.DescribeRegionsFunc = func(ctx context.Context,
+				params *ec2.DescribeRegionsInput,
+				optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error) {
+				return &ec2.DescribeRegionsOutput{Regions: []types.Region{
+					{RegionName: aws.String("us-west-2")},
+					{RegionName: aws.String("eu-central-1")},
+				}}, nil
+			}
+			regions, err := cleaner.ListRegions(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(regions).To(Equal([]string{"eu-central-1", "us-west-2"}))
+		})
+	})
+})
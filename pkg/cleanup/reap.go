@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	internalaws "github.com/NVIDIA/holodeck/internal/aws"
+)
+
+// Kinds of resources returned by FindExpired.
+const (
+	ResourceKindVPC      = "vpc"
+	ResourceKindInstance = "instance"
+)
+
+// ExpiredResource is a holodeck-tagged AWS resource whose ExpiresAt tag is
+// in the past.
+type ExpiredResource struct {
+	Kind      string
+	ID        string
+	Name      string
+	ExpiresAt time.Time
+}
+
+// FindExpired returns the holodeck VPCs and instances whose ExpiresAt tag is
+// before now. Instances inside an expired VPC are not listed separately:
+// they are deleted together with the VPC. Instances in a VPC holodeck does
+// not own (spec.network) are returned on their own. Resources with a
+// malformed ExpiresAt tag are logged and skipped.
+func (c *Cleaner) FindExpired(ctx context.Context, now time.Time) ([]ExpiredResource, error) {
+	filters := []types.Filter{
+		{Name: aws.String("tag:Project"), Values: []string{"holodeck"}},
+		{Name: aws.String("tag-key"), Values: []string{internalaws.ExpiresAtTag}},
+	}
+
+	var expired []ExpiredResource
+	expiredVPCs := make(map[string]bool)
+
+	vpcs := ec2.NewDescribeVpcsPaginator(c.ec2, &ec2.DescribeVpcsInput{Filters: filters})
+	for vpcs.HasMorePages() {
+		page, err := vpcs.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe vpcs: %w", err)
+		}
+		for _, vpc := range page.Vpcs {
+			r, ok := c.expiredResource(ResourceKindVPC, aws.ToString(vpc.VpcId), vpc.Tags, now)
+			if !ok {
+				continue
+			}
+			expiredVPCs[r.ID] = true
+			expired = append(expired, r)
+		}
+	}
+
+	instances := ec2.NewDescribeInstancesPaginator(c.ec2, &ec2.DescribeInstancesInput{
+		Filters: append(filters, types.Filter{
+			Name:   aws.String("instance-state-name"),
+			Values: []string{"pending", "running", "stopping", "stopped"},
+		}),
+	})
+	for instances.HasMorePages() {
+		page, err := instances.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe instances: %w", err)
+		}
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				if expiredVPCs[aws.ToString(instance.VpcId)] {
+					continue
+				}
+				r, ok := c.expiredResource(ResourceKindInstance, aws.ToString(instance.InstanceId), instance.Tags, now)
+				if ok {
+					expired = append(expired, r)
+				}
+			}
+		}
+	}
+
+	return expired, nil
+}
+
+func (c *Cleaner) expiredResource(kind, id string, tags []types.Tag, now time.Time) (ExpiredResource, bool) {
+	r := ExpiredResource{Kind: kind, ID: id}
+	var expiresAt string
+	for _, tag := range tags {
+		switch aws.ToString(tag.Key) {
+		case "Name":
+			r.Name = aws.ToString(tag.Value)
+		case internalaws.ExpiresAtTag:
+			expiresAt = aws.ToString(tag.Value)
+		}
+	}
+	t, err := internalaws.ParseExpiresAt(expiresAt)
+	if err != nil {
+		c.log.Warning("Ignoring %s %s with malformed %s tag %q", kind, id, internalaws.ExpiresAtTag, expiresAt)
+		return r, false
+	}
+	r.ExpiresAt = t
+	return r, t.Before(now)
+}
+
+// Reap deletes an expired resource returned by FindExpired: a VPC together
+// with everything in it, or a single instance.
+func (c *Cleaner) Reap(ctx context.Context, r ExpiredResource) error {
+	switch r.Kind {
+	case ResourceKindVPC:
+		return c.DeleteVPCResources(ctx, r.ID)
+	case ResourceKindInstance:
+		return c.TerminateInstances(ctx, []string{r.ID})
+	default:
+		return fmt.Errorf("unknown resource kind %q", r.Kind)
+	}
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cleanup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	internalaws "github.com/NVIDIA/holodeck/internal/aws"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/testutil/mocks"
+)
+
+func expiryTags(name string, expiresAt string) []types.Tag {
+	return []types.Tag{
+		{Key: aws.String("Name"), Value: aws.String(name)},
+		{Key: aws.String("Project"), Value: aws.String("holodeck")},
+		{Key: aws.String(internalaws.ExpiresAtTag), Value: aws.String(expiresAt)},
+	}
+}
+
+var _ = Describe("Reap", func() {
+	var (
+		log     *logger.FunLogger
+		buf     bytes.Buffer
+		mockEC  *mocks.MockEC2Client
+		cleaner *Cleaner
+		now     time.Time
+		past    string
+		future  string
+	)
+
+	BeforeEach(func() {
+		log = logger.NewLogger()
+		log.Out = &buf
+		mockEC = &mocks.MockEC2Client{}
+		now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		past = internalaws.FormatExpiresAt(now.Add(-time.Hour))
+		future = internalaws.FormatExpiresAt(now.Add(time.Hour))
+
+		var err error
+		cleaner, err = New(log, "us-west-2", WithEC2Client(mockEC))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("FindExpired", func() {
+		var vpcInput *ec2.DescribeVpcsInput
+		var instanceInput *ec2.DescribeInstancesInput
+
+		BeforeEach(func() {
+			mockEC.DescribeVpcsFunc = func(ctx context.Context,
+				params *ec2.DescribeVpcsInput,
+				optFns ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+				vpcInput = params
+				return &ec2.DescribeVpcsOutput{
+					Vpcs: []types.Vpc{
+						{VpcId: aws.String("vpc-old"), Tags: expiryTags("old-env", past)},
+						{VpcId: aws.String("vpc-new"), Tags: expiryTags("new-env", future)},
+					},
+				}, nil
+			}
+			mockEC.DescribeInstancesFunc = func(ctx context.Context,
+				params *ec2.DescribeInstancesInput,
+				optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+				instanceInput = params
+				return &ec2.DescribeInstancesOutput{
+					Reservations: []types.Reservation{{
+						Instances: []types.Instance{
+							{InstanceId: aws.String("i-in-old-vpc"), VpcId: aws.String("vpc-old"), Tags: expiryTags("old-env", past)},
+							{InstanceId: aws.String("i-byo"), VpcId: aws.String("vpc-shared"), Tags: expiryTags("byo-env", past)},
+							{InstanceId: aws.String("i-live"), VpcId: aws.String("vpc-shared"), Tags: expiryTags("live-env", future)},
+							{InstanceId: aws.String("i-bad"), VpcId: aws.String("vpc-shared"), Tags: expiryTags("bad-env", "tomorrow")},
+						},
+					}},
+				}, nil
+			}
+		})
+
+		It("should filter on the holodeck and ExpiresAt tags", func() {
+			_, err := cleaner.FindExpired(context.Background(), now)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(vpcInput.Filters).To(ContainElement(types.Filter{
+				Name: aws.String("tag:Project"), Values: []string{"holodeck"},
+			}))
+			Expect(vpcInput.Filters).To(ContainElement(types.Filter{
+				Name: aws.String("tag-key"), Values: []string{internalaws.ExpiresAtTag},
+			}))
+			Expect(instanceInput.Filters).To(HaveLen(3))
+		})
+
+		It("should return expired VPCs and instances outside them", func() {
+			expired, err := cleaner.FindExpired(context.Background(), now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expired).To(Equal([]ExpiredResource{
+				{Kind: ResourceKindVPC, ID: "vpc-old", Name: "old-env", ExpiresAt: now.Add(-time.Hour)},
+				{Kind: ResourceKindInstance, ID: "i-byo", Name: "byo-env", ExpiresAt: now.Add(-time.Hour)},
+			}))
+		})
+
+		It("should fail when DescribeVpcs fails", func() {
+			mockEC.DescribeVpcsFunc = func(ctx context.Context,
+				params *ec2.DescribeVpcsInput,
+				optFns ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+				return nil, errors.New("throttled")
+			}
+			_, err := cleaner.FindExpired(context.Background(), now)
+			Expect(err).To(MatchError(ContainSubstring("failed to describe vpcs")))
+		})
+	})
+
+	Describe("Reap", func() {
+		It("should terminate an expired instance", func() {
+			var terminated []string
+			mockEC.TerminateInstancesFunc = func(ctx context.Context,
+				params *ec2.TerminateInstancesInput,
+				optFns ...func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error) {
+				terminated = append(terminated, params.InstanceIds...)
+				return &ec2.TerminateInstancesOutput{}, nil
+			}
+			mockEC.DescribeInstancesFunc = func(ctx context.Context,
+				params *ec2.DescribeInstancesInput,
+				optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+				return &ec2.DescribeInstancesOutput{
+					Reservations: []types.Reservation{{
+						Instances: []types.Instance{{
+							InstanceId: aws.String("i-byo"),
+							State:      &types.InstanceState{Name: types.InstanceStateNameTerminated},
+						}},
+					}},
+				}, nil
+			}
+
+			err := cleaner.Reap(context.Background(), ExpiredResource{Kind: ResourceKindInstance, ID: "i-byo"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(terminated).To(Equal([]string{"i-byo"}))
+		})
+
+		It("should reject an unknown kind", func() {
+			err := cleaner.Reap(context.Background(), ExpiredResource{Kind: "subnet", ID: "subnet-1"})
+			Expect(err).To(MatchError(ContainSubstring("unknown resource kind")))
+		})
+	})
+})
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lock
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/ami"
+)
+
+// Apply pins the moving references of env to the values recorded in l:
+// tracked branches become git sources at the locked commit, git refs are
+// replaced by their commit, driver branches by their driver version and
+// OS images by their image ID. It fails if env no longer requests what l
+// was resolved from.
+func Apply(env *v1alpha1.Environment, l *Lock) error {
+	if err := l.check(*env); err != nil {
+		return fmt.Errorf("lock file does not match the environment, rerun holodeck lock: %w", err)
+	}
+
+	if c := l.Components.Driver; c != nil {
+		d := &env.Spec.NVIDIADriver
+		switch {
+		case c.Commit != "":
+			d.Git.Ref = c.Commit
+		case c.ResolvedVersion != "" && d.Package != nil:
+			d.Package.Version = c.ResolvedVersion
+		case c.ResolvedVersion != "":
+			d.Version = c.ResolvedVersion
+		}
+	}
+
+	if c := l.Components.Runtime; c != nil && c.Commit != "" {
+		cr := &env.Spec.ContainerRuntime
+		cr.Source = v1alpha1.RuntimeSourceGit
+		cr.Git = &v1alpha1.RuntimeGitSpec{Repo: c.Repo, Ref: c.Commit}
+		cr.Latest = nil
+	}
+
+	if c := l.Components.Toolkit; c != nil && c.Commit != "" {
+		nct := &env.Spec.NVIDIAContainerToolkit
+		nct.Source = v1alpha1.CTKSourceGit
+		nct.Git = &v1alpha1.CTKGitSpec{Repo: c.Repo, Ref: c.Commit}
+		nct.Latest = nil
+	}
+
+	if c := l.Components.Kubernetes; c != nil && c.Commit != "" {
+		k := &env.Spec.Kubernetes
+		k.Source = v1alpha1.K8sSourceGit
+		k.Git = &v1alpha1.K8sGitSpec{Repo: c.Repo, Ref: c.Commit}
+		k.Latest = nil
+	}
+
+	for _, img := range l.Images {
+		image := nodeImage(env, img.Node)
+		imageID := img.ImageID
+		image.ImageId = &imageID
+		image.Architecture = img.Arch
+	}
+	return nil
+}
+
+// check verifies that env requests the components and images l was
+// resolved from.
+func (l *Lock) check(env v1alpha1.Environment) error {
+	want, err := requestedComponents(env)
+	if err != nil {
+		return err
+	}
+	for _, name := range componentNames {
+		requested, locked := *want.field(name), (*l.Components.field(name)).requested()
+		if !reflect.DeepEqual(requested, locked) {
+			return fmt.Errorf("%s is requested as %s but locked as %s", name, requested, locked)
+		}
+	}
+
+	locked := make(map[string]Image, len(l.Images))
+	for _, img := range l.Images {
+		locked[img.Node] = img
+	}
+	for _, t := range requestedImages(env) {
+		img, ok := locked[t.Node]
+		if !ok {
+			return fmt.Errorf("no image is locked for %s nodes", t.Node)
+		}
+		delete(locked, t.Node)
+		if img.Region != t.Region || img.OS != t.OS {
+			return fmt.Errorf("%s nodes request %s in %s but the locked image is %s in %s",
+				t.Node, t.OS, t.Region, img.OS, img.Region)
+		}
+		if t.Arch != "" && ami.NormalizeArch(t.Arch) != ami.NormalizeArch(img.Arch) {
+			return fmt.Errorf("%s nodes request architecture %s but the locked image is %s", t.Node, t.Arch, img.Arch)
+		}
+	}
+	if len(locked) > 0 {
+		return fmt.Errorf("an image is locked for %s nodes, which do not resolve one",
+			strings.Join(slices.Sorted(maps.Keys(locked)), ", "))
+	}
+	return nil
+}
+
+// String describes the requested source of a component.
+func (c *Component) String() string {
+	if c == nil {
+		return "not installed"
+	}
+	parts := []string{"source " + c.Source}
+	for _, f := range []struct{ name, value string }{
+		{"repo", c.Repo},
+		{"ref", c.Ref},
+		{"branch", c.Branch},
+		{"version", c.Version},
+	} {
+		if f.value != "" {
+			parts = append(parts, f.name+" "+f.value)
+		}
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// nodeImage returns the image of the named group of nodes, which check has
+// verified to exist.
+func nodeImage(env *v1alpha1.Environment, node string) *v1alpha1.Image {
+	cluster := env.Spec.Cluster
+	var image **v1alpha1.Image
+	switch node {
+	case "instance":
+		//nolint:staticcheck // Instance is embedded but explicit access is clearer
+		return &env.Spec.Instance.Image
+	case "control-plane":
+		image = &cluster.ControlPlane.Image
+	case "etcd":
+		if cluster.Etcd == nil {
+			cluster.Etcd = &v1alpha1.EtcdPoolSpec{}
+		}
+		image = &cluster.Etcd.Image
+	default:
+		image = &cluster.WorkerPool(strings.TrimPrefix(strings.TrimPrefix(node, "worker"), "/")).Image
+	}
+	if *image == nil {
+		*image = &v1alpha1.Image{}
+	}
+	return *image
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultCUDARepo is the CUDA repository driver branches are resolved
+// against. Driver releases are published to the repositories of every
+// distribution at once, so a single index is representative.
+const DefaultCUDARepo = "https://developer.download.nvidia.com/compute/cuda/repos/ubuntu2404/x86_64/"
+
+// CUDARepoResolver resolves driver branches from the package listing of a
+// CUDA repository.
+type CUDARepoResolver struct {
+	client *http.Client
+	repo   string
+}
+
+// NewCUDARepoResolver creates a CUDARepoResolver for DefaultCUDARepo with
+// a default 30s timeout.
+func NewCUDARepoResolver() *CUDARepoResolver {
+	return &CUDARepoResolver{
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		repo: DefaultCUDARepo,
+	}
+}
+
+// NewCUDARepoResolverWithClient creates a CUDARepoResolver for repo with a
+// custom HTTP client.
+func NewCUDARepoResolverWithClient(client *http.Client, repo string) *CUDARepoResolver {
+	return &CUDARepoResolver{
+		client: client,
+		repo:   repo,
+	}
+}
+
+// ResolveBranch returns the newest driver version of branch, found in the
+// cuda-drivers-<branch>_<version> packages of the repository.
+func (r *CUDARepoResolver) ResolveBranch(ctx context.Context, branch string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.repo, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to list %s: %w", r.repo, err)
+	}
+	defer resp.Body.Close() // nolint:errcheck
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to list %s: %s", r.repo, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", r.repo, err)
+	}
+
+	b := regexp.QuoteMeta(branch)
+	pattern := regexp.MustCompile(`cuda-drivers-` + b + `_(` + b + `\.[0-9][0-9.]*[0-9])-`)
+	var versions []string
+	for _, m := range pattern.FindAllStringSubmatch(string(body), -1) {
+		versions = append(versions, m[1])
+	}
+	if len(versions) == 0 {
+		return "", fmt.Errorf("no driver packages found for branch %s in %s", branch, r.repo)
+	}
+	return slices.MaxFunc(versions, compareVersions), nil
+}
+
+// compareVersions compares dotted numeric versions.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		x, _ := strconv.Atoi(as[i])
+		y, _ := strconv.Atoi(bs[i])
+		if x != y {
+			return x - y
+		}
+	}
+	return len(as) - len(bs)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lock
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const repoListing = `<html><body>
+<a href="cuda-drivers-570_570.172.08-0ubuntu1_amd64.deb">cuda-drivers-570_570.172.08-0ubuntu1_amd64.deb</a>
+<a href="cuda-drivers-580_580.9.1-0ubuntu1_amd64.deb">cuda-drivers-580_580.9.1-0ubuntu1_amd64.deb</a>
+<a href="cuda-drivers-580_580.95.05-0ubuntu1_amd64.deb">cuda-drivers-580_580.95.05-0ubuntu1_amd64.deb</a>
+<a href="cuda-drivers-580_580.82.07-0ubuntu1_amd64.deb">cuda-drivers-580_580.82.07-0ubuntu1_amd64.deb</a>
+<a href="cuda-drivers-5800_5800.1.1-0ubuntu1_amd64.deb">cuda-drivers-5800_5800.1.1-0ubuntu1_amd64.deb</a>
+</body></html>`
+
+func TestCUDARepoResolver_ResolveBranch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(repoListing))
+	}))
+	defer server.Close()
+	r := NewCUDARepoResolverWithClient(server.Client(), server.URL)
+
+	version, err := r.ResolveBranch(context.Background(), "580")
+	require.NoError(t, err)
+	assert.Equal(t, "580.95.05", version)
+
+	version, err = r.ResolveBranch(context.Background(), "570")
+	require.NoError(t, err)
+	assert.Equal(t, "570.172.08", version)
+
+	_, err = r.ResolveBranch(context.Background(), "535")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no driver packages found for branch 535")
+}
+
+func TestCUDARepoResolver_HTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	r := NewCUDARepoResolverWithClient(server.Client(), server.URL)
+
+	_, err := r.ResolveBranch(context.Background(), "580")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package lock pins the moving references of an Environment, such as
+// tracked branches, driver package branches and OS images, to the exact
+// values they resolve to, so that an environment can be recreated
+// identically later.
+package lock
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultFile is the conventional name of a lock file.
+	DefaultFile = "holodeck.lock"
+	// Kind is the kind of a lock file.
+	Kind = "Lock"
+)
+
+// Lock records the resolved values of the moving references of an
+// Environment.
+type Lock struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ResolvedAt is when the references were resolved.
+	ResolvedAt metav1.Time `json:"resolvedAt"`
+
+	// Components records the requested source of each installed component
+	// and what it resolved to.
+	Components Components `json:"components"`
+
+	// Images records the machine image of each group of cloud nodes.
+	Images []Image `json:"images,omitempty"`
+}
+
+// Components records the locked components of an Environment.
+type Components struct {
+	Driver     *Component `json:"driver,omitempty"`
+	Runtime    *Component `json:"runtime,omitempty"`
+	Toolkit    *Component `json:"toolkit,omitempty"`
+	Kubernetes *Component `json:"kubernetes,omitempty"`
+}
+
+// Component records how a component was requested and what its moving
+// reference resolved to. The requested fields include the defaults
+// Holodeck applies, so that a lock detects a change of those defaults.
+type Component struct {
+	// Source is the requested installation source.
+	Source string `json:"source"`
+	// Repo is the git repository of a git or latest source.
+	Repo string `json:"repo,omitempty"`
+	// Ref is the requested git ref, or the tracked branch of a latest
+	// source.
+	Ref string `json:"ref,omitempty"`
+	// Branch is the requested driver package branch.
+	Branch string `json:"branch,omitempty"`
+	// Version is the requested package or release version.
+	Version string `json:"version,omitempty"`
+
+	// Commit is the full commit SHA Ref resolved to.
+	Commit string `json:"commit,omitempty"`
+	// ResolvedVersion is the package version Branch resolved to.
+	ResolvedVersion string `json:"resolvedVersion,omitempty"`
+}
+
+// requested returns the component without its resolved values.
+func (c *Component) requested() *Component {
+	if c == nil {
+		return nil
+	}
+	r := *c
+	r.Commit = ""
+	r.ResolvedVersion = ""
+	return &r
+}
+
+// Image records the machine image a group of nodes boots from.
+type Image struct {
+	// Node is the group of nodes: instance, control-plane, etcd, worker or
+	// worker/<pool>.
+	Node string `json:"node"`
+	// Region is the region the image was resolved in.
+	Region string `json:"region"`
+	// OS is the operating system the image was resolved for.
+	OS string `json:"os"`
+	// Arch is the architecture of the image.
+	Arch string `json:"arch"`
+	// ImageID is the resolved image.
+	ImageID string `json:"imageId"`
+}
+
+// Load reads a lock file.
+func Load(path string) (*Lock, error) {
+	l, err := jyaml.UnmarshalFromFile[Lock](path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lock file %s: %w", path, err)
+	}
+	if l.Kind != Kind {
+		return nil, fmt.Errorf("%s is not a lock file: kind is %q, expected %q", path, l.Kind, Kind)
+	}
+	if l.APIVersion != v1alpha1.GroupVersion.String() {
+		return nil, fmt.Errorf("unsupported lock file version %q in %s", l.APIVersion, path)
+	}
+	return &l, nil
+}
+
+// Write writes the lock file to path.
+func (l *Lock) Write(path string) error {
+	data, err := jyaml.MarshalYAML(l)
+	if err != nil {
+		return fmt.Errorf("failed to marshal lock file: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write lock file %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,372 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lock
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+)
+
+// fakeGit resolves "repo@ref" to a commit.
+type fakeGit map[string]string
+
+func (f fakeGit) Resolve(_ context.Context, repo, ref string) (string, string, error) {
+	sha, ok := f[repo+"@"+ref]
+	if !ok {
+		return "", "", fmt.Errorf("unknown ref %s@%s", repo, ref)
+	}
+	return sha, sha[:7], nil
+}
+
+type fakeDrivers map[string]string
+
+func (f fakeDrivers) ResolveBranch(_ context.Context, branch string) (string, error) {
+	v, ok := f[branch]
+	if !ok {
+		return "", fmt.Errorf("unknown branch %s", branch)
+	}
+	return v, nil
+}
+
+// fakeImages resolves region/os to an image and records the instance type
+// each resolution inferred its architecture from.
+type fakeImages struct {
+	instanceTypes []string
+}
+
+func (f *fakeImages) ResolveImage(_ context.Context, region, osID, arch, instanceType string) (string, string, error) {
+	f.instanceTypes = append(f.instanceTypes, instanceType)
+	if arch == "" {
+		arch = "x86_64"
+	}
+	return "ami-" + osID + "-" + region, arch, nil
+}
+
+const (
+	containerdRepo = "https://github.com/containerd/containerd.git"
+	ctkRepo        = "https://github.com/NVIDIA/nvidia-container-toolkit.git"
+	containerdSHA  = "1111111111111111111111111111111111111111"
+	ctkSHA         = "2222222222222222222222222222222222222222"
+)
+
+func testResolvers() (Resolvers, *fakeImages) {
+	images := &fakeImages{}
+	return Resolvers{
+		Git: fakeGit{
+			containerdRepo + "@main": containerdSHA,
+			ctkRepo + "@v1.17.0":     ctkSHA,
+		},
+		Drivers: fakeDrivers{"580": "580.95.05"},
+		Images:  images,
+	}, images
+}
+
+func singleNodeEnv() v1alpha1.Environment {
+	return v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			Provider: v1alpha1.ProviderAWS,
+			Instance: v1alpha1.Instance{
+				Type:   "g4dn.xlarge",
+				Region: "us-west-2",
+				OS:     "ubuntu-24.04",
+			},
+			NVIDIADriver: v1alpha1.NVIDIADriver{Install: true},
+			ContainerRuntime: v1alpha1.ContainerRuntime{
+				Install: true,
+				Name:    v1alpha1.ContainerRuntimeContainerd,
+				Source:  v1alpha1.RuntimeSourceLatest,
+			},
+			NVIDIAContainerToolkit: v1alpha1.NVIDIAContainerToolkit{
+				Install: true,
+				Source:  v1alpha1.CTKSourceGit,
+				Git:     &v1alpha1.CTKGitSpec{Ref: "v1.17.0"},
+			},
+			Kubernetes: v1alpha1.Kubernetes{
+				Install:             true,
+				KubernetesInstaller: "kubeadm",
+				KubernetesVersion:   "v1.33.1",
+			},
+		},
+	}
+}
+
+func TestResolve(t *testing.T) {
+	r, images := testResolvers()
+	l, err := Resolve(context.Background(), singleNodeEnv(), r)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Lock", l.Kind)
+	assert.Equal(t, v1alpha1.GroupVersion.String(), l.APIVersion)
+	assert.Equal(t, &Component{Source: "package", Branch: "580", ResolvedVersion: "580.95.05"}, l.Components.Driver)
+	assert.Equal(t, &Component{Source: "latest", Repo: containerdRepo, Ref: "main", Commit: containerdSHA}, l.Components.Runtime)
+	assert.Equal(t, &Component{Source: "git", Repo: ctkRepo, Ref: "v1.17.0", Commit: ctkSHA}, l.Components.Toolkit)
+	assert.Equal(t, &Component{Source: "release", Version: "v1.33.1"}, l.Components.Kubernetes)
+	assert.Equal(t, []Image{{
+		Node: "instance", Region: "us-west-2", OS: "ubuntu-24.04", Arch: "x86_64", ImageID: "ami-ubuntu-24.04-us-west-2",
+	}}, l.Images)
+	assert.Equal(t, []string{"g4dn.xlarge"}, images.instanceTypes)
+}
+
+func TestResolve_GitFailure(t *testing.T) {
+	r, _ := testResolvers()
+	r.Git = fakeGit{}
+	_, err := Resolve(context.Background(), singleNodeEnv(), r)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to resolve runtime ref main")
+}
+
+func TestResolve_UnpinnedPackages(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(*v1alpha1.Environment)
+		wantErr string
+	}{
+		{
+			name: "toolkit package without version",
+			change: func(env *v1alpha1.Environment) {
+				env.Spec.NVIDIAContainerToolkit = v1alpha1.NVIDIAContainerToolkit{Install: true}
+			},
+			wantErr: "toolkit package version is not pinned",
+		},
+		{
+			name: "docker package without version",
+			change: func(env *v1alpha1.Environment) {
+				env.Spec.ContainerRuntime = v1alpha1.ContainerRuntime{Install: true, Name: v1alpha1.ContainerRuntimeDocker}
+			},
+			wantErr: "runtime package version is not pinned",
+		},
+		{
+			name: "cri-o package without version",
+			change: func(env *v1alpha1.Environment) {
+				env.Spec.ContainerRuntime = v1alpha1.ContainerRuntime{Install: true, Name: v1alpha1.ContainerRuntimeCrio}
+			},
+			wantErr: "runtime package version is not pinned",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := singleNodeEnv()
+			tt.change(&env)
+			r, _ := testResolvers()
+			_, err := Resolve(context.Background(), env, r)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestResolve_PinnedPackages(t *testing.T) {
+	env := singleNodeEnv()
+	env.Spec.ContainerRuntime = v1alpha1.ContainerRuntime{Install: true, Name: v1alpha1.ContainerRuntimeContainerd}
+	env.Spec.NVIDIAContainerToolkit = v1alpha1.NVIDIAContainerToolkit{
+		Install: true,
+		Package: &v1alpha1.CTKPackageSpec{Version: "1.17.8-1"},
+	}
+	r, _ := testResolvers()
+	l, err := Resolve(context.Background(), env, r)
+	require.NoError(t, err)
+	assert.Equal(t, &Component{Source: "package", Version: "1.7.27"}, l.Components.Runtime)
+	assert.Equal(t, &Component{Source: "package", Version: "1.17.8-1"}, l.Components.Toolkit)
+}
+
+func TestResolve_ClusterImages(t *testing.T) {
+	env := v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			Provider: v1alpha1.ProviderAWS,
+			Instance: v1alpha1.Instance{OS: "rocky-9"},
+			Cluster: &v1alpha1.ClusterSpec{
+				Region: "eu-west-1",
+				ControlPlane: v1alpha1.ControlPlaneSpec{
+					Count:        1,
+					InstanceType: "m5.xlarge",
+				},
+				WorkerPools: []v1alpha1.WorkerPoolSpec{
+					{Name: "gpu", Count: 2, InstanceType: "g5.xlarge", OS: "ubuntu-22.04"},
+					{Name: "arm", Count: 1, InstanceType: "g5g.xlarge", Image: &v1alpha1.Image{Architecture: "arm64"}},
+					{Name: "custom", Count: 1, Image: &v1alpha1.Image{ImageId: strPtr("ami-custom")}},
+				},
+			},
+		},
+	}
+	r, _ := testResolvers()
+	l, err := Resolve(context.Background(), env, r)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Image{
+		{Node: "control-plane", Region: "eu-west-1", OS: "rocky-9", Arch: "x86_64", ImageID: "ami-rocky-9-eu-west-1"},
+		{Node: "worker/gpu", Region: "eu-west-1", OS: "ubuntu-22.04", Arch: "x86_64", ImageID: "ami-ubuntu-22.04-eu-west-1"},
+		{Node: "worker/arm", Region: "eu-west-1", OS: "rocky-9", Arch: "arm64", ImageID: "ami-rocky-9-eu-west-1"},
+	}, l.Images)
+}
+
+func TestResolve_SSHProviderHasNoImages(t *testing.T) {
+	env := singleNodeEnv()
+	env.Spec.Provider = v1alpha1.ProviderSSH
+	r, _ := testResolvers()
+	r.Images = nil
+	l, err := Resolve(context.Background(), env, r)
+	require.NoError(t, err)
+	assert.Empty(t, l.Images)
+}
+
+func TestApply(t *testing.T) {
+	env := singleNodeEnv()
+	r, _ := testResolvers()
+	l, err := Resolve(context.Background(), env, r)
+	require.NoError(t, err)
+
+	require.NoError(t, Apply(&env, l))
+
+	assert.Equal(t, "580.95.05", env.Spec.NVIDIADriver.Version)
+
+	cr := env.Spec.ContainerRuntime
+	assert.Equal(t, v1alpha1.RuntimeSourceGit, cr.Source)
+	assert.Equal(t, &v1alpha1.RuntimeGitSpec{Repo: containerdRepo, Ref: containerdSHA}, cr.Git)
+	assert.Nil(t, cr.Latest)
+
+	assert.Equal(t, v1alpha1.CTKSourceGit, env.Spec.NVIDIAContainerToolkit.Source)
+	assert.Equal(t, ctkSHA, env.Spec.NVIDIAContainerToolkit.Git.Ref)
+
+	assert.Equal(t, v1alpha1.K8sSource(""), env.Spec.Kubernetes.Source)
+
+	require.NotNil(t, env.Spec.Image.ImageId)
+	assert.Equal(t, "ami-ubuntu-24.04-us-west-2", *env.Spec.Image.ImageId)
+	assert.Equal(t, "x86_64", env.Spec.Image.Architecture)
+}
+
+func TestApply_DriverPackageSpec(t *testing.T) {
+	env := singleNodeEnv()
+	env.Spec.NVIDIADriver.Package = &v1alpha1.DriverPackageSpec{Branch: "580"}
+	r, _ := testResolvers()
+	l, err := Resolve(context.Background(), env, r)
+	require.NoError(t, err)
+
+	require.NoError(t, Apply(&env, l))
+	assert.Equal(t, "580.95.05", env.Spec.NVIDIADriver.Package.Version)
+	assert.Empty(t, env.Spec.NVIDIADriver.Version)
+}
+
+func TestApply_ClusterImages(t *testing.T) {
+	env := v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			Provider: v1alpha1.ProviderAWS,
+			Cluster: &v1alpha1.ClusterSpec{
+				Region:       "us-east-1",
+				ControlPlane: v1alpha1.ControlPlaneSpec{Count: 1, OS: "ubuntu-24.04"},
+				Workers:      &v1alpha1.WorkerPoolSpec{Count: 2, OS: "rocky-9"},
+				HighAvailability: &v1alpha1.HAConfig{
+					Enabled:      true,
+					EtcdTopology: v1alpha1.EtcdTopologyExternal,
+				},
+			},
+		},
+	}
+	r, _ := testResolvers()
+	l, err := Resolve(context.Background(), env, r)
+	require.NoError(t, err)
+	require.Len(t, l.Images, 3)
+
+	require.NoError(t, Apply(&env, l))
+	cluster := env.Spec.Cluster
+	assert.Equal(t, "ami-ubuntu-24.04-us-east-1", *cluster.ControlPlane.Image.ImageId)
+	assert.Equal(t, "ami-ubuntu-22.04-us-east-1", *cluster.Etcd.Image.ImageId)
+	assert.Equal(t, "ami-rocky-9-us-east-1", *cluster.Workers.Image.ImageId)
+}
+
+func TestApply_Stale(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(*v1alpha1.Environment)
+		wantErr string
+	}{
+		{
+			name:    "driver branch changed",
+			change:  func(env *v1alpha1.Environment) { env.Spec.NVIDIADriver.Branch = "570" },
+			wantErr: "driver is requested as (source package, branch 570) but locked as (source package, branch 580)",
+		},
+		{
+			name: "tracked branch changed",
+			change: func(env *v1alpha1.Environment) {
+				env.Spec.ContainerRuntime.Latest = &v1alpha1.RuntimeLatestSpec{Track: "release/2.0"}
+			},
+			wantErr: "runtime is requested as",
+		},
+		{
+			name:    "component added",
+			change:  func(env *v1alpha1.Environment) { env.Spec.Kubernetes.Install = false },
+			wantErr: "kubernetes is requested as not installed",
+		},
+		{
+			name:    "region changed",
+			change:  func(env *v1alpha1.Environment) { env.Spec.Region = "us-east-1" },
+			wantErr: "instance nodes request ubuntu-24.04 in us-east-1 but the locked image is ubuntu-24.04 in us-west-2",
+		},
+		{
+			name:    "image pinned in spec",
+			change:  func(env *v1alpha1.Environment) { env.Spec.Image.ImageId = strPtr("ami-mine") },
+			wantErr: "an image is locked for instance nodes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := singleNodeEnv()
+			r, _ := testResolvers()
+			l, err := Resolve(context.Background(), env, r)
+			require.NoError(t, err)
+
+			tt.change(&env)
+			err = Apply(&env, l)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "rerun holodeck lock")
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestWriteLoad(t *testing.T) {
+	r, _ := testResolvers()
+	l, err := Resolve(context.Background(), singleNodeEnv(), r)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), DefaultFile)
+	require.NoError(t, l.Write(path))
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, l.Components, loaded.Components)
+	assert.Equal(t, l.Images, loaded.Images)
+	assert.Equal(t, l.ResolvedAt.Unix(), loaded.ResolvedAt.Unix())
+}
+
+func TestLoad_RejectsOtherKinds(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "env.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("apiVersion: holodeck.nvidia.com/v1alpha1\nkind: Environment\n"), 0600))
+	_, err := Load(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a lock file")
+}
+
+func strPtr(s string) *string {
+	return &s
+}
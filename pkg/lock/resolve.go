@@ -0,0 +1,290 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/pkg/gitref"
+	"github.com/NVIDIA/holodeck/pkg/provisioner/templates"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultOS is the operating system AWS nodes boot when the spec names
+// neither an OS nor an image.
+const defaultOS = "ubuntu-22.04"
+
+// DriverResolver resolves an NVIDIA driver package branch to the newest
+// driver version published on it.
+type DriverResolver interface {
+	ResolveBranch(ctx context.Context, branch string) (string, error)
+}
+
+// ImageResolver resolves an operating system to a machine image.
+type ImageResolver interface {
+	// ResolveImage returns the image of osID in region and its
+	// architecture. An empty arch is inferred from instanceType.
+	ResolveImage(ctx context.Context, region, osID, arch, instanceType string) (imageID, resolvedArch string, err error)
+}
+
+// Resolvers resolve the moving references of an Environment.
+type Resolvers struct {
+	// Git resolves git refs and tracked branches to commits.
+	Git gitref.Resolver
+	// Drivers resolves driver package branches to driver versions.
+	Drivers DriverResolver
+	// Images resolves operating systems to machine images.
+	Images ImageResolver
+}
+
+// Resolve resolves every moving reference of env into a Lock.
+func Resolve(ctx context.Context, env v1alpha1.Environment, r Resolvers) (*Lock, error) {
+	components, err := requestedComponents(env)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range componentNames {
+		c := *components.field(name)
+		if c == nil {
+			continue
+		}
+		if err := r.resolveComponent(ctx, name, c); err != nil {
+			return nil, err
+		}
+	}
+
+	images := requestedImages(env)
+	for i := range images {
+		img := &images[i].Image
+		if r.Images == nil {
+			return nil, fmt.Errorf("no image resolver for %s nodes", img.Node)
+		}
+		id, arch, err := r.Images.ResolveImage(ctx, img.Region, img.OS, img.Arch, images[i].instanceType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve image of %s nodes: %w", img.Node, err)
+		}
+		img.ImageID, img.Arch = id, arch
+	}
+
+	l := &Lock{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.GroupVersion.String(),
+			Kind:       Kind,
+		},
+		ResolvedAt: metav1.NewTime(time.Now().UTC()),
+		Components: components,
+	}
+	for _, img := range images {
+		l.Images = append(l.Images, img.Image)
+	}
+	return l, nil
+}
+
+// resolveComponent resolves the moving reference of a requested component,
+// if it has one. It fails for a runtime or toolkit package without a
+// version, which would install whatever is newest when the lock is
+// applied.
+func (r Resolvers) resolveComponent(ctx context.Context, name string, c *Component) error {
+	switch {
+	case c.Source == "git" || c.Source == "latest":
+		if r.Git == nil {
+			return fmt.Errorf("no git resolver for %s", name)
+		}
+		commit, _, err := r.Git.Resolve(ctx, c.Repo, c.Ref)
+		if err != nil {
+			return fmt.Errorf("failed to resolve %s ref %s: %w", name, c.Ref, err)
+		}
+		c.Commit = commit
+	case name == "driver" && c.Source == "package" && c.Version == "":
+		if r.Drivers == nil {
+			return fmt.Errorf("no driver resolver for branch %s", c.Branch)
+		}
+		version, err := r.Drivers.ResolveBranch(ctx, c.Branch)
+		if err != nil {
+			return fmt.Errorf("failed to resolve driver branch %s: %w", c.Branch, err)
+		}
+		c.ResolvedVersion = version
+	case c.Source == "package" && (c.Version == "" || c.Version == "latest"):
+		// The runtime and toolkit repositories differ per distribution,
+		// so an unpinned package cannot be resolved to the version the
+		// nodes would install.
+		return fmt.Errorf("%s package version is not pinned: set package.version in the environment to lock it", name)
+	}
+	return nil
+}
+
+// componentNames lists the lockable components in a fixed order.
+var componentNames = []string{"driver", "runtime", "toolkit", "kubernetes"}
+
+// field returns the named component field.
+func (c *Components) field(name string) **Component {
+	switch name {
+	case "driver":
+		return &c.Driver
+	case "runtime":
+		return &c.Runtime
+	case "toolkit":
+		return &c.Toolkit
+	default:
+		return &c.Kubernetes
+	}
+}
+
+// requestedComponents returns the components env installs as requested,
+// with the defaults the provisioner applies filled in.
+func requestedComponents(env v1alpha1.Environment) (Components, error) {
+	var c Components
+
+	if env.Spec.NVIDIADriver.Install {
+		nvd, err := templates.NewNvDriver(env)
+		if err != nil {
+			return c, err
+		}
+		// A runfile is pinned by its URL and checksum
+		c.Driver = &Component{Source: nvd.Source}
+		switch nvd.Source {
+		case "package":
+			c.Driver.Branch, c.Driver.Version = nvd.Branch, nvd.Version
+		case "git":
+			c.Driver.Repo, c.Driver.Ref = nvd.GitRepo, nvd.GitRef
+		}
+	}
+
+	if env.Spec.ContainerRuntime.Install {
+		var err error
+		switch env.Spec.ContainerRuntime.Name {
+		case v1alpha1.ContainerRuntimeDocker:
+			var d *templates.Docker
+			if d, err = templates.NewDocker(env); err == nil {
+				c.Runtime = sourceComponent(d.Source, d.Version, d.GitRepo, d.GitRef, "")
+			}
+		case v1alpha1.ContainerRuntimeCrio:
+			var cr *templates.CriO
+			if cr, err = templates.NewCriO(env); err == nil {
+				c.Runtime = sourceComponent(cr.Source, cr.Version, cr.GitRepo, cr.GitRef, "")
+			}
+		default:
+			var cd *templates.Containerd
+			if cd, err = templates.NewContainerd(env); err == nil {
+				c.Runtime = sourceComponent(cd.Source, cd.Version, cd.GitRepo, cd.GitRef, cd.TrackBranch)
+			}
+		}
+		if err != nil {
+			return c, err
+		}
+	}
+
+	if env.Spec.NVIDIAContainerToolkit.Install {
+		ctk, err := templates.NewContainerToolkit(env)
+		if err != nil {
+			return c, err
+		}
+		c.Toolkit = sourceComponent(ctk.Source, ctk.Version, ctk.GitRepo, ctk.GitRef, ctk.TrackBranch)
+	}
+
+	if env.Spec.Kubernetes.Install {
+		k8s, err := templates.NewKubernetes(env)
+		if err != nil {
+			return c, err
+		}
+		c.Kubernetes = sourceComponent(k8s.Source, k8s.Version, k8s.GitRepo, k8s.GitRef, k8s.TrackBranch)
+	}
+
+	return c, nil
+}
+
+// sourceComponent returns the component of a package, release, git or
+// latest source.
+func sourceComponent(source, version, repo, ref, track string) *Component {
+	switch source {
+	case "git":
+		return &Component{Source: source, Repo: repo, Ref: ref}
+	case "latest":
+		return &Component{Source: source, Repo: repo, Ref: track}
+	default:
+		return &Component{Source: source, Version: version}
+	}
+}
+
+// imageTarget is an image to resolve, along with the instance type its
+// architecture is inferred from.
+type imageTarget struct {
+	Image
+	instanceType string
+}
+
+// requestedImages returns the images env launches nodes from that are not
+// already pinned to an image ID. Only AWS resolves images.
+func requestedImages(env v1alpha1.Environment) []imageTarget {
+	if env.Spec.Provider != v1alpha1.ProviderAWS && env.Spec.Provider != "" {
+		return nil
+	}
+
+	//nolint:staticcheck // Instance is embedded but explicit access is clearer
+	instanceOS := env.Spec.Instance.OS
+	target := func(node, region, os string, image *v1alpha1.Image, instanceType string) []imageTarget {
+		if image != nil && image.ImageId != nil && *image.ImageId != "" {
+			return nil
+		}
+		if os == "" {
+			os = instanceOS
+		}
+		if os == "" {
+			os = defaultOS
+		}
+		t := imageTarget{
+			Image:        Image{Node: node, Region: region, OS: os},
+			instanceType: instanceType,
+		}
+		if image != nil {
+			t.Arch = image.Architecture
+		}
+		return []imageTarget{t}
+	}
+
+	cluster := env.Spec.Cluster
+	if cluster == nil {
+		//nolint:staticcheck // Instance is embedded but explicit access is clearer
+		return target("instance", env.Spec.Instance.Region, "", &env.Spec.Image, env.Spec.Instance.Type)
+	}
+
+	cp := cluster.ControlPlane
+	targets := target("control-plane", cluster.Region, cp.OS, cp.Image, cp.InstanceType)
+	if cluster.ExternalEtcd() {
+		etcd := cluster.Etcd
+		if etcd == nil {
+			etcd = &v1alpha1.EtcdPoolSpec{}
+		}
+		targets = append(targets, target("etcd", cluster.Region, etcd.OS, etcd.Image, etcd.InstanceType)...)
+	}
+	for _, w := range cluster.WorkerPoolList() {
+		targets = append(targets, target(workerNode(w.Name), cluster.Region, w.OS, w.Image, w.InstanceType)...)
+	}
+	return targets
+}
+
+// workerNode returns the image node name of a worker pool.
+func workerNode(pool string) string {
+	if pool == "" {
+		return "worker"
+	}
+	return "worker/" + pool
+}
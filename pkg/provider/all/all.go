@@ -0,0 +1,30 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package all registers every built-in provider backend. Import it for its
+// side effects wherever providers are resolved through the registry:
+//
+//	import _ "github.com/NVIDIA/holodeck/pkg/provider/all"
+package all
+
+import (
+	// Built-in provider backends
+	_ "github.com/NVIDIA/holodeck/pkg/provider/aws"
+	_ "github.com/NVIDIA/holodeck/pkg/provider/gcp"
+	_ "github.com/NVIDIA/holodeck/pkg/provider/plugin"
+	_ "github.com/NVIDIA/holodeck/pkg/provider/ssh"
+	_ "github.com/NVIDIA/holodeck/pkg/provider/vsphere"
+)
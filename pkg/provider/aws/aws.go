@@ -56,8 +56,33 @@ const (
 	WorkerSecurityGroupID string = "worker-security-group-id"
 	EIPAllocationID       string = "eip-allocation-id"
 	IAMInstanceProfileArn string = "iam-instance-profile-arn"
+
+	// InstanceMarket records the purchasing option the instances were
+	// launched with: "spot", "on-demand", or "mixed" for clusters whose
+	// nodes ended up on both.
+	InstanceMarket string = "instance-market"
+
+	// LaunchedInstanceType and AvailabilityZone record the instance type and
+	// zone the single-node instance was actually launched with, which may be
+	// one of the spec.instance fallbacks.
+	LaunchedInstanceType string = "instance-type"
+	AvailabilityZone     string = "availability-zone"
+
+	// AdoptedResourceIDs lists the pre-existing resources from spec.network
+	// that the environment uses but never deletes; OwnedResourceIDs lists
+	// the resources holodeck created. Both are comma-separated.
+	AdoptedResourceIDs string = "adopted-resources"
+	OwnedResourceIDs   string = "owned-resources"
+
+	// PlacementGroupNames lists the placement groups holodeck created, and
+	// deletes with the environment. It is comma-separated.
+	PlacementGroupNames string = "placement-groups"
 )
 
+// marketMixed is the InstanceMarket value of a cluster with both spot and
+// on-demand nodes.
+const marketMixed = "mixed"
+
 var (
 	description string = "Holodeck managed AWS Cloud Provider"
 )
@@ -91,6 +116,18 @@ type AWS struct {
 	WorkerSecurityGroupid string
 	EIPAllocationid       string
 	IAMInstanceProfileArn string
+
+	InstanceMarket   string
+	InstanceType     string
+	AvailabilityZone string
+
+	// AdoptedResources are the IDs of pre-existing resources, see
+	// AdoptedResourceIDs
+	AdoptedResources []string
+
+	// PlacementGroups are the names of the placement groups holodeck
+	// created, see PlacementGroupNames
+	PlacementGroups []string
 }
 
 type Provider struct {
@@ -154,6 +191,31 @@ func WithAMIResolver(resolver *ami.Resolver) Option {
 // such as injecting a mock EC2 client for testing.
 func New(log *logger.FunLogger, env v1alpha1.Environment, cacheFile string,
 	opts ...Option) (*Provider, error) {
+	if err := env.Spec.ValidateMarket(); err != nil {
+		return nil, err
+	}
+	if err := env.Spec.Network.Validate(); err != nil {
+		return nil, err
+	}
+	if err := env.Spec.ValidateFallbacks(); err != nil {
+		return nil, err
+	}
+	if err := env.Spec.ValidatePlacement(); err != nil {
+		return nil, err
+	}
+	if err := env.Spec.ValidateExpiry(); err != nil {
+		return nil, err
+	}
+	if err := env.Spec.ValidateLoadBalancer(); err != nil {
+		return nil, err
+	}
+	if err := env.Spec.ValidateEtcd(); err != nil {
+		return nil, err
+	}
+	if err := env.Spec.ValidateWorkerPools(); err != nil {
+		return nil, err
+	}
+
 	// Create an AWS session and configure the EC2 client
 	// For cluster deployments, use cluster region; otherwise use instance region
 	var region string
@@ -198,6 +260,13 @@ func New(log *logger.FunLogger, env v1alpha1.Environment, cacheFile string,
 		Environment: &env,
 		log:         log,
 	}
+	// A ttl was resolved into expiresAt when the environment was created
+	if env.Spec.ExpiresAt != nil {
+		p.Tags = append(p.Tags, types.Tag{
+			Key:   aws.String(internalaws.ExpiresAtTag),
+			Value: aws.String(internalaws.FormatExpiresAt(env.Spec.ExpiresAt.Time)),
+		})
+	}
 
 	// Apply functional options
 	for _, opt := range opts {
@@ -278,6 +347,16 @@ func (p *Provider) unmarsalCache() (*AWS, error) {
 			aws.EIPAllocationid = p.Value
 		case IAMInstanceProfileArn:
 			aws.IAMInstanceProfileArn = p.Value
+		case InstanceMarket:
+			aws.InstanceMarket = p.Value
+		case LaunchedInstanceType:
+			aws.InstanceType = p.Value
+		case AvailabilityZone:
+			aws.AvailabilityZone = p.Value
+		case AdoptedResourceIDs:
+			aws.AdoptedResources = splitResources(p.Value)
+		case PlacementGroupNames:
+			aws.PlacementGroups = splitResources(p.Value)
 		default:
 			// Ignore non AWS infra properties
 			continue
@@ -17,6 +17,7 @@
 package aws
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 
@@ -340,7 +341,7 @@ status:
 
 		It("should succeed when instance type is available", func() {
 			// The default catalog contains t3.medium.
-			err := provider.checkInstanceTypes()
+			err := provider.checkInstanceTypes(context.Background())
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -348,7 +349,7 @@ status:
 			// A catalog without t3.medium makes checkInstanceTypes reject it.
 			f.Store.SetInstanceTypeCatalog("t3.large", "t3.xlarge")
 
-			err := provider.checkInstanceTypes()
+			err := provider.checkInstanceTypes(context.Background())
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("not supported"))
 		})
@@ -399,7 +400,7 @@ status:
 					Architecture: types.ArchitectureValuesX8664,
 				})
 
-				err := provider.checkImages()
+				err := provider.checkImages(context.Background())
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
@@ -432,14 +433,14 @@ status:
 					Architecture: types.ArchitectureValuesX8664,
 				})
 
-				err := provider.checkImages()
+				err := provider.checkImages(context.Background())
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("should fail when specified image does not exist", func() {
 				f.Store.SetImages() // empty catalog
 
-				err := provider.checkImages()
+				err := provider.checkImages(context.Background())
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -486,7 +487,7 @@ status:
 				Architecture: types.ArchitectureValuesX8664,
 			})
 
-			err := provider.DryRun()
+			err := provider.DryRun(context.Background())
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -494,7 +495,7 @@ status:
 			// An empty catalog makes checkInstanceTypes reject t3.medium.
 			f.Store.SetInstanceTypeCatalog()
 
-			err := provider.DryRun()
+			err := provider.DryRun(context.Background())
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("not supported"))
 		})
@@ -503,7 +504,7 @@ status:
 			// t3.medium is valid, but the image describe fails.
 			f.Store.FailNext("DescribeImages", ErrMockDescribeImages)
 
-			err := provider.DryRun()
+			err := provider.DryRun(context.Background())
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to get images"))
 		})
@@ -548,7 +549,7 @@ status:
 				Expect(err).NotTo(HaveOccurred())
 
 				// setAMI returns early when an explicit ImageId is set.
-				err = provider.setAMI()
+				err = provider.setAMI(context.Background())
 				Expect(err).NotTo(HaveOccurred())
 				Expect(*provider.Environment.Spec.Instance.Image.ImageId).To(
 					Equal("ami-custom-12345"))
@@ -585,7 +586,7 @@ status:
 					},
 				)
 
-				err = provider.setAMI()
+				err = provider.setAMI(context.Background())
 				Expect(err).NotTo(HaveOccurred())
 				// Should select the latest image
 				Expect(*provider.Environment.Spec.Instance.Image.ImageId).To(
@@ -610,7 +611,7 @@ status:
 
 				f.Store.SetImages() // empty catalog
 
-				err = provider.setAMI()
+				err = provider.setAMI(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("no images found"))
 			})
@@ -658,7 +659,7 @@ status:
 					Values: []string{"ami-test"},
 				},
 			}
-			_, err := provider.describeImages(filter)
+			_, err := provider.describeImages(context.Background(), filter)
 			Expect(err).To(HaveOccurred())
 		})
 	})
@@ -18,6 +18,7 @@ package aws_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -489,7 +490,7 @@ status:
 			provider, err := aws.New(log, env, tmpFile, aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 			Expect(err).NotTo(HaveOccurred())
 
-			err = provider.DryRun()
+			err = provider.DryRun(context.Background())
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
@@ -582,7 +583,7 @@ status:
 				provider, err := aws.New(log, env, tmpFile, aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				conditions, err := provider.Status()
+				conditions, err := provider.Status(context.Background())
 				Expect(err).NotTo(HaveOccurred())
 				Expect(conditions).To(HaveLen(2))
 				Expect(conditions[0].Type).To(Equal("Available"))
@@ -611,7 +612,7 @@ status:
 				provider, err := aws.New(log, env, tmpFile, aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				conditions, err := provider.Status()
+				conditions, err := provider.Status(context.Background())
 				Expect(err).NotTo(HaveOccurred())
 				Expect(conditions).To(BeEmpty())
 			})
@@ -626,7 +627,7 @@ status:
 				provider, err := aws.New(log, env, "/nonexistent/cache.yaml", aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = provider.Status()
+				_, err = provider.Status(context.Background())
 				Expect(err).To(HaveOccurred())
 			})
 
@@ -643,7 +644,7 @@ status:
 				provider, err := aws.New(log, env, tmpFile, aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = provider.Status()
+				_, err = provider.Status(context.Background())
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -810,7 +811,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Create()
+				err = provider.Create(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("error creating VPC"))
 			})
@@ -839,7 +840,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Create()
+				err = provider.Create(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("error creating VPC"))
 			})
@@ -870,7 +871,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Create()
+				err = provider.Create(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("error creating subnet"))
 			})
@@ -901,7 +902,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Create()
+				err = provider.Create(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring(
 					"error creating Internet Gateway"))
@@ -931,7 +932,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Create()
+				err = provider.Create(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring(
 					"error creating Internet Gateway"))
@@ -963,7 +964,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Create()
+				err = provider.Create(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("error creating route table"))
 			})
@@ -992,7 +993,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Create()
+				err = provider.Create(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("error creating route table"))
 			})
@@ -1021,7 +1022,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Create()
+				err = provider.Create(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("error creating route table"))
 			})
@@ -1052,7 +1053,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Create()
+				err = provider.Create(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring(
 					"error creating security group"))
@@ -1083,7 +1084,7 @@ spec:
 						aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 					Expect(err).NotTo(HaveOccurred())
 
-					err = provider.Create()
+					err = provider.Create(context.Background())
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring(
 						"error creating security group"))
@@ -1115,7 +1116,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Create()
+				err = provider.Create(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring(
 					"error creating EC2 instance"))
@@ -1151,7 +1152,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Create()
+				err = provider.Create(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring(
 					"error creating EC2 instance"))
@@ -1188,7 +1189,7 @@ spec:
 				aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 			Expect(err).NotTo(HaveOccurred())
 
-			err = provider.DryRun()
+			err = provider.DryRun(context.Background())
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -1221,7 +1222,7 @@ spec:
 				aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 			Expect(err).NotTo(HaveOccurred())
 
-			err = provider.DryRun()
+			err = provider.DryRun(context.Background())
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("is not supported"))
 		})
@@ -1250,7 +1251,7 @@ spec:
 				aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 			Expect(err).NotTo(HaveOccurred())
 
-			err = provider.DryRun()
+			err = provider.DryRun(context.Background())
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("no images found"))
 		})
@@ -1281,7 +1282,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Delete()
+				err = provider.Delete(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("error retrieving cache"))
 			})
@@ -1312,7 +1313,7 @@ spec:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Delete()
+				err = provider.Delete(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("error retrieving cache"))
 			})
@@ -1354,7 +1355,7 @@ status:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.Delete()
+				err = provider.Delete(context.Background())
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
@@ -1390,7 +1391,7 @@ status:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.DryRun()
+				err = provider.DryRun(context.Background())
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -1424,7 +1425,7 @@ status:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.DryRun()
+				err = provider.DryRun(context.Background())
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -1451,7 +1452,7 @@ status:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.DryRun()
+				err = provider.DryRun(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("invalid architecture"))
 			})
@@ -1485,7 +1486,7 @@ status:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.DryRun()
+				err = provider.DryRun(context.Background())
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -1518,7 +1519,7 @@ status:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.DryRun()
+				err = provider.DryRun(context.Background())
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("is not supported"))
 			})
@@ -1556,7 +1557,7 @@ status:
 					aws.WithEC2Client(fake.EC2), aws.WithSleep(func(time.Duration) {}))
 				Expect(err).NotTo(HaveOccurred())
 
-				err = provider.DryRun()
+				err = provider.DryRun(context.Background())
 				Expect(err).NotTo(HaveOccurred())
 
 				// The custom owner-id must have been passed as a DescribeImages filter.
@@ -41,6 +41,8 @@ type ClusterCache struct {
 	ControlPlaneInstances []InstanceInfo
 	// WorkerInstances holds instance IDs for worker nodes
 	WorkerInstances []InstanceInfo
+	// EtcdInstances holds instance IDs for dedicated etcd nodes
+	EtcdInstances []InstanceInfo
 
 	// LoadBalancerArn is the ARN of the NLB for HA control plane
 	LoadBalancerArn string
@@ -48,6 +50,39 @@ type ClusterCache struct {
 	LoadBalancerDNS string
 	// TargetGroupArn is the ARN of the target group for the load balancer
 	TargetGroupArn string
+
+	// VpcCIDR and NLBSubnetCIDR are the CIDR blocks of an adopted VPC and
+	// subnet; empty when holodeck created the network.
+	VpcCIDR       string
+	NLBSubnetCIDR string
+}
+
+// vpcCIDRBlock returns the VPC CIDR used in security group rules.
+func (c *ClusterCache) vpcCIDRBlock() string {
+	if c.VpcCIDR != "" {
+		return c.VpcCIDR
+	}
+	return vpcCIDR
+}
+
+// nlbSubnetCIDRBlock returns the load balancer subnet CIDR used in security
+// group rules.
+func (c *ClusterCache) nlbSubnetCIDRBlock() string {
+	if c.NLBSubnetCIDR != "" {
+		return c.NLBSubnetCIDR
+	}
+	return nlbSubnetCIDR
+}
+
+// instanceIDs returns the IDs of all instances of the cluster.
+func (c *ClusterCache) instanceIDs() []string {
+	var ids []string
+	for _, group := range [][]InstanceInfo{c.EtcdInstances, c.ControlPlaneInstances, c.WorkerInstances} {
+		for _, inst := range group {
+			ids = append(ids, inst.InstanceID)
+		}
+	}
+	return ids
 }
 
 // InstanceInfo holds information about a single instance
@@ -57,9 +92,11 @@ type InstanceInfo struct {
 	PrivateIP        string
 	PublicDNS        string
 	NetworkInterface string
-	Role             string // "control-plane" or "worker"
+	Role             string // "control-plane", "worker" or "etcd"
 	Name             string
-	SSHUsername      string // SSH username for this node's OS (e.g., "ubuntu", "ec2-user")
+	SSHUsername      string              // SSH username for this node's OS (e.g., "ubuntu", "ec2-user")
+	Market           v1alpha1.MarketType // "spot" or "on-demand"
+	Pool             string              // worker pool name, if any
 }
 
 // NodeRole represents the role of a node in the cluster
@@ -68,6 +105,7 @@ type NodeRole string
 const (
 	NodeRoleControlPlane NodeRole = "control-plane"
 	NodeRoleWorker       NodeRole = "worker"
+	NodeRoleEtcd         NodeRole = "etcd"
 )
 
 // Port constants for Kubernetes cluster communication
@@ -89,94 +127,190 @@ const (
 
 // VPC and subnet CIDRs used in security group rules
 const (
-	vpcCIDR       = "10.0.0.0/16"
-	nlbSubnetCIDR = "10.0.1.0/24"
+	vpcCIDR           = "10.0.0.0/16"
+	nlbSubnetCIDR     = "10.0.1.0/24"
+	privateSubnetCIDR = "10.0.0.0/24"
 )
 
 // ec2APITimeout is the timeout for EC2 API calls
 const ec2APITimeout = 2 * time.Minute
 
+// defaultEtcdInstanceType is the instance type of dedicated etcd nodes when
+// spec.cluster.etcd.instanceType is not set
+const defaultEtcdInstanceType = "m5.large"
+
 // IsMultinode returns true if the environment is configured for multinode cluster
 func (p *Provider) IsMultinode() bool {
 	return p.Spec.Cluster != nil
 }
 
 // CreateCluster creates a multinode cluster with the specified configuration
-func (p *Provider) CreateCluster() error {
+func (p *Provider) CreateCluster(ctx context.Context) error {
 	if !p.IsMultinode() {
 		return fmt.Errorf("cluster spec not defined, use Create() for single-node")
 	}
 
 	// Validate instance type before creating any resources (same rationale as Create())
-	if err := p.checkInstanceTypes(); err != nil {
+	if err := p.checkInstanceTypes(ctx); err != nil {
 		return fmt.Errorf("pre-flight check failed: %w", err)
 	}
 
 	cache := &ClusterCache{}
+	var cleanupStack []cleanupFunc
+	var err error
 
-	_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Creating multinode cluster resources")
+	// Roll back on failure the same way Create does, detached from ctx so
+	// that an interrupted create still cleans up
+	defer func() {
+		if err != nil {
+			if ctx.Err() != nil {
+				p.log.Warning("Creation interrupted, rolling back created resources...")
+			} else {
+				p.log.Warning("Creation failed, rolling back created resources...")
+			}
+			cleanupCtx := context.WithoutCancel(ctx)
+			for i := len(cleanupStack) - 1; i >= 0; i-- {
+				if cleanupErr := cleanupStack[i](cleanupCtx); cleanupErr != nil {
+					p.log.Warning("Cleanup failed: %v", cleanupErr)
+				}
+			}
+		}
+	}()
 
-	// Phase 1: Create VPC and networking (reuse existing functions)
-	if err := p.createVPC(&cache.AWS); err != nil {
-		_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating VPC")
-		return fmt.Errorf("error creating VPC: %w", err)
-	}
-	_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "VPC created")
+	_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Creating multinode cluster resources")
 
-	if err := p.createSubnet(&cache.AWS); err != nil {
-		_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating subnet")
-		return fmt.Errorf("error creating subnet: %w", err)
-	}
-	_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Subnet created")
+	if p.Spec.Network != nil {
+		// Pre-existing network: instances and the NLB go into the adopted
+		// subnet, which is expected to provide internet access.
+		var vpcBlock, subnetBlock string
+		vpcBlock, subnetBlock, err = p.adoptNetwork(ctx, &cache.AWS)
+		if err != nil {
+			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error adopting network")
+			return fmt.Errorf("error adopting network: %w", err)
+		}
+		cache.PublicSubnetid = cache.Subnetid
+		cache.VpcCIDR = vpcBlock
+		cache.NLBSubnetCIDR = subnetBlock
+		_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Network adopted")
+	} else {
+		// Phase 1: Create VPC and networking (reuse existing functions)
+		if err = p.createVPC(ctx, &cache.AWS); err != nil {
+			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating VPC")
+			return fmt.Errorf("error creating VPC: %w", err)
+		}
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			return p.deleteVPC(ctx, &AWS{Vpcid: cache.Vpcid})
+		})
+		_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "VPC created")
 
-	if err := p.createInternetGateway(&cache.AWS); err != nil {
-		_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating Internet Gateway")
-		return fmt.Errorf("error creating Internet Gateway: %w", err)
-	}
-	_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Internet Gateway created")
+		if err = p.createSubnet(ctx, &cache.AWS); err != nil {
+			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating subnet")
+			return fmt.Errorf("error creating subnet: %w", err)
+		}
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			return p.deleteSubnet(ctx, &AWS{Subnetid: cache.Subnetid})
+		})
+		_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Subnet created")
 
-	// Phase 1b: Create public subnet and route table for cluster instances.
-	// Cluster instances are placed in the public subnet with AssociatePublicIpAddress=true,
-	// so they have direct internet access via the IGW. NAT Gateway and private route table
-	// are NOT needed — skipping them avoids consuming scarce EIP quota (AWS limit: 5 per
-	// region), which caused CI failures when multiple jobs ran concurrently.
-	// The private subnet (created above) is retained for future SSM endpoint use.
-	if err := p.createPublicSubnet(&cache.AWS); err != nil {
-		_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating public subnet")
-		return fmt.Errorf("error creating public subnet: %w", err)
-	}
-	_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Public subnet created")
+		if err = p.createInternetGateway(ctx, &cache.AWS); err != nil {
+			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating Internet Gateway")
+			return fmt.Errorf("error creating Internet Gateway: %w", err)
+		}
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			return p.deleteInternetGateway(ctx, &AWS{InternetGwid: cache.InternetGwid, Vpcid: cache.Vpcid})
+		})
+		_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Internet Gateway created")
+
+		// Phase 1b: Create public subnet and route table for cluster instances.
+		// Cluster instances are placed in the public subnet with AssociatePublicIpAddress=true,
+		// so they have direct internet access via the IGW. NAT Gateway and private route table
+		// are NOT needed — skipping them avoids consuming scarce EIP quota (AWS limit: 5 per
+		// region), which caused CI failures when multiple jobs ran concurrently.
+		// The private subnet (created above) is retained for future SSM endpoint use.
+		if err = p.createPublicSubnet(ctx, &cache.AWS); err != nil {
+			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating public subnet")
+			return fmt.Errorf("error creating public subnet: %w", err)
+		}
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			return p.deletePublicSubnet(ctx, &AWS{PublicSubnetid: cache.PublicSubnetid})
+		})
+		_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Public subnet created")
 
-	if err := p.createPublicRouteTable(&cache.AWS); err != nil {
-		_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating public route table")
-		return fmt.Errorf("error creating public route table: %w", err)
+		if err = p.createPublicRouteTable(ctx, &cache.AWS); err != nil {
+			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating public route table")
+			return fmt.Errorf("error creating public route table: %w", err)
+		}
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			// The subnet association blocks the route table deletion, so
+			// delete the public subnet first, as Delete does
+			if err := p.deletePublicSubnet(ctx, &AWS{PublicSubnetid: cache.PublicSubnetid}); err != nil {
+				return err
+			}
+			return p.deletePublicRouteTable(ctx, &AWS{PublicRouteTable: cache.PublicRouteTable})
+		})
+		_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Public route table created")
 	}
-	_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Public route table created")
 
-	// Phase 2: Create separate CP and Worker security groups
-	if err := p.createControlPlaneSecurityGroup(cache); err != nil {
-		_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating control-plane security group")
-		return fmt.Errorf("error creating control-plane security group: %w", err)
-	}
-	_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Control-plane Security Group created")
+	// Phase 2: Create separate CP and Worker security groups, unless the
+	// instances use adopted ones
+	if !p.adoptsSecurityGroups() {
+		// The worker group references the control-plane group, so both are
+		// deleted together once they exist
+		err = p.createControlPlaneSecurityGroup(ctx, cache)
+		if cache.CPSecurityGroupid != "" {
+			cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+				return p.deleteSecurityGroups(ctx, &AWS{
+					CPSecurityGroupid:     cache.CPSecurityGroupid,
+					WorkerSecurityGroupid: cache.WorkerSecurityGroupid,
+				})
+			})
+		}
+		if err != nil {
+			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating control-plane security group")
+			return fmt.Errorf("error creating control-plane security group: %w", err)
+		}
+		_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Control-plane Security Group created")
 
-	if err := p.createWorkerSecurityGroup(cache); err != nil {
-		_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating worker security group")
-		return fmt.Errorf("error creating worker security group: %w", err)
+		if err = p.createWorkerSecurityGroup(ctx, cache); err != nil {
+			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating worker security group")
+			return fmt.Errorf("error creating worker security group: %w", err)
+		}
+		_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Worker Security Group created")
 	}
-	_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Worker Security Group created")
 
 	// Phase 3: Create load balancer for HA (if enabled)
 	if p.isHAEnabled() {
-		if err := p.createLoadBalancer(cache); err != nil {
+		err = p.createLoadBalancer(ctx, cache)
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			return p.deleteNLB(ctx, cache)
+		})
+		if err != nil {
 			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating load balancer")
 			return fmt.Errorf("error creating load balancer: %w", err)
 		}
 		_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Load Balancer created")
 	}
 
-	// Phase 4: Create control-plane instances
-	if err := p.createControlPlaneInstances(cache); err != nil {
+	// Instances may be launched even when their phase fails, and they are
+	// terminated before the placement groups they joined are deleted
+	cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+		return p.deletePlacementGroups(ctx, &AWS{PlacementGroups: cache.PlacementGroups})
+	})
+	cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+		return p.terminateInstances(ctx, &cache.AWS, cache.instanceIDs())
+	})
+
+	// Phase 4a: Create dedicated etcd instances (external etcd topology)
+	if p.Spec.Cluster.ExternalEtcd() {
+		if err = p.createEtcdInstances(ctx, cache); err != nil {
+			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating etcd instances")
+			return fmt.Errorf("error creating etcd instances: %w", err)
+		}
+		_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Etcd instances created")
+	}
+
+	// Phase 4b: Create control-plane instances
+	if err = p.createControlPlaneInstances(ctx, cache); err != nil {
 		_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating control-plane instances")
 		return fmt.Errorf("error creating control-plane instances: %w", err)
 	}
@@ -184,15 +318,15 @@ func (p *Provider) CreateCluster() error {
 
 	// Phase 5: Register control-plane instances with load balancer (if HA)
 	if p.isHAEnabled() {
-		if err := p.registerTargets(cache); err != nil {
+		if err = p.registerTargets(ctx, cache); err != nil {
 			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error registering targets")
 			return fmt.Errorf("error registering load balancer targets: %w", err)
 		}
 	}
 
 	// Phase 6: Create worker instances (if any)
-	if p.Spec.Cluster.Workers != nil && p.Spec.Cluster.Workers.Count > 0 {
-		if err := p.createWorkerInstances(cache); err != nil {
+	if p.Spec.Cluster.WorkerCount() > 0 {
+		if err = p.createWorkerInstances(ctx, cache); err != nil {
 			_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error creating worker instances")
 			return fmt.Errorf("error creating worker instances: %w", err)
 		}
@@ -200,14 +334,14 @@ func (p *Provider) CreateCluster() error {
 	}
 
 	// Phase 7: Disable Source/Destination Check on all instances (required for Calico)
-	if err := p.disableSourceDestCheck(cache); err != nil {
+	if err = p.disableSourceDestCheck(ctx, cache); err != nil {
 		_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Error disabling source/dest check")
 		return fmt.Errorf("error disabling source/destination check: %w", err)
 	}
 	_ = p.updateProgressingCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Creating", "Source/Destination Check disabled")
 
 	// Update status with cluster information
-	if err := p.updateClusterStatus(cache); err != nil {
+	if err = p.updateClusterStatus(cache); err != nil {
 		return fmt.Errorf("error updating cluster status: %w", err)
 	}
 
@@ -232,7 +366,7 @@ func (p *Provider) getCallerIPRanges() ([]types.IpRange, error) {
 
 // createControlPlaneSecurityGroup creates a security group for control-plane nodes
 // with least-privilege rules: etcd restricted to CP, scheduler/controller-manager self-only
-func (p *Provider) createControlPlaneSecurityGroup(cache *ClusterCache) error {
+func (p *Provider) createControlPlaneSecurityGroup(ctx context.Context, cache *ClusterCache) error {
 	cancelLoading := p.log.Loading("Creating control-plane security group")
 
 	sgInput := &ec2.CreateSecurityGroupInput{
@@ -247,7 +381,7 @@ func (p *Provider) createControlPlaneSecurityGroup(cache *ClusterCache) error {
 		},
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), ec2APITimeout)
+	ctx, cancel := context.WithTimeout(ctx, ec2APITimeout)
 	defer cancel()
 	sgOutput, err := p.ec2.CreateSecurityGroup(ctx, sgInput)
 	if err != nil {
@@ -266,6 +400,15 @@ func (p *Provider) createControlPlaneSecurityGroup(cache *ClusterCache) error {
 
 	cpSGRef := []types.UserIdGroupPair{{GroupId: aws.String(cache.CPSecurityGroupid)}}
 
+	apiRanges := append(callerRanges,
+		types.IpRange{CidrIp: aws.String(cache.nlbSubnetCIDRBlock())},
+	)
+	if p.internalLoadBalancer() && p.Spec.Network == nil {
+		// An internal NLB health-checks the API servers from the private
+		// subnet
+		apiRanges = append(apiRanges, types.IpRange{CidrIp: aws.String(privateSubnetCIDR)})
+	}
+
 	// CP SG rules — worker SG cross-references are added after worker SG is created
 	permissions := []types.IpPermission{
 		// SSH from caller IP
@@ -280,9 +423,7 @@ func (p *Provider) createControlPlaneSecurityGroup(cache *ClusterCache) error {
 			FromPort:   aws.Int32(portK8sAPI),
 			ToPort:     aws.Int32(portK8sAPI),
 			IpProtocol: aws.String("tcp"),
-			IpRanges: append(callerRanges,
-				types.IpRange{CidrIp: aws.String(nlbSubnetCIDR)},
-			),
+			IpRanges:   apiRanges,
 		},
 		// etcd client+peer: CP self only
 		{
@@ -338,7 +479,7 @@ func (p *Provider) createControlPlaneSecurityGroup(cache *ClusterCache) error {
 			FromPort:   aws.Int32(-1),
 			ToPort:     aws.Int32(-1),
 			IpProtocol: aws.String("icmp"),
-			IpRanges:   []types.IpRange{{CidrIp: aws.String(vpcCIDR)}},
+			IpRanges:   []types.IpRange{{CidrIp: aws.String(cache.vpcCIDRBlock())}},
 		},
 	}
 
@@ -347,7 +488,7 @@ func (p *Provider) createControlPlaneSecurityGroup(cache *ClusterCache) error {
 		IpPermissions: permissions,
 	}
 
-	ctxIngress, cancelIngress := context.WithTimeout(context.Background(), ec2APITimeout)
+	ctxIngress, cancelIngress := context.WithTimeout(ctx, ec2APITimeout)
 	defer cancelIngress()
 	if _, err = p.ec2.AuthorizeSecurityGroupIngress(ctxIngress, irInput); err != nil {
 		cancelLoading(logger.ErrLoadingFailed)
@@ -360,7 +501,7 @@ func (p *Provider) createControlPlaneSecurityGroup(cache *ClusterCache) error {
 
 // createWorkerSecurityGroup creates a security group for worker nodes
 // with least-privilege rules: kubelet from CP only, NodePort open to all
-func (p *Provider) createWorkerSecurityGroup(cache *ClusterCache) error {
+func (p *Provider) createWorkerSecurityGroup(ctx context.Context, cache *ClusterCache) error {
 	cancelLoading := p.log.Loading("Creating worker security group")
 
 	if cache.CPSecurityGroupid == "" {
@@ -380,7 +521,7 @@ func (p *Provider) createWorkerSecurityGroup(cache *ClusterCache) error {
 		},
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), ec2APITimeout)
+	ctx, cancel := context.WithTimeout(ctx, ec2APITimeout)
 	defer cancel()
 	sgOutput, err := p.ec2.CreateSecurityGroup(ctx, sgInput)
 	if err != nil {
@@ -458,7 +599,7 @@ func (p *Provider) createWorkerSecurityGroup(cache *ClusterCache) error {
 			FromPort:   aws.Int32(-1),
 			ToPort:     aws.Int32(-1),
 			IpProtocol: aws.String("icmp"),
-			IpRanges:   []types.IpRange{{CidrIp: aws.String(vpcCIDR)}},
+			IpRanges:   []types.IpRange{{CidrIp: aws.String(cache.vpcCIDRBlock())}},
 		},
 	}
 
@@ -468,7 +609,7 @@ func (p *Provider) createWorkerSecurityGroup(cache *ClusterCache) error {
 		IpPermissions: workerPermissions,
 	}
 
-	ctxIngress, cancelIngress := context.WithTimeout(context.Background(), ec2APITimeout)
+	ctxIngress, cancelIngress := context.WithTimeout(ctx, ec2APITimeout)
 	defer cancelIngress()
 	if _, err = p.ec2.AuthorizeSecurityGroupIngress(ctxIngress, irInput); err != nil {
 		cancelLoading(logger.ErrLoadingFailed)
@@ -520,7 +661,7 @@ func (p *Provider) createWorkerSecurityGroup(cache *ClusterCache) error {
 		IpPermissions: cpCrossRefs,
 	}
 
-	ctxCross, cancelCross := context.WithTimeout(context.Background(), ec2APITimeout)
+	ctxCross, cancelCross := context.WithTimeout(ctx, ec2APITimeout)
 	defer cancelCross()
 	if _, err = p.ec2.AuthorizeSecurityGroupIngress(ctxCross, cpCrossInput); err != nil {
 		cancelLoading(logger.ErrLoadingFailed)
@@ -532,23 +673,29 @@ func (p *Provider) createWorkerSecurityGroup(cache *ClusterCache) error {
 }
 
 // createControlPlaneInstances creates control-plane instances
-func (p *Provider) createControlPlaneInstances(cache *ClusterCache) error {
+func (p *Provider) createControlPlaneInstances(ctx context.Context, cache *ClusterCache) error {
 	cpSpec := p.Spec.Cluster.ControlPlane
 	count := int(cpSpec.Count)
 
 	cancel := p.log.Loading("Creating %d control-plane instance(s)", count)
 
-	instances, err := p.createInstances(
+	instances, err := p.createInstances(ctx,
 		cache, count, NodeRoleControlPlane,
 		cpSpec.InstanceType, cpSpec.RootVolumeSizeGB,
 		cpSpec.OS, cpSpec.Image,
+		launchOptions{
+			market:              cpSpec.Market,
+			spotMaxPrice:        cpSpec.SpotMaxPrice,
+			capacityReservation: cpSpec.CapacityReservation,
+			placementGroup:      cpSpec.PlacementGroup,
+		},
 	)
+	cache.ControlPlaneInstances = instances
 	if err != nil {
 		cancel(logger.ErrLoadingFailed)
 		return err
 	}
 
-	cache.ControlPlaneInstances = instances
 	// Set the first control-plane as the primary instance for backward compatibility
 	if len(instances) > 0 {
 		cache.Instanceid = instances[0].InstanceID
@@ -559,33 +706,78 @@ func (p *Provider) createControlPlaneInstances(cache *ClusterCache) error {
 	return nil
 }
 
-// createWorkerInstances creates worker instances
-func (p *Provider) createWorkerInstances(cache *ClusterCache) error {
-	wSpec := p.Spec.Cluster.Workers
-	if wSpec == nil || wSpec.Count == 0 {
-		return nil
+// createWorkerInstances creates the instances of every worker pool
+func (p *Provider) createWorkerInstances(ctx context.Context, cache *ClusterCache) error {
+	for _, wSpec := range p.Spec.Cluster.WorkerPoolList() {
+		if wSpec.Count == 0 {
+			continue
+		}
+
+		count := int(wSpec.Count)
+		what := fmt.Sprintf("%d worker instance(s)", count)
+		if wSpec.Name != "" {
+			what += " in pool " + wSpec.Name
+		}
+		cancel := p.log.Loading("Creating %s", what)
+
+		instances, err := p.createInstances(ctx,
+			cache, count, NodeRoleWorker,
+			wSpec.InstanceType, wSpec.RootVolumeSizeGB,
+			wSpec.OS, wSpec.Image,
+			launchOptions{
+				pool:                wSpec.Name,
+				market:              wSpec.Market,
+				spotMaxPrice:        wSpec.SpotMaxPrice,
+				capacityReservation: wSpec.CapacityReservation,
+				placementGroup:      wSpec.PlacementGroup,
+			},
+		)
+		cache.WorkerInstances = append(cache.WorkerInstances, instances...)
+		if err != nil {
+			cancel(logger.ErrLoadingFailed)
+			return err
+		}
+
+		cancel(nil)
+	}
+	return nil
+}
+
+// createEtcdInstances creates the dedicated etcd instances of an external
+// etcd topology. They share the control-plane security group, whose etcd
+// client and peer rule admits members of the group only.
+func (p *Provider) createEtcdInstances(ctx context.Context, cache *ClusterCache) error {
+	eSpec := p.Spec.Cluster.Etcd
+	if eSpec == nil {
+		eSpec = &v1alpha1.EtcdPoolSpec{}
+	}
+	instanceType := eSpec.InstanceType
+	if instanceType == "" {
+		instanceType = defaultEtcdInstanceType
 	}
 
-	count := int(wSpec.Count)
-	cancel := p.log.Loading("Creating %d worker instance(s)", count)
+	count := int(p.Spec.Cluster.EtcdCount())
+	cancel := p.log.Loading("Creating %d etcd instance(s)", count)
 
-	instances, err := p.createInstances(
-		cache, count, NodeRoleWorker,
-		wSpec.InstanceType, wSpec.RootVolumeSizeGB,
-		wSpec.OS, wSpec.Image,
+	instances, err := p.createInstances(ctx,
+		cache, count, NodeRoleEtcd,
+		instanceType, eSpec.RootVolumeSizeGB,
+		eSpec.OS, eSpec.Image,
+		launchOptions{},
 	)
+	cache.EtcdInstances = instances
 	if err != nil {
 		cancel(logger.ErrLoadingFailed)
 		return err
 	}
 
-	cache.WorkerInstances = instances
 	cancel(nil)
 	return nil
 }
 
-// createInstances creates multiple EC2 instances with the specified role
-func (p *Provider) createInstances(
+// createInstances creates multiple EC2 instances with the specified role. On
+// error it also returns the instances that were launched.
+func (p *Provider) createInstances(ctx context.Context,
 	cache *ClusterCache,
 	count int,
 	role NodeRole,
@@ -593,7 +785,20 @@ func (p *Provider) createInstances(
 	rootVolumeSizeGB *int32,
 	os string,
 	image *v1alpha1.Image,
+	opts launchOptions,
 ) ([]InstanceInfo, error) {
+	market, spotMaxPrice := p.marketFor(opts.market, opts.spotMaxPrice)
+
+	reservation, pg, defaultGroupName := p.placementFor(role, opts)
+	var placement *types.Placement
+	if pg != nil {
+		name, err := p.ensurePlacementGroup(ctx, &cache.AWS, pg, defaultGroupName)
+		if err != nil {
+			return nil, err
+		}
+		placement = &types.Placement{GroupName: aws.String(name)}
+	}
+
 	// Resolve AMI for this node pool
 	// Determine architecture: prefer explicit spec, then infer from instance type
 	var arch string
@@ -601,7 +806,7 @@ func (p *Provider) createInstances(
 		arch = image.Architecture
 	} else {
 		// Infer architecture from instance type (e.g., arm64 for g5g/m7g/c7g)
-		inferred, err := p.inferArchFromInstanceType(instanceType)
+		inferred, err := p.inferArchFromInstanceType(ctx, instanceType)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"failed to infer architecture from instance type %s: %w; set spec.image.architecture explicitly to override",
@@ -611,7 +816,7 @@ func (p *Provider) createInstances(
 		}
 		arch = inferred
 	}
-	resolved, err := p.resolveImageForNode(os, image, arch)
+	resolved, err := p.resolveImageForNode(ctx, os, image, arch)
 	if err != nil {
 		return nil, fmt.Errorf("error resolving AMI: %w", err)
 	}
@@ -631,7 +836,7 @@ func (p *Provider) createInstances(
 
 	// Query the AMI's root device name — different AMIs use different names
 	// (e.g., /dev/sda1 for Ubuntu/Rocky, /dev/xvda for Amazon Linux 2023)
-	rootDevice, err := p.describeImageRootDevice(imageID)
+	rootDevice, err := p.describeImageRootDevice(ctx, imageID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting root device name: %w", err)
 	}
@@ -649,8 +854,12 @@ func (p *Provider) createInstances(
 		wg.Add(1)
 		go func(index int) {
 			defer wg.Done()
+			index += opts.firstIndex
 
 			instanceName := fmt.Sprintf("%s-%s-%d", p.ObjectMeta.Name, role, index)
+			if opts.pool != "" {
+				instanceName = fmt.Sprintf("%s-%s-%s-%d", p.ObjectMeta.Name, role, opts.pool, index)
+			}
 			// Filter out the Name tag from p.Tags to avoid duplicates
 			var tags []types.Tag
 			for _, tag := range tagsCopy {
@@ -663,10 +872,13 @@ func (p *Provider) createInstances(
 				types.Tag{Key: aws.String("NodeIndex"), Value: aws.String(fmt.Sprintf("%d", index))},
 				types.Tag{Key: aws.String("Name"), Value: aws.String(instanceName)},
 			)
+			if opts.pool != "" {
+				tags = append(tags, types.Tag{Key: aws.String("Pool"), Value: aws.String(opts.pool)})
+			}
 
 			// Select security group based on node role
 			sgID := cache.SecurityGroupid // fallback for single-node
-			if role == NodeRoleControlPlane && cache.CPSecurityGroupid != "" {
+			if (role == NodeRoleControlPlane || role == NodeRoleEtcd) && cache.CPSecurityGroupid != "" {
 				sgID = cache.CPSecurityGroupid
 			} else if role == NodeRoleWorker && cache.WorkerSecurityGroupid != "" {
 				sgID = cache.WorkerSecurityGroupid
@@ -692,7 +904,7 @@ func (p *Provider) createInstances(
 						AssociatePublicIpAddress: aws.Bool(true),
 						DeleteOnTermination:      aws.Bool(true),
 						DeviceIndex:              aws.Int32(0),
-						Groups:                   []string{sgID},
+						Groups:                   p.securityGroupsFor(sgID),
 						SubnetId:                 aws.String(cache.PublicSubnetid),
 					},
 				},
@@ -703,41 +915,28 @@ func (p *Provider) createInstances(
 						Tags:         tags,
 					},
 				},
+				CapacityReservationSpecification: capacityReservationSpec(reservation),
+				Placement:                        placement,
 			}
 
-			ctxRun, cancelRun := context.WithTimeout(context.Background(), ec2APITimeout)
-			instanceOut, err := p.ec2.RunInstances(ctxRun, instanceIn)
-			cancelRun()
+			instanceID, launched, err := p.runInstance(ctx, instanceIn, market, spotMaxPrice)
 			if err != nil {
+				if instanceID != "" {
+					instancesChan <- InstanceInfo{InstanceID: instanceID, Role: string(role), Name: instanceName, Pool: opts.pool}
+				}
 				errorsChan <- fmt.Errorf("error creating instance %s: %w", instanceName, err)
 				return
 			}
 
-			instanceID := *instanceOut.Instances[0].InstanceId
-
-			// Wait for instance to be running
-			waiterOptions := []func(*ec2.InstanceRunningWaiterOptions){
-				func(o *ec2.InstanceRunningWaiterOptions) {
-					o.MaxDelay = 1 * time.Minute
-					o.MinDelay = 5 * time.Second
-				},
-			}
-			waiter := ec2.NewInstanceRunningWaiter(p.ec2, waiterOptions...)
-
-			ctxWait, cancelWait := context.WithTimeout(context.Background(), 5*time.Minute)
-			defer cancelWait()
-			if err = waiter.Wait(ctxWait, &ec2.DescribeInstancesInput{
-				InstanceIds: []string{instanceID},
-			}, 5*time.Minute, waiterOptions...); err != nil {
-				errorsChan <- fmt.Errorf("error waiting for instance %s: %w", instanceName, err)
-				return
-			}
-
 			// Get instance details
-			instanceRunning, err := p.ec2.DescribeInstances(context.Background(), &ec2.DescribeInstancesInput{
+			instanceRunning, err := p.ec2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
 				InstanceIds: []string{instanceID},
 			})
+			if err == nil && (len(instanceRunning.Reservations) == 0 || len(instanceRunning.Reservations[0].Instances) == 0) {
+				err = fmt.Errorf("instance %s not found", instanceID)
+			}
 			if err != nil {
+				instancesChan <- InstanceInfo{InstanceID: instanceID, Role: string(role), Name: instanceName, Pool: opts.pool}
 				errorsChan <- fmt.Errorf("error describing instance %s: %w", instanceName, err)
 				return
 			}
@@ -751,6 +950,8 @@ func (p *Provider) createInstances(
 				Role:        string(role),
 				Name:        instanceName,
 				SSHUsername: resolved.SSHUsername,
+				Market:      launched,
+				Pool:        opts.pool,
 			}
 
 			if len(inst.NetworkInterfaces) > 0 {
@@ -759,7 +960,7 @@ func (p *Provider) createInstances(
 
 			// Tag network interface
 			if info.NetworkInterface != "" {
-				ctxTags, cancelTags := context.WithTimeout(context.Background(), ec2APITimeout)
+				ctxTags, cancelTags := context.WithTimeout(ctx, ec2APITimeout)
 				_, err = p.ec2.CreateTags(ctxTags, &ec2.CreateTagsInput{
 					Resources: []string{info.NetworkInterface},
 					Tags:      tags,
@@ -778,19 +979,20 @@ func (p *Provider) createInstances(
 	close(instancesChan)
 	close(errorsChan)
 
+	// Collect instances, including those launched before an error so that
+	// the caller can terminate them
+	var instances []InstanceInfo
+	for info := range instancesChan {
+		instances = append(instances, info)
+	}
+
 	// Collect errors
 	var errs []error
 	for err := range errorsChan {
 		errs = append(errs, err)
 	}
 	if len(errs) > 0 {
-		return nil, fmt.Errorf("errors creating instances: %w", errors.Join(errs...))
-	}
-
-	// Collect instances
-	var instances []InstanceInfo
-	for info := range instancesChan {
-		instances = append(instances, info)
+		return instances, fmt.Errorf("errors creating instances: %w", errors.Join(errs...))
 	}
 
 	return instances, nil
@@ -799,7 +1001,7 @@ func (p *Provider) createInstances(
 // disableSourceDestCheck disables Source/Destination Check on all cluster instances
 // in parallel. This is required for Calico networking to work correctly.
 // See: https://github.com/NVIDIA/holodeck/issues/586
-func (p *Provider) disableSourceDestCheck(cache *ClusterCache) error {
+func (p *Provider) disableSourceDestCheck(ctx context.Context, cache *ClusterCache) error {
 	cancel := p.log.Loading("Disabling Source/Destination Check for Calico networking")
 
 	// Use explicit allocation to avoid potential slice backing array mutation
@@ -807,14 +1009,14 @@ func (p *Provider) disableSourceDestCheck(cache *ClusterCache) error {
 	allInstances = append(allInstances, cache.ControlPlaneInstances...)
 	allInstances = append(allInstances, cache.WorkerInstances...)
 
-	g, _ := errgroup.WithContext(context.Background())
+	g, _ := errgroup.WithContext(ctx)
 	for _, inst := range allInstances {
 		inst := inst // capture loop variable
 		if inst.NetworkInterface == "" {
 			continue
 		}
 		g.Go(func() error {
-			ctxMod, cancelMod := context.WithTimeout(context.Background(), ec2APITimeout)
+			ctxMod, cancelMod := context.WithTimeout(ctx, ec2APITimeout)
 			defer cancelMod()
 			_, err := p.ec2.ModifyNetworkInterfaceAttribute(ctxMod,
 				&ec2.ModifyNetworkInterfaceAttributeInput{
@@ -839,14 +1041,14 @@ func (p *Provider) disableSourceDestCheck(cache *ClusterCache) error {
 }
 
 // createLoadBalancer creates an NLB for HA control plane
-func (p *Provider) createLoadBalancer(cache *ClusterCache) error {
+func (p *Provider) createLoadBalancer(ctx context.Context, cache *ClusterCache) error {
 	// Create Network Load Balancer
-	if err := p.createNLB(cache); err != nil {
+	if err := p.createNLB(ctx, cache); err != nil {
 		return fmt.Errorf("error creating NLB: %w", err)
 	}
 
 	// Create target group for Kubernetes API (port 6443)
-	if err := p.createTargetGroup(cache); err != nil {
+	if err := p.createTargetGroup(ctx, cache); err != nil {
 		return fmt.Errorf("error creating target group: %w", err)
 	}
 
@@ -866,6 +1068,7 @@ func (p *Provider) updateClusterStatus(cache *ClusterCache) error {
 			PublicIP:    inst.PublicIP,
 			PrivateIP:   inst.PrivateIP,
 			SSHUsername: inst.SSHUsername,
+			Market:      inst.Market,
 			Phase:       "Ready",
 		})
 	}
@@ -878,6 +1081,21 @@ func (p *Provider) updateClusterStatus(cache *ClusterCache) error {
 			PublicIP:    inst.PublicIP,
 			PrivateIP:   inst.PrivateIP,
 			SSHUsername: inst.SSHUsername,
+			Market:      inst.Market,
+			Pool:        inst.Pool,
+			Phase:       "Ready",
+		})
+	}
+
+	for _, inst := range cache.EtcdInstances {
+		nodes = append(nodes, v1alpha1.NodeStatus{
+			Name:        inst.Name,
+			Role:        inst.Role,
+			InstanceID:  inst.InstanceID,
+			PublicIP:    inst.PublicIP,
+			PrivateIP:   inst.PrivateIP,
+			SSHUsername: inst.SSHUsername,
+			Market:      inst.Market,
 			Phase:       "Ready",
 		})
 	}
@@ -893,6 +1111,8 @@ func (p *Provider) updateClusterStatus(cache *ClusterCache) error {
 		ControlPlaneEndpoint: cache.PublicDnsName,
 	}
 
+	cache.InstanceMarket = clusterMarket(cache.ControlPlaneInstances, cache.WorkerInstances, cache.EtcdInstances)
+
 	if cache.LoadBalancerDNS != "" {
 		p.Environment.Status.Cluster.LoadBalancerDNS = cache.LoadBalancerDNS
 		p.Environment.Status.Cluster.ControlPlaneEndpoint = cache.LoadBalancerDNS
@@ -900,7 +1120,3 @@ func (p *Provider) updateClusterStatus(cache *ClusterCache) error {
 
 	return p.updateAvailableCondition(*p.Environment, &cache.AWS)
 }
-
-// TODO: Add ELBv2 support for HA load balancer
-// This requires adding github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 to go.mod
-// and implementing full NLB lifecycle management
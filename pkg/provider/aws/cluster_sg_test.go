@@ -17,6 +17,7 @@
 package aws
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -52,7 +53,7 @@ func TestCreateControlPlaneSecurityGroup(t *testing.T) {
 	cache := &ClusterCache{}
 	cache.Vpcid = "vpc-test"
 
-	err := p.createControlPlaneSecurityGroup(cache)
+	err := p.createControlPlaneSecurityGroup(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createControlPlaneSecurityGroup() error = %v", err)
 	}
@@ -138,7 +139,7 @@ func TestCreateWorkerSecurityGroup(t *testing.T) {
 	cache.CPSecurityGroupid = "sg-cp-existing"
 	cache.SecurityGroupid = "sg-cp-existing"
 
-	err := p.createWorkerSecurityGroup(cache)
+	err := p.createWorkerSecurityGroup(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createWorkerSecurityGroup() error = %v", err)
 	}
@@ -247,7 +248,7 @@ func TestWorkerSGRequiresCPSG(t *testing.T) {
 	cache.Vpcid = "vpc-test"
 	// CPSecurityGroupid is intentionally empty
 
-	err := p.createWorkerSecurityGroup(cache)
+	err := p.createWorkerSecurityGroup(context.Background(), cache)
 	if err == nil {
 		t.Fatal("expected error when CP SG not set, got nil")
 	}
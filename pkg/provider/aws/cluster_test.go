@@ -17,6 +17,8 @@
 package aws
 
 import (
+	"context"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -55,7 +57,7 @@ func TestCreateInstancesSetsPublicIP(t *testing.T) {
 		},
 	}
 
-	instances, err := provider.createInstances(
+	instances, err := provider.createInstances(context.Background(),
 		cache,
 		1,
 		NodeRoleControlPlane,
@@ -63,6 +65,7 @@ func TestCreateInstancesSetsPublicIP(t *testing.T) {
 		nil,
 		"",
 		&v1alpha1.Image{ImageId: aws.String("ami-test-123")},
+		launchOptions{},
 	)
 	if err != nil {
 		t.Fatalf("createInstances failed: %v", err)
@@ -101,6 +104,7 @@ func TestCreateInstancesUsesRoleSecurityGroup(t *testing.T) {
 	}{
 		{"control-plane uses CP SG", NodeRoleControlPlane, "sg-cp"},
 		{"worker uses Worker SG", NodeRoleWorker, "sg-worker"},
+		{"etcd uses CP SG", NodeRoleEtcd, "sg-cp"},
 	}
 
 	for _, tt := range tests {
@@ -117,7 +121,7 @@ func TestCreateInstancesUsesRoleSecurityGroup(t *testing.T) {
 				},
 			}
 
-			_, err := provider.createInstances(cache, 1, tt.role, "t3.medium", nil, "", &v1alpha1.Image{ImageId: aws.String("ami-test")})
+			_, err := provider.createInstances(context.Background(), cache, 1, tt.role, "t3.medium", nil, "", &v1alpha1.Image{ImageId: aws.String("ami-test")}, launchOptions{})
 			if err != nil {
 				t.Fatalf("createInstances failed: %v", err)
 			}
@@ -134,6 +138,111 @@ func TestCreateInstancesUsesRoleSecurityGroup(t *testing.T) {
 	}
 }
 
+// TestCreateEtcdInstances verifies that an external etcd topology launches
+// the default etcd pool and records it in the cluster status.
+func TestCreateEtcdInstances(t *testing.T) {
+	f := awsfake.New()
+	seedTestImage(f, "ami-test")
+
+	provider := newTestProvider(f.EC2)
+	provider.cacheFile = filepath.Join(t.TempDir(), "cache.yaml")
+	provider.Spec.Cluster = &v1alpha1.ClusterSpec{
+		ControlPlane: v1alpha1.ControlPlaneSpec{Count: 3},
+		HighAvailability: &v1alpha1.HAConfig{
+			Enabled:      true,
+			EtcdTopology: v1alpha1.EtcdTopologyExternal,
+		},
+		Etcd: &v1alpha1.EtcdPoolSpec{Image: &v1alpha1.Image{ImageId: aws.String("ami-test")}},
+	}
+	cache := &ClusterCache{AWS: AWS{PublicSubnetid: "subnet-public", CPSecurityGroupid: "sg-cp"}}
+
+	if err := provider.createEtcdInstances(context.Background(), cache); err != nil {
+		t.Fatalf("createEtcdInstances failed: %v", err)
+	}
+
+	if len(cache.EtcdInstances) != 3 {
+		t.Fatalf("expected 3 etcd instances, got %d", len(cache.EtcdInstances))
+	}
+	for _, in := range f.Store.Inputs("RunInstances") {
+		if got := in.(*ec2.RunInstancesInput).InstanceType; got != types.InstanceType(defaultEtcdInstanceType) {
+			t.Errorf("InstanceType = %q, want %q", got, defaultEtcdInstanceType)
+		}
+	}
+
+	if err := provider.updateClusterStatus(cache); err != nil {
+		t.Fatalf("updateClusterStatus failed: %v", err)
+	}
+	var etcdNodes int
+	for _, node := range provider.Environment.Status.Cluster.Nodes {
+		if node.Role == string(NodeRoleEtcd) {
+			etcdNodes++
+			if !strings.HasPrefix(node.Name, "test-cluster-etcd-") {
+				t.Errorf("etcd node name = %q, want test-cluster-etcd-<n>", node.Name)
+			}
+		}
+	}
+	if etcdNodes != 3 {
+		t.Errorf("cluster status has %d etcd nodes, want 3", etcdNodes)
+	}
+}
+
+func TestCreateWorkerInstances_Pools(t *testing.T) {
+	f := awsfake.New()
+	seedTestImage(f, "ami-test")
+
+	provider := newTestProvider(f.EC2)
+	provider.cacheFile = filepath.Join(t.TempDir(), "cache.yaml")
+	image := &v1alpha1.Image{ImageId: aws.String("ami-test")}
+	provider.Spec.Cluster = &v1alpha1.ClusterSpec{
+		ControlPlane: v1alpha1.ControlPlaneSpec{Count: 1},
+		WorkerPools: []v1alpha1.WorkerPoolSpec{
+			{Name: "cpu", Count: 2, InstanceType: "m5.xlarge", Image: image},
+			{Name: "gpu", Count: 1, InstanceType: "g4dn.xlarge", Image: image},
+			{Name: "empty", Count: 0, InstanceType: "m5.large", Image: image},
+		},
+	}
+	cache := &ClusterCache{AWS: AWS{PublicSubnetid: "subnet-public", WorkerSecurityGroupid: "sg-worker"}}
+
+	if err := provider.createWorkerInstances(context.Background(), cache); err != nil {
+		t.Fatalf("createWorkerInstances failed: %v", err)
+	}
+	if len(cache.WorkerInstances) != 3 {
+		t.Fatalf("expected 3 worker instances, got %d", len(cache.WorkerInstances))
+	}
+
+	instanceTypes := map[string]types.InstanceType{}
+	for _, in := range f.Store.Inputs("RunInstances") {
+		input := in.(*ec2.RunInstancesInput)
+		tags := map[string]string{}
+		for _, tag := range input.TagSpecifications[0].Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+		if !strings.HasPrefix(tags["Name"], "test-cluster-worker-"+tags["Pool"]+"-") {
+			t.Errorf("instance name %q does not carry pool %q", tags["Name"], tags["Pool"])
+		}
+		instanceTypes[tags["Pool"]] = input.InstanceType
+	}
+	if instanceTypes["cpu"] != "m5.xlarge" || instanceTypes["gpu"] != "g4dn.xlarge" {
+		t.Errorf("instance types per pool = %v", instanceTypes)
+	}
+	if _, ok := instanceTypes["empty"]; ok {
+		t.Error("empty pool should not launch instances")
+	}
+
+	if err := provider.updateClusterStatus(cache); err != nil {
+		t.Fatalf("updateClusterStatus failed: %v", err)
+	}
+	pools := map[string]int{}
+	for _, node := range provider.Environment.Status.Cluster.Nodes {
+		if node.Role == string(NodeRoleWorker) {
+			pools[node.Pool]++
+		}
+	}
+	if pools["cpu"] != 2 || pools["gpu"] != 1 {
+		t.Errorf("worker nodes per pool = %v, want cpu=2 gpu=1", pools)
+	}
+}
+
 // TestPrivateRouteTableRoutesToNATGW verifies that createPrivateRouteTable
 // routes 0.0.0.0/0 to the NAT Gateway (not the Internet Gateway).
 func TestPrivateRouteTableRoutesToNATGW(t *testing.T) {
@@ -147,7 +256,7 @@ func TestPrivateRouteTableRoutesToNATGW(t *testing.T) {
 		InternetGwid: "igw-test-456",
 	}
 
-	if err := provider.createPrivateRouteTable(cache); err != nil {
+	if err := provider.createPrivateRouteTable(context.Background(), cache); err != nil {
 		t.Fatalf("createPrivateRouteTable failed: %v", err)
 	}
 
@@ -179,7 +288,7 @@ func TestPublicRouteTableRoutesToIGW(t *testing.T) {
 		InternetGwid:   "igw-test-456",
 	}
 
-	if err := provider.createPublicRouteTable(cache); err != nil {
+	if err := provider.createPublicRouteTable(context.Background(), cache); err != nil {
 		t.Fatalf("createPublicRouteTable failed: %v", err)
 	}
 
@@ -215,7 +324,7 @@ func TestPublicSubnetCreatedInCorrectCIDR(t *testing.T) {
 		Vpcid: "vpc-test",
 	}
 
-	if err := provider.createPublicSubnet(cache); err != nil {
+	if err := provider.createPublicSubnet(context.Background(), cache); err != nil {
 		t.Fatalf("createPublicSubnet failed: %v", err)
 	}
 
@@ -255,7 +364,7 @@ func TestNATGatewayCreatedInPublicSubnet(t *testing.T) {
 		Subnetid:       "subnet-private",
 	}
 
-	if err := provider.createNATGateway(cache); err != nil {
+	if err := provider.createNATGateway(context.Background(), cache); err != nil {
 		t.Fatalf("createNATGateway failed: %v", err)
 	}
 
@@ -282,7 +391,7 @@ func TestNATGatewayWaitsForAvailable(t *testing.T) {
 		Subnetid:       "subnet-private",
 	}
 
-	if err := provider.createNATGateway(cache); err != nil {
+	if err := provider.createNATGateway(context.Background(), cache); err != nil {
 		t.Fatalf("createNATGateway failed: %v", err)
 	}
 
@@ -316,7 +425,7 @@ func TestNATGatewayFailedState(t *testing.T) {
 		Subnetid:       "subnet-private",
 	}
 
-	err := provider.createNATGateway(cache)
+	err := provider.createNATGateway(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when NAT Gateway reaches failed state")
 	}
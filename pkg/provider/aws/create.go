@@ -40,15 +40,15 @@ const (
 	defaultNATGatewayTimeout    = 5 * time.Minute
 )
 
-type cleanupFunc func() error
+type cleanupFunc func(ctx context.Context) error
 
 // Create creates an EC2 instance with proper Network configuration
 // VPC, Subnet, Internet Gateway, Route Table, Security Group
 // If the environment specifies a cluster configuration, it delegates to CreateCluster()
-func (p *Provider) Create() error {
+func (p *Provider) Create(ctx context.Context) error {
 	// Check if this is a multinode cluster deployment
 	if p.IsMultinode() {
-		return p.CreateCluster()
+		return p.CreateCluster(ctx)
 	}
 
 	// Single-node deployment
@@ -57,7 +57,7 @@ func (p *Provider) Create() error {
 	// resources. Without this check, VPC/subnet/IGW/etc. are created and then
 	// RunInstances fails with an opaque EC2 "Unsupported configuration" error,
 	// leaking resources that must be cleaned up manually.
-	if err := p.checkInstanceTypes(); err != nil {
+	if err := p.checkInstanceTypes(ctx); err != nil {
 		return fmt.Errorf("pre-flight check failed: %w", err)
 	}
 
@@ -65,12 +65,19 @@ func (p *Provider) Create() error {
 	var cleanupStack []cleanupFunc
 	var err error
 
-	// Defer cleanup on failure - execute cleanup functions in reverse order
+	// Defer cleanup on failure - execute cleanup functions in reverse order.
+	// The rollback runs detached from ctx so that it still reaches AWS when
+	// creation failed because ctx was cancelled (e.g. Ctrl-C).
 	defer func() {
 		if err != nil {
-			p.log.Warning("Creation failed, rolling back created resources...")
+			if ctx.Err() != nil {
+				p.log.Warning("Creation interrupted, rolling back created resources...")
+			} else {
+				p.log.Warning("Creation failed, rolling back created resources...")
+			}
+			cleanupCtx := context.WithoutCancel(ctx)
 			for i := len(cleanupStack) - 1; i >= 0; i-- {
-				if cleanupErr := cleanupStack[i](); cleanupErr != nil {
+				if cleanupErr := cleanupStack[i](cleanupCtx); cleanupErr != nil {
 					p.log.Warning("Cleanup failed: %v", cleanupErr)
 				}
 			}
@@ -81,83 +88,123 @@ func (p *Provider) Create() error {
 		p.log.Warning("Failed to update progressing condition: %v", err)
 	}
 
-	if err = p.createVPC(cache); err != nil {
-		if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating VPC"); updateErr != nil {
-			p.log.Warning("Failed to update degraded condition: %v", updateErr)
+	if p.Spec.Network != nil {
+		// Pre-existing network: nothing to create, and nothing to roll back
+		if _, _, err = p.adoptNetwork(ctx, cache); err != nil {
+			if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error adopting network"); updateErr != nil {
+				p.log.Warning("Failed to update degraded condition: %v", updateErr)
+			}
+			return fmt.Errorf("error adopting network: %w", err)
+		}
+		if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Network adopted"); err != nil {
+			p.log.Warning("Failed to update progressing condition: %v", err)
+		}
+	} else {
+		if err = p.createVPC(ctx, cache); err != nil {
+			if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating VPC"); updateErr != nil {
+				p.log.Warning("Failed to update degraded condition: %v", updateErr)
+			}
+			return fmt.Errorf("error creating VPC: %w", err)
+		}
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			cleanupCache := &AWS{Vpcid: cache.Vpcid}
+			return p.deleteVPC(ctx, cleanupCache)
+		})
+		if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "VPC created"); err != nil {
+			p.log.Warning("Failed to update progressing condition: %v", err)
 		}
-		return fmt.Errorf("error creating VPC: %w", err)
-	}
-	cleanupStack = append(cleanupStack, func() error {
-		cleanupCache := &AWS{Vpcid: cache.Vpcid}
-		return p.deleteVPC(cleanupCache)
-	})
-	if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "VPC created"); err != nil {
-		p.log.Warning("Failed to update progressing condition: %v", err)
-	}
 
-	if err = p.createSubnet(cache); err != nil {
-		if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating subnet"); updateErr != nil {
-			p.log.Warning("Failed to update degraded condition: %v", updateErr)
+		if err = p.createSubnet(ctx, cache); err != nil {
+			if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating subnet"); updateErr != nil {
+				p.log.Warning("Failed to update degraded condition: %v", updateErr)
+			}
+			return fmt.Errorf("error creating subnet: %w", err)
+		}
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			cleanupCache := &AWS{Subnetid: cache.Subnetid}
+			return p.deleteSubnet(ctx, cleanupCache)
+		})
+		if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Subnet created"); err != nil {
+			p.log.Warning("Failed to update progressing condition: %v", err)
 		}
-		return fmt.Errorf("error creating subnet: %w", err)
-	}
-	cleanupStack = append(cleanupStack, func() error {
-		cleanupCache := &AWS{Subnetid: cache.Subnetid}
-		return p.deleteSubnet(cleanupCache)
-	})
-	if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Subnet created"); err != nil {
-		p.log.Warning("Failed to update progressing condition: %v", err)
-	}
 
-	if err = p.createInternetGateway(cache); err != nil {
-		if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating Internet Gateway"); updateErr != nil {
-			p.log.Warning("Failed to update degraded condition: %v", updateErr)
+		if err = p.createInternetGateway(ctx, cache); err != nil {
+			if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating Internet Gateway"); updateErr != nil {
+				p.log.Warning("Failed to update degraded condition: %v", updateErr)
+			}
+			return fmt.Errorf("error creating Internet Gateway: %w", err)
 		}
-		return fmt.Errorf("error creating Internet Gateway: %w", err)
-	}
-	cleanupStack = append(cleanupStack, func() error {
-		cleanupCache := &AWS{
-			InternetGwid: cache.InternetGwid,
-			Vpcid:        cache.Vpcid,
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			cleanupCache := &AWS{
+				InternetGwid: cache.InternetGwid,
+				Vpcid:        cache.Vpcid,
+			}
+			return p.deleteInternetGateway(ctx, cleanupCache)
+		})
+		if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Internet Gateway created"); err != nil {
+			p.log.Warning("Failed to update progressing condition: %v", err)
 		}
-		return p.deleteInternetGateway(cleanupCache)
-	})
-	if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Internet Gateway created"); err != nil {
-		p.log.Warning("Failed to update progressing condition: %v", err)
-	}
 
-	if err = p.createRouteTable(cache); err != nil {
-		if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating route table"); updateErr != nil {
-			p.log.Warning("Failed to update degraded condition: %v", updateErr)
+		if err = p.createRouteTable(ctx, cache); err != nil {
+			if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating route table"); updateErr != nil {
+				p.log.Warning("Failed to update degraded condition: %v", updateErr)
+			}
+			return fmt.Errorf("error creating route table: %w", err)
 		}
-		return fmt.Errorf("error creating route table: %w", err)
-	}
-	cleanupStack = append(cleanupStack, func() error {
-		cleanupCache := &AWS{
-			RouteTable: cache.RouteTable,
-			Vpcid:      cache.Vpcid,
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			cleanupCache := &AWS{
+				RouteTable: cache.RouteTable,
+				Vpcid:      cache.Vpcid,
+			}
+			return p.deleteRouteTable(ctx, cleanupCache)
+		})
+		if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Route Table created"); err != nil {
+			p.log.Warning("Failed to update progressing condition: %v", err)
 		}
-		return p.deleteRouteTable(cleanupCache)
-	})
-	if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Route Table created"); err != nil {
-		p.log.Warning("Failed to update progressing condition: %v", err)
 	}
 
-	if err = p.createSecurityGroup(cache); err != nil {
-		if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating security group"); updateErr != nil {
-			p.log.Warning("Failed to update degraded condition: %v", updateErr)
+	if !p.adoptsSecurityGroups() {
+		if err = p.createSecurityGroup(ctx, cache); err != nil {
+			if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating security group"); updateErr != nil {
+				p.log.Warning("Failed to update degraded condition: %v", updateErr)
+			}
+			return fmt.Errorf("error creating security group: %w", err)
+		}
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			cleanupCache := &AWS{SecurityGroupid: cache.SecurityGroupid}
+			return p.deleteSecurityGroups(ctx, cleanupCache)
+		})
+		if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Security Group created"); err != nil {
+			p.log.Warning("Failed to update progressing condition: %v", err)
 		}
-		return fmt.Errorf("error creating security group: %w", err)
 	}
-	cleanupStack = append(cleanupStack, func() error {
-		cleanupCache := &AWS{SecurityGroupid: cache.SecurityGroupid}
-		return p.deleteSecurityGroups(cleanupCache)
-	})
-	if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Security Group created"); err != nil {
-		p.log.Warning("Failed to update progressing condition: %v", err)
+
+	if p.Spec.PlacementGroup != nil && p.Spec.PlacementGroup.Strategy != "" {
+		if err = p.createPlacementGroup(ctx, cache); err != nil {
+			if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating placement group"); updateErr != nil {
+				p.log.Warning("Failed to update degraded condition: %v", updateErr)
+			}
+			return fmt.Errorf("error creating placement group: %w", err)
+		}
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			cleanupCache := &AWS{PlacementGroups: cache.PlacementGroups}
+			return p.deletePlacementGroups(ctx, cleanupCache)
+		})
+		if err = p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Placement Group created"); err != nil {
+			p.log.Warning("Failed to update progressing condition: %v", err)
+		}
 	}
 
-	if err = p.createEC2Instance(cache); err != nil {
+	// The instance can exist even when createEC2Instance fails, e.g. when
+	// ctx is cancelled while waiting for it to start.
+	err = p.createEC2Instance(ctx, cache)
+	if cache.Instanceid != "" {
+		cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+			cleanupCache := &AWS{Instanceid: cache.Instanceid}
+			return p.deleteEC2Instances(ctx, cleanupCache)
+		})
+	}
+	if err != nil {
 		if updateErr := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Creating", "Error creating EC2 instance"); updateErr != nil {
 			p.log.Warning("Failed to update degraded condition: %v", updateErr)
 		}
@@ -172,7 +219,7 @@ func (p *Provider) Create() error {
 }
 
 // createVPC creates a VPC with CIDR
-func (p *Provider) createVPC(cache *AWS) error {
+func (p *Provider) createVPC(ctx context.Context, cache *AWS) error {
 	cancelLoading := p.log.Loading("Creating VPC")
 
 	vpcInput := &ec2.CreateVpcInput{
@@ -187,7 +234,7 @@ func (p *Provider) createVPC(cache *AWS) error {
 		},
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), defaultVPCTimeout)
+	ctx, cancel := context.WithTimeout(ctx, defaultVPCTimeout)
 
 	defer cancel()
 
@@ -203,7 +250,7 @@ func (p *Provider) createVPC(cache *AWS) error {
 		EnableDnsHostnames: &types.AttributeBooleanValue{Value: &yes},
 	}
 
-	_, err = p.ec2.ModifyVpcAttribute(context.Background(), modVcp)
+	_, err = p.ec2.ModifyVpcAttribute(ctx, modVcp)
 	if err != nil {
 		cancelLoading(logger.ErrLoadingFailed)
 		return fmt.Errorf("error modifying VPC attributes: %w", err)
@@ -214,7 +261,34 @@ func (p *Provider) createVPC(cache *AWS) error {
 }
 
 // createSubnet creates a subnet for the VPC
-func (p *Provider) createSubnet(cache *AWS) error {
+func (p *Provider) createSubnet(ctx context.Context, cache *AWS) error {
+	zone := ""
+	if p.IsMultinode() && p.internalLoadBalancer() {
+		// An internal NLB in this subnet only serves API servers in its
+		// own zone, which is the zone of the public subnet
+		reserved, err := p.reservationZone(ctx)
+		if err != nil {
+			return err
+		}
+		zone = reserved
+	} else if !p.IsMultinode() {
+		if len(p.Spec.AvailabilityZones) > 0 {
+			zone = p.Spec.AvailabilityZones[0]
+		} else {
+			// A capacity reservation only serves its own zone
+			reserved, err := p.reservationZone(ctx)
+			if err != nil {
+				return err
+			}
+			zone = reserved
+		}
+	}
+	return p.createSubnetInZone(ctx, cache, zone)
+}
+
+// createSubnetInZone creates the environment subnet in zone, or in a zone
+// chosen by AWS when zone is empty.
+func (p *Provider) createSubnetInZone(ctx context.Context, cache *AWS, zone string) error {
 	cancelLoading := p.log.Loading("Creating subnet")
 
 	subnetInput := &ec2.CreateSubnetInput{
@@ -227,7 +301,10 @@ func (p *Provider) createSubnet(cache *AWS) error {
 			},
 		},
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), defaultSubnetTimeout)
+	if zone != "" {
+		subnetInput.AvailabilityZone = aws.String(zone)
+	}
+	ctx, cancel := context.WithTimeout(ctx, defaultSubnetTimeout)
 
 	defer cancel()
 
@@ -243,7 +320,7 @@ func (p *Provider) createSubnet(cache *AWS) error {
 }
 
 // createInternetGateway creates an Internet Gateway and attaches it to the VPC
-func (p *Provider) createInternetGateway(cache *AWS) error {
+func (p *Provider) createInternetGateway(ctx context.Context, cache *AWS) error {
 	cancelLoading := p.log.Loading("Creating Internet Gateway")
 
 	gwInput := &ec2.CreateInternetGatewayInput{
@@ -254,7 +331,7 @@ func (p *Provider) createInternetGateway(cache *AWS) error {
 			},
 		},
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), defaultIGWTimeout)
+	ctx, cancel := context.WithTimeout(ctx, defaultIGWTimeout)
 
 	defer cancel()
 
@@ -284,7 +361,7 @@ func (p *Provider) createInternetGateway(cache *AWS) error {
 }
 
 // createRouteTable creates a route table and associates it with the subnet
-func (p *Provider) createRouteTable(cache *AWS) error {
+func (p *Provider) createRouteTable(ctx context.Context, cache *AWS) error {
 	cancelLoading := p.log.Loading("Creating route table")
 
 	rtInput := &ec2.CreateRouteTableInput{
@@ -296,7 +373,7 @@ func (p *Provider) createRouteTable(cache *AWS) error {
 			},
 		},
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), defaultRouteTableTimeout)
+	ctx, cancel := context.WithTimeout(ctx, defaultRouteTableTimeout)
 
 	defer cancel()
 
@@ -308,13 +385,9 @@ func (p *Provider) createRouteTable(cache *AWS) error {
 	cache.RouteTable = *rtOutput.RouteTable.RouteTableId
 
 	// Associate the route table with the subnet
-	assocInput := &ec2.AssociateRouteTableInput{
-		RouteTableId: rtOutput.RouteTable.RouteTableId,
-		SubnetId:     aws.String(cache.Subnetid),
-	}
-	if _, err = p.ec2.AssociateRouteTable(context.Background(), assocInput); err != nil {
+	if err = p.associateRouteTable(ctx, cache); err != nil {
 		cancelLoading(logger.ErrLoadingFailed)
-		return fmt.Errorf("error associating route table: %w", err)
+		return err
 	}
 
 	routeInput := &ec2.CreateRouteInput{
@@ -331,9 +404,21 @@ func (p *Provider) createRouteTable(cache *AWS) error {
 	return nil
 }
 
+// associateRouteTable associates the environment route table with its subnet.
+func (p *Provider) associateRouteTable(ctx context.Context, cache *AWS) error {
+	assocInput := &ec2.AssociateRouteTableInput{
+		RouteTableId: aws.String(cache.RouteTable),
+		SubnetId:     aws.String(cache.Subnetid),
+	}
+	if _, err := p.ec2.AssociateRouteTable(ctx, assocInput); err != nil {
+		return fmt.Errorf("error associating route table: %w", err)
+	}
+	return nil
+}
+
 // createSecurityGroup creates a security group to allow external communication
 // with K8S control plane and SSH
-func (p *Provider) createSecurityGroup(cache *AWS) error {
+func (p *Provider) createSecurityGroup(ctx context.Context, cache *AWS) error {
 	cancelLoading := p.log.Loading("Creating security group")
 
 	sgInput := &ec2.CreateSecurityGroupInput{
@@ -347,7 +432,7 @@ func (p *Provider) createSecurityGroup(cache *AWS) error {
 			},
 		},
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), defaultSecurityGroupTimeout)
+	ctx, cancel := context.WithTimeout(ctx, defaultSecurityGroupTimeout)
 
 	defer cancel()
 
@@ -438,11 +523,11 @@ func (p *Provider) createSecurityGroup(cache *AWS) error {
 }
 
 // createEC2Instance creates an EC2 instance with proper Network configuration
-func (p *Provider) createEC2Instance(cache *AWS) error {
+func (p *Provider) createEC2Instance(ctx context.Context, cache *AWS) error {
 	cancelLoading := p.log.Loading("Creating EC2 instance")
 
 	// Check if the image is provided, if not get the latest image
-	err := p.setAMI()
+	err := p.setAMI(ctx)
 	if err != nil {
 		cancelLoading(logger.ErrLoadingFailed)
 		return fmt.Errorf("error getting AMI: %w", err)
@@ -458,12 +543,22 @@ func (p *Provider) createEC2Instance(cache *AWS) error {
 
 	// Query the AMI's root device name — different AMIs use different names
 	// (e.g., /dev/sda1 for Ubuntu/Rocky, /dev/xvda for Amazon Linux 2023)
-	rootDevice, err := p.describeImageRootDevice(*p.Spec.Image.ImageId)
+	rootDevice, err := p.describeImageRootDevice(ctx, *p.Spec.Image.ImageId)
 	if err != nil {
 		cancelLoading(logger.ErrLoadingFailed)
 		return fmt.Errorf("error getting root device name: %w", err)
 	}
 
+	// A golden image records the components baked into it; the provisioner
+	// skips those whose provenance matches the spec
+	components, err := p.imageComponents(ctx, *p.Spec.Image.ImageId)
+	if err != nil {
+		p.log.Warning("Failed to read component provenance of image %s: %v", *p.Spec.Image.ImageId, err)
+	} else if components != nil {
+		p.log.Info("Image %s is a golden image with pre-installed components", *p.Spec.Image.ImageId)
+		p.Environment.Status.ImageComponents = components
+	}
+
 	instanceIn := &ec2.RunInstancesInput{
 		ImageId:                           p.Spec.Image.ImageId,
 		InstanceType:                      types.InstanceType(p.Spec.Type),
@@ -484,10 +579,8 @@ func (p *Provider) createEC2Instance(cache *AWS) error {
 				AssociatePublicIpAddress: &yes,
 				DeleteOnTermination:      &yes,
 				DeviceIndex:              aws.Int32(0),
-				Groups: []string{
-					cache.SecurityGroupid,
-				},
-				SubnetId: aws.String(cache.Subnetid),
+				Groups:                   p.securityGroupsFor(cache.SecurityGroupid),
+				SubnetId:                 aws.String(cache.Subnetid),
 			},
 		},
 		KeyName: aws.String(p.Spec.KeyName),
@@ -497,43 +590,41 @@ func (p *Provider) createEC2Instance(cache *AWS) error {
 				Tags:         p.Tags,
 			},
 		},
+		CapacityReservationSpecification: capacityReservationSpec(p.Spec.CapacityReservation),
 	}
-	instanceOut, err := p.ec2.RunInstances(context.Background(), instanceIn)
-	if err != nil {
-		cancelLoading(logger.ErrLoadingFailed)
-		return fmt.Errorf("error creating instance: %w", err)
+	if pg := p.Spec.PlacementGroup; pg != nil {
+		instanceIn.Placement = &types.Placement{GroupName: aws.String(placementGroupName(pg, p.ObjectMeta.Name))}
 	}
-	cache.Instanceid = *instanceOut.Instances[0].InstanceId
-
-	waiterOptions := []func(*ec2.InstanceRunningWaiterOptions){
-		func(o *ec2.InstanceRunningWaiterOptions) {
-			o.MaxDelay = 1 * time.Minute
-			o.MinDelay = 5 * time.Second
-		},
-	}
-	waiter := ec2.NewInstanceRunningWaiter(p.ec2, waiterOptions...)
-
-	if err = waiter.Wait(context.Background(), &ec2.DescribeInstancesInput{
-		InstanceIds: []string{*instanceOut.Instances[0].InstanceId},
-	}, 5*time.Minute, waiterOptions...); err != nil {
+	market, spotMaxPrice := p.marketFor("", "")
+	instanceID, launched, err := p.runInstanceWithFallbacks(ctx, cache, instanceIn, market, spotMaxPrice)
+	cache.Instanceid = instanceID
+	if err != nil {
 		cancelLoading(logger.ErrLoadingFailed)
-		return fmt.Errorf("error waiting for instance to be in running state: %w", err)
+		return err
 	}
+	cache.InstanceMarket = string(launched)
 
 	// Describe instance now that is running
-	instanceRunning, err := p.ec2.DescribeInstances(context.Background(), &ec2.DescribeInstancesInput{
-		InstanceIds: []string{*instanceOut.Instances[0].InstanceId},
+	instanceRunning, err := p.ec2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []string{instanceID},
 	})
 	if err != nil {
 		cancelLoading(logger.ErrLoadingFailed)
 		return fmt.Errorf("error describing instances: %w", err)
 	}
-	cache.PublicDnsName = *instanceRunning.Reservations[0].Instances[0].PublicDnsName
+	if len(instanceRunning.Reservations) == 0 || len(instanceRunning.Reservations[0].Instances) == 0 {
+		cancelLoading(logger.ErrLoadingFailed)
+		return fmt.Errorf("error describing instances: instance %s not found", instanceID)
+	}
+	cache.PublicDnsName = aws.ToString(instanceRunning.Reservations[0].Instances[0].PublicDnsName)
 
 	// tag network interface
-	instance := instanceOut.Instances[0]
+	instance := instanceRunning.Reservations[0].Instances[0]
+	if instance.Placement != nil {
+		cache.AvailabilityZone = aws.ToString(instance.Placement.AvailabilityZone)
+	}
 	networkInterfaceId := *instance.NetworkInterfaces[0].NetworkInterfaceId
-	ctx, cancel := context.WithTimeout(context.Background(), defaultEC2Timeout)
+	ctx, cancel := context.WithTimeout(ctx, defaultEC2Timeout)
 
 	defer cancel()
 
@@ -565,9 +656,26 @@ func (p *Provider) createEC2Instance(cache *AWS) error {
 	return nil
 }
 
+// subnetZone returns the availability zone of a subnet
+func (p *Provider) subnetZone(ctx context.Context, subnetID string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+	defer cancel()
+
+	out, err := p.ec2.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
+		SubnetIds: []string{subnetID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("error describing subnet %s: %w", subnetID, err)
+	}
+	if len(out.Subnets) == 0 {
+		return "", fmt.Errorf("subnet %s not found", subnetID)
+	}
+	return aws.ToString(out.Subnets[0].AvailabilityZone), nil
+}
+
 // createPublicSubnet creates a public subnet (10.0.1.0/24) for NAT gateway and NLB.
 // The subnet is configured with MapPublicIpOnLaunch enabled.
-func (p *Provider) createPublicSubnet(cache *AWS) error {
+func (p *Provider) createPublicSubnet(ctx context.Context, cache *AWS) error {
 	cancelLoading := p.log.Loading("Creating public subnet")
 
 	// Build tags with a public-specific Name tag
@@ -593,7 +701,25 @@ func (p *Provider) createPublicSubnet(cache *AWS) error {
 			},
 		},
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), defaultSubnetTimeout)
+	// The cluster nodes are launched into the public subnet, so it must be
+	// in the zone of their capacity reservation
+	zone, err := p.reservationZone(ctx)
+	if err != nil {
+		cancelLoading(logger.ErrLoadingFailed)
+		return err
+	}
+	// An internal NLB in the private subnet only serves the nodes of its
+	// own zone
+	if zone == "" && p.internalLoadBalancer() && cache.Subnetid != "" {
+		if zone, err = p.subnetZone(ctx, cache.Subnetid); err != nil {
+			cancelLoading(logger.ErrLoadingFailed)
+			return err
+		}
+	}
+	if zone != "" {
+		subnetInput.AvailabilityZone = aws.String(zone)
+	}
+	ctx, cancel := context.WithTimeout(ctx, defaultSubnetTimeout)
 	defer cancel()
 
 	subnetOutput, err := p.ec2.CreateSubnet(ctx, subnetInput)
@@ -609,10 +735,10 @@ func (p *Provider) createPublicSubnet(cache *AWS) error {
 
 // createNATGateway allocates an EIP and creates a NAT Gateway in the public subnet.
 // CRITICAL (D4): If NAT Gateway creation fails, the EIP is released.
-func (p *Provider) createNATGateway(cache *AWS) error {
+func (p *Provider) createNATGateway(ctx context.Context, cache *AWS) error {
 	cancelLoading := p.log.Loading("Creating NAT Gateway")
 
-	ctx, cancel := context.WithTimeout(context.Background(), defaultNATGatewayTimeout)
+	ctx, cancel := context.WithTimeout(ctx, defaultNATGatewayTimeout)
 	defer cancel()
 
 	// Allocate Elastic IP for the NAT Gateway
@@ -646,7 +772,7 @@ func (p *Provider) createNATGateway(cache *AWS) error {
 		cancelLoading(logger.ErrLoadingFailed)
 		// D4: Clean up EIP if NAT Gateway creation fails
 		p.log.Warning("NAT gateway creation failed, releasing EIP %s", *eipOutput.AllocationId)
-		if releaseErr := p.releaseEIP(*eipOutput.AllocationId); releaseErr != nil {
+		if releaseErr := p.releaseEIP(ctx, *eipOutput.AllocationId); releaseErr != nil {
 			p.log.Warning("Failed to release EIP %s: %v", *eipOutput.AllocationId, releaseErr)
 		}
 		cache.EIPAllocationid = ""
@@ -661,7 +787,7 @@ func (p *Provider) createNATGateway(cache *AWS) error {
 	p.log.Info("Waiting for NAT Gateway %s to become available", cache.NatGatewayid)
 	for i := 0; i < 60; i++ { // 60 × 5s = 5 minutes max
 		p.sleep(5 * time.Second)
-		dCtx, dCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		dCtx, dCancel := context.WithTimeout(ctx, 30*time.Second)
 		out, err := p.ec2.DescribeNatGateways(dCtx, &ec2.DescribeNatGatewaysInput{
 			NatGatewayIds: []string{cache.NatGatewayid},
 		})
@@ -686,8 +812,8 @@ func (p *Provider) createNATGateway(cache *AWS) error {
 }
 
 // releaseEIP releases an Elastic IP by allocation ID.
-func (p *Provider) releaseEIP(allocationID string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), defaultSubnetTimeout)
+func (p *Provider) releaseEIP(ctx context.Context, allocationID string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultSubnetTimeout)
 	defer cancel()
 
 	_, err := p.ec2.ReleaseAddress(ctx, &ec2.ReleaseAddressInput{
@@ -697,7 +823,7 @@ func (p *Provider) releaseEIP(allocationID string) error {
 }
 
 // createPublicRouteTable creates a route table for the public subnet with a route to the IGW.
-func (p *Provider) createPublicRouteTable(cache *AWS) error {
+func (p *Provider) createPublicRouteTable(ctx context.Context, cache *AWS) error {
 	cancelLoading := p.log.Loading("Creating public route table")
 
 	rtInput := &ec2.CreateRouteTableInput{
@@ -709,7 +835,7 @@ func (p *Provider) createPublicRouteTable(cache *AWS) error {
 			},
 		},
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), defaultRouteTableTimeout)
+	ctx, cancel := context.WithTimeout(ctx, defaultRouteTableTimeout)
 	defer cancel()
 
 	rtOutput, err := p.ec2.CreateRouteTable(ctx, rtInput)
@@ -745,7 +871,7 @@ func (p *Provider) createPublicRouteTable(cache *AWS) error {
 }
 
 // createPrivateRouteTable creates a route table for the private subnet with a route to the NAT GW.
-func (p *Provider) createPrivateRouteTable(cache *AWS) error {
+func (p *Provider) createPrivateRouteTable(ctx context.Context, cache *AWS) error {
 	cancelLoading := p.log.Loading("Creating private route table")
 
 	rtInput := &ec2.CreateRouteTableInput{
@@ -757,7 +883,7 @@ func (p *Provider) createPrivateRouteTable(cache *AWS) error {
 			},
 		},
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), defaultRouteTableTimeout)
+	ctx, cancel := context.WithTimeout(ctx, defaultRouteTableTimeout)
 	defer cancel()
 
 	rtOutput, err := p.ec2.CreateRouteTable(ctx, rtInput)
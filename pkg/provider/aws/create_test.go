@@ -17,6 +17,7 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"io"
 	"strings"
@@ -90,7 +91,7 @@ func TestCreateEC2Instance_DisablesSourceDestCheck(t *testing.T) {
 		Subnetid:        "subnet-123",
 	}
 
-	err := provider.createEC2Instance(cache)
+	err := provider.createEC2Instance(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createEC2Instance failed: %v", err)
 	}
@@ -151,7 +152,7 @@ func TestCreateEC2Instance_SourceDestCheckError(t *testing.T) {
 		Subnetid:        "subnet-123",
 	}
 
-	err := provider.createEC2Instance(cache)
+	err := provider.createEC2Instance(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when ModifyNetworkInterfaceAttribute fails")
 	}
@@ -189,7 +190,7 @@ func TestCreateVPC_Success(t *testing.T) {
 	provider := createTestProvider(f.EC2)
 	cache := &AWS{}
 
-	err := provider.createVPC(cache)
+	err := provider.createVPC(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createVPC failed: %v", err)
 	}
@@ -224,7 +225,7 @@ func TestCreateVPC_CreateVpcError(t *testing.T) {
 	provider := createTestProvider(f.EC2)
 	cache := &AWS{}
 
-	err := provider.createVPC(cache)
+	err := provider.createVPC(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when CreateVpc fails")
 	}
@@ -241,7 +242,7 @@ func TestCreateVPC_ModifyVpcAttributeError(t *testing.T) {
 	provider := createTestProvider(f.EC2)
 	cache := &AWS{}
 
-	err := provider.createVPC(cache)
+	err := provider.createVPC(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when ModifyVpcAttribute fails")
 	}
@@ -258,7 +259,7 @@ func TestCreateSubnet_Success(t *testing.T) {
 		Vpcid: "vpc-test-123",
 	}
 
-	err := provider.createSubnet(cache)
+	err := provider.createSubnet(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createSubnet failed: %v", err)
 	}
@@ -289,7 +290,7 @@ func TestCreateSubnet_Error(t *testing.T) {
 		Vpcid: "vpc-test-123",
 	}
 
-	err := provider.createSubnet(cache)
+	err := provider.createSubnet(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when CreateSubnet fails")
 	}
@@ -306,7 +307,7 @@ func TestCreateInternetGateway_Success(t *testing.T) {
 		Vpcid: "vpc-test-123",
 	}
 
-	err := provider.createInternetGateway(cache)
+	err := provider.createInternetGateway(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createInternetGateway failed: %v", err)
 	}
@@ -343,7 +344,7 @@ func TestCreateInternetGateway_CreateError(t *testing.T) {
 		Vpcid: "vpc-test-123",
 	}
 
-	err := provider.createInternetGateway(cache)
+	err := provider.createInternetGateway(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when CreateInternetGateway fails")
 	}
@@ -362,7 +363,7 @@ func TestCreateInternetGateway_AttachError(t *testing.T) {
 		Vpcid: "vpc-test-123",
 	}
 
-	err := provider.createInternetGateway(cache)
+	err := provider.createInternetGateway(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when AttachInternetGateway fails")
 	}
@@ -381,7 +382,7 @@ func TestCreateRouteTable_Success(t *testing.T) {
 		InternetGwid: "igw-test-123",
 	}
 
-	err := provider.createRouteTable(cache)
+	err := provider.createRouteTable(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createRouteTable failed: %v", err)
 	}
@@ -437,7 +438,7 @@ func TestCreateRouteTable_CreateError(t *testing.T) {
 		InternetGwid: "igw-test-123",
 	}
 
-	err := provider.createRouteTable(cache)
+	err := provider.createRouteTable(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when CreateRouteTable fails")
 	}
@@ -458,7 +459,7 @@ func TestCreateRouteTable_AssociateError(t *testing.T) {
 		InternetGwid: "igw-test-123",
 	}
 
-	err := provider.createRouteTable(cache)
+	err := provider.createRouteTable(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when AssociateRouteTable fails")
 	}
@@ -479,7 +480,7 @@ func TestCreateRouteTable_CreateRouteError(t *testing.T) {
 		InternetGwid: "igw-test-123",
 	}
 
-	err := provider.createRouteTable(cache)
+	err := provider.createRouteTable(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when CreateRoute fails")
 	}
@@ -497,7 +498,7 @@ func TestCreateSecurityGroup_Success(t *testing.T) {
 		Vpcid: "vpc-test-123",
 	}
 
-	err := provider.createSecurityGroup(cache)
+	err := provider.createSecurityGroup(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createSecurityGroup failed: %v", err)
 	}
@@ -542,7 +543,7 @@ func TestCreateSecurityGroup_CreateError(t *testing.T) {
 		Vpcid: "vpc-test-123",
 	}
 
-	err := provider.createSecurityGroup(cache)
+	err := provider.createSecurityGroup(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when CreateSecurityGroup fails")
 	}
@@ -562,7 +563,7 @@ func TestCreateSecurityGroup_AuthorizeError(t *testing.T) {
 		Vpcid: "vpc-test-123",
 	}
 
-	err := provider.createSecurityGroup(cache)
+	err := provider.createSecurityGroup(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when AuthorizeSecurityGroupIngress fails")
 	}
@@ -603,7 +604,7 @@ func TestCreate_RejectsUnsupportedInstanceType(t *testing.T) {
 		},
 	}
 
-	err := provider.Create()
+	err := provider.Create(context.Background())
 
 	// Must fail before creating any resources
 	if err == nil {
@@ -629,7 +630,7 @@ func TestCreatePublicSubnet_Success(t *testing.T) {
 		Vpcid: "vpc-test-123",
 	}
 
-	err := provider.createPublicSubnet(cache)
+	err := provider.createPublicSubnet(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createPublicSubnet failed: %v", err)
 	}
@@ -669,7 +670,7 @@ func TestCreatePublicSubnet_Error(t *testing.T) {
 		Vpcid: "vpc-test-123",
 	}
 
-	err := provider.createPublicSubnet(cache)
+	err := provider.createPublicSubnet(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when CreateSubnet fails")
 	}
@@ -686,7 +687,7 @@ func TestCreateNATGateway_Success(t *testing.T) {
 		PublicSubnetid: "subnet-pub-123",
 	}
 
-	err := provider.createNATGateway(cache)
+	err := provider.createNATGateway(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createNATGateway failed: %v", err)
 	}
@@ -729,7 +730,7 @@ func TestCreateNATGateway_AllocateAddressError(t *testing.T) {
 		PublicSubnetid: "subnet-pub-123",
 	}
 
-	err := provider.createNATGateway(cache)
+	err := provider.createNATGateway(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when AllocateAddress fails")
 	}
@@ -748,7 +749,7 @@ func TestCreateNATGateway_CreateNatGatewayError_CleansUpEIP(t *testing.T) {
 		PublicSubnetid: "subnet-pub-123",
 	}
 
-	err := provider.createNATGateway(cache)
+	err := provider.createNATGateway(context.Background(), cache)
 	if err == nil {
 		t.Fatal("Expected error when CreateNatGateway fails")
 	}
@@ -776,7 +777,7 @@ func TestCreatePublicRouteTable_Success(t *testing.T) {
 		InternetGwid:   "igw-test-123",
 	}
 
-	err := provider.createPublicRouteTable(cache)
+	err := provider.createPublicRouteTable(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createPublicRouteTable failed: %v", err)
 	}
@@ -822,7 +823,7 @@ func TestCreatePrivateRouteTable_Success(t *testing.T) {
 		NatGatewayid: "nat-test-123",
 	}
 
-	err := provider.createPrivateRouteTable(cache)
+	err := provider.createPrivateRouteTable(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("createPrivateRouteTable failed: %v", err)
 	}
@@ -866,3 +867,45 @@ func TestCreatePrivateRouteTable_Success(t *testing.T) {
 func contains(s, substr string) bool {
 	return strings.Contains(s, substr)
 }
+
+// cancelOnAttachEC2 cancels the caller's context as soon as the Internet
+// Gateway has been attached, simulating a Ctrl-C halfway through create.
+type cancelOnAttachEC2 struct {
+	*awsfake.FakeEC2
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnAttachEC2) AttachInternetGateway(ctx context.Context, params *ec2.AttachInternetGatewayInput, optFns ...func(*ec2.Options)) (*ec2.AttachInternetGatewayOutput, error) {
+	out, err := c.FakeEC2.AttachInternetGateway(ctx, params, optFns...)
+	c.cancel()
+	return out, err
+}
+
+func TestCreate_InterruptedRollsBack(t *testing.T) {
+	f := awsfake.New()
+	seedTestImage(f, "ami-123")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	provider := createTestProvider(&cancelOnAttachEC2{FakeEC2: f.EC2, cancel: cancel})
+	provider.Spec.Auth.KeyName = "test-key"
+	provider.Spec.Instance.Type = "t3.medium"
+	provider.Spec.Instance.Region = "us-east-1"
+	provider.Spec.Instance.Image.ImageId = aws.String("ami-123")
+
+	err := provider.Create(ctx)
+	if err == nil {
+		t.Fatal("Expected Create() to fail after the context was cancelled")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected error to wrap context.Canceled, got: %v", err)
+	}
+
+	if f.Store.CallsTo("CreateVpc") != 1 {
+		t.Fatalf("Expected exactly 1 CreateVpc call, got %d", f.Store.CallsTo("CreateVpc"))
+	}
+	if !f.Store.Empty() {
+		t.Errorf("Interrupted create leaked resources: %v", f.Store.ResourceCounts())
+	}
+}
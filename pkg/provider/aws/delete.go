@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	internalaws "github.com/NVIDIA/holodeck/internal/aws"
 	"github.com/NVIDIA/holodeck/internal/logger"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -41,13 +42,13 @@ const (
 )
 
 // Delete deletes the EC2 instance and all associated resources
-func (p *Provider) Delete() error {
+func (p *Provider) Delete(ctx context.Context) error {
 	cache, err := p.unmarsalCache()
 	if err != nil {
 		return fmt.Errorf("error retrieving cache: %w", err)
 	}
 
-	if err := p.delete(cache); err != nil {
+	if err := p.delete(ctx, cache); err != nil {
 		return fmt.Errorf("error destroying AWS resources: %w", err)
 	}
 
@@ -55,16 +56,16 @@ func (p *Provider) Delete() error {
 }
 
 // deleteNLBForCluster deletes the NLB for a cluster by looking it up by DNS name
-func (p *Provider) deleteNLBForCluster(cache *ClusterCache) error {
+func (p *Provider) deleteNLBForCluster(ctx context.Context, cache *ClusterCache) error {
 	if cache.LoadBalancerDNS == "" {
 		return nil
 	}
 
 	// Describe load balancers to find the one matching our DNS name
-	ctx, cancel := context.WithTimeout(context.Background(), elbv2APITimeout)
+	ctx, cancel := context.WithTimeout(ctx, elbv2APITimeout)
 	defer cancel()
 
-	lbName := fmt.Sprintf("%s-nlb", p.ObjectMeta.Name)
+	lbName := p.loadBalancerName()
 	describeInput := &elasticloadbalancingv2.DescribeLoadBalancersInput{
 		Names: []string{lbName},
 	}
@@ -90,14 +91,18 @@ func (p *Provider) deleteNLBForCluster(cache *ClusterCache) error {
 			if err == nil && len(tgOutput.TargetGroups) > 0 {
 				cache.TargetGroupArn = aws.ToString(tgOutput.TargetGroups[0].TargetGroupArn)
 			}
-			return p.deleteNLB(cache)
+			return p.deleteNLB(ctx, cache)
 		}
 	}
 
 	return nil
 }
 
-func (p *Provider) delete(cache *AWS) error {
+func (p *Provider) delete(ctx context.Context, cache *AWS) error {
+	// Pre-existing resources adopted from spec.network are never deleted
+	adoptedVPC := cache.isAdopted(cache.Vpcid)
+	cache = cache.withoutAdopted()
+
 	// Phase 0: Delete Load Balancer (for clusters with HA)
 	if p.IsMultinode() && p.Environment.Status.Cluster != nil {
 		if lbDNS := p.Environment.Status.Cluster.LoadBalancerDNS; lbDNS != "" {
@@ -108,39 +113,48 @@ func (p *Provider) delete(cache *AWS) error {
 			// For now, we'll need to describe the LB to get ARN
 			// But we can also store it in status.Cluster properties
 			// Actually, let's check if we can get it from the environment status
-			if err := p.deleteNLBForCluster(clusterCache); err != nil {
+			if err := p.deleteNLBForCluster(ctx, clusterCache); err != nil {
 				return fmt.Errorf("failed to delete load balancer (resources may be leaked): %w", err)
 			}
 		}
 	}
 
 	// Phase 1: Terminate EC2 instances
-	if err := p.deleteEC2Instances(cache); err != nil {
+	if err := p.deleteEC2Instances(ctx, cache); err != nil {
 		return fmt.Errorf("failed to delete EC2 instances: %w", err)
 	}
 
 	// Phase 1.5: Wait for ENIs to detach after instance termination.
 	// AWS ENIs can linger for 2-5 minutes after termination, blocking SG deletion.
-	if cache.Vpcid != "" {
-		if err := p.waitForENIsDrained(cache.Vpcid); err != nil {
+	if adoptedVPC {
+		if err := p.waitForSecurityGroupENIsDrained(ctx, cache.ownedSecurityGroups()); err != nil {
+			p.log.Warning("ENI drain wait failed (continuing): %v", err)
+		}
+	} else if cache.Vpcid != "" {
+		if err := p.waitForENIsDrained(ctx, cache.Vpcid); err != nil {
 			p.log.Warning("ENI drain wait failed (continuing): %v", err)
 		}
 	}
 
+	// Phase 1.6: Delete the placement groups, now that they are empty
+	if err := p.deletePlacementGroups(ctx, cache); err != nil {
+		return fmt.Errorf("failed to delete placement groups: %w", err)
+	}
+
 	// Phase 2: Delete Security Groups
-	if err := p.deleteSecurityGroups(cache); err != nil {
+	if err := p.deleteSecurityGroups(ctx, cache); err != nil {
 		return fmt.Errorf("failed to delete security groups: %w", err)
 	}
 
 	// Phase 3: Delete VPC and related resources
-	if err := p.deleteVPCResources(cache); err != nil {
+	if err := p.deleteVPCResources(ctx, cache); err != nil {
 		return fmt.Errorf("failed to delete VPC resources: %w", err)
 	}
 
 	return nil
 }
 
-func (p *Provider) deleteEC2Instances(cache *AWS) error {
+func (p *Provider) deleteEC2Instances(ctx context.Context, cache *AWS) error {
 	// Collect all instance IDs to terminate
 	var instanceIDs []string
 
@@ -168,6 +182,11 @@ func (p *Provider) deleteEC2Instances(cache *AWS) error {
 		}
 	}
 
+	return p.terminateInstances(ctx, cache, instanceIDs)
+}
+
+// terminateInstances terminates instanceIDs and waits until they are gone.
+func (p *Provider) terminateInstances(ctx context.Context, cache *AWS, instanceIDs []string) error {
 	if len(instanceIDs) == 0 {
 		p.log.Info("No EC2 instances to delete")
 		return nil
@@ -179,7 +198,7 @@ func (p *Provider) deleteEC2Instances(cache *AWS) error {
 
 	// Terminate all instances with retries
 	err := p.retryWithBackoff(func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+		ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 		defer cancel()
 		_, err := p.ec2.TerminateInstances(ctx, &ec2.TerminateInstancesInput{
 			InstanceIds: instanceIDs,
@@ -213,7 +232,7 @@ func (p *Provider) deleteEC2Instances(cache *AWS) error {
 
 			cancelLoading := p.log.Loading("Waiting for instance %s to be terminated", id)
 
-			ctx, cancel := context.WithTimeout(context.Background(), deletionTimeout)
+			ctx, cancel := context.WithTimeout(ctx, deletionTimeout)
 			defer cancel()
 
 			waiter := ec2.NewInstanceTerminatedWaiter(p.ec2)
@@ -223,7 +242,7 @@ func (p *Provider) deleteEC2Instances(cache *AWS) error {
 
 			if waitErr != nil {
 				// Verify if instance is actually terminated despite waiter error
-				if p.isInstanceTerminated(id) {
+				if p.isInstanceTerminated(ctx, id) {
 					cancelLoading(nil)
 					p.log.Info("Instance %s confirmed terminated despite waiter error", id)
 					return
@@ -234,7 +253,7 @@ func (p *Provider) deleteEC2Instances(cache *AWS) error {
 			}
 
 			// Additional verification
-			if !p.isInstanceTerminated(id) {
+			if !p.isInstanceTerminated(ctx, id) {
 				cancelLoading(logger.ErrLoadingFailed)
 				errChan <- fmt.Errorf("instance %s not terminated after waiting", id)
 				return
@@ -260,7 +279,7 @@ func (p *Provider) deleteEC2Instances(cache *AWS) error {
 	return nil
 }
 
-func (p *Provider) deleteSecurityGroups(cache *AWS) error {
+func (p *Provider) deleteSecurityGroups(ctx context.Context, cache *AWS) error {
 	if err := p.updateProgressingCondition(*p.DeepCopy(), cache, "v1alpha1.Destroying", "Deleting security groups"); err != nil {
 		p.log.Error(fmt.Errorf("failed to update progressing condition: %w", err))
 	}
@@ -270,17 +289,17 @@ func (p *Provider) deleteSecurityGroups(cache *AWS) error {
 	// rules referencing Worker SG. Both must be cleared to avoid
 	// DependencyViolation errors on DeleteSecurityGroup.
 	if cache.WorkerSecurityGroupid != "" && cache.CPSecurityGroupid != "" {
-		if err := p.revokeSecurityGroupRules(cache.CPSecurityGroupid); err != nil {
+		if err := p.revokeSecurityGroupRules(ctx, cache.CPSecurityGroupid); err != nil {
 			p.log.Warning("Error revoking CP SG %s rules (continuing): %v", cache.CPSecurityGroupid, err)
 		}
-		if err := p.revokeSecurityGroupRules(cache.WorkerSecurityGroupid); err != nil {
+		if err := p.revokeSecurityGroupRules(ctx, cache.WorkerSecurityGroupid); err != nil {
 			p.log.Warning("Error revoking Worker SG %s rules (continuing): %v", cache.WorkerSecurityGroupid, err)
 		}
 	}
 
 	// Delete Worker SG first — it references CP SG, so CP SG can't be deleted
 	// while Worker SG still exists.
-	if err := p.deleteSecurityGroup(cache.WorkerSecurityGroupid, "worker"); err != nil {
+	if err := p.deleteSecurityGroup(ctx, cache.WorkerSecurityGroupid, "worker"); err != nil {
 		if err := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Destroying", "Error deleting worker security group"); err != nil {
 			p.log.Error(fmt.Errorf("failed to update degraded condition: %w", err))
 		}
@@ -288,7 +307,7 @@ func (p *Provider) deleteSecurityGroups(cache *AWS) error {
 	}
 
 	// Delete CP SG
-	if err := p.deleteSecurityGroup(cache.CPSecurityGroupid, "control-plane"); err != nil {
+	if err := p.deleteSecurityGroup(ctx, cache.CPSecurityGroupid, "control-plane"); err != nil {
 		if err := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Destroying", "Error deleting control-plane security group"); err != nil {
 			p.log.Error(fmt.Errorf("failed to update degraded condition: %w", err))
 		}
@@ -299,7 +318,7 @@ func (p *Provider) deleteSecurityGroups(cache *AWS) error {
 	if cache.SecurityGroupid == cache.CPSecurityGroupid {
 		cache.SecurityGroupid = "" // already deleted above
 	}
-	if err := p.deleteSecurityGroup(cache.SecurityGroupid, "shared"); err != nil {
+	if err := p.deleteSecurityGroup(ctx, cache.SecurityGroupid, "shared"); err != nil {
 		if err := p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Destroying", "Error deleting security group"); err != nil {
 			p.log.Error(fmt.Errorf("failed to update degraded condition: %w", err))
 		}
@@ -312,14 +331,14 @@ func (p *Provider) deleteSecurityGroups(cache *AWS) error {
 // deleteSecurityGroup deletes a single security group by ID. It gracefully
 // handles empty IDs (skips) and already-deleted groups. The label parameter
 // is used only for logging (e.g. "worker", "control-plane", "shared").
-func (p *Provider) deleteSecurityGroup(sgID, label string) error {
+func (p *Provider) deleteSecurityGroup(ctx context.Context, sgID, label string) error {
 	if sgID == "" {
 		p.log.Info("No %s security group to delete", label)
 		return nil
 	}
 
 	err := p.retryWithBackoff(func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+		ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 		defer cancel()
 		_, err := p.ec2.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{
 			GroupId: &sgID,
@@ -344,7 +363,7 @@ func (p *Provider) deleteSecurityGroup(sgID, label string) error {
 
 	// Verify deletion
 	p.sleep(verificationDelay)
-	if p.securityGroupExists(sgID) {
+	if p.securityGroupExists(ctx, sgID) {
 		return fmt.Errorf("security group %s (%s) still exists after deletion", sgID, label)
 	}
 
@@ -352,7 +371,7 @@ func (p *Provider) deleteSecurityGroup(sgID, label string) error {
 	return nil
 }
 
-func (p *Provider) deleteVPCResources(cache *AWS) error {
+func (p *Provider) deleteVPCResources(ctx context.Context, cache *AWS) error {
 	cancel := p.log.Loading("Deleting VPC resources")
 	defer cancel(nil)
 
@@ -361,44 +380,44 @@ func (p *Provider) deleteVPCResources(cache *AWS) error {
 	}
 
 	// Step 1: Delete NAT Gateway (async — must wait for deleted state before releasing EIP)
-	if err := p.deleteNATGateway(cache); err != nil {
+	if err := p.deleteNATGateway(ctx, cache); err != nil {
 		return err
 	}
 
 	// Step 2: Release Elastic IP (must happen after NAT GW is deleted)
-	if err := p.releaseElasticIP(cache); err != nil {
+	if err := p.releaseElasticIP(ctx, cache); err != nil {
 		return err
 	}
 
 	// Step 3: Delete public subnet (before route table — deleting the subnet
 	// implicitly removes the route table association, avoiding the need for
 	// ec2:DisassociateRouteTable which CI IAM may lack)
-	if err := p.deletePublicSubnet(cache); err != nil {
+	if err := p.deletePublicSubnet(ctx, cache); err != nil {
 		return err
 	}
 
 	// Step 4: Delete public route table (association removed by step 3)
-	if err := p.deletePublicRouteTable(cache); err != nil {
+	if err := p.deletePublicRouteTable(ctx, cache); err != nil {
 		return err
 	}
 
 	// Step 5: Delete private Subnet
-	if err := p.deleteSubnet(cache); err != nil {
+	if err := p.deleteSubnet(ctx, cache); err != nil {
 		return err
 	}
 
 	// Step 6: Delete private Route Table (association removed by step 5)
-	if err := p.deleteRouteTable(cache); err != nil {
+	if err := p.deleteRouteTable(ctx, cache); err != nil {
 		return err
 	}
 
 	// Step 7: Detach and Delete Internet Gateway
-	if err := p.deleteInternetGateway(cache); err != nil {
+	if err := p.deleteInternetGateway(ctx, cache); err != nil {
 		return err
 	}
 
 	// Step 8: Delete VPC
-	if err := p.deleteVPC(cache); err != nil {
+	if err := p.deleteVPC(ctx, cache); err != nil {
 		return err
 	}
 
@@ -412,13 +431,13 @@ func (p *Provider) deleteVPCResources(cache *AWS) error {
 	return p.updateTerminatedCondition(*p.Environment, cache)
 }
 
-func (p *Provider) deleteNATGateway(cache *AWS) error {
+func (p *Provider) deleteNATGateway(ctx context.Context, cache *AWS) error {
 	if cache.NatGatewayid == "" {
 		return nil
 	}
 
 	p.log.Info("Deleting NAT Gateway %s", cache.NatGatewayid)
-	ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+	ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 	defer cancel()
 	_, err := p.ec2.DeleteNatGateway(ctx, &ec2.DeleteNatGatewayInput{
 		NatGatewayId: &cache.NatGatewayid,
@@ -435,7 +454,7 @@ func (p *Provider) deleteNATGateway(cache *AWS) error {
 	p.log.Info("Waiting for NAT Gateway %s to reach deleted state", cache.NatGatewayid)
 	for i := 0; i < 36; i++ { // 36 × 5s = 3 minutes max
 		p.sleep(5 * time.Second)
-		dCtx, dCancel := context.WithTimeout(context.Background(), apiCallTimeout)
+		dCtx, dCancel := context.WithTimeout(ctx, apiCallTimeout)
 		out, err := p.ec2.DescribeNatGateways(dCtx, &ec2.DescribeNatGatewaysInput{
 			NatGatewayIds: []string{cache.NatGatewayid},
 		})
@@ -456,13 +475,13 @@ func (p *Provider) deleteNATGateway(cache *AWS) error {
 	return nil
 }
 
-func (p *Provider) releaseElasticIP(cache *AWS) error {
+func (p *Provider) releaseElasticIP(ctx context.Context, cache *AWS) error {
 	if cache.EIPAllocationid == "" {
 		return nil
 	}
 
 	p.log.Info("Releasing Elastic IP %s", cache.EIPAllocationid)
-	ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+	ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 	defer cancel()
 	_, err := p.ec2.ReleaseAddress(ctx, &ec2.ReleaseAddressInput{
 		AllocationId: &cache.EIPAllocationid,
@@ -479,14 +498,14 @@ func (p *Provider) releaseElasticIP(cache *AWS) error {
 	return nil
 }
 
-func (p *Provider) deletePublicRouteTable(cache *AWS) error {
+func (p *Provider) deletePublicRouteTable(ctx context.Context, cache *AWS) error {
 	if cache.PublicRouteTable == "" {
 		return nil
 	}
 
 	p.log.Info("Deleting public route table %s", cache.PublicRouteTable)
 	err := p.retryWithBackoff(func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+		ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 		defer cancel()
 		_, err := p.ec2.DeleteRouteTable(ctx, &ec2.DeleteRouteTableInput{
 			RouteTableId: &cache.PublicRouteTable,
@@ -508,14 +527,14 @@ func (p *Provider) deletePublicRouteTable(cache *AWS) error {
 	return nil
 }
 
-func (p *Provider) deletePublicSubnet(cache *AWS) error {
+func (p *Provider) deletePublicSubnet(ctx context.Context, cache *AWS) error {
 	if cache.PublicSubnetid == "" {
 		return nil
 	}
 
 	p.log.Info("Deleting public subnet %s", cache.PublicSubnetid)
 	err := p.retryWithBackoff(func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+		ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 		defer cancel()
 		_, err := p.ec2.DeleteSubnet(ctx, &ec2.DeleteSubnetInput{
 			SubnetId: &cache.PublicSubnetid,
@@ -537,14 +556,14 @@ func (p *Provider) deletePublicSubnet(cache *AWS) error {
 	return nil
 }
 
-func (p *Provider) deleteSubnet(cache *AWS) error {
+func (p *Provider) deleteSubnet(ctx context.Context, cache *AWS) error {
 	if cache.Subnetid == "" {
 		p.log.Info("No subnet to delete")
 		return nil
 	}
 
 	err := p.retryWithBackoff(func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+		ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 		defer cancel()
 		_, err := p.ec2.DeleteSubnet(ctx, &ec2.DeleteSubnetInput{
 			SubnetId: &cache.Subnetid,
@@ -565,7 +584,7 @@ func (p *Provider) deleteSubnet(cache *AWS) error {
 
 	// Verify deletion
 	p.sleep(verificationDelay)
-	if p.subnetExists(cache.Subnetid) {
+	if p.subnetExists(ctx, cache.Subnetid) {
 		return fmt.Errorf("subnet %s still exists after deletion", cache.Subnetid)
 	}
 
@@ -573,14 +592,14 @@ func (p *Provider) deleteSubnet(cache *AWS) error {
 	return nil
 }
 
-func (p *Provider) deleteRouteTable(cache *AWS) error {
+func (p *Provider) deleteRouteTable(ctx context.Context, cache *AWS) error {
 	if cache.RouteTable == "" {
 		p.log.Info("No route table to delete")
 		return nil
 	}
 
 	// First, check if this is the main route table (which cannot be deleted)
-	isMain, err := p.isMainRouteTable(cache.RouteTable, cache.Vpcid)
+	isMain, err := p.isMainRouteTable(ctx, cache.RouteTable, cache.Vpcid)
 	if err != nil {
 		p.log.Warning("Error checking if route table is main: %v", err)
 	}
@@ -590,7 +609,7 @@ func (p *Provider) deleteRouteTable(cache *AWS) error {
 	}
 
 	err = p.retryWithBackoff(func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+		ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 		defer cancel()
 		_, err := p.ec2.DeleteRouteTable(ctx, &ec2.DeleteRouteTableInput{
 			RouteTableId: &cache.RouteTable,
@@ -613,7 +632,7 @@ func (p *Provider) deleteRouteTable(cache *AWS) error {
 	return nil
 }
 
-func (p *Provider) deleteInternetGateway(cache *AWS) error {
+func (p *Provider) deleteInternetGateway(ctx context.Context, cache *AWS) error {
 	if cache.InternetGwid == "" {
 		p.log.Info("No Internet Gateway to delete")
 		return nil
@@ -622,7 +641,7 @@ func (p *Provider) deleteInternetGateway(cache *AWS) error {
 	// Step 1: Detach Internet Gateway
 	if cache.Vpcid != "" {
 		err := p.retryWithBackoff(func() error {
-			ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+			ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 			defer cancel()
 			_, err := p.ec2.DetachInternetGateway(ctx, &ec2.DetachInternetGatewayInput{
 				InternetGatewayId: &cache.InternetGwid,
@@ -648,7 +667,7 @@ func (p *Provider) deleteInternetGateway(cache *AWS) error {
 
 	// Step 2: Delete Internet Gateway
 	err := p.retryWithBackoff(func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+		ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 		defer cancel()
 		_, err := p.ec2.DeleteInternetGateway(ctx, &ec2.DeleteInternetGatewayInput{
 			InternetGatewayId: &cache.InternetGwid,
@@ -671,7 +690,7 @@ func (p *Provider) deleteInternetGateway(cache *AWS) error {
 	return nil
 }
 
-func (p *Provider) deleteVPC(cache *AWS) error {
+func (p *Provider) deleteVPC(ctx context.Context, cache *AWS) error {
 	if cache.Vpcid == "" {
 		p.log.Info("No VPC to delete")
 		return nil
@@ -681,7 +700,7 @@ func (p *Provider) deleteVPC(cache *AWS) error {
 	p.sleep(verificationDelay * 2)
 
 	err := p.retryWithBackoff(func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+		ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 		defer cancel()
 		_, err := p.ec2.DeleteVpc(ctx, &ec2.DeleteVpcInput{
 			VpcId: &cache.Vpcid,
@@ -694,7 +713,7 @@ func (p *Provider) deleteVPC(cache *AWS) error {
 			// If VPC has dependencies, list them for debugging
 			if strings.Contains(err.Error(), "DependencyViolation") {
 				p.log.Warning("VPC %s has dependencies, checking...", cache.Vpcid)
-				p.listVPCDependencies(cache.Vpcid)
+				p.listVPCDependencies(ctx, cache.Vpcid)
 				return err
 			}
 			return err
@@ -708,7 +727,7 @@ func (p *Provider) deleteVPC(cache *AWS) error {
 
 	// Verify deletion
 	p.sleep(verificationDelay)
-	if p.vpcExists(cache.Vpcid) {
+	if p.vpcExists(ctx, cache.Vpcid) {
 		return fmt.Errorf("VPC %s still exists after deletion", cache.Vpcid)
 	}
 
@@ -729,61 +748,38 @@ func isAlreadyDetachedError(errMsg string) bool {
 // ENIs in the VPC are detached or deleted. This prevents DependencyViolation
 // errors when deleting security groups, since AWS ENIs can linger for 2-5
 // minutes after instance termination.
-func (p *Provider) waitForENIsDrained(vpcID string) error {
+func (p *Provider) waitForENIsDrained(ctx context.Context, vpcID string) error {
 	if vpcID == "" {
 		return nil
 	}
+	filter := types.Filter{Name: aws.String("vpc-id"), Values: []string{vpcID}}
+	return p.waitForENIs(ctx, filter, "VPC "+vpcID)
+}
 
-	const (
-		eniPollInterval = 10 * time.Second
-		eniPollTimeout  = 5 * time.Minute
-	)
-
-	deadline := time.Now().Add(eniPollTimeout)
-
-	for {
-		ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
-		result, err := p.ec2.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
-			Filters: []types.Filter{
-				{Name: aws.String("vpc-id"), Values: []string{vpcID}},
-			},
-		})
-		cancel()
-
-		if err != nil {
-			p.log.Warning("Error checking ENIs in VPC %s: %v", vpcID, err)
-		} else {
-			// Count non-available ENIs (in-use ENIs block SG deletion)
-			var blocking int
-			for _, eni := range result.NetworkInterfaces {
-				if eni.Status != types.NetworkInterfaceStatusAvailable {
-					blocking++
-				}
-			}
-			if blocking == 0 {
-				p.log.Info("All ENIs in VPC %s are drained", vpcID)
-				return nil
-			}
-			p.log.Info("Waiting for %d in-use ENI(s) in VPC %s to detach...", blocking, vpcID)
-		}
-
-		if time.Now().After(deadline) {
-			return fmt.Errorf("timed out waiting for ENIs to drain in VPC %s", vpcID)
-		}
-
-		p.sleep(eniPollInterval)
+// waitForSecurityGroupENIsDrained is waitForENIsDrained for an adopted VPC:
+// other workloads' ENIs in it never drain, so only the ENIs in the given
+// holodeck-managed security groups are waited on.
+func (p *Provider) waitForSecurityGroupENIsDrained(ctx context.Context, sgIDs []string) error {
+	if len(sgIDs) == 0 {
+		return nil
 	}
+	filter := types.Filter{Name: aws.String("group-id"), Values: sgIDs}
+	return p.waitForENIs(ctx, filter, "security groups "+strings.Join(sgIDs, ", "))
+}
+
+func (p *Provider) waitForENIs(ctx context.Context, filter types.Filter, scope string) error {
+	return internalaws.WaitForENIsDrained(ctx, p.ec2, p.log, p.sleep, filter, scope)
 }
 
 // revokeSecurityGroupRules removes all ingress and egress rules from a security
 // group before deletion. This prevents DependencyViolation errors caused by
 // cross-SG references (e.g., worker SG referencing control-plane SG).
-func (p *Provider) revokeSecurityGroupRules(sgID string) error {
+func (p *Provider) revokeSecurityGroupRules(ctx context.Context, sgID string) error {
 	if sgID == "" {
 		return nil
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), apiCallTimeout)
+	ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
 	defer cancel()
 
 	result, err := p.ec2.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
@@ -805,7 +801,7 @@ func (p *Provider) revokeSecurityGroupRules(sgID string) error {
 	// Revoke all ingress rules
 	if len(sg.IpPermissions) > 0 {
 		p.log.Info("Revoking %d ingress rule(s) from security group %s", len(sg.IpPermissions), sgID)
-		rCtx, rCancel := context.WithTimeout(context.Background(), apiCallTimeout)
+		rCtx, rCancel := context.WithTimeout(ctx, apiCallTimeout)
 		defer rCancel()
 		_, err := p.ec2.RevokeSecurityGroupIngress(rCtx, &ec2.RevokeSecurityGroupIngressInput{
 			GroupId:       &sgID,
@@ -851,8 +847,8 @@ func (p *Provider) retryWithBackoff(operation func() error) error {
 	return fmt.Errorf("operation failed after %d retries", maxRetries)
 }
 
-func (p *Provider) isInstanceTerminated(instanceID string) bool {
-	result, err := p.ec2.DescribeInstances(context.Background(), &ec2.DescribeInstancesInput{
+func (p *Provider) isInstanceTerminated(ctx context.Context, instanceID string) bool {
+	result, err := p.ec2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
 		InstanceIds: []string{instanceID},
 	})
 	if err != nil {
@@ -866,29 +862,29 @@ func (p *Provider) isInstanceTerminated(instanceID string) bool {
 	return true
 }
 
-func (p *Provider) securityGroupExists(sgID string) bool {
-	_, err := p.ec2.DescribeSecurityGroups(context.Background(), &ec2.DescribeSecurityGroupsInput{
+func (p *Provider) securityGroupExists(ctx context.Context, sgID string) bool {
+	_, err := p.ec2.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
 		GroupIds: []string{sgID},
 	})
 	return err == nil
 }
 
-func (p *Provider) subnetExists(subnetID string) bool {
-	_, err := p.ec2.DescribeSubnets(context.Background(), &ec2.DescribeSubnetsInput{
+func (p *Provider) subnetExists(ctx context.Context, subnetID string) bool {
+	_, err := p.ec2.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
 		SubnetIds: []string{subnetID},
 	})
 	return err == nil
 }
 
-func (p *Provider) vpcExists(vpcID string) bool {
-	_, err := p.ec2.DescribeVpcs(context.Background(), &ec2.DescribeVpcsInput{
+func (p *Provider) vpcExists(ctx context.Context, vpcID string) bool {
+	_, err := p.ec2.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
 		VpcIds: []string{vpcID},
 	})
 	return err == nil
 }
 
-func (p *Provider) isMainRouteTable(rtID, vpcID string) (bool, error) {
-	result, err := p.ec2.DescribeRouteTables(context.Background(), &ec2.DescribeRouteTablesInput{
+func (p *Provider) isMainRouteTable(ctx context.Context, rtID, vpcID string) (bool, error) {
+	result, err := p.ec2.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{
 		RouteTableIds: []string{rtID},
 	})
 	if err != nil {
@@ -907,9 +903,9 @@ func (p *Provider) isMainRouteTable(rtID, vpcID string) (bool, error) {
 	return false, nil
 }
 
-func (p *Provider) listVPCDependencies(vpcID string) {
+func (p *Provider) listVPCDependencies(ctx context.Context, vpcID string) {
 	// List remaining network interfaces
-	niResult, err := p.ec2.DescribeNetworkInterfaces(context.Background(), &ec2.DescribeNetworkInterfacesInput{
+	niResult, err := p.ec2.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
 		Filters: []types.Filter{
 			{
 				Name:   aws.String("vpc-id"),
@@ -925,7 +921,7 @@ func (p *Provider) listVPCDependencies(vpcID string) {
 	}
 
 	// List remaining security groups
-	sgResult, err := p.ec2.DescribeSecurityGroups(context.Background(), &ec2.DescribeSecurityGroupsInput{
+	sgResult, err := p.ec2.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
 		Filters: []types.Filter{
 			{
 				Name:   aws.String("vpc-id"),
@@ -943,7 +939,7 @@ func (p *Provider) listVPCDependencies(vpcID string) {
 	}
 
 	// List remaining subnets
-	subnetResult, err := p.ec2.DescribeSubnets(context.Background(), &ec2.DescribeSubnetsInput{
+	subnetResult, err := p.ec2.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
 		Filters: []types.Filter{
 			{
 				Name:   aws.String("vpc-id"),
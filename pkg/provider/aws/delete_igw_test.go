@@ -37,7 +37,7 @@ func TestDeleteInternetGateway_DetachNotFound(t *testing.T) {
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{InternetGwid: "igw-gone", Vpcid: "vpc-123"}
 
-	err := provider.deleteInternetGateway(cache)
+	err := provider.deleteInternetGateway(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("expected no error when IGW is already gone, got: %v", err)
 	}
@@ -66,7 +66,7 @@ func TestDeleteInternetGateway_DetachNotAttached(t *testing.T) {
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{InternetGwid: igwID, Vpcid: "vpc-456"}
 
-	err = provider.deleteInternetGateway(cache)
+	err = provider.deleteInternetGateway(context.Background(), cache)
 	if err != nil {
 		t.Fatalf("expected no error for Gateway.NotAttached, got: %v", err)
 	}
@@ -87,7 +87,7 @@ func TestDeleteInternetGateway_DetachRealErrorRetries(t *testing.T) {
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{InternetGwid: "igw-busy", Vpcid: "vpc-789"}
 
-	err := provider.deleteInternetGateway(cache)
+	err := provider.deleteInternetGateway(context.Background(), cache)
 	if err == nil {
 		t.Fatal("expected error for DependencyViolation, got nil")
 	}
@@ -183,7 +183,7 @@ func TestSecurityGroupExists(t *testing.T) {
 			f := awsfake.New()
 			sgID := tt.setup(f)
 			provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
-			if got := provider.securityGroupExists(sgID); got != tt.expected {
+			if got := provider.securityGroupExists(context.Background(), sgID); got != tt.expected {
 				t.Errorf("securityGroupExists(%s) = %v, want %v", sgID, got, tt.expected)
 			}
 		})
@@ -195,7 +195,7 @@ func TestSecurityGroupExists(t *testing.T) {
 func TestDeleteNATGateway_Empty(t *testing.T) {
 	provider := &Provider{log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{NatGatewayid: ""}
-	if err := provider.deleteNATGateway(cache); err != nil {
+	if err := provider.deleteNATGateway(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error for empty NatGatewayid, got: %v", err)
 	}
 }
@@ -206,7 +206,7 @@ func TestDeleteNATGateway_AlreadyDeleted(t *testing.T) {
 	f := awsfake.New()
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{NatGatewayid: "nat-gone"}
-	if err := provider.deleteNATGateway(cache); err != nil {
+	if err := provider.deleteNATGateway(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error for NatGatewayNotFound, got: %v", err)
 	}
 }
@@ -220,7 +220,7 @@ func TestDeleteNATGateway_WaitsForDeletedState(t *testing.T) {
 
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{NatGatewayid: natID}
-	if err := provider.deleteNATGateway(cache); err != nil {
+	if err := provider.deleteNATGateway(context.Background(), cache); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if got := f.Store.CallsTo("DescribeNatGateways"); got < 2 {
@@ -237,7 +237,7 @@ func TestDeleteNATGateway_EmptyDescribeBreaksLoop(t *testing.T) {
 
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{NatGatewayid: natID}
-	if err := provider.deleteNATGateway(cache); err != nil {
+	if err := provider.deleteNATGateway(context.Background(), cache); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
@@ -245,7 +245,7 @@ func TestDeleteNATGateway_EmptyDescribeBreaksLoop(t *testing.T) {
 func TestReleaseElasticIP_Empty(t *testing.T) {
 	provider := &Provider{log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{EIPAllocationid: ""}
-	if err := provider.releaseElasticIP(cache); err != nil {
+	if err := provider.releaseElasticIP(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error for empty EIPAllocationid, got: %v", err)
 	}
 }
@@ -257,7 +257,7 @@ func TestReleaseElasticIP_Success(t *testing.T) {
 
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{EIPAllocationid: eipID}
-	if err := provider.releaseElasticIP(cache); err != nil {
+	if err := provider.releaseElasticIP(context.Background(), cache); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -279,7 +279,7 @@ func TestReleaseElasticIP_AlreadyReleased(t *testing.T) {
 	f := awsfake.New()
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{EIPAllocationid: "eipalloc-gone"}
-	if err := provider.releaseElasticIP(cache); err != nil {
+	if err := provider.releaseElasticIP(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error for already-released EIP, got: %v", err)
 	}
 }
@@ -287,7 +287,7 @@ func TestReleaseElasticIP_AlreadyReleased(t *testing.T) {
 func TestDeletePublicRouteTable_Empty(t *testing.T) {
 	provider := &Provider{log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{PublicRouteTable: ""}
-	if err := provider.deletePublicRouteTable(cache); err != nil {
+	if err := provider.deletePublicRouteTable(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error for empty PublicRouteTable, got: %v", err)
 	}
 }
@@ -299,7 +299,7 @@ func TestDeletePublicRouteTable_Success(t *testing.T) {
 
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{PublicRouteTable: rtID}
-	if err := provider.deletePublicRouteTable(cache); err != nil {
+	if err := provider.deletePublicRouteTable(context.Background(), cache); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -318,7 +318,7 @@ func TestDeletePublicRouteTable_Success(t *testing.T) {
 func TestDeletePublicSubnet_Empty(t *testing.T) {
 	provider := &Provider{log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{PublicSubnetid: ""}
-	if err := provider.deletePublicSubnet(cache); err != nil {
+	if err := provider.deletePublicSubnet(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error for empty PublicSubnetid, got: %v", err)
 	}
 }
@@ -330,7 +330,7 @@ func TestDeletePublicSubnet_Success(t *testing.T) {
 
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 	cache := &AWS{PublicSubnetid: snID}
-	if err := provider.deletePublicSubnet(cache); err != nil {
+	if err := provider.deletePublicSubnet(context.Background(), cache); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -350,7 +350,7 @@ func TestDeletePublicSubnet_Success(t *testing.T) {
 
 func TestDeleteSecurityGroup_EmptyID(t *testing.T) {
 	provider := &Provider{log: mockLogger(), sleep: noopSleep}
-	if err := provider.deleteSecurityGroup("", "worker"); err != nil {
+	if err := provider.deleteSecurityGroup(context.Background(), "", "worker"); err != nil {
 		t.Fatalf("expected no error for empty SG ID, got: %v", err)
 	}
 }
@@ -360,7 +360,7 @@ func TestDeleteSecurityGroup_AlreadyDeleted(t *testing.T) {
 	// deleteSecurityGroup treats as success.
 	f := awsfake.New()
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
-	if err := provider.deleteSecurityGroup("sg-gone", "control-plane"); err != nil {
+	if err := provider.deleteSecurityGroup(context.Background(), "sg-gone", "control-plane"); err != nil {
 		t.Fatalf("expected no error for InvalidGroup.NotFound, got: %v", err)
 	}
 }
@@ -370,7 +370,7 @@ func TestDeleteSecurityGroup_Success(t *testing.T) {
 	seedSG(f, "sg-cp-123")
 
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
-	if err := provider.deleteSecurityGroup("sg-cp-123", "control-plane"); err != nil {
+	if err := provider.deleteSecurityGroup(context.Background(), "sg-cp-123", "control-plane"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -413,7 +413,7 @@ func TestDeleteSecurityGroups_DualSG_DeleteOrder(t *testing.T) {
 		WorkerSecurityGroupid: "sg-worker",
 	}
 
-	if err := provider.deleteSecurityGroups(cache); err != nil {
+	if err := provider.deleteSecurityGroups(context.Background(), cache); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -450,7 +450,7 @@ func TestDeleteSecurityGroups_SingleNode_EmptyCPAndWorker(t *testing.T) {
 		WorkerSecurityGroupid: "",
 	}
 
-	if err := provider.deleteSecurityGroups(cache); err != nil {
+	if err := provider.deleteSecurityGroups(context.Background(), cache); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -472,7 +472,7 @@ func TestDeleteSecurityGroups_AllEmpty(t *testing.T) {
 	}
 	cache := &AWS{}
 
-	if err := provider.deleteSecurityGroups(cache); err != nil {
+	if err := provider.deleteSecurityGroups(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error when all SG IDs are empty, got: %v", err)
 	}
 }
@@ -496,7 +496,7 @@ func TestDeleteSecurityGroups_SharedSameAsCP(t *testing.T) {
 		WorkerSecurityGroupid: "sg-worker-002",
 	}
 
-	if err := provider.deleteSecurityGroups(cache); err != nil {
+	if err := provider.deleteSecurityGroups(context.Background(), cache); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -566,7 +566,7 @@ func TestDeleteSecurityGroups_DualSG_RevokesCrossReferencePair(t *testing.T) {
 		WorkerSecurityGroupid: "sg-worker",
 	}
 
-	if err := provider.deleteSecurityGroups(cache); err != nil {
+	if err := provider.deleteSecurityGroups(context.Background(), cache); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -615,7 +615,7 @@ func TestRevokeSecurityGroupRules_RevokesIngressOnly(t *testing.T) {
 
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 
-	err := provider.revokeSecurityGroupRules("sg-worker")
+	err := provider.revokeSecurityGroupRules(context.Background(), "sg-worker")
 	if err != nil {
 		t.Fatalf("revokeSecurityGroupRules failed: %v", err)
 	}
@@ -647,7 +647,7 @@ func TestRevokeSecurityGroupRules_SkipsEmptyRules(t *testing.T) {
 
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 
-	err := provider.revokeSecurityGroupRules("sg-empty")
+	err := provider.revokeSecurityGroupRules(context.Background(), "sg-empty")
 	if err != nil {
 		t.Fatalf("revokeSecurityGroupRules failed: %v", err)
 	}
@@ -664,7 +664,7 @@ func TestRevokeSecurityGroupRules_SkipsEmptyID(t *testing.T) {
 	f := awsfake.New()
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 
-	err := provider.revokeSecurityGroupRules("")
+	err := provider.revokeSecurityGroupRules(context.Background(), "")
 	if err != nil {
 		t.Fatalf("revokeSecurityGroupRules should skip empty SG ID, got: %v", err)
 	}
@@ -676,7 +676,7 @@ func TestRevokeSecurityGroupRules_DescribeError(t *testing.T) {
 	f := awsfake.New()
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 
-	err := provider.revokeSecurityGroupRules("sg-gone")
+	err := provider.revokeSecurityGroupRules(context.Background(), "sg-gone")
 	if err != nil {
 		t.Fatalf("revokeSecurityGroupRules should handle NotFound gracefully, got: %v", err)
 	}
@@ -687,7 +687,7 @@ func TestWaitForENIsDrained_NoENIs(t *testing.T) {
 	f := awsfake.New()
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 
-	err := provider.waitForENIsDrained("vpc-123")
+	err := provider.waitForENIsDrained(context.Background(), "vpc-123")
 	if err != nil {
 		t.Fatalf("waitForENIsDrained should succeed with no ENIs, got: %v", err)
 	}
@@ -697,7 +697,7 @@ func TestWaitForENIsDrained_SkipsEmptyVPC(t *testing.T) {
 	f := awsfake.New()
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 
-	err := provider.waitForENIsDrained("")
+	err := provider.waitForENIsDrained(context.Background(), "")
 	if err != nil {
 		t.Fatalf("waitForENIsDrained should skip empty VPC ID, got: %v", err)
 	}
@@ -713,7 +713,7 @@ func TestWaitForENIsDrained_ENIsDrainOnSecondPoll(t *testing.T) {
 
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 
-	err := provider.waitForENIsDrained("vpc-123")
+	err := provider.waitForENIsDrained(context.Background(), "vpc-123")
 	if err != nil {
 		t.Fatalf("waitForENIsDrained should succeed after ENIs drain, got: %v", err)
 	}
@@ -733,7 +733,7 @@ func TestWaitForENIsDrained_AvailableENIsIgnored(t *testing.T) {
 
 	provider := &Provider{ec2: f.EC2, log: mockLogger(), sleep: noopSleep}
 
-	err := provider.waitForENIsDrained("vpc-123")
+	err := provider.waitForENIsDrained(context.Background(), "vpc-123")
 	if err != nil {
 		t.Fatalf("waitForENIsDrained should ignore 'available' ENIs, got: %v", err)
 	}
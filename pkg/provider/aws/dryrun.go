@@ -17,15 +17,16 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NVIDIA/holodeck/internal/logger"
 )
 
-func (p *Provider) DryRun() error {
+func (p *Provider) DryRun(ctx context.Context) error {
 	// Check if the desired instance type is supported in the region
 	cancel := p.log.Loading("Checking if instance type %s is supported in region %s", p.Spec.Type, p.Spec.Region)
-	err := p.checkInstanceTypes()
+	err := p.checkInstanceTypes(ctx)
 	if err != nil {
 		cancel(logger.ErrLoadingFailed)
 		return err
@@ -34,7 +35,7 @@ func (p *Provider) DryRun() error {
 
 	// Check if the desired image is supported in the region
 	cancel = p.log.Loading("Checking image")
-	err = p.checkImages()
+	err = p.checkImages(ctx)
 	if err != nil {
 		cancel(logger.ErrLoadingFailed)
 		return fmt.Errorf("failed to get images: %w", err)
@@ -44,7 +45,7 @@ func (p *Provider) DryRun() error {
 	// Cross-validate architecture compatibility
 	if p.Spec.Image.Architecture != "" {
 		cancel = p.log.Loading("Validating architecture compatibility")
-		archs, err := p.getInstanceTypeArch(p.Spec.Type)
+		archs, err := p.getInstanceTypeArch(ctx, p.Spec.Type)
 		if err != nil {
 			cancel(logger.ErrLoadingFailed)
 			return fmt.Errorf("failed to check instance type architecture: %w", err)
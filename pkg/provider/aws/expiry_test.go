@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aws
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	internalaws "github.com/NVIDIA/holodeck/internal/aws"
+	"github.com/NVIDIA/holodeck/internal/aws/awsfake"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newExpiryTestProvider(t *testing.T, spec v1alpha1.EnvironmentSpec) (*Provider, error) {
+	t.Helper()
+	f := awsfake.New()
+	env := v1alpha1.Environment{Spec: spec}
+	env.Name = "expiring"
+	return New(mockLogger(), env, "", WithEC2Client(f.EC2), WithSSMClient(f.SSM), WithELBv2Client(f.ELBv2))
+}
+
+func expiresAtTag(p *Provider) (string, bool) {
+	for _, tag := range p.Tags {
+		if aws.ToString(tag.Key) == internalaws.ExpiresAtTag {
+			return aws.ToString(tag.Value), true
+		}
+	}
+	return "", false
+}
+
+func TestNew_ExpiresAtTag(t *testing.T) {
+	expiresAt := metav1.NewTime(time.Date(2026, 5, 4, 3, 2, 1, 0, time.UTC))
+	p, err := newExpiryTestProvider(t, v1alpha1.EnvironmentSpec{ExpiresAt: &expiresAt})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	got, ok := expiresAtTag(p)
+	if !ok || got != "2026-05-04T03:02:01Z" {
+		t.Errorf("ExpiresAt tag = %q (present %v), want 2026-05-04T03:02:01Z", got, ok)
+	}
+}
+
+func TestNew_TTLTag(t *testing.T) {
+	spec := v1alpha1.EnvironmentSpec{TTL: &metav1.Duration{Duration: time.Hour}}
+	spec.ResolveTTL(time.Date(2026, 5, 4, 2, 2, 1, 0, time.UTC))
+
+	// Every New, e.g. on create and later on delete, tags the same time
+	for i := 0; i < 2; i++ {
+		p, err := newExpiryTestProvider(t, spec)
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		got, ok := expiresAtTag(p)
+		if !ok || got != "2026-05-04T03:02:01Z" {
+			t.Errorf("ExpiresAt tag = %q (present %v), want 2026-05-04T03:02:01Z", got, ok)
+		}
+	}
+}
+
+func TestNew_NoExpiry(t *testing.T) {
+	p, err := newExpiryTestProvider(t, v1alpha1.EnvironmentSpec{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, ok := expiresAtTag(p); ok {
+		t.Error("ExpiresAt tag set without ttl or expiresAt")
+	}
+}
+
+func TestNew_RejectsInvalidTTL(t *testing.T) {
+	_, err := newExpiryTestProvider(t, v1alpha1.EnvironmentSpec{TTL: &metav1.Duration{Duration: -time.Minute}})
+	if err == nil || !strings.Contains(err.Error(), "must be positive") {
+		t.Fatalf("New() error = %v, want invalid ttl rejected", err)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// isCapacityError checks if a RunInstances error means the instance type
+// cannot be launched in the subnet's zone right now, so that another type or
+// zone may succeed
+func isCapacityError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if isSpotCapacityError(err) || errors.Is(err, errSpotInterrupted) {
+		return true
+	}
+	errStr := err.Error()
+	capacity := []string{
+		"InstanceLimitExceeded",
+		"VcpuLimitExceeded",
+		// The type is not offered in the zone
+		"api error Unsupported:",
+	}
+	for _, c := range capacity {
+		if strings.Contains(errStr, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// runInstanceWithFallbacks launches the single-node instance like
+// runInstance, trying spec.instance.type and then each of its typeFallbacks
+// while RunInstances fails for lack of capacity. When every type fails in a
+// zone, the subnet is moved to the next of spec.instance.availabilityZones
+// and the types are tried again. The instance type that launched is recorded
+// in cache.
+func (p *Provider) runInstanceWithFallbacks(ctx context.Context, cache *AWS, in *ec2.RunInstancesInput,
+	market v1alpha1.MarketType, spotMaxPrice string) (string, v1alpha1.MarketType, error) {
+	candidates := append([]string{p.Spec.Type}, p.Spec.TypeFallbacks...)
+	zones := p.Spec.AvailabilityZones
+	if len(zones) == 0 {
+		zones = []string{""}
+	}
+
+	var errs []error
+	for i, zone := range zones {
+		if i > 0 {
+			if err := p.moveSubnet(ctx, cache, zone); err != nil {
+				return "", "", err
+			}
+		}
+		for _, instanceType := range candidates {
+			attempt := *in
+			attempt.InstanceType = types.InstanceType(instanceType)
+			attempt.NetworkInterfaces = slices.Clone(in.NetworkInterfaces)
+			attempt.NetworkInterfaces[0].SubnetId = aws.String(cache.Subnetid)
+			instanceID, launched, err := p.runInstance(ctx, &attempt, market, spotMaxPrice)
+			if err == nil {
+				cache.InstanceType = instanceType
+				return instanceID, launched, nil
+			}
+			if !isCapacityError(err) || ctx.Err() != nil || len(candidates)*len(zones) == 1 {
+				return instanceID, launched, err
+			}
+			p.log.Warning("No capacity for %s%s, trying the next candidate: %v", instanceType, inZone(zone), err)
+			errs = append(errs, fmt.Errorf("%s%s: %w", instanceType, inZone(zone), err))
+		}
+	}
+	return "", "", fmt.Errorf("no capacity for any instance type candidate: %w", errors.Join(errs...))
+}
+
+// moveSubnet replaces the environment subnet, which holds no instance, with
+// one in zone. The old subnet is deleted first so the new one can reuse its
+// CIDR block.
+func (p *Provider) moveSubnet(ctx context.Context, cache *AWS, zone string) error {
+	p.log.Info("Moving subnet %s to availability zone %s", cache.Subnetid, zone)
+	if err := p.deleteSubnet(ctx, &AWS{Subnetid: cache.Subnetid}); err != nil {
+		return fmt.Errorf("error moving subnet to %s: %w", zone, err)
+	}
+	cache.Subnetid = ""
+	if err := p.createSubnetInZone(ctx, cache, zone); err != nil {
+		return fmt.Errorf("error moving subnet to %s: %w", zone, err)
+	}
+	if err := p.associateRouteTable(ctx, cache); err != nil {
+		return fmt.Errorf("error moving subnet to %s: %w", zone, err)
+	}
+	return nil
+}
+
+func inZone(zone string) string {
+	if zone == "" {
+		return ""
+	}
+	return " in " + zone
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aws
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/aws/awsfake"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+// newFallbackTestProvider returns a provider with a VPC, subnet and route
+// table created in the fake, as Create does before launching the instance.
+func newFallbackTestProvider(t *testing.T, f *awsfake.Fake, fallbacks, zones []string) (*Provider, *AWS) {
+	t.Helper()
+	seedTestImage(f, "ami-123")
+	provider := newTestProvider(f.EC2)
+	provider.Spec.Type = "p5.48xlarge"
+	provider.Spec.Image.ImageId = aws.String("ami-123")
+	provider.Spec.TypeFallbacks = fallbacks
+	provider.Spec.AvailabilityZones = zones
+
+	ctx := context.Background()
+	cache := &AWS{SecurityGroupid: "sg-123"}
+	for _, step := range []func(context.Context, *AWS) error{
+		provider.createVPC,
+		provider.createSubnet,
+		provider.createInternetGateway,
+		provider.createRouteTable,
+	} {
+		if err := step(ctx, cache); err != nil {
+			t.Fatalf("network setup failed: %v", err)
+		}
+	}
+	return provider, cache
+}
+
+func TestCreateEC2Instance_Fallbacks(t *testing.T) {
+	capacityErr := errors.New("api error InsufficientInstanceCapacity: no capacity")
+
+	tests := []struct {
+		name      string
+		fallbacks []string
+		zones     []string
+		failures  []error // RunInstances errors, in order
+		wantTypes []string
+		wantType  string
+		wantZone  string
+		wantErr   string
+	}{
+		{
+			name:      "primary type",
+			fallbacks: []string{"p4d.24xlarge"},
+			wantTypes: []string{"p5.48xlarge"},
+			wantType:  "p5.48xlarge",
+		},
+		{
+			name:      "next type on capacity error",
+			fallbacks: []string{"p4d.24xlarge", "g6.48xlarge"},
+			failures:  []error{capacityErr},
+			wantTypes: []string{"p5.48xlarge", "p4d.24xlarge"},
+			wantType:  "p4d.24xlarge",
+		},
+		{
+			name:      "next zone when every type fails",
+			fallbacks: []string{"p4d.24xlarge"},
+			zones:     []string{"us-west-2a", "us-west-2b"},
+			failures:  []error{capacityErr, capacityErr},
+			wantTypes: []string{"p5.48xlarge", "p4d.24xlarge", "p5.48xlarge"},
+			wantType:  "p5.48xlarge",
+			wantZone:  "us-west-2b",
+		},
+		{
+			name:      "type not offered in the zone",
+			zones:     []string{"us-west-2a", "us-west-2b"},
+			failures:  []error{errors.New("api error Unsupported: not supported in us-west-2a")},
+			wantTypes: []string{"p5.48xlarge", "p5.48xlarge"},
+			wantType:  "p5.48xlarge",
+			wantZone:  "us-west-2b",
+		},
+		{
+			name:      "no fallback on other errors",
+			fallbacks: []string{"p4d.24xlarge"},
+			failures:  []error{errors.New("api error InvalidKeyPair.NotFound")},
+			wantTypes: []string{"p5.48xlarge"},
+			wantErr:   "InvalidKeyPair.NotFound",
+		},
+		{
+			name:      "every candidate exhausted",
+			fallbacks: []string{"p4d.24xlarge"},
+			failures:  []error{capacityErr, capacityErr},
+			wantTypes: []string{"p5.48xlarge", "p4d.24xlarge"},
+			wantErr:   "no capacity for any instance type candidate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := awsfake.New()
+			provider, cache := newFallbackTestProvider(t, f, tt.fallbacks, tt.zones)
+			firstSubnet := cache.Subnetid
+			for _, err := range tt.failures {
+				f.Store.FailNext("RunInstances", err)
+			}
+
+			err := provider.createEC2Instance(context.Background(), cache)
+
+			var gotTypes []string
+			for _, c := range f.Store.Inputs("RunInstances") {
+				gotTypes = append(gotTypes, string(c.(*ec2.RunInstancesInput).InstanceType))
+			}
+			if strings.Join(gotTypes, ",") != strings.Join(tt.wantTypes, ",") {
+				t.Errorf("RunInstances types = %v, want %v", gotTypes, tt.wantTypes)
+			}
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("createEC2Instance() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("createEC2Instance() error = %v", err)
+			}
+			if cache.InstanceType != tt.wantType {
+				t.Errorf("InstanceType = %q, want %q", cache.InstanceType, tt.wantType)
+			}
+			if tt.wantZone == "" {
+				return
+			}
+			if cache.AvailabilityZone != tt.wantZone {
+				t.Errorf("AvailabilityZone = %q, want %q", cache.AvailabilityZone, tt.wantZone)
+			}
+			if _, ok := f.Store.Subnets[firstSubnet]; ok || len(f.Store.Subnets) != 1 {
+				t.Errorf("subnet %s was not replaced: %d subnet(s) in store", firstSubnet, len(f.Store.Subnets))
+			}
+			subnet := f.Store.Subnets[cache.Subnetid]
+			if subnet == nil || aws.ToString(subnet.AvailabilityZone) != tt.wantZone {
+				t.Errorf("subnet %s is not in %s", cache.Subnetid, tt.wantZone)
+			}
+			rt := f.Store.RouteTables[cache.RouteTable]
+			if last := rt.Associations[len(rt.Associations)-1]; aws.ToString(last.SubnetId) != cache.Subnetid {
+				t.Errorf("route table not associated with the new subnet %s", cache.Subnetid)
+			}
+		})
+	}
+}
+
+func TestCheckInstanceTypes_Fallbacks(t *testing.T) {
+	f := awsfake.New()
+	f.Store.SeedInstanceType("p5.48xlarge")
+	provider := newTestProvider(f.EC2)
+	provider.Spec.Type = "p5.48xlarge"
+	provider.Spec.Region = "us-west-2"
+	provider.Spec.TypeFallbacks = []string{"p4d.24xlarge"}
+
+	err := provider.checkInstanceTypes(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "instance type p4d.24xlarge is not supported") {
+		t.Fatalf("checkInstanceTypes() error = %v, want the fallback rejected", err)
+	}
+
+	f.Store.SeedInstanceType("p4d.24xlarge")
+	if err := provider.checkInstanceTypes(context.Background()); err != nil {
+		t.Errorf("checkInstanceTypes() error = %v", err)
+	}
+}
+
+func TestCheckInstanceTypes_FallbackArchitecture(t *testing.T) {
+	f := awsfake.New()
+	f.Store.SeedInstanceType("p5.48xlarge")
+	f.Store.SeedInstanceType("g5g.16xlarge")
+	provider := newTestProvider(f.EC2)
+	provider.Spec.Type = "p5.48xlarge"
+	provider.Spec.Region = "us-west-2"
+	provider.Spec.TypeFallbacks = []string{"g5g.16xlarge"}
+
+	err := provider.checkInstanceTypes(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "g5g.16xlarge does not support the x86_64 architecture") {
+		t.Fatalf("checkInstanceTypes() error = %v, want the arm64 fallback rejected", err)
+	}
+
+	provider.Spec.Type = "g5g.16xlarge"
+	provider.Spec.TypeFallbacks = []string{"p5.48xlarge"}
+	provider.Spec.Image.Architecture = "aarch64"
+	err = provider.checkInstanceTypes(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "p5.48xlarge does not support the arm64 architecture") {
+		t.Fatalf("checkInstanceTypes() error = %v, want the x86_64 fallback rejected", err)
+	}
+}
+
+func TestNew_RejectsClusterFallbacks(t *testing.T) {
+	env := v1alpha1.Environment{}
+	env.Spec.TypeFallbacks = []string{"p4d.24xlarge"}
+	env.Spec.Cluster = &v1alpha1.ClusterSpec{Region: "us-west-2"}
+
+	_, err := New(mockLogger(), env, "", WithEC2Client(awsfake.New().EC2))
+	if err == nil || !strings.Contains(err.Error(), "not supported in cluster mode") {
+		t.Fatalf("New() error = %v, want cluster mode rejected", err)
+	}
+}
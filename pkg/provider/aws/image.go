@@ -20,10 +20,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/ami"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -41,16 +43,16 @@ func (a ByCreationDate) Len() int           { return len(a) }
 func (a ByCreationDate) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a ByCreationDate) Less(i, j int) bool { return a[i].CreationDate < a[j].CreationDate }
 
-func (p *Provider) checkImages() error {
+func (p *Provider) checkImages(ctx context.Context) error {
 	// Check if the given image is supported in the region
 	if p.Spec.Image.ImageId != nil {
-		return p.assertImageIdSupported()
+		return p.assertImageIdSupported(ctx)
 	}
 
-	return p.setAMI()
+	return p.setAMI(ctx)
 }
 
-func (p *Provider) setAMI() error {
+func (p *Provider) setAMI(ctx context.Context) error {
 	// If the image ID is already set by the user, return
 	if p.Spec.Image.ImageId != nil {
 		return nil
@@ -59,19 +61,19 @@ func (p *Provider) setAMI() error {
 	// If OS is specified, use the AMI resolver
 	//nolint:staticcheck // Instance is embedded but explicit access is clearer
 	if p.Spec.Instance.OS != "" {
-		return p.resolveOSToAMI()
+		return p.resolveOSToAMI(ctx)
 	}
 
 	// Fall back to legacy behavior: Ubuntu 22.04 by default
-	return p.setLegacyAMI()
+	return p.setLegacyAMI(ctx)
 }
 
 // resolveOSToAMI uses the AMI resolver to look up the AMI for the specified OS.
-func (p *Provider) resolveOSToAMI() error {
+func (p *Provider) resolveOSToAMI(ctx context.Context) error {
 	arch := p.Spec.Image.Architecture
 	if arch == "" {
 		// Infer architecture from instance type (e.g., arm64 for g5g/m7g/c7g)
-		inferred, err := p.inferArchFromInstanceType(p.Spec.Type)
+		inferred, err := p.inferArchFromInstanceType(ctx, p.Spec.Type)
 		if err != nil {
 			return fmt.Errorf(
 				"failed to infer architecture from instance type %s: %w; set spec.image.architecture explicitly to override",
@@ -84,7 +86,7 @@ func (p *Provider) resolveOSToAMI() error {
 
 	//nolint:staticcheck // Instance is embedded but explicit access is clearer
 	resolved, err := p.amiResolver.Resolve(
-		context.TODO(),
+		ctx,
 		p.Spec.Instance.OS,
 		arch,
 	)
@@ -120,16 +122,25 @@ type ResolvedImage struct {
 // resolveImageForNode resolves the AMI for a node based on OS or explicit Image.
 // This method does not mutate provider state, making it safe for cluster mode
 // where different node pools may use different images.
-func (p *Provider) resolveImageForNode(os string, image *v1alpha1.Image, arch string) (*ResolvedImage, error) {
+func (p *Provider) resolveImageForNode(ctx context.Context, os string, image *v1alpha1.Image, arch string) (*ResolvedImage, error) {
 	// If explicit ImageId is provided, use it
 	if image != nil && image.ImageId != nil && *image.ImageId != "" {
-		arch, err := p.describeImageArch(*image.ImageId)
+		arch, err := p.describeImageArch(ctx, *image.ImageId)
 		if err != nil {
 			return nil, fmt.Errorf("failed to determine architecture for image %s: %w", *image.ImageId, err)
 		}
+		// A pinned image of a registered OS, e.g. from a lock file, keeps
+		// that OS's username; otherwise it must be provided in auth config
+		if os == "" {
+			os = p.Spec.Instance.OS //nolint:staticcheck
+		}
+		var sshUsername string
+		if osImage, ok := ami.Get(os); ok {
+			sshUsername = osImage.SSHUsername
+		}
 		return &ResolvedImage{
 			ImageID:      *image.ImageId,
-			SSHUsername:  "", // Username must be provided in auth config
+			SSHUsername:  sshUsername,
 			Architecture: arch,
 		}, nil
 	}
@@ -146,7 +157,7 @@ func (p *Provider) resolveImageForNode(os string, image *v1alpha1.Image, arch st
 
 	// If OS is specified, resolve via AMI resolver
 	if os != "" {
-		resolved, err := p.amiResolver.Resolve(context.TODO(), os, arch)
+		resolved, err := p.amiResolver.Resolve(ctx, os, arch)
 		if err != nil {
 			return nil, fmt.Errorf("failed to resolve AMI for OS %s: %w", os, err)
 		}
@@ -160,7 +171,7 @@ func (p *Provider) resolveImageForNode(os string, image *v1alpha1.Image, arch st
 	// Fall back to legacy behavior: use Instance.OS or default Ubuntu 22.04
 	//nolint:staticcheck // Instance is embedded but explicit access is clearer
 	if p.Spec.Instance.OS != "" {
-		resolved, err := p.amiResolver.Resolve(context.TODO(), p.Spec.Instance.OS, arch)
+		resolved, err := p.amiResolver.Resolve(ctx, p.Spec.Instance.OS, arch)
 		if err != nil {
 			return nil, fmt.Errorf("failed to resolve AMI for OS %s: %w", p.Spec.Instance.OS, err)
 		}
@@ -173,7 +184,7 @@ func (p *Provider) resolveImageForNode(os string, image *v1alpha1.Image, arch st
 
 	// Fall back to legacy AMI lookup for Ubuntu 22.04
 	// Use findLegacyAMI to avoid mutating provider state
-	imageID, err := p.findLegacyAMI(arch)
+	imageID, err := p.findLegacyAMI(ctx, arch)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +197,7 @@ func (p *Provider) resolveImageForNode(os string, image *v1alpha1.Image, arch st
 
 // findLegacyAMI looks up the latest Ubuntu 22.04 AMI without mutating state.
 // This is a pure query function safe for use in cluster mode.
-func (p *Provider) findLegacyAMI(arch string) (string, error) {
+func (p *Provider) findLegacyAMI(ctx context.Context, arch string) (string, error) {
 	// Default to the official Ubuntu images in the AWS Marketplace
 	awsOwner := []string{"099720109477", "679593333241"}
 	if p.Spec.Image.OwnerId != nil {
@@ -235,7 +246,7 @@ func (p *Provider) findLegacyAMI(arch string) (string, error) {
 		},
 	}
 
-	images, err := p.describeImages(filter)
+	images, err := p.describeImages(ctx, filter)
 	if err != nil {
 		return "", fmt.Errorf("failed to describe images: %w", err)
 	}
@@ -252,12 +263,12 @@ func (p *Provider) findLegacyAMI(arch string) (string, error) {
 
 // setLegacyAMI implements the original Ubuntu 22.04 default behavior for
 // backward compatibility when OS is not specified. This mutates provider state.
-func (p *Provider) setLegacyAMI() error {
+func (p *Provider) setLegacyAMI(ctx context.Context) error {
 	// Determine architecture before AMI lookup
 	arch := p.Spec.Image.Architecture
 	if arch == "" {
 		// Infer architecture from instance type (e.g., arm64 for g5g/m7g/c7g)
-		inferred, err := p.inferArchFromInstanceType(p.Spec.Type)
+		inferred, err := p.inferArchFromInstanceType(ctx, p.Spec.Type)
 		if err != nil {
 			return fmt.Errorf(
 				"failed to infer architecture from instance type %s: %w; set spec.image.architecture explicitly to override",
@@ -268,7 +279,7 @@ func (p *Provider) setLegacyAMI() error {
 		arch = inferred
 	}
 
-	imageID, err := p.findLegacyAMI(arch)
+	imageID, err := p.findLegacyAMI(ctx, arch)
 	if err != nil {
 		return err
 	}
@@ -284,8 +295,8 @@ func (p *Provider) setLegacyAMI() error {
 	return nil
 }
 
-func (p *Provider) assertImageIdSupported() error {
-	images, err := p.describeImages([]types.Filter{})
+func (p *Provider) assertImageIdSupported(ctx context.Context) error {
+	images, err := p.describeImages(ctx, []types.Filter{})
 	if err == nil {
 		for _, image := range images {
 			if image.ImageID == *p.Spec.Image.ImageId {
@@ -297,13 +308,13 @@ func (p *Provider) assertImageIdSupported() error {
 	return errors.Join(err, fmt.Errorf("image %s is not supported in the current region %s", *p.Spec.Image.ImageId, p.Spec.Region))
 }
 
-func (p *Provider) describeImages(filter []types.Filter) ([]ImageInfo, error) {
+func (p *Provider) describeImages(ctx context.Context, filter []types.Filter) ([]ImageInfo, error) {
 	var images []ImageInfo
 	var nextToken *string
 
 	for {
 		// Use the DescribeImages API to get a list of supported images in the current region
-		resp, err := p.ec2.DescribeImages(context.TODO(), &ec2.DescribeImagesInput{
+		resp, err := p.ec2.DescribeImages(ctx, &ec2.DescribeImagesInput{
 			NextToken: nextToken,
 			Filters:   filter,
 		})
@@ -331,22 +342,33 @@ func (p *Provider) describeImages(filter []types.Filter) ([]ImageInfo, error) {
 	return images, nil
 }
 
-func (p *Provider) checkInstanceTypes() error {
+func (p *Provider) checkInstanceTypes(ctx context.Context) error {
 	// Collect all instance types that need validation.
 	// For cluster configs, check control-plane and worker instance types;
-	// for single-node configs, check the instance type field.
+	// for single-node configs, check the instance type and its fallbacks.
 	needed := make(map[string]bool)
+	archs := make(map[string][]string)
 	if p.Spec.Cluster != nil {
 		if t := p.Spec.Cluster.ControlPlane.InstanceType; t != "" {
 			needed[t] = false
 		}
-		if p.Spec.Cluster.Workers != nil {
-			if t := p.Spec.Cluster.Workers.InstanceType; t != "" {
+		for _, w := range p.Spec.Cluster.WorkerPoolList() {
+			if t := w.InstanceType; t != "" {
 				needed[t] = false
 			}
 		}
+		if p.Spec.Cluster.ExternalEtcd() {
+			t := defaultEtcdInstanceType
+			if p.Spec.Cluster.Etcd != nil && p.Spec.Cluster.Etcd.InstanceType != "" {
+				t = p.Spec.Cluster.Etcd.InstanceType
+			}
+			needed[t] = false
+		}
 	} else if p.Spec.Type != "" {
 		needed[p.Spec.Type] = false
+		for _, t := range p.Spec.TypeFallbacks {
+			needed[t] = false
+		}
 	}
 
 	if len(needed) == 0 {
@@ -355,7 +377,7 @@ func (p *Provider) checkInstanceTypes() error {
 
 	var nextToken *string
 	for {
-		resp, err := p.ec2.DescribeInstanceTypes(context.TODO(), &ec2.DescribeInstanceTypesInput{NextToken: nextToken})
+		resp, err := p.ec2.DescribeInstanceTypes(ctx, &ec2.DescribeInstanceTypesInput{NextToken: nextToken})
 		if err != nil {
 			return err
 		}
@@ -363,6 +385,11 @@ func (p *Provider) checkInstanceTypes() error {
 		for _, it := range resp.InstanceTypes {
 			if _, ok := needed[string(it.InstanceType)]; ok {
 				needed[string(it.InstanceType)] = true
+				if it.ProcessorInfo != nil {
+					for _, a := range it.ProcessorInfo.SupportedArchitectures {
+						archs[string(it.InstanceType)] = append(archs[string(it.InstanceType)], string(a))
+					}
+				}
 			}
 		}
 
@@ -375,7 +402,7 @@ func (p *Provider) checkInstanceTypes() error {
 			}
 		}
 		if allFound {
-			return nil
+			return p.checkFallbackArchs(archs)
 		}
 
 		if resp.NextToken != nil {
@@ -395,6 +422,24 @@ func (p *Provider) checkInstanceTypes() error {
 			return fmt.Errorf("instance type %s is not supported in the current region %s", instanceType, region)
 		}
 	}
+	return p.checkFallbackArchs(archs)
+}
+
+// checkFallbackArchs checks that every fallback instance type supports the
+// architecture of the AMI, which is resolved for spec.instance.type.
+func (p *Provider) checkFallbackArchs(archs map[string][]string) error {
+	if p.Spec.Cluster != nil || len(p.Spec.TypeFallbacks) == 0 {
+		return nil
+	}
+	arch := normalizeArchToEC2(p.Spec.Image.Architecture)
+	if arch == "" {
+		arch = preferredArch(archs[p.Spec.Type])
+	}
+	for _, t := range p.Spec.TypeFallbacks {
+		if !slices.Contains(archs[t], arch) {
+			return fmt.Errorf("instance type fallback %s does not support the %s architecture of %s", t, arch, p.Spec.Type)
+		}
+	}
 	return nil
 }
 
@@ -417,11 +462,17 @@ func normalizeArchToEC2(arch string) string {
 // "arm64"; otherwise returns "x86_64" for backward compatibility.
 // This enables automatic ARM64 AMI selection when users specify an arm64-only
 // instance type (e.g., g5g, m7g, c7g) without explicitly setting Architecture.
-func (p *Provider) inferArchFromInstanceType(instanceType string) (string, error) {
-	archs, err := p.getInstanceTypeArch(instanceType)
+func (p *Provider) inferArchFromInstanceType(ctx context.Context, instanceType string) (string, error) {
+	archs, err := p.getInstanceTypeArch(ctx, instanceType)
 	if err != nil {
 		return "", err
 	}
+	return preferredArch(archs), nil
+}
+
+// preferredArch returns "arm64" for an arm64-only instance type and
+// "x86_64" otherwise.
+func preferredArch(archs []string) string {
 	hasX86 := false
 	hasArm := false
 	for _, a := range archs {
@@ -433,15 +484,15 @@ func (p *Provider) inferArchFromInstanceType(instanceType string) (string, error
 		}
 	}
 	if hasArm && !hasX86 {
-		return "arm64", nil
+		return "arm64"
 	}
-	return "x86_64", nil
+	return "x86_64"
 }
 
 // describeImageArch queries EC2 DescribeImages for a specific AMI ID and
 // returns its architecture string (e.g., "x86_64" or "arm64").
-func (p *Provider) describeImageArch(imageID string) (string, error) {
-	resp, err := p.ec2.DescribeImages(context.TODO(), &ec2.DescribeImagesInput{
+func (p *Provider) describeImageArch(ctx context.Context, imageID string) (string, error) {
+	resp, err := p.ec2.DescribeImages(ctx, &ec2.DescribeImagesInput{
 		ImageIds: []string{imageID},
 	})
 	if err != nil {
@@ -458,8 +509,8 @@ func (p *Provider) describeImageArch(imageID string) (string, error) {
 // Different AMIs use different root device names — Ubuntu and Rocky use /dev/sda1,
 // while Amazon Linux 2023 uses /dev/xvda. Using the wrong device name causes
 // the volume size to be applied to a secondary disk instead of the root volume.
-func (p *Provider) describeImageRootDevice(imageID string) (string, error) {
-	resp, err := p.ec2.DescribeImages(context.TODO(), &ec2.DescribeImagesInput{
+func (p *Provider) describeImageRootDevice(ctx context.Context, imageID string) (string, error) {
+	resp, err := p.ec2.DescribeImages(ctx, &ec2.DescribeImagesInput{
 		ImageIds: []string{imageID},
 	})
 	if err != nil {
@@ -478,8 +529,8 @@ func (p *Provider) describeImageRootDevice(imageID string) (string, error) {
 
 // getInstanceTypeArch queries EC2 DescribeInstanceTypes for a specific instance
 // type and returns its list of supported architecture strings.
-func (p *Provider) getInstanceTypeArch(instanceType string) ([]string, error) {
-	resp, err := p.ec2.DescribeInstanceTypes(context.TODO(), &ec2.DescribeInstanceTypesInput{
+func (p *Provider) getInstanceTypeArch(ctx context.Context, instanceType string) ([]string, error) {
+	resp, err := p.ec2.DescribeInstanceTypes(ctx, &ec2.DescribeInstanceTypesInput{
 		InstanceTypes: []types.InstanceType{types.InstanceType(instanceType)},
 	})
 	if err != nil {
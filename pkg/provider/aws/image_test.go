@@ -90,7 +90,7 @@ func TestResolveImageForNode(t *testing.T) {
 	}{
 		{
 			name: "explicit ImageId takes precedence",
-			os:   "ubuntu-22.04", // Should be ignored
+			os:   "ubuntu-22.04", // Only determines the username
 			image: &v1alpha1.Image{
 				ImageId:      aws.String("ami-explicit-123"),
 				Architecture: "x86_64",
@@ -102,7 +102,7 @@ func TestResolveImageForNode(t *testing.T) {
 				})
 			},
 			wantImageID: "ami-explicit-123",
-			wantSSHUser: "", // Must be provided in auth config
+			wantSSHUser: "ubuntu",
 			wantErr:     false,
 		},
 		{
@@ -325,7 +325,7 @@ func TestResolveImageForNode(t *testing.T) {
 			}
 
 			// Call the function under test
-			result, err := p.resolveImageForNode(tt.os, tt.image, tt.arch)
+			result, err := p.resolveImageForNode(context.Background(), tt.os, tt.image, tt.arch)
 
 			// Assertions
 			if tt.wantErr {
@@ -381,7 +381,7 @@ func TestResolveImageForNode_DoesNotMutateState(t *testing.T) {
 	initialImageId := p.Spec.Image.ImageId
 
 	// Call resolveImageForNode with no OS (triggers legacy fallback)
-	result, err := p.resolveImageForNode("", nil, "")
+	result, err := p.resolveImageForNode(context.Background(), "", nil, "")
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Equal(t, "ami-legacy-fallback", result.ImageID)
@@ -493,7 +493,7 @@ func TestFindLegacyAMI(t *testing.T) {
 				ec2:         f.EC2,
 			}
 
-			imageID, err := p.findLegacyAMI(tt.arch)
+			imageID, err := p.findLegacyAMI(context.Background(), tt.arch)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -587,7 +587,7 @@ func TestDescribeImageArch(t *testing.T) {
 				ec2:         f.EC2,
 			}
 
-			arch, err := p.describeImageArch(tt.imageID)
+			arch, err := p.describeImageArch(context.Background(), tt.imageID)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -669,7 +669,7 @@ func TestGetInstanceTypeArch(t *testing.T) {
 				ec2:         f.EC2,
 			}
 
-			archs, err := p.getInstanceTypeArch(tt.instanceType)
+			archs, err := p.getInstanceTypeArch(context.Background(), tt.instanceType)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -713,7 +713,7 @@ func TestResolveImageForNode_ExplicitImageId_ReturnsArchitecture(t *testing.T) {
 	image := &v1alpha1.Image{
 		ImageId: aws.String("ami-arm64-custom"),
 	}
-	result, err := p.resolveImageForNode("", image, "")
+	result, err := p.resolveImageForNode(context.Background(), "", image, "")
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Equal(t, "ami-arm64-custom", result.ImageID)
@@ -764,7 +764,7 @@ func TestDryRun_ArchitectureMismatch(t *testing.T) {
 		log:         log,
 	}
 
-	err := p.DryRun()
+	err := p.DryRun(context.Background())
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "architecture mismatch")
 	assert.Contains(t, err.Error(), "arm64")
@@ -809,7 +809,7 @@ func TestDryRun_ArchitectureMatch(t *testing.T) {
 		log:         log,
 	}
 
-	err := p.DryRun()
+	err := p.DryRun(context.Background())
 	require.NoError(t, err)
 }
 
@@ -881,7 +881,7 @@ func TestInferArchFromInstanceType(t *testing.T) {
 			}
 
 			p := &Provider{ec2: f.EC2}
-			arch, err := p.inferArchFromInstanceType(tt.instanceType)
+			arch, err := p.inferArchFromInstanceType(context.Background(), tt.instanceType)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -933,7 +933,7 @@ func TestResolveOSToAMI_InfersArchFromInstanceType(t *testing.T) {
 		amiResolver: resolver,
 	}
 
-	err := p.resolveOSToAMI()
+	err := p.resolveOSToAMI(context.Background())
 	require.NoError(t, err)
 
 	// Architecture should have been inferred as arm64
@@ -1011,7 +1011,7 @@ func TestDescribeImageRootDevice(t *testing.T) {
 
 			p := &Provider{ec2: f.EC2}
 
-			device, err := p.describeImageRootDevice(tt.imageID)
+			device, err := p.describeImageRootDevice(context.Background(), tt.imageID)
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
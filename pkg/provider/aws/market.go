@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// spotTerminationReason is the state reason code of an instance reclaimed
+// by the spot service.
+const spotTerminationReason = "Server.SpotInstanceTermination"
+
+// errSpotInterrupted is returned when a spot instance is reclaimed before it
+// reaches the running state.
+var errSpotInterrupted = errors.New("spot instance interrupted")
+
+// marketFor returns the effective market and spot max price of a node pool,
+// falling back to spec.instance when the pool sets none.
+func (p *Provider) marketFor(market v1alpha1.MarketType, spotMaxPrice string) (v1alpha1.MarketType, string) {
+	if market == "" {
+		market = p.Spec.Market
+	}
+	if spotMaxPrice == "" {
+		spotMaxPrice = p.Spec.SpotMaxPrice
+	}
+	if market == "" {
+		market = v1alpha1.MarketOnDemand
+	}
+	return market, spotMaxPrice
+}
+
+// spotMarketOptions returns the RunInstances market options for a one-time
+// spot request. Without maxPrice the spot price is capped at on-demand.
+func spotMarketOptions(maxPrice string) *types.InstanceMarketOptionsRequest {
+	opts := &types.SpotMarketOptions{
+		SpotInstanceType:             types.SpotInstanceTypeOneTime,
+		InstanceInterruptionBehavior: types.InstanceInterruptionBehaviorTerminate,
+	}
+	if maxPrice != "" {
+		opts.MaxPrice = aws.String(maxPrice)
+	}
+	return &types.InstanceMarketOptionsRequest{
+		MarketType:  types.MarketTypeSpot,
+		SpotOptions: opts,
+	}
+}
+
+// isSpotCapacityError checks if a RunInstances error means spot capacity is
+// not available at the requested price
+func isSpotCapacityError(err error) bool {
+	if err == nil {
+		return false
+	}
+	errStr := err.Error()
+	capacity := []string{
+		"InsufficientInstanceCapacity",
+		"InsufficientCapacity",
+		"SpotMaxPriceTooLow",
+		"MaxSpotInstanceCountExceeded",
+		"UnfulfillableCapacity",
+	}
+	for _, c := range capacity {
+		if strings.Contains(errStr, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// runInstance launches a single instance from in with the given market and
+// waits for it to be running. It returns the instance ID, which is set
+// whenever an instance was launched even if waiting for it failed, and the
+// market the instance was launched with. With spot-with-fallback, a spot
+// launch that fails for lack of capacity or is interrupted before it starts
+// is retried on-demand.
+func (p *Provider) runInstance(ctx context.Context, in *ec2.RunInstancesInput,
+	market v1alpha1.MarketType, spotMaxPrice string) (string, v1alpha1.MarketType, error) {
+	if market == v1alpha1.MarketSpot || market == v1alpha1.MarketSpotWithFallback {
+		spotIn := *in
+		spotIn.InstanceMarketOptions = spotMarketOptions(spotMaxPrice)
+		instanceID, err := p.launchInstance(ctx, &spotIn)
+		if err == nil {
+			return instanceID, v1alpha1.MarketSpot, nil
+		}
+		fallback := isSpotCapacityError(err) || errors.Is(err, errSpotInterrupted)
+		if market == v1alpha1.MarketSpot || !fallback || ctx.Err() != nil {
+			return instanceID, v1alpha1.MarketSpot, err
+		}
+		p.log.Warning("Spot %s unavailable, falling back to on-demand: %v", in.InstanceType, err)
+	}
+
+	instanceID, err := p.launchInstance(ctx, in)
+	return instanceID, v1alpha1.MarketOnDemand, err
+}
+
+// launchInstance runs in and waits for the instance to be running.
+func (p *Provider) launchInstance(ctx context.Context, in *ec2.RunInstancesInput) (string, error) {
+	ctxRun, cancelRun := context.WithTimeout(ctx, ec2APITimeout)
+	instanceOut, err := p.ec2.RunInstances(ctxRun, in)
+	cancelRun()
+	if err != nil {
+		return "", fmt.Errorf("error creating instance: %w", err)
+	}
+	if len(instanceOut.Instances) == 0 {
+		return "", fmt.Errorf("error creating instance: RunInstances returned no instances")
+	}
+	instanceID := aws.ToString(instanceOut.Instances[0].InstanceId)
+
+	waiterOptions := []func(*ec2.InstanceRunningWaiterOptions){
+		func(o *ec2.InstanceRunningWaiterOptions) {
+			o.MaxDelay = 1 * time.Minute
+			o.MinDelay = 5 * time.Second
+		},
+	}
+	waiter := ec2.NewInstanceRunningWaiter(p.ec2, waiterOptions...)
+
+	ctxWait, cancelWait := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancelWait()
+	if err = waiter.Wait(ctxWait, &ec2.DescribeInstancesInput{
+		InstanceIds: []string{instanceID},
+	}, 5*time.Minute, waiterOptions...); err != nil {
+		if in.InstanceMarketOptions != nil && ctx.Err() == nil && p.spotInterrupted(ctx, instanceID) {
+			return instanceID, fmt.Errorf("%w: %s", errSpotInterrupted, instanceID)
+		}
+		return instanceID, fmt.Errorf("error waiting for instance to be in running state: %w", err)
+	}
+	return instanceID, nil
+}
+
+// spotInterrupted reports whether the spot service reclaimed instanceID.
+func (p *Provider) spotInterrupted(ctx context.Context, instanceID string) bool {
+	out, err := p.ec2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []string{instanceID},
+	})
+	if err != nil || len(out.Reservations) == 0 || len(out.Reservations[0].Instances) == 0 {
+		return false
+	}
+	reason := out.Reservations[0].Instances[0].StateReason
+	return reason != nil && aws.ToString(reason.Code) == spotTerminationReason
+}
+
+// clusterMarket summarizes the markets of the cluster nodes for the
+// instance-market property: the shared market, or "mixed".
+func clusterMarket(instances ...[]InstanceInfo) string {
+	market := ""
+	for _, pool := range instances {
+		for _, inst := range pool {
+			switch {
+			case market == "":
+				market = string(inst.Market)
+			case market != string(inst.Market):
+				return marketMixed
+			}
+		}
+	}
+	return market
+}
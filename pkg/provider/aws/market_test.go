@@ -0,0 +1,240 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/aws/awsfake"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func runInput() *ec2.RunInstancesInput {
+	return &ec2.RunInstancesInput{
+		ImageId:      aws.String("ami-123"),
+		InstanceType: types.InstanceType("g4dn.xlarge"),
+		MaxCount:     aws.Int32(1),
+		MinCount:     aws.Int32(1),
+	}
+}
+
+func TestRunInstance_Market(t *testing.T) {
+	capacityErr := errors.New("api error InsufficientInstanceCapacity: no spot capacity")
+
+	tests := []struct {
+		name         string
+		market       v1alpha1.MarketType
+		spotMaxPrice string
+		setup        func(f *awsfake.Fake)
+		wantMarkets  []types.MarketType // per RunInstances call, "" for on-demand
+		wantLaunched v1alpha1.MarketType
+		wantErr      string
+	}{
+		{
+			name:         "on-demand",
+			market:       v1alpha1.MarketOnDemand,
+			wantMarkets:  []types.MarketType{""},
+			wantLaunched: v1alpha1.MarketOnDemand,
+		},
+		{
+			name:         "spot",
+			market:       v1alpha1.MarketSpot,
+			spotMaxPrice: "0.50",
+			wantMarkets:  []types.MarketType{types.MarketTypeSpot},
+			wantLaunched: v1alpha1.MarketSpot,
+		},
+		{
+			name:        "spot without capacity fails",
+			market:      v1alpha1.MarketSpot,
+			setup:       func(f *awsfake.Fake) { f.Store.FailNext("RunInstances", capacityErr) },
+			wantMarkets: []types.MarketType{types.MarketTypeSpot},
+			wantErr:     "InsufficientInstanceCapacity",
+		},
+		{
+			name:         "fallback on capacity error",
+			market:       v1alpha1.MarketSpotWithFallback,
+			setup:        func(f *awsfake.Fake) { f.Store.FailNext("RunInstances", capacityErr) },
+			wantMarkets:  []types.MarketType{types.MarketTypeSpot, ""},
+			wantLaunched: v1alpha1.MarketOnDemand,
+		},
+		{
+			name:         "fallback on interruption",
+			market:       v1alpha1.MarketSpotWithFallback,
+			setup:        func(f *awsfake.Fake) { f.Store.InterruptNextSpot() },
+			wantMarkets:  []types.MarketType{types.MarketTypeSpot, ""},
+			wantLaunched: v1alpha1.MarketOnDemand,
+		},
+		{
+			name:   "no fallback on other errors",
+			market: v1alpha1.MarketSpotWithFallback,
+			setup: func(f *awsfake.Fake) {
+				f.Store.FailNext("RunInstances", errors.New("InvalidParameterValue"))
+			},
+			wantMarkets: []types.MarketType{types.MarketTypeSpot},
+			wantErr:     "InvalidParameterValue",
+		},
+		{
+			name:        "spot interruption without fallback",
+			market:      v1alpha1.MarketSpot,
+			setup:       func(f *awsfake.Fake) { f.Store.InterruptNextSpot() },
+			wantMarkets: []types.MarketType{types.MarketTypeSpot},
+			wantErr:     "spot instance interrupted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := awsfake.New()
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+			provider := newTestProvider(f.EC2)
+
+			id, launched, err := provider.runInstance(context.Background(), runInput(), tt.market, tt.spotMaxPrice)
+
+			calls := f.Store.Inputs("RunInstances")
+			if len(calls) != len(tt.wantMarkets) {
+				t.Fatalf("RunInstances called %d times, want %d", len(calls), len(tt.wantMarkets))
+			}
+			for i, c := range calls {
+				opts := c.(*ec2.RunInstancesInput).InstanceMarketOptions
+				var got types.MarketType
+				if opts != nil {
+					got = opts.MarketType
+					if i == 0 && aws.ToString(opts.SpotOptions.MaxPrice) != tt.spotMaxPrice {
+						t.Errorf("MaxPrice = %q, want %q", aws.ToString(opts.SpotOptions.MaxPrice), tt.spotMaxPrice)
+					}
+				}
+				if got != tt.wantMarkets[i] {
+					t.Errorf("call %d market = %q, want %q", i, got, tt.wantMarkets[i])
+				}
+			}
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("runInstance() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("runInstance() error = %v", err)
+			}
+			if launched != tt.wantLaunched {
+				t.Errorf("launched market = %q, want %q", launched, tt.wantLaunched)
+			}
+			if inst := f.Store.Instances[id]; inst == nil || inst.State.Name != types.InstanceStateNameRunning {
+				t.Errorf("instance %q is not running", id)
+			}
+		})
+	}
+}
+
+// noInstancesEC2 answers RunInstances without an error or any instance.
+type noInstancesEC2 struct {
+	*awsfake.FakeEC2
+}
+
+func (c *noInstancesEC2) RunInstances(context.Context, *ec2.RunInstancesInput, ...func(*ec2.Options)) (*ec2.RunInstancesOutput, error) {
+	return &ec2.RunInstancesOutput{}, nil
+}
+
+func TestRunInstance_NoInstances(t *testing.T) {
+	f := awsfake.New()
+	provider := newTestProvider(&noInstancesEC2{FakeEC2: f.EC2})
+
+	_, _, err := provider.runInstance(context.Background(), runInput(), v1alpha1.MarketOnDemand, "")
+	if err == nil || !strings.Contains(err.Error(), "returned no instances") {
+		t.Fatalf("runInstance() error = %v, want no instances error", err)
+	}
+}
+
+func TestMarketFor(t *testing.T) {
+	provider := newTestProvider(nil)
+	provider.Spec.Market = v1alpha1.MarketSpotWithFallback
+	provider.Spec.SpotMaxPrice = "1.00"
+
+	market, price := provider.marketFor("", "")
+	if market != v1alpha1.MarketSpotWithFallback || price != "1.00" {
+		t.Errorf("inherited market = %q/%q, want spot-with-fallback/1.00", market, price)
+	}
+	market, price = provider.marketFor(v1alpha1.MarketSpot, "0.25")
+	if market != v1alpha1.MarketSpot || price != "0.25" {
+		t.Errorf("pool market = %q/%q, want spot/0.25", market, price)
+	}
+
+	provider.Spec.Market = ""
+	if market, _ = provider.marketFor("", ""); market != v1alpha1.MarketOnDemand {
+		t.Errorf("default market = %q, want on-demand", market)
+	}
+}
+
+func TestCreateEC2Instance_RecordsMarket(t *testing.T) {
+	f := awsfake.New()
+	seedTestImage(f, "ami-123")
+	f.Store.FailNext("RunInstances", errors.New("SpotMaxPriceTooLow"))
+
+	provider := newTestProvider(f.EC2)
+	provider.Spec.Type = "t3.medium"
+	provider.Spec.Image.ImageId = aws.String("ami-123")
+	provider.Spec.Market = v1alpha1.MarketSpotWithFallback
+	cache := &AWS{SecurityGroupid: "sg-123", Subnetid: "subnet-123"}
+
+	if err := provider.createEC2Instance(context.Background(), cache); err != nil {
+		t.Fatalf("createEC2Instance failed: %v", err)
+	}
+	if cache.InstanceMarket != string(v1alpha1.MarketOnDemand) {
+		t.Errorf("InstanceMarket = %q, want on-demand", cache.InstanceMarket)
+	}
+	if cache.Instanceid == "" || cache.PublicDnsName == "" {
+		t.Errorf("instance not recorded in cache: %+v", cache)
+	}
+}
+
+func TestCreateInstances_PoolMarket(t *testing.T) {
+	f := awsfake.New()
+	seedTestImage(f, "ami-test")
+
+	provider := newTestProvider(f.EC2)
+	cache := &ClusterCache{AWS: AWS{PublicSubnetid: "subnet-public", WorkerSecurityGroupid: "sg-worker"}}
+
+	workers, err := provider.createInstances(context.Background(), cache, 2, NodeRoleWorker,
+		"g4dn.xlarge", nil, "", &v1alpha1.Image{ImageId: aws.String("ami-test")},
+		launchOptions{market: v1alpha1.MarketSpot})
+	if err != nil {
+		t.Fatalf("createInstances failed: %v", err)
+	}
+	for _, w := range workers {
+		if w.Market != v1alpha1.MarketSpot {
+			t.Errorf("worker %s market = %q, want spot", w.Name, w.Market)
+		}
+	}
+
+	controlPlane := []InstanceInfo{{Name: "cp-0", Market: v1alpha1.MarketOnDemand}}
+	if got := clusterMarket(controlPlane, workers); got != marketMixed {
+		t.Errorf("clusterMarket() = %q, want %q", got, marketMixed)
+	}
+	if got := clusterMarket(workers); got != string(v1alpha1.MarketSpot) {
+		t.Errorf("clusterMarket() = %q, want spot", got)
+	}
+}
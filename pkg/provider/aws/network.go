@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/holodeck/internal/logger"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+// adoptNetwork records the pre-existing VPC, subnet and security groups of
+// spec.network in cache instead of creating them, and marks them adopted so
+// that Delete leaves them alone. It checks that the subnet and security
+// groups belong to the VPC and returns the CIDR blocks of the VPC and subnet.
+func (p *Provider) adoptNetwork(ctx context.Context, cache *AWS) (vpcCIDR, subnetCIDR string, err error) {
+	network := p.Spec.Network
+	cancelLoading := p.log.Loading("Adopting VPC %s and subnet %s", network.VpcID, network.SubnetID)
+
+	ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+	defer cancel()
+
+	vpcs, err := p.ec2.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{VpcIds: []string{network.VpcID}})
+	if err != nil {
+		cancelLoading(logger.ErrLoadingFailed)
+		return "", "", fmt.Errorf("error describing VPC %s: %w", network.VpcID, err)
+	}
+	if len(vpcs.Vpcs) == 0 {
+		cancelLoading(logger.ErrLoadingFailed)
+		return "", "", fmt.Errorf("VPC %s not found", network.VpcID)
+	}
+
+	subnets, err := p.ec2.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{SubnetIds: []string{network.SubnetID}})
+	if err != nil {
+		cancelLoading(logger.ErrLoadingFailed)
+		return "", "", fmt.Errorf("error describing subnet %s: %w", network.SubnetID, err)
+	}
+	if len(subnets.Subnets) == 0 {
+		cancelLoading(logger.ErrLoadingFailed)
+		return "", "", fmt.Errorf("subnet %s not found", network.SubnetID)
+	}
+	if vpc := aws.ToString(subnets.Subnets[0].VpcId); vpc != network.VpcID {
+		cancelLoading(logger.ErrLoadingFailed)
+		return "", "", fmt.Errorf("subnet %s belongs to VPC %s, not %s", network.SubnetID, vpc, network.VpcID)
+	}
+
+	if len(network.SecurityGroupIDs) > 0 {
+		sgs, err := p.ec2.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+			GroupIds: network.SecurityGroupIDs,
+		})
+		if err != nil {
+			cancelLoading(logger.ErrLoadingFailed)
+			return "", "", fmt.Errorf("error describing security groups: %w", err)
+		}
+		for _, sg := range sgs.SecurityGroups {
+			if vpc := aws.ToString(sg.VpcId); vpc != network.VpcID {
+				cancelLoading(logger.ErrLoadingFailed)
+				return "", "", fmt.Errorf("security group %s belongs to VPC %s, not %s",
+					aws.ToString(sg.GroupId), vpc, network.VpcID)
+			}
+		}
+	}
+
+	cache.Vpcid = network.VpcID
+	cache.Subnetid = network.SubnetID
+	cache.AdoptedResources = append([]string{network.VpcID, network.SubnetID}, network.SecurityGroupIDs...)
+
+	cancelLoading(nil)
+	return aws.ToString(vpcs.Vpcs[0].CidrBlock), aws.ToString(subnets.Subnets[0].CidrBlock), nil
+}
+
+// securityGroupsFor returns the security groups attached to an instance:
+// the adopted ones from spec.network if any, otherwise the holodeck-managed
+// group sgID.
+func (p *Provider) securityGroupsFor(sgID string) []string {
+	if p.Spec.Network != nil && len(p.Spec.Network.SecurityGroupIDs) > 0 {
+		return p.Spec.Network.SecurityGroupIDs
+	}
+	return []string{sgID}
+}
+
+// adoptsSecurityGroups returns true if instances use the security groups
+// from spec.network instead of holodeck-managed ones.
+func (p *Provider) adoptsSecurityGroups() bool {
+	return p.Spec.Network != nil && len(p.Spec.Network.SecurityGroupIDs) > 0
+}
+
+// isAdopted returns true if id is a pre-existing resource holodeck must not
+// delete.
+func (a *AWS) isAdopted(id string) bool {
+	return slices.Contains(a.AdoptedResources, id)
+}
+
+// withoutAdopted returns a copy of the cache in which every reference to an
+// adopted resource is cleared, so that the delete steps skip it.
+func (a *AWS) withoutAdopted() *AWS {
+	owned := *a
+	for _, id := range []*string{
+		&owned.Vpcid,
+		&owned.Subnetid,
+		&owned.InternetGwid,
+		&owned.InternetGatewayAttachment,
+		&owned.RouteTable,
+		&owned.SecurityGroupid,
+		&owned.PublicSubnetid,
+		&owned.PublicRouteTable,
+		&owned.CPSecurityGroupid,
+		&owned.WorkerSecurityGroupid,
+	} {
+		if *id != "" && a.isAdopted(*id) {
+			*id = ""
+		}
+	}
+	return &owned
+}
+
+// ownedResources lists the IDs of the resources holodeck created, and
+// deletes with the environment.
+func (a *AWS) ownedResources() []string {
+	var owned []string
+	seen := map[string]bool{}
+	for _, id := range []string{
+		a.Vpcid,
+		a.Subnetid,
+		a.InternetGwid,
+		a.RouteTable,
+		a.SecurityGroupid,
+		a.Instanceid,
+		a.PublicSubnetid,
+		a.NatGatewayid,
+		a.PublicRouteTable,
+		a.CPSecurityGroupid,
+		a.WorkerSecurityGroupid,
+		a.EIPAllocationid,
+	} {
+		if id == "" || seen[id] || a.isAdopted(id) {
+			continue
+		}
+		seen[id] = true
+		owned = append(owned, id)
+	}
+	return owned
+}
+
+// ownedSecurityGroups lists the holodeck-managed security groups.
+func (a *AWS) ownedSecurityGroups() []string {
+	var sgs []string
+	for _, id := range []string{a.SecurityGroupid, a.CPSecurityGroupid, a.WorkerSecurityGroupid} {
+		if id != "" && !a.isAdopted(id) && !slices.Contains(sgs, id) {
+			sgs = append(sgs, id)
+		}
+	}
+	return sgs
+}
+
+// splitResources parses a comma-separated resource list property.
+func splitResources(value string) []string {
+	var ids []string
+	for _, id := range strings.Split(value, ",") {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,333 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aws
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/aws/awsfake"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// seedNetwork creates a pre-existing VPC, subnet and security group in the
+// fake, as if they belonged to another team, and returns their IDs.
+func seedNetwork(t *testing.T, f *awsfake.Fake) (vpcID, subnetID, sgID string) {
+	t.Helper()
+	ctx := context.Background()
+	vpc, err := f.EC2.CreateVpc(ctx, &ec2.CreateVpcInput{CidrBlock: aws.String("172.31.0.0/16")})
+	if err != nil {
+		t.Fatalf("CreateVpc: %v", err)
+	}
+	vpcID = aws.ToString(vpc.Vpc.VpcId)
+	subnet, err := f.EC2.CreateSubnet(ctx, &ec2.CreateSubnetInput{
+		VpcId:     aws.String(vpcID),
+		CidrBlock: aws.String("172.31.16.0/20"),
+	})
+	if err != nil {
+		t.Fatalf("CreateSubnet: %v", err)
+	}
+	sg, err := f.EC2.CreateSecurityGroup(ctx, &ec2.CreateSecurityGroupInput{
+		GroupName: aws.String("shared"),
+		VpcId:     aws.String(vpcID),
+	})
+	if err != nil {
+		t.Fatalf("CreateSecurityGroup: %v", err)
+	}
+	return vpcID, aws.ToString(subnet.Subnet.SubnetId), aws.ToString(sg.GroupId)
+}
+
+func newNetworkTestProvider(t *testing.T, f *awsfake.Fake, network *v1alpha1.Network) *Provider {
+	t.Helper()
+	seedTestImage(f, "ami-123")
+	provider := newTestProvider(f.EC2)
+	provider.cacheFile = filepath.Join(t.TempDir(), "cache.yaml")
+	provider.Spec.Type = "t3.medium"
+	provider.Spec.Image.ImageId = aws.String("ami-123")
+	provider.Spec.Network = network
+	return provider
+}
+
+func TestCreateDelete_AdoptedNetwork(t *testing.T) {
+	f := awsfake.New()
+	vpcID, subnetID, sgID := seedNetwork(t, f)
+
+	provider := newNetworkTestProvider(t, f, &v1alpha1.Network{
+		VpcID:            vpcID,
+		SubnetID:         subnetID,
+		SecurityGroupIDs: []string{sgID},
+	})
+	if err := provider.Create(context.Background()); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// Only the seeding calls, nothing from Create
+	for call, want := range map[string]int{
+		"CreateVpc":             1,
+		"CreateSubnet":          1,
+		"CreateSecurityGroup":   1,
+		"CreateInternetGateway": 0,
+		"CreateRouteTable":      0,
+	} {
+		if n := f.Store.CallsTo(call); n != want {
+			t.Errorf("%s called %d times, want %d", call, n, want)
+		}
+	}
+	run := f.Store.Inputs("RunInstances")[0].(*ec2.RunInstancesInput)
+	if ni := run.NetworkInterfaces[0]; aws.ToString(ni.SubnetId) != subnetID || !slices.Equal(ni.Groups, []string{sgID}) {
+		t.Errorf("instance launched in %s with groups %v, want %s with [%s]", aws.ToString(ni.SubnetId), ni.Groups, subnetID, sgID)
+	}
+
+	cache, err := provider.unmarsalCache()
+	if err != nil {
+		t.Fatalf("unmarsalCache() error = %v", err)
+	}
+	if want := []string{vpcID, subnetID, sgID}; !slices.Equal(cache.AdoptedResources, want) {
+		t.Errorf("AdoptedResources = %v, want %v", cache.AdoptedResources, want)
+	}
+	if owned := cache.ownedResources(); !slices.Equal(owned, []string{cache.Instanceid}) {
+		t.Errorf("ownedResources() = %v, want only the instance", owned)
+	}
+
+	if err := provider.Delete(context.Background()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if f.Store.Instances[cache.Instanceid].State.Name != types.InstanceStateNameTerminated {
+		t.Errorf("instance %s was not terminated", cache.Instanceid)
+	}
+	if f.Store.Vpcs[vpcID] == nil || f.Store.Subnets[subnetID] == nil || f.Store.SecurityGroups[sgID] == nil {
+		t.Errorf("adopted resources were deleted: %v", f.Store.ResourceCounts())
+	}
+	for _, call := range []string{"DeleteVpc", "DeleteSubnet", "DeleteSecurityGroup"} {
+		if n := f.Store.CallsTo(call); n != 0 {
+			t.Errorf("%s called %d times on adopted resources", call, n)
+		}
+	}
+}
+
+func TestCreateCluster_AdoptedNetwork(t *testing.T) {
+	f := awsfake.New()
+	vpcID, subnetID, sgID := seedNetwork(t, f)
+
+	provider := newNetworkTestProvider(t, f, &v1alpha1.Network{
+		VpcID:            vpcID,
+		SubnetID:         subnetID,
+		SecurityGroupIDs: []string{sgID},
+	})
+	provider.Spec.Cluster = &v1alpha1.ClusterSpec{
+		Region: "us-west-2",
+		ControlPlane: v1alpha1.ControlPlaneSpec{
+			Count:        1,
+			InstanceType: "t3.medium",
+			Image:        &v1alpha1.Image{ImageId: aws.String("ami-123")},
+		},
+		Workers: &v1alpha1.WorkerPoolSpec{
+			Count:        1,
+			InstanceType: "t3.medium",
+			Image:        &v1alpha1.Image{ImageId: aws.String("ami-123")},
+		},
+	}
+	if err := provider.CreateCluster(context.Background()); err != nil {
+		t.Fatalf("CreateCluster() error = %v", err)
+	}
+
+	for call, want := range map[string]int{
+		"CreateVpc":             1,
+		"CreateSubnet":          1,
+		"CreateSecurityGroup":   1,
+		"CreateInternetGateway": 0,
+		"CreateRouteTable":      0,
+	} {
+		if n := f.Store.CallsTo(call); n != want {
+			t.Errorf("%s called %d times, want %d", call, n, want)
+		}
+	}
+	runs := f.Store.Inputs("RunInstances")
+	if len(runs) != 2 {
+		t.Fatalf("RunInstances called %d times, want 2", len(runs))
+	}
+	for _, c := range runs {
+		ni := c.(*ec2.RunInstancesInput).NetworkInterfaces[0]
+		if aws.ToString(ni.SubnetId) != subnetID || !slices.Equal(ni.Groups, []string{sgID}) {
+			t.Errorf("instance launched in %s with groups %v, want %s with [%s]", aws.ToString(ni.SubnetId), ni.Groups, subnetID, sgID)
+		}
+	}
+}
+
+// failWorkerLaunchEC2 fails RunInstances for worker nodes only.
+type failWorkerLaunchEC2 struct {
+	*awsfake.FakeEC2
+}
+
+func (c *failWorkerLaunchEC2) RunInstances(ctx context.Context, params *ec2.RunInstancesInput, optFns ...func(*ec2.Options)) (*ec2.RunInstancesOutput, error) {
+	for _, tag := range params.TagSpecifications[0].Tags {
+		if aws.ToString(tag.Key) == "Role" && aws.ToString(tag.Value) == string(NodeRoleWorker) {
+			return nil, errors.New("api error InsufficientInstanceCapacity")
+		}
+	}
+	return c.FakeEC2.RunInstances(ctx, params, optFns...)
+}
+
+func TestCreateCluster_RollsBackInstances(t *testing.T) {
+	f := awsfake.New()
+	vpcID, subnetID, sgID := seedNetwork(t, f)
+
+	provider := newNetworkTestProvider(t, f, &v1alpha1.Network{
+		VpcID:            vpcID,
+		SubnetID:         subnetID,
+		SecurityGroupIDs: []string{sgID},
+	})
+	provider.Spec.Cluster = &v1alpha1.ClusterSpec{
+		Region: "us-west-2",
+		ControlPlane: v1alpha1.ControlPlaneSpec{
+			Count:          1,
+			InstanceType:   "t3.medium",
+			Image:          &v1alpha1.Image{ImageId: aws.String("ami-123")},
+			PlacementGroup: &v1alpha1.PlacementGroup{Strategy: v1alpha1.PlacementSpread},
+		},
+		Workers: &v1alpha1.WorkerPoolSpec{
+			Count:        1,
+			InstanceType: "t3.medium",
+			Image:        &v1alpha1.Image{ImageId: aws.String("ami-123")},
+		},
+	}
+	// The control plane launches, the worker does not
+	provider.ec2 = &failWorkerLaunchEC2{FakeEC2: f.EC2}
+
+	if err := provider.CreateCluster(context.Background()); err == nil {
+		t.Fatal("CreateCluster() succeeded, want the RunInstances error")
+	}
+	counts := f.Store.ResourceCounts()
+	if counts["instances"] != 0 {
+		t.Errorf("%d instances left after rollback", counts["instances"])
+	}
+	if counts["placementgroups"] != 0 {
+		t.Errorf("%d placement groups left after rollback", counts["placementgroups"])
+	}
+	if f.Store.Vpcs[vpcID] == nil || f.Store.Subnets[subnetID] == nil || f.Store.SecurityGroups[sgID] == nil {
+		t.Errorf("adopted resources were deleted: %v", counts)
+	}
+}
+
+func TestCreateCluster_RollsBackNetwork(t *testing.T) {
+	f := awsfake.New()
+	seedTestImage(f, "ami-123")
+
+	provider := newTestProvider(f.EC2)
+	provider.cacheFile = filepath.Join(t.TempDir(), "cache.yaml")
+	provider.Spec.Cluster = &v1alpha1.ClusterSpec{
+		Region: "us-west-2",
+		ControlPlane: v1alpha1.ControlPlaneSpec{
+			Count:        1,
+			InstanceType: "t3.medium",
+			Image:        &v1alpha1.Image{ImageId: aws.String("ami-123")},
+		},
+	}
+	f.Store.FailNext("CreateSecurityGroup", errors.New("api error SecurityGroupLimitExceeded"))
+
+	if err := provider.CreateCluster(context.Background()); err == nil {
+		t.Fatal("CreateCluster() succeeded, want the CreateSecurityGroup error")
+	}
+	if f.Store.CallsTo("CreateVpc") != 1 {
+		t.Fatalf("CreateVpc called %d times, want 1", f.Store.CallsTo("CreateVpc"))
+	}
+	if !f.Store.Empty() {
+		t.Errorf("failed cluster create leaked resources: %v", f.Store.ResourceCounts())
+	}
+}
+
+func TestAdoptNetwork_Mismatch(t *testing.T) {
+	f := awsfake.New()
+	vpcID, subnetID, sgID := seedNetwork(t, f)
+	otherVPC, _, otherSG := seedNetwork(t, f)
+
+	tests := []struct {
+		name    string
+		network v1alpha1.Network
+		wantErr string
+	}{
+		{
+			name:    "subnet in another VPC",
+			network: v1alpha1.Network{VpcID: otherVPC, SubnetID: subnetID},
+			wantErr: "belongs to VPC " + vpcID,
+		},
+		{
+			name:    "security group in another VPC",
+			network: v1alpha1.Network{VpcID: vpcID, SubnetID: subnetID, SecurityGroupIDs: []string{sgID, otherSG}},
+			wantErr: "security group " + otherSG,
+		},
+		{
+			name:    "missing VPC",
+			network: v1alpha1.Network{VpcID: "vpc-missing", SubnetID: subnetID},
+			wantErr: "error describing VPC vpc-missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newTestProvider(f.EC2)
+			provider.Spec.Network = &tt.network
+			cache := &AWS{}
+
+			_, _, err := provider.adoptNetwork(context.Background(), cache)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("adoptNetwork() error = %v, want %q", err, tt.wantErr)
+			}
+			if cache.Vpcid != "" || len(cache.AdoptedResources) != 0 {
+				t.Errorf("cache modified on error: %+v", cache)
+			}
+		})
+	}
+}
+
+func TestWithoutAdopted(t *testing.T) {
+	cache := &AWS{
+		Vpcid:                     "vpc-1",
+		Subnetid:                  "subnet-1",
+		PublicSubnetid:            "subnet-1",
+		InternetGatewayAttachment: "vpc-1",
+		CPSecurityGroupid:         "sg-cp",
+		WorkerSecurityGroupid:     "sg-worker",
+		Instanceid:                "i-1",
+		AdoptedResources:          []string{"vpc-1", "subnet-1", "sg-worker"},
+	}
+
+	owned := cache.withoutAdopted()
+	if owned.Vpcid != "" || owned.Subnetid != "" || owned.PublicSubnetid != "" ||
+		owned.InternetGatewayAttachment != "" || owned.WorkerSecurityGroupid != "" {
+		t.Errorf("adopted IDs not cleared: %+v", owned)
+	}
+	if owned.CPSecurityGroupid != "sg-cp" || owned.Instanceid != "i-1" {
+		t.Errorf("owned IDs cleared: %+v", owned)
+	}
+	if cache.Vpcid != "vpc-1" {
+		t.Error("withoutAdopted modified the original cache")
+	}
+	if got, want := cache.ownedResources(), []string{"i-1", "sg-cp"}; !slices.Equal(got, want) {
+		t.Errorf("ownedResources() = %v, want %v", got, want)
+	}
+	if got, want := cache.ownedSecurityGroups(), []string{"sg-cp"}; !slices.Equal(got, want) {
+		t.Errorf("ownedSecurityGroups() = %v, want %v", got, want)
+	}
+}
@@ -56,22 +56,43 @@ const (
 	elbv2APITimeout = 2 * time.Minute
 )
 
+// loadBalancerName returns the name of the API server load balancer,
+// "<env>-nlb". AWS load balancer names are limited to 32 characters, so the
+// environment name is truncated to fit.
+func (p *Provider) loadBalancerName() string {
+	const suffix = "-nlb"
+	base := p.ObjectMeta.Name
+	if maxLen := 32 - len(suffix); len(base) > maxLen {
+		base = base[:maxLen]
+	}
+	return base + suffix
+}
+
+// internalLoadBalancer returns true if the NLB goes into the private subnet
+func (p *Provider) internalLoadBalancer() bool {
+	return p.Spec.LoadBalancer != nil && p.Spec.LoadBalancer.Internal
+}
+
+// loadBalancerSubnet returns the subnet the NLB is created in: the private
+// subnet for an internal load balancer, the public subnet of the nodes
+// otherwise. Both are the same subnet in an adopted network.
+func (p *Provider) loadBalancerSubnet(cache *ClusterCache) string {
+	if p.internalLoadBalancer() && cache.Subnetid != "" {
+		return cache.Subnetid
+	}
+	return cache.PublicSubnetid
+}
+
 // createNLB creates a Network Load Balancer for HA control plane
-func (p *Provider) createNLB(cache *ClusterCache) error {
+func (p *Provider) createNLB(ctx context.Context, cache *ClusterCache) error {
 	cancelLoading := p.log.Loading("Creating Network Load Balancer")
 
 	lbType := elbv2types.LoadBalancerTypeEnumNetwork
-	// AWS load balancer names are limited to 32 characters.
-	const nlbSuffix = "-nlb"
-	maxNLBNameLen := 32 - len(nlbSuffix)
-	nlbBaseName := p.ObjectMeta.Name
-	if len(nlbBaseName) > maxNLBNameLen {
-		nlbBaseName = nlbBaseName[:maxNLBNameLen]
-	}
-	lbName := nlbBaseName + nlbSuffix
+	lbName := p.loadBalancerName()
 
-	// Use the public subnet for the internal NLB (same subnet as instances)
-	subnetIDs := []string{cache.PublicSubnetid}
+	// Use the public subnet for the internal NLB (same subnet as instances),
+	// or the private subnet when the API endpoint must stay private
+	subnetIDs := []string{p.loadBalancerSubnet(cache)}
 
 	// Create load balancer — internal scheme avoids hairpin routing issues
 	// where nodes connecting to the NLB's public IP get i/o timeouts
@@ -84,7 +105,7 @@ func (p *Provider) createNLB(cache *ClusterCache) error {
 		Tags:          p.convertTagsToELBv2Tags(),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), elbv2APITimeout)
+	ctx, cancel := context.WithTimeout(ctx, elbv2APITimeout)
 	defer cancel()
 
 	createLBOutput, err := p.elbv2.CreateLoadBalancer(ctx, createLBInput)
@@ -108,7 +129,7 @@ func (p *Provider) createNLB(cache *ClusterCache) error {
 }
 
 // createTargetGroup creates a target group for the Kubernetes API server (port 6443)
-func (p *Provider) createTargetGroup(cache *ClusterCache) error {
+func (p *Provider) createTargetGroup(ctx context.Context, cache *ClusterCache) error {
 	if cache.LoadBalancerArn == "" {
 		return fmt.Errorf("load balancer ARN is required to create target group")
 	}
@@ -141,7 +162,7 @@ func (p *Provider) createTargetGroup(cache *ClusterCache) error {
 		Tags:                       p.convertTagsToELBv2Tags(),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), elbv2APITimeout)
+	ctx, cancel := context.WithTimeout(ctx, elbv2APITimeout)
 	defer cancel()
 
 	createTGOutput, err := p.elbv2.CreateTargetGroup(ctx, createTGInput)
@@ -161,7 +182,7 @@ func (p *Provider) createTargetGroup(cache *ClusterCache) error {
 	p.log.Info("Created target group: %s", cache.TargetGroupArn)
 
 	// Create listener to forward traffic from NLB to target group
-	if err := p.createListener(cache); err != nil {
+	if err := p.createListener(ctx, cache); err != nil {
 		return fmt.Errorf("error creating listener: %w", err)
 	}
 
@@ -170,7 +191,7 @@ func (p *Provider) createTargetGroup(cache *ClusterCache) error {
 }
 
 // createListener creates a listener on the load balancer forwarding to the target group
-func (p *Provider) createListener(cache *ClusterCache) error {
+func (p *Provider) createListener(ctx context.Context, cache *ClusterCache) error {
 	if cache.LoadBalancerArn == "" || cache.TargetGroupArn == "" {
 		return fmt.Errorf("load balancer ARN and target group ARN are required")
 	}
@@ -194,7 +215,7 @@ func (p *Provider) createListener(cache *ClusterCache) error {
 		Tags: p.convertTagsToELBv2Tags(),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), elbv2APITimeout)
+	ctx, cancel := context.WithTimeout(ctx, elbv2APITimeout)
 	defer cancel()
 
 	_, err := p.elbv2.CreateListener(ctx, createListenerInput)
@@ -207,7 +228,7 @@ func (p *Provider) createListener(cache *ClusterCache) error {
 }
 
 // registerTargets registers control-plane instances with the load balancer target group
-func (p *Provider) registerTargets(cache *ClusterCache) error {
+func (p *Provider) registerTargets(ctx context.Context, cache *ClusterCache) error {
 	if cache.TargetGroupArn == "" {
 		return fmt.Errorf("target group ARN is required")
 	}
@@ -240,7 +261,7 @@ func (p *Provider) registerTargets(cache *ClusterCache) error {
 		Targets:        targets,
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), elbv2APITimeout)
+	ctx, cancel := context.WithTimeout(ctx, elbv2APITimeout)
 	defer cancel()
 
 	_, err := p.elbv2.RegisterTargets(ctx, registerInput)
@@ -255,7 +276,7 @@ func (p *Provider) registerTargets(cache *ClusterCache) error {
 }
 
 // deleteNLB deletes the Network Load Balancer and associated resources
-func (p *Provider) deleteNLB(cache *ClusterCache) error {
+func (p *Provider) deleteNLB(ctx context.Context, cache *ClusterCache) error {
 	if cache.LoadBalancerArn == "" {
 		// No load balancer to delete
 		return nil
@@ -265,14 +286,14 @@ func (p *Provider) deleteNLB(cache *ClusterCache) error {
 
 	// Delete listener first (if exists)
 	if cache.TargetGroupArn != "" {
-		if err := p.deleteListener(cache); err != nil {
+		if err := p.deleteListener(ctx, cache); err != nil {
 			p.log.Warning("Error deleting listener: %v", err)
 		}
 	}
 
 	// Delete target group
 	if cache.TargetGroupArn != "" {
-		if err := p.deleteTargetGroup(cache); err != nil {
+		if err := p.deleteTargetGroup(ctx, cache); err != nil {
 			p.log.Warning("Error deleting target group: %v", err)
 		}
 	}
@@ -282,7 +303,7 @@ func (p *Provider) deleteNLB(cache *ClusterCache) error {
 		LoadBalancerArn: aws.String(cache.LoadBalancerArn),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), elbv2APITimeout)
+	ctx, cancel := context.WithTimeout(ctx, elbv2APITimeout)
 	defer cancel()
 
 	_, err := p.elbv2.DeleteLoadBalancer(ctx, deleteLBInput)
@@ -302,7 +323,7 @@ func (p *Provider) deleteNLB(cache *ClusterCache) error {
 }
 
 // deleteListener deletes the listener associated with the load balancer
-func (p *Provider) deleteListener(cache *ClusterCache) error {
+func (p *Provider) deleteListener(ctx context.Context, cache *ClusterCache) error {
 	if cache.LoadBalancerArn == "" {
 		return nil
 	}
@@ -312,7 +333,7 @@ func (p *Provider) deleteListener(cache *ClusterCache) error {
 		LoadBalancerArn: aws.String(cache.LoadBalancerArn),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), elbv2APITimeout)
+	ctx, cancel := context.WithTimeout(ctx, elbv2APITimeout)
 	defer cancel()
 
 	describeOutput, err := p.elbv2.DescribeListeners(ctx, describeInput)
@@ -329,7 +350,7 @@ func (p *Provider) deleteListener(cache *ClusterCache) error {
 			ListenerArn: listener.ListenerArn,
 		}
 
-		ctxDel, cancelDel := context.WithTimeout(context.Background(), elbv2APITimeout)
+		ctxDel, cancelDel := context.WithTimeout(ctx, elbv2APITimeout)
 		_, err := p.elbv2.DeleteListener(ctxDel, deleteInput)
 		cancelDel()
 
@@ -346,7 +367,7 @@ func (p *Provider) deleteListener(cache *ClusterCache) error {
 }
 
 // deleteTargetGroup deletes the target group
-func (p *Provider) deleteTargetGroup(cache *ClusterCache) error {
+func (p *Provider) deleteTargetGroup(ctx context.Context, cache *ClusterCache) error {
 	if cache.TargetGroupArn == "" {
 		return nil
 	}
@@ -356,7 +377,7 @@ func (p *Provider) deleteTargetGroup(cache *ClusterCache) error {
 		TargetGroupArn: aws.String(cache.TargetGroupArn),
 	}
 
-	ctxTargets, cancelTargets := context.WithTimeout(context.Background(), elbv2APITimeout)
+	ctxTargets, cancelTargets := context.WithTimeout(ctx, elbv2APITimeout)
 	defer cancelTargets()
 
 	targetsOutput, err := p.elbv2.DescribeTargetHealth(ctxTargets, describeTargetsInput)
@@ -375,7 +396,7 @@ func (p *Provider) deleteTargetGroup(cache *ClusterCache) error {
 				TargetGroupArn: aws.String(cache.TargetGroupArn),
 				Targets:        targets,
 			}
-			ctxDereg, cancelDereg := context.WithTimeout(context.Background(), elbv2APITimeout)
+			ctxDereg, cancelDereg := context.WithTimeout(ctx, elbv2APITimeout)
 			if _, err := p.elbv2.DeregisterTargets(ctxDereg, deregisterInput); err != nil {
 				p.log.Warning("Failed to deregister targets from %s: %v", cache.TargetGroupArn, err)
 			}
@@ -388,7 +409,7 @@ func (p *Provider) deleteTargetGroup(cache *ClusterCache) error {
 		TargetGroupArn: aws.String(cache.TargetGroupArn),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), elbv2APITimeout)
+	ctx, cancel := context.WithTimeout(ctx, elbv2APITimeout)
 	defer cancel()
 
 	_, err = p.elbv2.DeleteTargetGroup(ctx, deleteTGInput)
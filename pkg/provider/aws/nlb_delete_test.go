@@ -38,7 +38,7 @@ func TestDeleteNLB_LoadBalancerNotFound(t *testing.T) {
 	provider := &Provider{elbv2: f.ELBv2, log: mockLogger(), sleep: noopSleep}
 	cache := &ClusterCache{LoadBalancerArn: "arn:aws:elasticloadbalancing:us-east-1:123:loadbalancer/net/gone/abc"}
 
-	if err := provider.deleteNLB(cache); err != nil {
+	if err := provider.deleteNLB(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error when NLB is already deleted, got: %v", err)
 	}
 }
@@ -51,7 +51,7 @@ func TestDeleteNLB_RealError(t *testing.T) {
 	provider := &Provider{elbv2: f.ELBv2, log: mockLogger(), sleep: noopSleep}
 	cache := &ClusterCache{LoadBalancerArn: "arn:aws:elasticloadbalancing:us-east-1:123:loadbalancer/net/test/abc"}
 
-	if err := provider.deleteNLB(cache); err == nil {
+	if err := provider.deleteNLB(context.Background(), cache); err == nil {
 		t.Fatal("expected error for InternalError, got nil")
 	}
 }
@@ -77,7 +77,7 @@ func TestDeleteListener_ListenerNotFound(t *testing.T) {
 	provider := &Provider{elbv2: f.ELBv2, log: mockLogger(), sleep: noopSleep}
 	cache := &ClusterCache{LoadBalancerArn: lbArn}
 
-	if err := provider.deleteListener(cache); err != nil {
+	if err := provider.deleteListener(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error when listener is already deleted, got: %v", err)
 	}
 }
@@ -90,7 +90,7 @@ func TestDeleteListener_DescribeNotFound(t *testing.T) {
 	provider := &Provider{elbv2: f.ELBv2, log: mockLogger(), sleep: noopSleep}
 	cache := &ClusterCache{LoadBalancerArn: "arn:lb/gone"}
 
-	if err := provider.deleteListener(cache); err != nil {
+	if err := provider.deleteListener(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error when LB is already deleted during describe, got: %v", err)
 	}
 }
@@ -103,7 +103,7 @@ func TestDeleteTargetGroup_NotFound(t *testing.T) {
 	provider := &Provider{elbv2: f.ELBv2, log: mockLogger(), sleep: noopSleep}
 	cache := &ClusterCache{TargetGroupArn: "arn:tg/gone"}
 
-	if err := provider.deleteTargetGroup(cache); err != nil {
+	if err := provider.deleteTargetGroup(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error when target group is already deleted, got: %v", err)
 	}
 }
@@ -124,7 +124,7 @@ func TestDeleteTargetGroup_RealError(t *testing.T) {
 	provider := &Provider{elbv2: f.ELBv2, log: mockLogger(), sleep: noopSleep}
 	cache := &ClusterCache{TargetGroupArn: tgArn}
 
-	if err := provider.deleteTargetGroup(cache); err == nil {
+	if err := provider.deleteTargetGroup(context.Background(), cache); err == nil {
 		t.Fatal("expected error for InternalError, got nil")
 	}
 }
@@ -140,7 +140,7 @@ func TestDeleteNLBForCluster_DescribeNotFound(t *testing.T) {
 	provider := &Provider{elbv2: f.ELBv2, log: mockLogger(), sleep: noopSleep, Environment: env}
 	cache := &ClusterCache{LoadBalancerDNS: "gone-nlb.elb.amazonaws.com"}
 
-	if err := provider.deleteNLBForCluster(cache); err != nil {
+	if err := provider.deleteNLBForCluster(context.Background(), cache); err != nil {
 		t.Fatalf("expected no error when NLB is already deleted, got: %v", err)
 	}
 }
@@ -216,7 +216,7 @@ func TestDeleteNLB_TeardownOrder(t *testing.T) {
 	}
 	cache := &ClusterCache{LoadBalancerArn: lbArn, TargetGroupArn: tgArn}
 
-	if err := provider.deleteNLB(cache); err != nil {
+	if err := provider.deleteNLB(context.Background(), cache); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/aws/awsfake"
+)
+
+// newNLBTestProvider returns a multinode provider backed by f, with the
+// given load balancer spec.
+func newNLBTestProvider(f *awsfake.Fake, lb *v1alpha1.LoadBalancer) *Provider {
+	p := createTestProvider(f.EC2)
+	p.elbv2 = f.ELBv2
+	p.Spec.Cluster = &v1alpha1.ClusterSpec{
+		ControlPlane: v1alpha1.ControlPlaneSpec{Count: 3},
+	}
+	p.Spec.LoadBalancer = lb
+	return p
+}
+
+func TestCreateLoadBalancer_Subnet(t *testing.T) {
+	tests := []struct {
+		name       string
+		lb         *v1alpha1.LoadBalancer
+		wantSubnet string
+	}{
+		{name: "default", lb: nil, wantSubnet: "subnet-public"},
+		{name: "nlb", lb: &v1alpha1.LoadBalancer{Type: v1alpha1.LoadBalancerNLB}, wantSubnet: "subnet-public"},
+		{name: "internal", lb: &v1alpha1.LoadBalancer{Internal: true}, wantSubnet: "subnet-private"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := awsfake.New()
+			p := newNLBTestProvider(f, tt.lb)
+			cache := &ClusterCache{AWS: AWS{
+				Vpcid:          "vpc-1",
+				Subnetid:       "subnet-private",
+				PublicSubnetid: "subnet-public",
+			}}
+
+			if err := p.createLoadBalancer(context.Background(), cache); err != nil {
+				t.Fatalf("createLoadBalancer: %v", err)
+			}
+
+			in := f.Store.Inputs("CreateLoadBalancer")[0].(*elasticloadbalancingv2.CreateLoadBalancerInput)
+			if len(in.Subnets) != 1 || in.Subnets[0] != tt.wantSubnet {
+				t.Errorf("expected subnets [%s], got %v", tt.wantSubnet, in.Subnets)
+			}
+			if in.Scheme != elbv2types.LoadBalancerSchemeEnumInternal {
+				t.Errorf("expected internal scheme, got %q", in.Scheme)
+			}
+		})
+	}
+}
+
+// TestCreatePublicSubnet_InternalLoadBalancerZone: without a capacity
+// reservation the nodes' public subnet follows the zone of the private subnet,
+// so that the internal NLB in it can reach them.
+func TestCreatePublicSubnet_InternalLoadBalancerZone(t *testing.T) {
+	f := awsfake.New()
+	p := newNLBTestProvider(f, &v1alpha1.LoadBalancer{Internal: true})
+	private, err := f.EC2.CreateSubnet(context.Background(), &ec2.CreateSubnetInput{
+		VpcId:            aws.String("vpc-1"),
+		CidrBlock:        aws.String("10.0.0.0/24"),
+		AvailabilityZone: aws.String("us-west-2b"),
+	})
+	if err != nil {
+		t.Fatalf("CreateSubnet: %v", err)
+	}
+	cache := &AWS{Vpcid: "vpc-1", Subnetid: aws.ToString(private.Subnet.SubnetId)}
+
+	if err := p.createPublicSubnet(context.Background(), cache); err != nil {
+		t.Fatalf("createPublicSubnet: %v", err)
+	}
+
+	public := f.Store.Subnets[cache.PublicSubnetid]
+	if public == nil {
+		t.Fatalf("public subnet %q not found", cache.PublicSubnetid)
+	}
+	if got := aws.ToString(public.AvailabilityZone); got != "us-west-2b" {
+		t.Errorf("expected public subnet in us-west-2b, got %q", got)
+	}
+}
+
+// TestDeleteNLBForCluster_Internal: the internal NLB is found by name like the
+// default one and torn down with its target group.
+func TestDeleteNLBForCluster_Internal(t *testing.T) {
+	f := awsfake.New()
+	p := newNLBTestProvider(f, &v1alpha1.LoadBalancer{Internal: true})
+	cache := &ClusterCache{AWS: AWS{
+		Vpcid:          "vpc-1",
+		Subnetid:       "subnet-private",
+		PublicSubnetid: "subnet-public",
+	}}
+	if err := p.createLoadBalancer(context.Background(), cache); err != nil {
+		t.Fatalf("createLoadBalancer: %v", err)
+	}
+
+	deleteCache := &ClusterCache{LoadBalancerDNS: cache.LoadBalancerDNS}
+	if err := p.deleteNLBForCluster(context.Background(), deleteCache); err != nil {
+		t.Fatalf("deleteNLBForCluster: %v", err)
+	}
+
+	counts := f.Store.ResourceCounts()
+	if counts["loadbalancers"] != 0 || counts["targetgroups"] != 0 {
+		t.Errorf("expected the NLB and its target group to be deleted, got %v", counts)
+	}
+}
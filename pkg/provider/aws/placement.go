@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aws
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// defaultPartitionCount is the number of partitions of a partition placement
+// group that sets none.
+const defaultPartitionCount int32 = 2
+
+// launchOptions are the purchasing and placement options of a node pool.
+// Unset options fall back to spec.instance.
+type launchOptions struct {
+	market              v1alpha1.MarketType
+	spotMaxPrice        string
+	capacityReservation *v1alpha1.CapacityReservation
+	placementGroup      *v1alpha1.PlacementGroup
+
+	// pool is the name of the worker pool being launched, if any
+	pool string
+	// firstIndex is the node index of the first instance, so that
+	// instances added to a running cluster do not reuse node names
+	firstIndex int
+}
+
+// placementFor returns the effective capacity reservation and placement
+// group of a node pool, falling back to spec.instance when the pool sets
+// none, and the name a placement group created for it gets by default: the
+// environment name when inherited, the environment and pool role (and the
+// worker pool name, if any) otherwise.
+func (p *Provider) placementFor(role NodeRole, opts launchOptions) (*v1alpha1.CapacityReservation, *v1alpha1.PlacementGroup, string) {
+	cr := opts.capacityReservation
+	if cr == nil {
+		cr = p.Spec.CapacityReservation
+	}
+	pg, defaultName := opts.placementGroup, fmt.Sprintf("%s-%s", p.ObjectMeta.Name, role)
+	if opts.pool != "" {
+		defaultName = fmt.Sprintf("%s-%s", defaultName, opts.pool)
+	}
+	if pg == nil {
+		pg, defaultName = p.Spec.PlacementGroup, p.ObjectMeta.Name
+	}
+	return cr, pg, defaultName
+}
+
+// capacityReservationSpec returns the RunInstances capacity reservation
+// target of cr, or nil to launch into open capacity.
+func capacityReservationSpec(cr *v1alpha1.CapacityReservation) *types.CapacityReservationSpecification {
+	if cr == nil {
+		return nil
+	}
+	target := &types.CapacityReservationTarget{}
+	if cr.ID != "" {
+		target.CapacityReservationId = aws.String(cr.ID)
+	} else {
+		target.CapacityReservationResourceGroupArn = aws.String(cr.ResourceGroupArn)
+	}
+	return &types.CapacityReservationSpecification{CapacityReservationTarget: target}
+}
+
+// placementGroupName returns the name of placement group pg, or
+// defaultName for a group holodeck creates without an explicit name.
+func placementGroupName(pg *v1alpha1.PlacementGroup, defaultName string) string {
+	if pg.Name != "" {
+		return pg.Name
+	}
+	return defaultName
+}
+
+// ensurePlacementGroup creates placement group pg unless it references an
+// existing group or was already created for another pool, and returns its
+// name. Created groups are recorded in cache so that Delete removes them.
+func (p *Provider) ensurePlacementGroup(ctx context.Context, cache *AWS, pg *v1alpha1.PlacementGroup, defaultName string) (string, error) {
+	name := placementGroupName(pg, defaultName)
+	if pg.Strategy == "" || slices.Contains(cache.PlacementGroups, name) {
+		return name, nil
+	}
+
+	cancelLoading := p.log.Loading("Creating %s placement group %s", pg.Strategy, name)
+
+	input := &ec2.CreatePlacementGroupInput{
+		GroupName: aws.String(name),
+		Strategy:  types.PlacementStrategy(pg.Strategy),
+		TagSpecifications: []types.TagSpecification{
+			{
+				ResourceType: types.ResourceTypePlacementGroup,
+				Tags:         p.Tags,
+			},
+		},
+	}
+	if pg.Strategy == v1alpha1.PlacementPartition {
+		count := pg.PartitionCount
+		if count == 0 {
+			count = defaultPartitionCount
+		}
+		input.PartitionCount = aws.Int32(count)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+	defer cancel()
+
+	if _, err := p.ec2.CreatePlacementGroup(ctx, input); err != nil {
+		cancelLoading(logger.ErrLoadingFailed)
+		return "", fmt.Errorf("error creating placement group %s: %w", name, err)
+	}
+	cache.PlacementGroups = append(cache.PlacementGroups, name)
+
+	cancelLoading(nil)
+	return name, nil
+}
+
+// createPlacementGroup creates the spec.instance placement group of a
+// single-node environment.
+func (p *Provider) createPlacementGroup(ctx context.Context, cache *AWS) error {
+	_, err := p.ensurePlacementGroup(ctx, cache, p.Spec.PlacementGroup, p.ObjectMeta.Name)
+	return err
+}
+
+// reservationZone returns the availability zone of the capacity reservation
+// the instances target by ID, so that their subnet is created there. In
+// cluster mode the control-plane reservation wins over the worker ones. It
+// returns "" when no reservation is targeted by ID.
+func (p *Provider) reservationZone(ctx context.Context) (string, error) {
+	reservations := []*v1alpha1.CapacityReservation{p.Spec.CapacityReservation}
+	if p.IsMultinode() {
+		cr, _, _ := p.placementFor(NodeRoleControlPlane, launchOptions{
+			capacityReservation: p.Spec.Cluster.ControlPlane.CapacityReservation,
+		})
+		reservations = []*v1alpha1.CapacityReservation{cr}
+		for _, w := range p.Spec.Cluster.WorkerPoolList() {
+			if w.Count == 0 {
+				continue
+			}
+			cr, _, _ := p.placementFor(NodeRoleWorker, launchOptions{capacityReservation: w.CapacityReservation})
+			reservations = append(reservations, cr)
+		}
+	}
+
+	id := ""
+	for _, cr := range reservations {
+		if cr != nil && cr.ID != "" {
+			id = cr.ID
+			break
+		}
+	}
+	if id == "" {
+		return "", nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+	defer cancel()
+
+	out, err := p.ec2.DescribeCapacityReservations(ctx, &ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: []string{id},
+	})
+	if err != nil {
+		return "", fmt.Errorf("error describing capacity reservation %s: %w", id, err)
+	}
+	if len(out.CapacityReservations) == 0 {
+		return "", fmt.Errorf("capacity reservation %s not found", id)
+	}
+	return aws.ToString(out.CapacityReservations[0].AvailabilityZone), nil
+}
+
+// deletePlacementGroups deletes the placement groups holodeck created. It
+// must run once the instances in them are terminated.
+func (p *Provider) deletePlacementGroups(ctx context.Context, cache *AWS) error {
+	for _, name := range cache.PlacementGroups {
+		err := p.retryWithBackoff(func() error {
+			ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+			defer cancel()
+			_, err := p.ec2.DeletePlacementGroup(ctx, &ec2.DeletePlacementGroupInput{
+				GroupName: aws.String(name),
+			})
+			if err != nil {
+				if strings.Contains(err.Error(), "InvalidPlacementGroup.Unknown") {
+					p.log.Info("Placement group %s already deleted", name)
+					return nil
+				}
+				if strings.Contains(err.Error(), "InvalidPlacementGroup.InUse") {
+					p.log.Info("Placement group %s still in use, will retry", name)
+				}
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting placement group %s: %w", name, err)
+		}
+		p.log.Info("Placement group %s successfully deleted", name)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aws
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/aws/awsfake"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestCreateDelete_PlacementAndReservation(t *testing.T) {
+	f := awsfake.New()
+	vpcID, subnetID, sgID := seedNetwork(t, f)
+	reservationID := f.Store.SeedCapacityReservation("t3.medium", "us-west-2a", 1)
+
+	provider := newNetworkTestProvider(t, f, &v1alpha1.Network{
+		VpcID:            vpcID,
+		SubnetID:         subnetID,
+		SecurityGroupIDs: []string{sgID},
+	})
+	provider.Spec.CapacityReservation = &v1alpha1.CapacityReservation{ID: reservationID}
+	provider.Spec.PlacementGroup = &v1alpha1.PlacementGroup{Strategy: v1alpha1.PlacementPartition}
+	if err := provider.Create(context.Background()); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	pg := f.Store.PlacementGroups["test-cluster"]
+	if pg == nil {
+		t.Fatalf("placement group test-cluster not created: %v", f.Store.ResourceCounts())
+	}
+	if pg.Strategy != types.PlacementStrategyPartition || aws.ToInt32(pg.PartitionCount) != defaultPartitionCount {
+		t.Errorf("placement group = %s with %d partitions, want partition with %d", pg.Strategy, aws.ToInt32(pg.PartitionCount), defaultPartitionCount)
+	}
+	run := f.Store.Inputs("RunInstances")[0].(*ec2.RunInstancesInput)
+	if run.Placement == nil || aws.ToString(run.Placement.GroupName) != "test-cluster" {
+		t.Errorf("instance placement = %+v, want group test-cluster", run.Placement)
+	}
+	if got := f.Store.CapacityReservations[reservationID].AvailableInstanceCount; aws.ToInt32(got) != 0 {
+		t.Errorf("reservation has %d instances available, want 0", aws.ToInt32(got))
+	}
+
+	cache, err := provider.unmarsalCache()
+	if err != nil {
+		t.Fatalf("unmarsalCache() error = %v", err)
+	}
+	if !slices.Equal(cache.PlacementGroups, []string{"test-cluster"}) {
+		t.Errorf("PlacementGroups = %v, want [test-cluster]", cache.PlacementGroups)
+	}
+
+	if err := provider.Delete(context.Background()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if n := f.Store.ResourceCounts()["placementgroups"]; n != 0 {
+		t.Errorf("%d placement groups left after Delete", n)
+	}
+}
+
+func TestCreate_PlacementGroupRolledBack(t *testing.T) {
+	f := awsfake.New()
+	vpcID, subnetID, sgID := seedNetwork(t, f)
+
+	provider := newNetworkTestProvider(t, f, &v1alpha1.Network{
+		VpcID:            vpcID,
+		SubnetID:         subnetID,
+		SecurityGroupIDs: []string{sgID},
+	})
+	provider.Spec.PlacementGroup = &v1alpha1.PlacementGroup{Name: "gpus", Strategy: v1alpha1.PlacementCluster}
+	f.Store.FailNext("RunInstances", errors.New("api error InvalidKeyPair.NotFound"))
+
+	if err := provider.Create(context.Background()); err == nil {
+		t.Fatal("Create() succeeded, want the RunInstances error")
+	}
+	if f.Store.CallsTo("CreatePlacementGroup") != 1 {
+		t.Errorf("CreatePlacementGroup called %d times, want 1", f.Store.CallsTo("CreatePlacementGroup"))
+	}
+	if n := f.Store.ResourceCounts()["placementgroups"]; n != 0 {
+		t.Errorf("%d placement groups left after rollback", n)
+	}
+}
+
+func TestCreate_JoinsExistingPlacementGroup(t *testing.T) {
+	f := awsfake.New()
+	vpcID, subnetID, sgID := seedNetwork(t, f)
+	if _, err := f.EC2.CreatePlacementGroup(context.Background(), &ec2.CreatePlacementGroupInput{
+		GroupName: aws.String("shared"),
+		Strategy:  types.PlacementStrategySpread,
+	}); err != nil {
+		t.Fatalf("CreatePlacementGroup: %v", err)
+	}
+
+	provider := newNetworkTestProvider(t, f, &v1alpha1.Network{
+		VpcID:            vpcID,
+		SubnetID:         subnetID,
+		SecurityGroupIDs: []string{sgID},
+	})
+	provider.Spec.PlacementGroup = &v1alpha1.PlacementGroup{Name: "shared"}
+	if err := provider.Create(context.Background()); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := provider.Delete(context.Background()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if n := f.Store.CallsTo("CreatePlacementGroup"); n != 1 {
+		t.Errorf("CreatePlacementGroup called %d times, want only the seeding call", n)
+	}
+	if f.Store.PlacementGroups["shared"] == nil {
+		t.Error("existing placement group was deleted")
+	}
+}
+
+func TestCreateSubnet_ReservationZone(t *testing.T) {
+	f := awsfake.New()
+	provider := newTestProvider(f.EC2)
+	provider.Spec.CapacityReservation = &v1alpha1.CapacityReservation{
+		ID: f.Store.SeedCapacityReservation("p5.48xlarge", "us-west-2c", 1),
+	}
+
+	cache := &AWS{}
+	if err := provider.createVPC(context.Background(), cache); err != nil {
+		t.Fatalf("createVPC() error = %v", err)
+	}
+	if err := provider.createSubnet(context.Background(), cache); err != nil {
+		t.Fatalf("createSubnet() error = %v", err)
+	}
+	if zone := aws.ToString(f.Store.Subnets[cache.Subnetid].AvailabilityZone); zone != "us-west-2c" {
+		t.Errorf("subnet zone = %q, want the reservation zone us-west-2c", zone)
+	}
+
+	provider.Spec.CapacityReservation.ID = "cr-missing"
+	if err := provider.createSubnet(context.Background(), &AWS{Vpcid: cache.Vpcid}); err == nil {
+		t.Error("createSubnet() succeeded with an unknown reservation")
+	}
+}
+
+func TestCreateInstances_PoolPlacement(t *testing.T) {
+	f := awsfake.New()
+	seedTestImage(f, "ami-test")
+
+	provider := newTestProvider(f.EC2)
+	provider.Spec.PlacementGroup = &v1alpha1.PlacementGroup{Strategy: v1alpha1.PlacementCluster}
+	cache := &ClusterCache{AWS: AWS{PublicSubnetid: "subnet-public"}}
+	image := &v1alpha1.Image{ImageId: aws.String("ami-test")}
+
+	// Both pools inherit spec.instance.placementGroup and share one group
+	for _, role := range []NodeRole{NodeRoleControlPlane, NodeRoleWorker} {
+		if _, err := provider.createInstances(context.Background(), cache, 1, role,
+			"t3.medium", nil, "", image, launchOptions{}); err != nil {
+			t.Fatalf("createInstances(%s) failed: %v", role, err)
+		}
+	}
+	// A pool with its own group gets one named after its role
+	if _, err := provider.createInstances(context.Background(), cache, 1, NodeRoleWorker,
+		"t3.medium", nil, "", image, launchOptions{
+			placementGroup: &v1alpha1.PlacementGroup{Strategy: v1alpha1.PlacementSpread},
+		}); err != nil {
+		t.Fatalf("createInstances(worker) failed: %v", err)
+	}
+
+	if want := []string{"test-cluster", "test-cluster-worker"}; !slices.Equal(cache.PlacementGroups, want) {
+		t.Errorf("PlacementGroups = %v, want %v", cache.PlacementGroups, want)
+	}
+	var groups []string
+	for _, in := range f.Store.Inputs("RunInstances") {
+		groups = append(groups, aws.ToString(in.(*ec2.RunInstancesInput).Placement.GroupName))
+	}
+	if want := []string{"test-cluster", "test-cluster", "test-cluster-worker"}; !slices.Equal(groups, want) {
+		t.Errorf("instances launched in groups %v, want %v", groups, want)
+	}
+}
+
+func TestDeletePlacementGroups_AlreadyDeleted(t *testing.T) {
+	f := awsfake.New()
+	provider := newTestProvider(f.EC2)
+
+	if err := provider.deletePlacementGroups(context.Background(), &AWS{PlacementGroups: []string{"gone"}}); err != nil {
+		t.Errorf("deletePlacementGroups() error = %v", err)
+	}
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+const (
+	// instanceStateTimeout bounds how long Stop and Start wait for the
+	// instances to reach the stopped or running state.
+	instanceStateTimeout = 10 * time.Minute
+
+	// nodePhaseStopped is the phase of nodes and clusters whose instances
+	// are stopped.
+	nodePhaseStopped = "Stopped"
+)
+
+// Stop stops the EC2 instances of the environment. The instances keep their
+// EBS volumes but lose their public addresses, which Start refreshes.
+func (p *Provider) Stop(ctx context.Context) error {
+	cache, err := p.unmarsalCache()
+	if err != nil {
+		return fmt.Errorf("error retrieving cache: %w", err)
+	}
+	instanceIDs, err := p.stoppableInstances(cache)
+	if err != nil {
+		return err
+	}
+
+	ctxStop, cancelStop := context.WithTimeout(ctx, ec2APITimeout)
+	_, err = p.ec2.StopInstances(ctxStop, &ec2.StopInstancesInput{InstanceIds: instanceIDs})
+	cancelStop()
+	if err != nil {
+		return fmt.Errorf("error stopping instances: %w", err)
+	}
+
+	cancelLoading := p.log.Loading("Waiting for %d instance(s) to stop", len(instanceIDs))
+	waiter := ec2.NewInstanceStoppedWaiter(p.ec2, func(o *ec2.InstanceStoppedWaiterOptions) {
+		o.MaxDelay = 1 * time.Minute
+		o.MinDelay = 5 * time.Second
+	})
+	if err := waiter.Wait(ctx, &ec2.DescribeInstancesInput{InstanceIds: instanceIDs}, instanceStateTimeout); err != nil {
+		cancelLoading(logger.ErrLoadingFailed)
+		return fmt.Errorf("error waiting for instances to stop: %w", err)
+	}
+	cancelLoading(nil)
+
+	if cluster := p.Environment.Status.Cluster; cluster != nil {
+		for i := range cluster.Nodes {
+			cluster.Nodes[i].Phase = nodePhaseStopped
+		}
+		cluster.ReadyNodes = 0
+		cluster.Phase = nodePhaseStopped
+	}
+	return p.updateCondition(*p.Environment, cache, getStoppedConditions("v1alpha1.Stopped", "EC2 instances are stopped"))
+}
+
+// Start starts the stopped EC2 instances of the environment and records the
+// public DNS name and node addresses they were assigned on restart.
+func (p *Provider) Start(ctx context.Context) error {
+	cache, err := p.unmarsalCache()
+	if err != nil {
+		return fmt.Errorf("error retrieving cache: %w", err)
+	}
+	instanceIDs, err := p.stoppableInstances(cache)
+	if err != nil {
+		return err
+	}
+
+	ctxStart, cancelStart := context.WithTimeout(ctx, ec2APITimeout)
+	_, err = p.ec2.StartInstances(ctxStart, &ec2.StartInstancesInput{InstanceIds: instanceIDs})
+	cancelStart()
+	if err != nil {
+		return fmt.Errorf("error starting instances: %w", err)
+	}
+
+	cancelLoading := p.log.Loading("Waiting for %d instance(s) to be running", len(instanceIDs))
+	waiter := ec2.NewInstanceRunningWaiter(p.ec2, func(o *ec2.InstanceRunningWaiterOptions) {
+		o.MaxDelay = 1 * time.Minute
+		o.MinDelay = 5 * time.Second
+	})
+	if err := waiter.Wait(ctx, &ec2.DescribeInstancesInput{InstanceIds: instanceIDs}, instanceStateTimeout); err != nil {
+		cancelLoading(logger.ErrLoadingFailed)
+		return fmt.Errorf("error waiting for instances to be running: %w", err)
+	}
+	cancelLoading(nil)
+
+	if err := p.refreshAddresses(ctx, cache, instanceIDs); err != nil {
+		return err
+	}
+	return p.updateAvailableCondition(*p.Environment, cache)
+}
+
+// stoppableInstances returns the instance IDs of the environment: the
+// cluster nodes, or the single instance. Spot instances are launched as
+// one-time requests, which EC2 cannot stop, so environments with spot
+// instances are rejected.
+func (p *Provider) stoppableInstances(cache *AWS) ([]string, error) {
+	var instanceIDs []string
+	if cluster := p.Environment.Status.Cluster; cluster != nil && len(cluster.Nodes) > 0 {
+		for _, node := range cluster.Nodes {
+			if node.Market == v1alpha1.MarketSpot {
+				return nil, fmt.Errorf("node %s is a spot instance and cannot be stopped", node.Name)
+			}
+			if node.InstanceID != "" {
+				instanceIDs = append(instanceIDs, node.InstanceID)
+			}
+		}
+	} else if cache.Instanceid != "" {
+		if cache.InstanceMarket == string(v1alpha1.MarketSpot) {
+			return nil, fmt.Errorf("instance %s is a spot instance and cannot be stopped", cache.Instanceid)
+		}
+		instanceIDs = append(instanceIDs, cache.Instanceid)
+	}
+	if len(instanceIDs) == 0 {
+		return nil, fmt.Errorf("no EC2 instances found in cache")
+	}
+	return instanceIDs, nil
+}
+
+// refreshAddresses updates the public DNS name in cache and the addresses
+// of the cluster nodes from the running instances. The control-plane
+// endpoint follows the first control-plane node unless it is fronted by a
+// load balancer. Nodes whose instance is not found keep their phase, and the
+// cluster is only Ready once all of its nodes are.
+func (p *Provider) refreshAddresses(ctx context.Context, cache *AWS, instanceIDs []string) error {
+	ctxDescribe, cancel := context.WithTimeout(ctx, ec2APITimeout)
+	defer cancel()
+	out, err := p.ec2.DescribeInstances(ctxDescribe, &ec2.DescribeInstancesInput{InstanceIds: instanceIDs})
+	if err != nil {
+		return fmt.Errorf("error describing instances: %w", err)
+	}
+	running := map[string]types.Instance{}
+	for _, r := range out.Reservations {
+		for _, inst := range r.Instances {
+			running[aws.ToString(inst.InstanceId)] = inst
+		}
+	}
+
+	cluster := p.Environment.Status.Cluster
+	if cluster == nil || len(cluster.Nodes) == 0 {
+		if inst, ok := running[cache.Instanceid]; ok {
+			cache.PublicDnsName = aws.ToString(inst.PublicDnsName)
+		}
+		return nil
+	}
+
+	controlPlaneDNS := ""
+	var ready int32
+	for i := range cluster.Nodes {
+		node := &cluster.Nodes[i]
+		inst, ok := running[node.InstanceID]
+		if !ok {
+			p.log.Warning("Instance of node %s not found", node.Name)
+			continue
+		}
+		ready++
+		node.PublicIP = aws.ToString(inst.PublicIpAddress)
+		node.PrivateIP = aws.ToString(inst.PrivateIpAddress)
+		node.Phase = "Ready"
+		if controlPlaneDNS == "" && node.Role == "control-plane" {
+			controlPlaneDNS = aws.ToString(inst.PublicDnsName)
+		}
+	}
+	cluster.ReadyNodes = ready
+	// #nosec G115 -- node count is bounded by cluster spec, will never overflow int32
+	if ready == int32(len(cluster.Nodes)) {
+		cluster.Phase = "Ready"
+	}
+	if controlPlaneDNS != "" {
+		cache.PublicDnsName = controlPlaneDNS
+		if cluster.LoadBalancerDNS == "" {
+			cluster.ControlPlaneEndpoint = controlPlaneDNS
+		}
+	}
+	return nil
+}
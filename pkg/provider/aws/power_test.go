@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aws
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/aws/awsfake"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	meta "k8s.io/apimachinery/pkg/api/meta"
+)
+
+// newPowerTestProvider launches n instances in f and returns a provider whose
+// cache file records them as an available environment.
+func newPowerTestProvider(t *testing.T, f *awsfake.Fake, cluster bool, n int) (*Provider, []string) {
+	t.Helper()
+	out, err := f.EC2.RunInstances(context.Background(), &ec2.RunInstancesInput{MinCount: aws.Int32(int32(n))})
+	if err != nil {
+		t.Fatalf("RunInstances: %v", err)
+	}
+	var ids []string
+	for _, inst := range out.Instances {
+		ids = append(ids, aws.ToString(inst.InstanceId))
+	}
+
+	p := newTestProvider(f.EC2)
+	p.cacheFile = filepath.Join(t.TempDir(), "cache.yaml")
+	cache := &AWS{PublicDnsName: aws.ToString(out.Instances[0].PublicDnsName)}
+	if cluster {
+		status := &v1alpha1.ClusterStatus{Phase: "Ready", ControlPlaneEndpoint: cache.PublicDnsName}
+		for i, inst := range out.Instances {
+			role := "worker"
+			if i == 0 {
+				role = "control-plane"
+			}
+			status.Nodes = append(status.Nodes, v1alpha1.NodeStatus{
+				Name:       ids[i],
+				Role:       role,
+				InstanceID: ids[i],
+				PublicIP:   aws.ToString(inst.PublicIpAddress),
+				PrivateIP:  aws.ToString(inst.PrivateIpAddress),
+				Phase:      "Ready",
+			})
+		}
+		status.TotalNodes = int32(n)
+		status.ReadyNodes = int32(n)
+		p.Environment.Status.Cluster = status
+	} else {
+		cache.Instanceid = ids[0]
+	}
+	if err := p.updateAvailableCondition(*p.Environment, cache); err != nil {
+		t.Fatalf("writing cache: %v", err)
+	}
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](p.cacheFile)
+	if err != nil {
+		t.Fatalf("reading cache: %v", err)
+	}
+	p.Environment = &env
+	return p, ids
+}
+
+func readPowerTestCache(t *testing.T, p *Provider) (v1alpha1.Environment, *AWS) {
+	t.Helper()
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](p.cacheFile)
+	if err != nil {
+		t.Fatalf("reading cache: %v", err)
+	}
+	cache, err := p.unmarsalCache()
+	if err != nil {
+		t.Fatalf("reading cache: %v", err)
+	}
+	return env, cache
+}
+
+func TestStopStart_SingleNode(t *testing.T) {
+	f := awsfake.New()
+	p, ids := newPowerTestProvider(t, f, false, 1)
+	_, before := readPowerTestCache(t, p)
+
+	if err := p.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	if state := f.Store.Instances[ids[0]].State.Name; state != "stopped" {
+		t.Errorf("instance state after Stop = %q, want stopped", state)
+	}
+	env, _ := readPowerTestCache(t, p)
+	if !meta.IsStatusConditionTrue(env.Status.Conditions, v1alpha1.ConditionStopped) {
+		t.Errorf("Stopped condition not set: %+v", env.Status.Conditions)
+	}
+	if meta.IsStatusConditionTrue(env.Status.Conditions, v1alpha1.ConditionAvailable) {
+		t.Error("Available condition still true after Stop")
+	}
+
+	p.Environment = &env
+	if err := p.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	env, after := readPowerTestCache(t, p)
+	if meta.FindStatusCondition(env.Status.Conditions, v1alpha1.ConditionStopped) != nil {
+		t.Errorf("Stopped condition kept after Start: %+v", env.Status.Conditions)
+	}
+	if !meta.IsStatusConditionTrue(env.Status.Conditions, v1alpha1.ConditionAvailable) {
+		t.Error("Available condition not restored after Start")
+	}
+	want := aws.ToString(f.Store.Instances[ids[0]].PublicDnsName)
+	if after.PublicDnsName != want || after.PublicDnsName == before.PublicDnsName {
+		t.Errorf("PublicDnsName = %q, want refreshed %q (was %q)", after.PublicDnsName, want, before.PublicDnsName)
+	}
+}
+
+func TestStopStart_Cluster(t *testing.T) {
+	f := awsfake.New()
+	p, ids := newPowerTestProvider(t, f, true, 3)
+
+	if err := p.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	stopped := f.Store.Inputs("StopInstances")
+	if len(stopped) != 1 || len(stopped[0].(*ec2.StopInstancesInput).InstanceIds) != len(ids) {
+		t.Fatalf("StopInstances inputs = %+v, want one call for %d instances", stopped, len(ids))
+	}
+	env, _ := readPowerTestCache(t, p)
+	if env.Status.Cluster.Phase != nodePhaseStopped || env.Status.Cluster.Nodes[1].Phase != nodePhaseStopped {
+		t.Errorf("cluster phases after Stop = %q/%q, want Stopped", env.Status.Cluster.Phase, env.Status.Cluster.Nodes[1].Phase)
+	}
+
+	p.Environment = &env
+	if err := p.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	env, cache := readPowerTestCache(t, p)
+	for i, node := range env.Status.Cluster.Nodes {
+		want := aws.ToString(f.Store.Instances[ids[i]].PublicIpAddress)
+		if node.PublicIP != want || node.Phase != "Ready" {
+			t.Errorf("node %s = %s/%s, want %s/Ready", node.Name, node.PublicIP, node.Phase, want)
+		}
+	}
+	cpDNS := aws.ToString(f.Store.Instances[ids[0]].PublicDnsName)
+	if cache.PublicDnsName != cpDNS || env.Status.Cluster.ControlPlaneEndpoint != cpDNS {
+		t.Errorf("endpoint = %q/%q, want %q", cache.PublicDnsName, env.Status.Cluster.ControlPlaneEndpoint, cpDNS)
+	}
+	if env.Status.Cluster.ReadyNodes != 3 {
+		t.Errorf("ReadyNodes = %d, want 3", env.Status.Cluster.ReadyNodes)
+	}
+}
+
+// TestRefreshAddresses_MissingInstance: a node whose instance is not found
+// keeps its phase and is not counted as ready, and the cluster is not Ready.
+func TestRefreshAddresses_MissingInstance(t *testing.T) {
+	f := awsfake.New()
+	p, ids := newPowerTestProvider(t, f, true, 3)
+	if err := p.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	env, cache := readPowerTestCache(t, p)
+	p.Environment = &env
+
+	if err := p.refreshAddresses(context.Background(), cache, ids[:2]); err != nil {
+		t.Fatalf("refreshAddresses() error = %v", err)
+	}
+	cluster := p.Environment.Status.Cluster
+	if cluster.ReadyNodes != 2 {
+		t.Errorf("ReadyNodes = %d, want 2", cluster.ReadyNodes)
+	}
+	if cluster.Phase != nodePhaseStopped {
+		t.Errorf("cluster phase = %q, want %q", cluster.Phase, nodePhaseStopped)
+	}
+	if cluster.Nodes[1].Phase != "Ready" || cluster.Nodes[2].Phase != nodePhaseStopped {
+		t.Errorf("node phases = %q/%q, want Ready/%s", cluster.Nodes[1].Phase, cluster.Nodes[2].Phase, nodePhaseStopped)
+	}
+}
+
+func TestStop_RejectsSpot(t *testing.T) {
+	f := awsfake.New()
+	p, _ := newPowerTestProvider(t, f, true, 2)
+	p.Environment.Status.Cluster.Nodes[1].Market = v1alpha1.MarketSpot
+
+	err := p.Stop(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "spot instance") {
+		t.Fatalf("Stop() error = %v, want spot instance error", err)
+	}
+	if n := f.Store.CallsTo("StopInstances"); n != 0 {
+		t.Errorf("StopInstances called %d times, want 0", n)
+	}
+}
@@ -0,0 +1,259 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+// nodePhasePending is the phase of workers added to a running cluster until
+// the caller has joined them to it
+const nodePhasePending = "Pending"
+
+// AddWorkers launches count workers into a worker pool of a running cluster,
+// reusing its subnet and security groups, and records them in
+// status.cluster and the pool count in spec.cluster. The workers are
+// Pending: joining them and marking them Ready is up to the caller.
+func (p *Provider) AddWorkers(ctx context.Context, pool string, count int) ([]v1alpha1.NodeStatus, error) {
+	if !p.IsMultinode() || p.Environment.Status.Cluster == nil {
+		return nil, fmt.Errorf("environment is not a cluster")
+	}
+	if count <= 0 {
+		return nil, fmt.Errorf("worker count to add must be positive, got %d", count)
+	}
+	return p.addWorkers(ctx, pool, count, p.nextWorkerIndex(pool))
+}
+
+// addWorkers launches count workers into a worker pool, numbering them from
+// firstIndex.
+func (p *Provider) addWorkers(ctx context.Context, pool string, count, firstIndex int) ([]v1alpha1.NodeStatus, error) {
+	wSpec := p.Spec.Cluster.WorkerPool(pool)
+	if wSpec == nil {
+		return nil, fmt.Errorf("cluster has no worker pool %q", pool)
+	}
+
+	awsCache, err := p.unmarsalCache()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving cache: %w", err)
+	}
+	cache := &ClusterCache{AWS: *awsCache}
+	if err := p.scaling(cache, fmt.Sprintf("Adding %d worker(s)", count)); err != nil {
+		return nil, err
+	}
+
+	cancel := p.log.Loading("Creating %d worker instance(s)", count)
+	instances, err := p.createInstances(ctx,
+		cache, count, NodeRoleWorker,
+		wSpec.InstanceType, wSpec.RootVolumeSizeGB,
+		wSpec.OS, wSpec.Image,
+		launchOptions{
+			market:              wSpec.Market,
+			spotMaxPrice:        wSpec.SpotMaxPrice,
+			capacityReservation: wSpec.CapacityReservation,
+			placementGroup:      wSpec.PlacementGroup,
+			pool:                pool,
+			firstIndex:          firstIndex,
+		},
+	)
+	if err != nil {
+		cancel(logger.ErrLoadingFailed)
+		_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Scaling", "Error creating worker instances")
+		return nil, fmt.Errorf("error creating worker instances: %w", err)
+	}
+	cancel(nil)
+
+	cache.WorkerInstances = instances
+	if err := p.disableSourceDestCheck(ctx, cache); err != nil {
+		_ = p.updateDegradedCondition(*p.DeepCopy(), &cache.AWS, "v1alpha1.Scaling", "Error disabling source/dest check")
+		return nil, fmt.Errorf("error disabling source/destination check: %w", err)
+	}
+
+	var nodes []v1alpha1.NodeStatus
+	for _, inst := range instances {
+		nodes = append(nodes, v1alpha1.NodeStatus{
+			Name:        inst.Name,
+			Role:        inst.Role,
+			InstanceID:  inst.InstanceID,
+			PublicIP:    inst.PublicIP,
+			PrivateIP:   inst.PrivateIP,
+			SSHUsername: inst.SSHUsername,
+			Market:      inst.Market,
+			Pool:        inst.Pool,
+			Phase:       nodePhasePending,
+		})
+	}
+	// Keep the nodes of a pool in index order
+	slices.SortFunc(nodes, func(a, b v1alpha1.NodeStatus) int { return nodeIndex(a.Name) - nodeIndex(b.Name) })
+
+	status := p.Environment.Status.Cluster
+	status.Nodes = append(status.Nodes, nodes...)
+	// #nosec G115 -- count is bounded by the instances just launched, will never overflow int32
+	added := int32(len(nodes))
+	status.TotalNodes += added
+	wSpec.Count += added
+
+	return nodes, p.updateAvailableCondition(*p.Environment, &cache.AWS)
+}
+
+// RemoveWorkers terminates the named worker nodes of a running cluster and
+// removes them from status.cluster and their pool count in spec.cluster.
+// Draining the nodes is up to the caller.
+func (p *Provider) RemoveWorkers(ctx context.Context, names []string) error {
+	if !p.IsMultinode() || p.Environment.Status.Cluster == nil {
+		return fmt.Errorf("environment is not a cluster")
+	}
+	status := p.Environment.Status.Cluster
+
+	var instanceIDs []string
+	for _, name := range names {
+		i := slices.IndexFunc(status.Nodes, func(n v1alpha1.NodeStatus) bool { return n.Name == name })
+		if i < 0 {
+			return fmt.Errorf("node %s not found in cluster status", name)
+		}
+		if status.Nodes[i].Role != string(NodeRoleWorker) {
+			return fmt.Errorf("node %s is a %s node, only workers can be removed", name, status.Nodes[i].Role)
+		}
+		if id := status.Nodes[i].InstanceID; id != "" {
+			instanceIDs = append(instanceIDs, id)
+		}
+	}
+
+	cache, err := p.unmarsalCache()
+	if err != nil {
+		return fmt.Errorf("error retrieving cache: %w", err)
+	}
+	if err := p.scaling(&ClusterCache{AWS: *cache}, fmt.Sprintf("Removing %d worker(s)", len(names))); err != nil {
+		return err
+	}
+
+	if len(instanceIDs) > 0 {
+		ctxTerminate, cancelTerminate := context.WithTimeout(ctx, ec2APITimeout)
+		_, err = p.ec2.TerminateInstances(ctxTerminate, &ec2.TerminateInstancesInput{InstanceIds: instanceIDs})
+		cancelTerminate()
+		if err != nil && !strings.Contains(err.Error(), "InvalidInstanceID.NotFound") {
+			_ = p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Scaling", "Error terminating worker instances")
+			return fmt.Errorf("error terminating instances: %w", err)
+		}
+
+		cancelLoading := p.log.Loading("Waiting for %d instance(s) to be terminated", len(instanceIDs))
+		waiter := ec2.NewInstanceTerminatedWaiter(p.ec2, func(o *ec2.InstanceTerminatedWaiterOptions) {
+			o.MaxDelay = 1 * time.Minute
+			o.MinDelay = 5 * time.Second
+		})
+		if err := waiter.Wait(ctx, &ec2.DescribeInstancesInput{InstanceIds: instanceIDs}, deletionTimeout); err != nil {
+			cancelLoading(logger.ErrLoadingFailed)
+			_ = p.updateDegradedCondition(*p.DeepCopy(), cache, "v1alpha1.Scaling", "Error waiting for worker termination")
+			return fmt.Errorf("error waiting for instances to be terminated: %w", err)
+		}
+		cancelLoading(nil)
+	}
+
+	status.Nodes = slices.DeleteFunc(status.Nodes, func(n v1alpha1.NodeStatus) bool {
+		if !slices.Contains(names, n.Name) {
+			return false
+		}
+		if wSpec := p.Spec.Cluster.WorkerPool(n.Pool); wSpec != nil && wSpec.Count > 0 {
+			wSpec.Count--
+		}
+		if n.Phase == "Ready" {
+			status.ReadyNodes--
+		}
+		status.TotalNodes--
+		return true
+	})
+
+	return p.updateAvailableCondition(*p.Environment, cache)
+}
+
+// ReplaceWorker terminates the named worker of a running cluster and
+// launches a new instance with the same name and pool in its place, so that
+// a node whose provisioning wedged can be rebuilt without recreating the
+// cluster. Draining the node, and joining the Pending replacement, is up to
+// the caller.
+func (p *Provider) ReplaceWorker(ctx context.Context, name string) (*v1alpha1.NodeStatus, error) {
+	if !p.IsMultinode() || p.Environment.Status.Cluster == nil {
+		return nil, fmt.Errorf("environment is not a cluster")
+	}
+	i := slices.IndexFunc(p.Environment.Status.Cluster.Nodes, func(n v1alpha1.NodeStatus) bool { return n.Name == name })
+	if i < 0 {
+		return nil, fmt.Errorf("node %s not found in cluster status", name)
+	}
+	old := p.Environment.Status.Cluster.Nodes[i]
+	if old.Role != string(NodeRoleWorker) {
+		return nil, fmt.Errorf("node %s is a %s node, only workers can be replaced", name, old.Role)
+	}
+	if p.Spec.Cluster.WorkerPool(old.Pool) == nil {
+		return nil, fmt.Errorf("cluster has no worker pool %q", old.Pool)
+	}
+
+	if err := p.RemoveWorkers(ctx, []string{name}); err != nil {
+		return nil, err
+	}
+	index := nodeIndex(name)
+	if index < 0 {
+		index = p.nextWorkerIndex(old.Pool)
+	}
+	nodes, err := p.addWorkers(ctx, old.Pool, 1, index)
+	if err != nil {
+		return nil, err
+	}
+	return &nodes[0], nil
+}
+
+// scaling marks the environment as progressing while its workers change.
+// Recording the condition on the environment makes the available condition
+// set at the end of the change count as an update of the cache file.
+func (p *Provider) scaling(cache *ClusterCache, message string) error {
+	conditions := getProgressingConditions("v1alpha1.Scaling", message)
+	if err := p.updateCondition(*p.Environment, &cache.AWS, conditions); err != nil {
+		return fmt.Errorf("error updating status: %w", err)
+	}
+	p.Environment.Status.Conditions = conditions
+	return nil
+}
+
+// nextWorkerIndex returns the node index following the highest one in use
+// by the workers of pool.
+func (p *Provider) nextWorkerIndex(pool string) int {
+	next := 0
+	for _, n := range p.Environment.Status.Cluster.Nodes {
+		if n.Role == string(NodeRoleWorker) && n.Pool == pool {
+			next = max(next, nodeIndex(n.Name)+1)
+		}
+	}
+	return next
+}
+
+// nodeIndex returns the index suffix of a node name such as
+// "<env>-worker-3", or -1 if it has none.
+func nodeIndex(name string) int {
+	i, err := strconv.Atoi(name[strings.LastIndex(name, "-")+1:])
+	if err != nil {
+		return -1
+	}
+	return i
+}
@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aws
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/aws/awsfake"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	meta "k8s.io/apimachinery/pkg/api/meta"
+)
+
+// newScaleTestProvider returns a provider whose cache file records a
+// running cluster with one control-plane node and one worker in pool gpu.
+func newScaleTestProvider(t *testing.T, f *awsfake.Fake) *Provider {
+	t.Helper()
+	seedTestImage(f, "ami-test")
+
+	p := newTestProvider(f.EC2)
+	p.cacheFile = filepath.Join(t.TempDir(), "cache.yaml")
+	p.Spec.Cluster = &v1alpha1.ClusterSpec{
+		ControlPlane: v1alpha1.ControlPlaneSpec{Count: 1},
+		WorkerPools: []v1alpha1.WorkerPoolSpec{
+			{Name: "gpu", Count: 1, InstanceType: "g4dn.xlarge", Image: &v1alpha1.Image{ImageId: aws.String("ami-test")}},
+		},
+	}
+	p.Environment.Status.Cluster = &v1alpha1.ClusterStatus{
+		Phase: "Ready",
+		Nodes: []v1alpha1.NodeStatus{
+			{Name: "test-cluster-control-plane-0", Role: "control-plane", InstanceID: "i-cp", Phase: "Ready"},
+			{Name: "test-cluster-worker-gpu-0", Role: "worker", Pool: "gpu", InstanceID: "i-gpu", Phase: "Ready"},
+		},
+		TotalNodes: 2,
+		ReadyNodes: 2,
+	}
+	cache := &AWS{PublicSubnetid: "subnet-public", WorkerSecurityGroupid: "sg-worker"}
+	if err := p.updateAvailableCondition(*p.Environment, cache); err != nil {
+		t.Fatalf("writing cache: %v", err)
+	}
+	env, _ := readPowerTestCache(t, p)
+	p.Environment = &env
+	return p
+}
+
+func TestAddRemoveWorkers(t *testing.T) {
+	f := awsfake.New()
+	p := newScaleTestProvider(t, f)
+
+	nodes, err := p.AddWorkers(context.Background(), "gpu", 2)
+	if err != nil {
+		t.Fatalf("AddWorkers() error = %v", err)
+	}
+	if len(nodes) != 2 || nodes[0].Name != "test-cluster-worker-gpu-1" || nodes[1].Name != "test-cluster-worker-gpu-2" {
+		t.Fatalf("added nodes = %+v, want test-cluster-worker-gpu-1 and -2", nodes)
+	}
+	for _, in := range f.Store.Inputs("RunInstances") {
+		input := in.(*ec2.RunInstancesInput)
+		if got := aws.ToString(input.NetworkInterfaces[0].SubnetId); got != "subnet-public" {
+			t.Errorf("SubnetId = %q, want the cluster subnet", got)
+		}
+		if got := input.NetworkInterfaces[0].Groups; len(got) != 1 || got[0] != "sg-worker" {
+			t.Errorf("Groups = %v, want the worker security group", got)
+		}
+	}
+
+	env, _ := readPowerTestCache(t, p)
+	if got := env.Spec.Cluster.WorkerPools[0].Count; got != 3 {
+		t.Errorf("pool count = %d, want 3", got)
+	}
+	if got := env.Status.Cluster.TotalNodes; got != 4 || len(env.Status.Cluster.Nodes) != 4 {
+		t.Errorf("cluster has %d/%d nodes, want 4", got, len(env.Status.Cluster.Nodes))
+	}
+	// The new workers are not Ready until they join the cluster
+	if got := env.Status.Cluster.ReadyNodes; got != 2 {
+		t.Errorf("ready nodes = %d, want 2", got)
+	}
+	for _, n := range env.Status.Cluster.Nodes[2:] {
+		if n.Phase != "Pending" {
+			t.Errorf("added node %s phase = %q, want Pending", n.Name, n.Phase)
+		}
+	}
+	if !meta.IsStatusConditionTrue(env.Status.Conditions, v1alpha1.ConditionAvailable) {
+		t.Errorf("Available condition not set after scaling: %+v", env.Status.Conditions)
+	}
+
+	p.Environment = &env
+	if err := p.RemoveWorkers(context.Background(), []string{nodes[1].Name}); err != nil {
+		t.Fatalf("RemoveWorkers() error = %v", err)
+	}
+	if state := f.Store.Instances[nodes[1].InstanceID].State.Name; state != "terminated" {
+		t.Errorf("removed instance state = %q, want terminated", state)
+	}
+	env, _ = readPowerTestCache(t, p)
+	if got := env.Spec.Cluster.WorkerPools[0].Count; got != 2 {
+		t.Errorf("pool count = %d, want 2", got)
+	}
+	if got := env.Status.Cluster.TotalNodes; got != 3 || len(env.Status.Cluster.Nodes) != 3 {
+		t.Errorf("cluster has %d/%d nodes, want 3", got, len(env.Status.Cluster.Nodes))
+	}
+	if got := env.Status.Cluster.ReadyNodes; got != 2 {
+		t.Errorf("ready nodes = %d after removing a Pending worker, want 2", got)
+	}
+	for _, n := range env.Status.Cluster.Nodes {
+		if n.Name == nodes[1].Name {
+			t.Errorf("removed node %s still in status", n.Name)
+		}
+	}
+}
+
+func TestAddWorkers_UnknownPool(t *testing.T) {
+	p := newScaleTestProvider(t, awsfake.New())
+
+	if _, err := p.AddWorkers(context.Background(), "cpu", 1); err == nil || err.Error() != `cluster has no worker pool "cpu"` {
+		t.Errorf("AddWorkers() error = %v, want unknown pool", err)
+	}
+}
+
+func TestRemoveWorkers_RejectsControlPlane(t *testing.T) {
+	f := awsfake.New()
+	p := newScaleTestProvider(t, f)
+
+	err := p.RemoveWorkers(context.Background(), []string{"test-cluster-control-plane-0"})
+	if err == nil || err.Error() != "node test-cluster-control-plane-0 is a control-plane node, only workers can be removed" {
+		t.Errorf("RemoveWorkers() error = %v, want control-plane rejection", err)
+	}
+	if calls := len(f.Store.Inputs("TerminateInstances")); calls != 0 {
+		t.Errorf("TerminateInstances called %d times", calls)
+	}
+}
+
+func TestReplaceWorker(t *testing.T) {
+	f := awsfake.New()
+	p := newScaleTestProvider(t, f)
+
+	added, err := p.AddWorkers(context.Background(), "gpu", 2)
+	if err != nil {
+		t.Fatalf("AddWorkers() error = %v", err)
+	}
+	env, _ := readPowerTestCache(t, p)
+	p.Environment = &env
+
+	// Replacing a worker that is not the last of its pool keeps its name
+	old := added[0]
+	node, err := p.ReplaceWorker(context.Background(), old.Name)
+	if err != nil {
+		t.Fatalf("ReplaceWorker() error = %v", err)
+	}
+	if node.Name != old.Name || node.Pool != "gpu" {
+		t.Errorf("replacement = %s in pool %q, want %s in pool gpu", node.Name, node.Pool, old.Name)
+	}
+	if node.InstanceID == old.InstanceID {
+		t.Errorf("replacement reuses instance %s", old.InstanceID)
+	}
+	if state := f.Store.Instances[old.InstanceID].State.Name; state != "terminated" {
+		t.Errorf("replaced instance state = %q, want terminated", state)
+	}
+
+	env, _ = readPowerTestCache(t, p)
+	if got := env.Spec.Cluster.WorkerPools[0].Count; got != 3 {
+		t.Errorf("pool count = %d, want 3", got)
+	}
+	if got := env.Status.Cluster.TotalNodes; got != 4 || len(env.Status.Cluster.Nodes) != 4 {
+		t.Errorf("cluster has %d/%d nodes, want 4", got, len(env.Status.Cluster.Nodes))
+	}
+	for _, n := range env.Status.Cluster.Nodes {
+		if n.Name == old.Name && n.InstanceID != node.InstanceID {
+			t.Errorf("status records %s as %s, want %s", n.Name, n.InstanceID, node.InstanceID)
+		}
+	}
+}
+
+func TestReplaceWorker_RejectsControlPlane(t *testing.T) {
+	f := awsfake.New()
+	p := newScaleTestProvider(t, f)
+
+	_, err := p.ReplaceWorker(context.Background(), "test-cluster-control-plane-0")
+	if err == nil || err.Error() != "node test-cluster-control-plane-0 is a control-plane node, only workers can be replaced" {
+		t.Errorf("ReplaceWorker() error = %v, want control-plane rejection", err)
+	}
+	if calls := len(f.Store.Inputs("TerminateInstances")); calls != 0 {
+		t.Errorf("TerminateInstances called %d times", calls)
+	}
+}
+
+func TestNodeIndex(t *testing.T) {
+	for name, want := range map[string]int{
+		"env-worker-0":     0,
+		"env-worker-gpu-7": 7,
+		"env-worker":       -1,
+	} {
+		if got := nodeIndex(name); got != want {
+			t.Errorf("nodeIndex(%q) = %d, want %d", name, got, want)
+		}
+	}
+}
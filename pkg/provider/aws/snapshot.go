@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aws
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	internalaws "github.com/NVIDIA/holodeck/internal/aws"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+const (
+	// ComponentTagPrefix prefixes the image tags recording the provenance of
+	// the components baked into a golden image, one tag per field, e.g.
+	// "holodeck:driver:version".
+	ComponentTagPrefix = "holodeck:"
+
+	// SourceEnvironmentTag records the environment a golden image was taken
+	// from.
+	SourceEnvironmentTag = "HolodeckEnvironment"
+
+	// imageAvailableTimeout bounds how long Snapshot waits for the image to
+	// become available. Images of large root volumes take a while.
+	imageAvailableTimeout = 45 * time.Minute
+)
+
+// Snapshot creates an AMI from the instance of a single-node environment.
+// The component provenance in status.components is recorded in the image
+// tags, so that environments created from the image skip reinstalling
+// components whose provenance matches their spec.
+func (p *Provider) Snapshot(ctx context.Context, opts provider.SnapshotOptions) (string, error) {
+	if opts.Name == "" {
+		return "", fmt.Errorf("image name is required")
+	}
+	if p.IsMultinode() {
+		return "", fmt.Errorf("snapshots of cluster environments are not supported")
+	}
+	cache, err := p.unmarsalCache()
+	if err != nil {
+		return "", fmt.Errorf("error retrieving cache: %w", err)
+	}
+	if cache.Instanceid == "" {
+		return "", fmt.Errorf("no EC2 instance found in cache")
+	}
+
+	// The image outlives the environment: it is named after the image and
+	// does not inherit the expiry of the environment.
+	tags := []types.Tag{
+		{Key: aws.String("Name"), Value: aws.String(opts.Name)},
+		{Key: aws.String(SourceEnvironmentTag), Value: aws.String(p.ObjectMeta.Name)},
+	}
+	for _, tag := range p.Tags {
+		switch aws.ToString(tag.Key) {
+		case "Name", internalaws.ExpiresAtTag:
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	tags = append(tags, componentTags(p.Environment.Status.Components)...)
+
+	ctxCreate, cancelCreate := context.WithTimeout(ctx, ec2APITimeout)
+	out, err := p.ec2.CreateImage(ctxCreate, &ec2.CreateImageInput{
+		InstanceId:  aws.String(cache.Instanceid),
+		Name:        aws.String(opts.Name),
+		Description: aws.String(fmt.Sprintf("Holodeck golden image of %s", p.ObjectMeta.Name)),
+		NoReboot:    aws.Bool(opts.NoReboot),
+		TagSpecifications: []types.TagSpecification{
+			{ResourceType: types.ResourceTypeImage, Tags: tags},
+			{ResourceType: types.ResourceTypeSnapshot, Tags: tags},
+		},
+	})
+	cancelCreate()
+	if err != nil {
+		return "", fmt.Errorf("error creating image: %w", err)
+	}
+	imageID := aws.ToString(out.ImageId)
+
+	cancelLoading := p.log.Loading("Waiting for image %s to be available", imageID)
+	waiter := ec2.NewImageAvailableWaiter(p.ec2, func(o *ec2.ImageAvailableWaiterOptions) {
+		o.MaxDelay = 1 * time.Minute
+		o.MinDelay = 15 * time.Second
+	})
+	if err := waiter.Wait(ctx, &ec2.DescribeImagesInput{ImageIds: []string{imageID}}, imageAvailableTimeout); err != nil {
+		cancelLoading(logger.ErrLoadingFailed)
+		return imageID, fmt.Errorf("error waiting for image %s to be available: %w", imageID, err)
+	}
+	cancelLoading(nil)
+
+	return imageID, nil
+}
+
+// imageComponents returns the component provenance recorded in the tags of
+// a golden image created by Snapshot, or nil for any other image.
+func (p *Provider) imageComponents(ctx context.Context, imageID string) (*v1alpha1.ComponentsStatus, error) {
+	resp, err := p.ec2.DescribeImages(ctx, &ec2.DescribeImagesInput{
+		ImageIds: []string{imageID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe image %s: %w", imageID, err)
+	}
+	if len(resp.Images) == 0 {
+		return nil, fmt.Errorf("image %s not found", imageID)
+	}
+	return componentsFromTags(resp.Images[0].Tags), nil
+}
+
+// componentTags encodes cs as image tags, one per non-empty provenance
+// field so that each value stays within the tag value length limit.
+func componentTags(cs *v1alpha1.ComponentsStatus) []types.Tag {
+	if cs == nil {
+		return nil
+	}
+	var tags []types.Tag
+	slots := componentSlots(cs)
+	for _, name := range slices.Sorted(maps.Keys(slots)) {
+		prov := *slots[name]
+		if prov == nil {
+			continue
+		}
+		fields := provenanceFields(prov)
+		for _, field := range slices.Sorted(maps.Keys(fields)) {
+			if value := *fields[field]; value != "" {
+				tags = append(tags, types.Tag{
+					Key:   aws.String(ComponentTagPrefix + name + ":" + field),
+					Value: aws.String(value),
+				})
+			}
+		}
+	}
+	return tags
+}
+
+// componentsFromTags decodes the tags written by componentTags. It returns
+// nil if tags record no component.
+func componentsFromTags(tags []types.Tag) *v1alpha1.ComponentsStatus {
+	cs := &v1alpha1.ComponentsStatus{}
+	slots := componentSlots(cs)
+	found := false
+	for _, tag := range tags {
+		key, ok := strings.CutPrefix(aws.ToString(tag.Key), ComponentTagPrefix)
+		if !ok {
+			continue
+		}
+		name, field, ok := strings.Cut(key, ":")
+		if !ok {
+			continue
+		}
+		slot, ok := slots[name]
+		if !ok {
+			continue
+		}
+		if *slot == nil {
+			*slot = &v1alpha1.ComponentProvenance{}
+		}
+		if value, ok := provenanceFields(*slot)[field]; ok {
+			*value = aws.ToString(tag.Value)
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+	return cs
+}
+
+// componentSlots returns the components of cs by tag name.
+func componentSlots(cs *v1alpha1.ComponentsStatus) map[string]**v1alpha1.ComponentProvenance {
+	return map[string]**v1alpha1.ComponentProvenance{
+		"driver":     &cs.Driver,
+		"runtime":    &cs.Runtime,
+		"toolkit":    &cs.Toolkit,
+		"kubernetes": &cs.Kubernetes,
+	}
+}
+
+// provenanceFields returns the fields of prov by tag name.
+func provenanceFields(prov *v1alpha1.ComponentProvenance) map[string]*string {
+	return map[string]*string{
+		"source":   &prov.Source,
+		"version":  &prov.Version,
+		"branch":   &prov.Branch,
+		"repo":     &prov.Repo,
+		"ref":      &prov.Ref,
+		"commit":   &prov.Commit,
+		"observed": &prov.ObservedVersion,
+	}
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aws
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/aws/awsfake"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func testComponents() *v1alpha1.ComponentsStatus {
+	return &v1alpha1.ComponentsStatus{
+		Driver:  &v1alpha1.ComponentProvenance{Source: "package", Branch: "575"},
+		Toolkit: &v1alpha1.ComponentProvenance{Source: "git", Repo: "https://github.com/NVIDIA/nvidia-container-toolkit.git", Ref: "main", Commit: "0123abcd"},
+	}
+}
+
+func TestComponentTagsRoundTrip(t *testing.T) {
+	tags := componentTags(testComponents())
+	if len(tags) != 6 {
+		t.Fatalf("componentTags() returned %d tags, want 6: %v", len(tags), tags)
+	}
+	for _, tag := range tags {
+		if !strings.HasPrefix(aws.ToString(tag.Key), ComponentTagPrefix) {
+			t.Errorf("tag %q lacks prefix %q", aws.ToString(tag.Key), ComponentTagPrefix)
+		}
+	}
+
+	// Unrelated tags are ignored
+	tags = append(tags, types.Tag{Key: aws.String("Name"), Value: aws.String("golden")})
+	got := componentsFromTags(tags)
+	want := testComponents()
+	if got == nil || got.Driver == nil || *got.Driver != *want.Driver || got.Toolkit == nil || *got.Toolkit != *want.Toolkit {
+		t.Errorf("componentsFromTags() = %+v, want %+v", got, want)
+	}
+	if got.Runtime != nil || got.Kubernetes != nil {
+		t.Errorf("componentsFromTags() decoded components that were not recorded: %+v", got)
+	}
+
+	if componentsFromTags([]types.Tag{{Key: aws.String("Name"), Value: aws.String("plain")}}) != nil {
+		t.Error("componentsFromTags() of an image without component tags should be nil")
+	}
+	if componentTags(nil) != nil {
+		t.Error("componentTags(nil) should be empty")
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	f := awsfake.New()
+	p, ids := newPowerTestProvider(t, f, false, 1)
+	p.Environment.Status.Components = testComponents()
+	p.Tags = append(p.Tags, types.Tag{Key: aws.String("ExpiresAt"), Value: aws.String("2026-01-01T00:00:00Z")})
+
+	imageID, err := p.Snapshot(context.Background(), provider.SnapshotOptions{Name: "golden"})
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	inputs := f.Store.Inputs("CreateImage")
+	if len(inputs) != 1 {
+		t.Fatalf("CreateImage called %d times, want 1", len(inputs))
+	}
+	in := inputs[0].(*ec2.CreateImageInput)
+	if aws.ToString(in.InstanceId) != ids[0] || aws.ToString(in.Name) != "golden" {
+		t.Errorf("CreateImage input = %s/%s, want %s/golden", aws.ToString(in.InstanceId), aws.ToString(in.Name), ids[0])
+	}
+
+	components, err := p.imageComponents(context.Background(), imageID)
+	if err != nil {
+		t.Fatalf("imageComponents() error = %v", err)
+	}
+	if components == nil || components.Driver == nil || components.Driver.Branch != "575" {
+		t.Errorf("image components = %+v, want the recorded driver provenance", components)
+	}
+	for _, img := range f.Store.Images {
+		if aws.ToString(img.ImageId) != imageID {
+			continue
+		}
+		for _, tag := range img.Tags {
+			switch aws.ToString(tag.Key) {
+			case "ExpiresAt":
+				t.Error("image must not inherit the environment expiry")
+			case "Name":
+				if aws.ToString(tag.Value) != "golden" {
+					t.Errorf("image Name tag = %q, want golden", aws.ToString(tag.Value))
+				}
+			}
+		}
+	}
+}
+
+func TestSnapshot_RejectsCluster(t *testing.T) {
+	f := awsfake.New()
+	p, _ := newPowerTestProvider(t, f, true, 2)
+	p.Spec.Cluster = &v1alpha1.ClusterSpec{}
+
+	_, err := p.Snapshot(context.Background(), provider.SnapshotOptions{Name: "golden"})
+	if err == nil || !strings.Contains(err.Error(), "cluster") {
+		t.Fatalf("Snapshot() error = %v, want cluster error", err)
+	}
+	if n := f.Store.CallsTo("CreateImage"); n != 0 {
+		t.Errorf("CreateImage called %d times, want 0", n)
+	}
+}
+
+func TestCreateEC2Instance_GoldenImage(t *testing.T) {
+	f := awsfake.New()
+	f.Store.SeedImage(types.Image{
+		ImageId:        aws.String("ami-golden"),
+		Architecture:   types.ArchitectureValuesX8664,
+		RootDeviceName: aws.String("/dev/sda1"),
+		State:          types.ImageStateAvailable,
+		Tags:           componentTags(testComponents()),
+	})
+
+	provider := newTestProvider(f.EC2)
+	provider.Spec.Type = "t3.medium"
+	provider.Spec.Image.ImageId = aws.String("ami-golden")
+	cache := &AWS{SecurityGroupid: "sg-123", Subnetid: "subnet-123"}
+
+	if err := provider.createEC2Instance(context.Background(), cache); err != nil {
+		t.Fatalf("createEC2Instance failed: %v", err)
+	}
+	got := provider.Environment.Status.ImageComponents
+	if got == nil || got.Toolkit == nil || got.Toolkit.Commit != "0123abcd" {
+		t.Errorf("Status.ImageComponents = %+v, want the provenance recorded on the image", got)
+	}
+}
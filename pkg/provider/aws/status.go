@@ -17,8 +17,10 @@
 package aws
 
 import (
+	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"sigs.k8s.io/yaml"
@@ -29,7 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func (p *Provider) Status() ([]metav1.Condition, error) {
+func (p *Provider) Status(_ context.Context) ([]metav1.Condition, error) {
 	// Read the cache file
 	data, err := os.ReadFile(p.cacheFile)
 	if err != nil {
@@ -65,9 +67,9 @@ func (p *Provider) updateStatus(env v1alpha1.Environment, cache *AWS, condition
 	// Next step is to check if we need to update the status
 	modified := false
 
-	// Because there are only 4 possible conditions (degraded, available,
-	// progressing and Terminated), it isn't necessary to check if old
-	// conditions should be removed.
+	// Conditions are always replaced as a full set (degraded, available,
+	// progressing and terminated, plus stopped while stopped), so it isn't
+	// necessary to check if old conditions should be removed.
 	for _, newCondition := range envCopy.Status.Conditions {
 		oldCondition := meta.FindStatusCondition(env.Status.Conditions, newCondition.Type)
 		if oldCondition == nil {
@@ -100,10 +102,17 @@ func (p *Provider) updateStatus(env v1alpha1.Environment, cache *AWS, condition
 			{Name: WorkerSecurityGroupID, Value: cache.WorkerSecurityGroupid},
 			{Name: EIPAllocationID, Value: cache.EIPAllocationid},
 			{Name: IAMInstanceProfileArn, Value: cache.IAMInstanceProfileArn},
+			{Name: InstanceMarket, Value: cache.InstanceMarket},
+			{Name: LaunchedInstanceType, Value: cache.InstanceType},
+			{Name: AvailabilityZone, Value: cache.AvailabilityZone},
+			{Name: AdoptedResourceIDs, Value: strings.Join(cache.AdoptedResources, ",")},
+			{Name: OwnedResourceIDs, Value: strings.Join(cache.ownedResources(), ",")},
+			{Name: PlacementGroupNames, Value: strings.Join(cache.PlacementGroups, ",")},
 		}
 		modified = true
 	} else {
-		for _, properties := range envCopy.Status.Properties {
+		for i := range envCopy.Status.Properties {
+			properties := &envCopy.Status.Properties[i]
 			switch properties.Name {
 			case VpcID:
 				if properties.Value != cache.Vpcid {
@@ -180,6 +189,36 @@ func (p *Provider) updateStatus(env v1alpha1.Environment, cache *AWS, condition
 					properties.Value = cache.IAMInstanceProfileArn
 					modified = true
 				}
+			case InstanceMarket:
+				if properties.Value != cache.InstanceMarket {
+					properties.Value = cache.InstanceMarket
+					modified = true
+				}
+			case LaunchedInstanceType:
+				if properties.Value != cache.InstanceType {
+					properties.Value = cache.InstanceType
+					modified = true
+				}
+			case AvailabilityZone:
+				if properties.Value != cache.AvailabilityZone {
+					properties.Value = cache.AvailabilityZone
+					modified = true
+				}
+			case AdoptedResourceIDs:
+				if adopted := strings.Join(cache.AdoptedResources, ","); properties.Value != adopted {
+					properties.Value = adopted
+					modified = true
+				}
+			case OwnedResourceIDs:
+				if owned := strings.Join(cache.ownedResources(), ","); properties.Value != owned {
+					properties.Value = owned
+					modified = true
+				}
+			case PlacementGroupNames:
+				if groups := strings.Join(cache.PlacementGroups, ","); properties.Value != groups {
+					properties.Value = groups
+					modified = true
+				}
 			default:
 				// Ignore non AWS infra properties
 				continue
@@ -286,3 +325,16 @@ func getProgressingConditions(reason, message string) []metav1.Condition {
 func getTerminatedConditions(reason, message string) []metav1.Condition {
 	return buildConditions(v1alpha1.ConditionTerminated, reason, message)
 }
+
+// getStoppedConditions marks every standard condition false and adds a true
+// Stopped condition.
+func getStoppedConditions(reason, message string) []metav1.Condition {
+	conditions := buildConditions(v1alpha1.ConditionStopped, "", "")
+	return append(conditions, metav1.Condition{
+		Type:               v1alpha1.ConditionStopped,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: conditions[0].LastTransitionTime,
+		Reason:             reason,
+		Message:            message,
+	})
+}
@@ -27,7 +27,7 @@ import (
 )
 
 // Update updates an AWS resources tags
-func (p *Provider) UpdateResourcesTags(tags map[string]string, resources ...string) error {
+func (p *Provider) UpdateResourcesTags(ctx context.Context, tags map[string]string, resources ...string) error {
 	cancel := p.log.Loading("Tagging AWS resources...")
 
 	var awsTags []types.Tag
@@ -43,7 +43,7 @@ func (p *Provider) UpdateResourcesTags(tags map[string]string, resources ...stri
 		Tags:      awsTags,
 	}
 
-	_, err := p.ec2.CreateTags(context.Background(), createTagsIn)
+	_, err := p.ec2.CreateTags(ctx, createTagsIn)
 	if err != nil {
 		cancel(logger.ErrLoadingFailed)
 		return err
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BuildConditions creates the standard set of conditions with the specified
+// type set to True, carrying reason and message.
+func BuildConditions(trueType string, reason, message string) []metav1.Condition {
+	now := metav1.Time{Time: time.Now()}
+	types := []string{
+		v1alpha1.ConditionAvailable,
+		v1alpha1.ConditionProgressing,
+		v1alpha1.ConditionDegraded,
+		v1alpha1.ConditionTerminated,
+	}
+	conditions := make([]metav1.Condition, 0, len(types))
+	for _, t := range types {
+		c := metav1.Condition{
+			Type:               t,
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: now,
+		}
+		if t == trueType {
+			c.Status = metav1.ConditionTrue
+			c.Reason = reason
+			c.Message = message
+		}
+		conditions = append(conditions, c)
+	}
+	return conditions
+}
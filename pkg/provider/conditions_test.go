@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBuildConditions(t *testing.T) {
+	conditions := BuildConditions(v1alpha1.ConditionDegraded, "v1alpha1.Creating", "quota exceeded")
+
+	if len(conditions) != 4 {
+		t.Fatalf("expected 4 conditions, got %d", len(conditions))
+	}
+	for _, c := range conditions {
+		if c.Type == v1alpha1.ConditionDegraded {
+			if c.Status != metav1.ConditionTrue || c.Reason != "v1alpha1.Creating" || c.Message != "quota exceeded" {
+				t.Errorf("unexpected %s condition: %+v", c.Type, c)
+			}
+			continue
+		}
+		if c.Status != metav1.ConditionFalse || c.Reason != "" || c.Message != "" {
+			t.Errorf("unexpected %s condition: %+v", c.Type, c)
+		}
+		if !c.LastTransitionTime.Equal(&conditions[0].LastTransitionTime) {
+			t.Errorf("expected all conditions to share a transition time")
+		}
+	}
+}
@@ -0,0 +1,315 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	internalgcp "github.com/NVIDIA/holodeck/internal/gcp"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+)
+
+type cleanupFunc func(ctx context.Context) error
+
+// Create creates the network, subnetwork, firewall rule and instance, then
+// waits for the instance to be running with an external IP. On failure the
+// resources created so far are deleted in reverse order.
+func (p *Provider) Create(ctx context.Context) error {
+	spec := p.Spec.GCP
+	envName := p.ObjectMeta.Name
+	cache := &gcpCache{Zone: spec.Zone}
+
+	if err := p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionProgressing, "v1alpha1.Creating", "Creating GCP resources")); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	var cleanupStack []cleanupFunc
+	var err error
+
+	// Defer cleanup on failure - execute cleanup functions in reverse order.
+	// The rollback runs detached from ctx so that it still reaches GCP when
+	// creation failed because ctx was cancelled.
+	defer func() {
+		if err == nil {
+			return
+		}
+		if ctx.Err() != nil {
+			p.log.Warning("Creation interrupted, rolling back created resources...")
+		} else {
+			p.log.Warning("Creation failed, rolling back created resources...")
+		}
+		cleanupCtx := context.WithoutCancel(ctx)
+		for i := len(cleanupStack) - 1; i >= 0; i-- {
+			if cleanupErr := cleanupStack[i](cleanupCtx); cleanupErr != nil {
+				p.log.Warning("Cleanup failed: %v", cleanupErr)
+			}
+		}
+		// Anything that failed to roll back stays in the cache for Delete
+		_ = p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionDegraded, "v1alpha1.Creating", err.Error()))
+	}()
+
+	if err = p.createNetwork(ctx, cache, envName); err != nil {
+		return err
+	}
+	cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+		return p.deleteNetwork(ctx, cache)
+	})
+
+	if err = p.createSubnetwork(ctx, cache, envName); err != nil {
+		return err
+	}
+	cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+		return p.deleteSubnetwork(ctx, cache)
+	})
+
+	if err = p.createFirewall(ctx, cache, envName); err != nil {
+		return err
+	}
+	cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+		return p.deleteFirewall(ctx, cache)
+	})
+	if err = p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionProgressing, "v1alpha1.Creating", "Network created")); err != nil {
+		return err
+	}
+
+	if err = p.createInstance(ctx, cache, envName); err != nil {
+		return err
+	}
+	cleanupStack = append(cleanupStack, func(ctx context.Context) error {
+		return p.deleteInstance(ctx, cache)
+	})
+	if err = p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionProgressing, "v1alpha1.Creating", "Instance created")); err != nil {
+		return err
+	}
+
+	if err = p.waitForInstance(ctx, cache); err != nil {
+		return err
+	}
+
+	if err = p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionAvailable, "", "")); err != nil {
+		return fmt.Errorf("error creating cache file: %w", err)
+	}
+	return nil
+}
+
+// createNetwork creates a custom-mode VPC network.
+func (p *Provider) createNetwork(ctx context.Context, cache *gcpCache, envName string) error {
+	name := resourceName(envName, "net")
+	cancel := p.log.Loading("Creating network %s", name)
+	err := p.client.InsertNetwork(ctx, &internalgcp.Network{
+		Name:                  name,
+		Description:           "holodeck environment " + envName,
+		AutoCreateSubnetworks: false,
+	})
+	if err != nil {
+		cancel(logger.ErrLoadingFailed)
+		return fmt.Errorf("error creating network: %w", err)
+	}
+	cache.Network = name
+	cancel(nil)
+	return nil
+}
+
+// createSubnetwork creates the subnetwork in the region of the zone.
+func (p *Provider) createSubnetwork(ctx context.Context, cache *gcpCache, envName string) error {
+	name := resourceName(envName, "subnet")
+	cancel := p.log.Loading("Creating subnetwork %s", name)
+	err := p.client.InsertSubnetwork(ctx, region(cache.Zone), &internalgcp.Subnetwork{
+		Name:        name,
+		Network:     networkLink(cache.Network),
+		IPCidrRange: subnetCIDR,
+	})
+	if err != nil {
+		cancel(logger.ErrLoadingFailed)
+		return fmt.Errorf("error creating subnetwork: %w", err)
+	}
+	cache.Subnetwork = name
+	cancel(nil)
+	return nil
+}
+
+// createFirewall allows SSH and the Kubernetes API from spec.ingressIpRanges,
+// or the caller's detected public IP when none are given, to instances
+// tagged with the environment name.
+func (p *Provider) createFirewall(ctx context.Context, cache *gcpCache, envName string) error {
+	name := resourceName(envName, "ingress")
+	cancel := p.log.Loading("Creating firewall rule %s", name)
+
+	sourceRanges := p.Spec.IngressIpRanges
+	if len(sourceRanges) == 0 {
+		publicIP, err := p.detectIP()
+		if err != nil {
+			cancel(logger.ErrLoadingFailed)
+			return fmt.Errorf("could not detect public IP for firewall rule (set ingressIpRanges explicitly): %w", err)
+		}
+		p.log.Info("Using detected public IP for firewall rule: %s", publicIP)
+		sourceRanges = []string{publicIP}
+	}
+
+	err := p.client.InsertFirewall(ctx, &internalgcp.Firewall{
+		Name:         name,
+		Network:      networkLink(cache.Network),
+		Direction:    "INGRESS",
+		SourceRanges: sourceRanges,
+		TargetTags:   []string{resourceName(envName, "")},
+		Allowed: []internalgcp.FirewallAllowed{
+			{IPProtocol: "tcp", Ports: []string{"22", "443", "6443"}},
+		},
+	})
+	if err != nil {
+		cancel(logger.ErrLoadingFailed)
+		return fmt.Errorf("error creating firewall rule: %w", err)
+	}
+	cache.Firewall = name
+	cancel(nil)
+	return nil
+}
+
+// createInstance creates the instance with its boot disk, external IP,
+// accelerators and the SSH public key in its metadata.
+func (p *Provider) createInstance(ctx context.Context, cache *gcpCache, envName string) error {
+	spec := p.Spec.GCP
+	image, err := p.sourceImage()
+	if err != nil {
+		return err
+	}
+	publicKey, err := os.ReadFile(p.Spec.PublicKey)
+	if err != nil {
+		return fmt.Errorf("error reading public key: %w", err)
+	}
+
+	diskSize := int64(defaultDiskSizeGB)
+	if p.Spec.RootVolumeSizeGB != nil {
+		diskSize = int64(*p.Spec.RootVolumeSizeGB)
+	}
+
+	accelerators := make([]internalgcp.AcceleratorConfig, 0, len(spec.Accelerators))
+	for _, a := range spec.Accelerators {
+		count := a.Count
+		if count == 0 {
+			count = 1
+		}
+		accelerators = append(accelerators, internalgcp.AcceleratorConfig{
+			AcceleratorType:  fmt.Sprintf("projects/%s/zones/%s/acceleratorTypes/%s", spec.Project, spec.Zone, a.Type),
+			AcceleratorCount: count,
+		})
+	}
+
+	name := resourceName(envName, "")
+	restart := true
+	instance := &internalgcp.Instance{
+		Name:        name,
+		MachineType: fmt.Sprintf("zones/%s/machineTypes/%s", spec.Zone, p.Spec.Type),
+		Labels: map[string]string{
+			"holodeck-environment": name,
+			"managed-by":           "holodeck",
+		},
+		Tags: &internalgcp.Tags{Items: []string{name}},
+		Disks: []internalgcp.AttachedDisk{{
+			Boot:       true,
+			AutoDelete: true,
+			InitializeParams: &internalgcp.AttachedDiskInitParams{
+				SourceImage: image,
+				DiskSizeGb:  diskSize,
+			},
+		}},
+		NetworkInterfaces: []internalgcp.NetworkInterface{{
+			Subnetwork:    subnetworkLink(region(cache.Zone), cache.Subnetwork),
+			AccessConfigs: []internalgcp.AccessConfig{{Name: "External NAT", Type: "ONE_TO_ONE_NAT"}},
+		}},
+		GuestAccelerators: accelerators,
+		// Instances with GPUs cannot live migrate
+		Scheduling: &internalgcp.Scheduling{OnHostMaintenance: "TERMINATE", AutomaticRestart: &restart},
+		Metadata: &internalgcp.Metadata{Items: []internalgcp.MetadataItem{
+			{Key: "ssh-keys", Value: p.Spec.Username + ":" + strings.TrimSpace(string(publicKey))},
+		}},
+	}
+
+	cancel := p.log.Loading("Creating instance %s (%s) in %s", name, p.Spec.Type, spec.Zone)
+	if err := p.client.InsertInstance(ctx, cache.Zone, instance); err != nil {
+		cancel(logger.ErrLoadingFailed)
+		return fmt.Errorf("error creating instance: %w", err)
+	}
+	cache.Instance = name
+	cancel(nil)
+	return nil
+}
+
+// waitForInstance polls the instance until it is running and has an
+// external IP, recording its addresses in cache.
+func (p *Provider) waitForInstance(ctx context.Context, cache *gcpCache) error {
+	cancel := p.log.Loading("Waiting for instance %s to be running", cache.Instance)
+	deadline := time.Now().Add(p.bootTimeout)
+	for {
+		instance, err := p.client.GetInstance(ctx, cache.Zone, cache.Instance)
+		if err != nil {
+			cancel(logger.ErrLoadingFailed)
+			return fmt.Errorf("error reading instance: %w", err)
+		}
+		switch instance.Status {
+		case "RUNNING":
+			if ip := instance.PublicIP(); ip != "" {
+				cache.PublicIP, cache.PrivateIP = ip, instance.PrivateIP()
+				cancel(nil)
+				return nil
+			}
+		case "STOPPING", "SUSPENDING", "SUSPENDED", "TERMINATED":
+			cancel(logger.ErrLoadingFailed)
+			return fmt.Errorf("instance %s is %s", cache.Instance, instance.Status)
+		}
+		if time.Now().After(deadline) {
+			cancel(logger.ErrLoadingFailed)
+			return fmt.Errorf("timed out after %v waiting for instance %s to be running", p.bootTimeout, cache.Instance)
+		}
+		if err := p.wait(ctx, bootPollInterval); err != nil {
+			cancel(logger.ErrLoadingFailed)
+			return fmt.Errorf("interrupted waiting for instance %s: %w", cache.Instance, err)
+		}
+	}
+}
+
+// wait sleeps for d, returning early with the context's error once ctx is
+// done.
+func (p *Provider) wait(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	go func() {
+		p.sleep(d)
+		close(done)
+	}()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+		return nil
+	}
+}
+
+func networkLink(name string) string {
+	return "global/networks/" + name
+}
+
+func subnetworkLink(region, name string) string {
+	return "regions/" + region + "/subnetworks/" + name
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package gcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	internalgcp "github.com/NVIDIA/holodeck/internal/gcp"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+)
+
+// Delete deletes the instance, firewall rule, subnetwork and network
+// recorded in the cache, in that order.
+func (p *Provider) Delete(ctx context.Context) error {
+	cache, err := p.readCache()
+	if err != nil {
+		return fmt.Errorf("error retrieving cache: %w", err)
+	}
+
+	for _, step := range []func(context.Context, *gcpCache) error{
+		p.deleteInstance,
+		p.deleteFirewall,
+		p.deleteSubnetwork,
+		p.deleteNetwork,
+	} {
+		if err := step(ctx, cache); err != nil {
+			_ = p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionDegraded, "v1alpha1.Destroying", err.Error()))
+			return err
+		}
+	}
+
+	return p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionTerminated, "v1alpha1.Terminated", "GCP resources have been deleted"))
+}
+
+// The delete helpers clear the cache field of the resource they delete.
+// A resource that no longer exists is treated as already deleted.
+
+func (p *Provider) deleteInstance(ctx context.Context, cache *gcpCache) error {
+	if cache.Instance == "" {
+		return nil
+	}
+	p.log.Info("Deleting instance %s", cache.Instance)
+	if err := p.client.DeleteInstance(ctx, cache.Zone, cache.Instance); err != nil && !errors.Is(err, internalgcp.ErrNotFound) {
+		return fmt.Errorf("error deleting instance %s: %w", cache.Instance, err)
+	}
+	cache.Instance, cache.PublicIP, cache.PrivateIP = "", "", ""
+	return nil
+}
+
+func (p *Provider) deleteFirewall(ctx context.Context, cache *gcpCache) error {
+	if cache.Firewall == "" {
+		return nil
+	}
+	p.log.Info("Deleting firewall rule %s", cache.Firewall)
+	if err := p.client.DeleteFirewall(ctx, cache.Firewall); err != nil && !errors.Is(err, internalgcp.ErrNotFound) {
+		return fmt.Errorf("error deleting firewall rule %s: %w", cache.Firewall, err)
+	}
+	cache.Firewall = ""
+	return nil
+}
+
+func (p *Provider) deleteSubnetwork(ctx context.Context, cache *gcpCache) error {
+	if cache.Subnetwork == "" {
+		return nil
+	}
+	p.log.Info("Deleting subnetwork %s", cache.Subnetwork)
+	if err := p.client.DeleteSubnetwork(ctx, region(cache.Zone), cache.Subnetwork); err != nil && !errors.Is(err, internalgcp.ErrNotFound) {
+		return fmt.Errorf("error deleting subnetwork %s: %w", cache.Subnetwork, err)
+	}
+	cache.Subnetwork = ""
+	return nil
+}
+
+func (p *Provider) deleteNetwork(ctx context.Context, cache *gcpCache) error {
+	if cache.Network == "" {
+		return nil
+	}
+	p.log.Info("Deleting network %s", cache.Network)
+	if err := p.client.DeleteNetwork(ctx, cache.Network); err != nil && !errors.Is(err, internalgcp.ErrNotFound) {
+		return fmt.Errorf("error deleting network %s: %w", cache.Network, err)
+	}
+	cache.Network = ""
+	return nil
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package gcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	internalgcp "github.com/NVIDIA/holodeck/internal/gcp"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+// DryRun checks the spec and that the instance name is free in the zone.
+func (p *Provider) DryRun(ctx context.Context) error {
+	spec := p.Spec.GCP
+
+	if p.Spec.Cluster != nil {
+		return fmt.Errorf("provider %s does not support cluster mode", Name)
+	}
+	if p.Spec.Type == "" {
+		return fmt.Errorf("instance type is required for provider %s", Name)
+	}
+	if _, err := p.sourceImage(); err != nil {
+		return err
+	}
+
+	name := resourceName(p.ObjectMeta.Name, "")
+	cancel := p.log.Loading("Checking instance name %s in %s", name, spec.Zone)
+	_, err := p.client.GetInstance(ctx, spec.Zone, name)
+	switch {
+	case err == nil:
+		cancel(logger.ErrLoadingFailed)
+		return fmt.Errorf("an instance named %q already exists in zone %q", name, spec.Zone)
+	case !errors.Is(err, internalgcp.ErrNotFound):
+		cancel(logger.ErrLoadingFailed)
+		return err
+	}
+	cancel(nil)
+	return nil
+}
@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package gcp implements the "gcp" provider: a single Compute Engine
+// instance, optionally with attached GPUs, in a dedicated VPC network.
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	internalgcp "github.com/NVIDIA/holodeck/internal/gcp"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/utils"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Name of this provider
+	Name = string(v1alpha1.ProviderGCP)
+
+	// Status properties recorded in the cache
+	Network      string = "network"
+	Subnetwork   string = "subnetwork"
+	Firewall     string = "firewall"
+	InstanceName string = "instance-name"
+	Zone         string = "zone"
+	PublicIP     string = "public-ip"
+	PrivateIP    string = "private-ip"
+
+	// subnetCIDR is the primary range of the holodeck subnetwork
+	subnetCIDR = "10.0.0.0/24"
+	// defaultDiskSizeGB matches the AWS provider's root volume default
+	defaultDiskSizeGB = 64
+
+	defaultBootTimeout = 10 * time.Minute
+	bootPollInterval   = 5 * time.Second
+)
+
+// imageFamilies maps instance.os IDs to public image families.
+var imageFamilies = map[string]string{
+	"ubuntu-20.04": "projects/ubuntu-os-cloud/global/images/family/ubuntu-2004-lts",
+	"ubuntu-22.04": "projects/ubuntu-os-cloud/global/images/family/ubuntu-2204-lts",
+	"ubuntu-24.04": "projects/ubuntu-os-cloud/global/images/family/ubuntu-2404-lts-amd64",
+	"rocky-9":      "projects/rocky-linux-cloud/global/images/family/rocky-linux-9",
+}
+
+// defaultOS is used when neither gcp.image nor instance.os is set.
+const defaultOS = "ubuntu-22.04"
+
+// Provider is the GCP provider.
+type Provider struct {
+	client      internalgcp.ComputeClient
+	cacheFile   string
+	sleep       func(time.Duration)
+	detectIP    func() (string, error)
+	bootTimeout time.Duration
+
+	*v1alpha1.Environment
+	log *logger.FunLogger
+}
+
+// Option is a functional option for configuring the Provider.
+type Option func(*Provider)
+
+// WithClient sets a custom Compute Engine client for the Provider.
+// This is primarily used for testing to inject fake clients.
+func WithClient(client internalgcp.ComputeClient) Option {
+	return func(p *Provider) {
+		p.client = client
+	}
+}
+
+// WithSleep sets a custom sleep function for the Provider.
+// This is used in tests to eliminate real wall-clock delays.
+func WithSleep(fn func(time.Duration)) Option {
+	return func(p *Provider) {
+		p.sleep = fn
+	}
+}
+
+// WithIPDetector sets the function used to detect the caller's public IP
+// for the firewall rule. This is used in tests to avoid network access.
+func WithIPDetector(fn func() (string, error)) Option {
+	return func(p *Provider) {
+		p.detectIP = fn
+	}
+}
+
+// New creates a new GCP Provider. Credentials are resolved by
+// internal/gcp.DefaultTokenSource.
+func New(log *logger.FunLogger, env v1alpha1.Environment, cacheFile string, opts ...Option) (*Provider, error) {
+	if err := env.Spec.GCP.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		cacheFile:   cacheFile,
+		sleep:       time.Sleep,
+		detectIP:    utils.GetIPAddress,
+		bootTimeout: defaultBootTimeout,
+		Environment: &env,
+		log:         log,
+	}
+
+	// Apply functional options
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	// Create the Compute Engine client if not injected (for testing)
+	if p.client == nil {
+		client, err := internalgcp.NewRESTClient(env.Spec.GCP.Project, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		p.client = client
+	}
+
+	return p, nil
+}
+
+// Name returns the name of the provider
+func (p *Provider) Name() string { return Name }
+
+// UpdateResourcesTags is a no-op: instance labels are set at creation and
+// relabeling requires the current label fingerprint.
+func (p *Provider) UpdateResourcesTags(_ context.Context, tags map[string]string, resources ...string) error {
+	p.log.Debug("GCP relabeling is not supported, skipping")
+	return nil
+}
+
+// gcpCache holds the resource state persisted in status.properties.
+type gcpCache struct {
+	Network    string
+	Subnetwork string
+	Firewall   string
+	Instance   string
+	Zone       string
+	PublicIP   string
+	PrivateIP  string
+}
+
+// readCache reads the resource state from the cache file.
+func (p *Provider) readCache() (*gcpCache, error) {
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](p.cacheFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &gcpCache{}
+	for _, prop := range env.Status.Properties {
+		switch prop.Name {
+		case Network:
+			cache.Network = prop.Value
+		case Subnetwork:
+			cache.Subnetwork = prop.Value
+		case Firewall:
+			cache.Firewall = prop.Value
+		case InstanceName:
+			cache.Instance = prop.Value
+		case Zone:
+			cache.Zone = prop.Value
+		case PublicIP:
+			cache.PublicIP = prop.Value
+		case PrivateIP:
+			cache.PrivateIP = prop.Value
+		}
+	}
+	return cache, nil
+}
+
+// updateStatus records cache and conditions in the cache file.
+func (p *Provider) updateStatus(cache *gcpCache, conditions []metav1.Condition) error {
+	env := p.DeepCopy()
+	env.Status.Properties = []v1alpha1.Properties{
+		{Name: Network, Value: cache.Network},
+		{Name: Subnetwork, Value: cache.Subnetwork},
+		{Name: Firewall, Value: cache.Firewall},
+		{Name: InstanceName, Value: cache.Instance},
+		{Name: Zone, Value: cache.Zone},
+		{Name: PublicIP, Value: cache.PublicIP},
+		{Name: PrivateIP, Value: cache.PrivateIP},
+	}
+	env.Status.Conditions = conditions
+
+	data, err := jyaml.MarshalYAML(env)
+	if err != nil {
+		return fmt.Errorf("failed to marshal environment: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(p.cacheFile), 0750); err != nil {
+		return err
+	}
+	if err := os.WriteFile(p.cacheFile, data, 0600); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	return nil
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// resourceName returns a Compute Engine resource name, which must match
+// [a-z]([-a-z0-9]*[a-z0-9])? and be at most 63 characters, derived from the
+// environment name and suffix.
+func resourceName(envName, suffix string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(envName), "-")
+	name = strings.Trim(name, "-")
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		name = "holodeck-" + name
+	}
+	if suffix != "" {
+		suffix = "-" + suffix
+	}
+	if limit := 63 - len(suffix); len(name) > limit {
+		name = name[:limit]
+	}
+	return strings.TrimRight(name, "-") + suffix
+}
+
+// region returns the region of a zone, e.g. us-central1 for us-central1-a.
+func region(zone string) string {
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		return zone[:i]
+	}
+	return zone
+}
+
+// sourceImage returns gcp.image, or the image family matching instance.os.
+func (p *Provider) sourceImage() (string, error) {
+	if p.Spec.GCP.Image != "" {
+		return p.Spec.GCP.Image, nil
+	}
+	osID := p.Spec.Instance.OS
+	if osID == "" {
+		osID = defaultOS
+	}
+	image, ok := imageFamilies[osID]
+	if !ok {
+		return "", fmt.Errorf("no GCP image known for os %q, set gcp.image", osID)
+	}
+	return image, nil
+}
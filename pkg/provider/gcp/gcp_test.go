@@ -0,0 +1,258 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package gcp
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/gcp/gcpfake"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestProvider(t *testing.T, fake *gcpfake.Fake) *Provider {
+	t.Helper()
+	dir := t.TempDir()
+	publicKey := filepath.Join(dir, "key.pub")
+	require.NoError(t, os.WriteFile(publicKey, []byte("ssh-ed25519 AAAA test\n"), 0600))
+
+	env := v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "Holodeck_GPU"},
+		Spec: v1alpha1.EnvironmentSpec{
+			Provider: v1alpha1.ProviderGCP,
+			Auth:     v1alpha1.Auth{Username: "ubuntu", PublicKey: publicKey},
+			Instance: v1alpha1.Instance{Type: "n1-standard-8", IngressIpRanges: []string{"198.51.100.0/24"}},
+			GCP: &v1alpha1.GCP{
+				Project:      "proj",
+				Zone:         "us-central1-a",
+				Accelerators: []v1alpha1.GCPAccelerator{{Type: "nvidia-tesla-t4"}},
+			},
+		},
+	}
+	p, err := New(logger.NewLogger(), env, filepath.Join(dir, "cache.yaml"),
+		WithClient(fake),
+		WithSleep(func(time.Duration) {}),
+		WithIPDetector(func() (string, error) { return "203.0.113.200/32", nil }))
+	require.NoError(t, err)
+	return p
+}
+
+func cachedEnv(t *testing.T, p *Provider) v1alpha1.Environment {
+	t.Helper()
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](p.cacheFile)
+	require.NoError(t, err)
+	return env
+}
+
+func property(env v1alpha1.Environment, name string) string {
+	for _, p := range env.Status.Properties {
+		if p.Name == name {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+func conditionTrue(env v1alpha1.Environment, condition string) bool {
+	for _, c := range env.Status.Conditions {
+		if c.Type == condition {
+			return c.Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func TestCreateAndDelete(t *testing.T) {
+	fake := gcpfake.New()
+	fake.SeedBootDelay(2)
+	p := newTestProvider(t, fake)
+
+	require.NoError(t, p.Create(context.Background()))
+
+	env := cachedEnv(t, p)
+	assert.Equal(t, "holodeck-gpu", property(env, InstanceName))
+	assert.Equal(t, "holodeck-gpu-net", property(env, Network))
+	assert.NotEmpty(t, property(env, PublicIP))
+	assert.NotEmpty(t, property(env, PrivateIP))
+	assert.True(t, conditionTrue(env, v1alpha1.ConditionAvailable))
+	assert.Equal(t, 3, fake.CallsTo("GetInstance"), "waits until the instance is running")
+	assert.Equal(t, 4, fake.ResourceCount())
+
+	inst := fake.Instances["holodeck-gpu"]
+	require.NotNil(t, inst)
+	assert.Equal(t, "zones/us-central1-a/machineTypes/n1-standard-8", inst.MachineType)
+	require.Len(t, inst.GuestAccelerators, 1)
+	assert.Equal(t, "projects/proj/zones/us-central1-a/acceleratorTypes/nvidia-tesla-t4", inst.GuestAccelerators[0].AcceleratorType)
+	assert.Equal(t, int32(1), inst.GuestAccelerators[0].AcceleratorCount, "count defaults to 1")
+	assert.Equal(t, "TERMINATE", inst.Scheduling.OnHostMaintenance)
+	assert.Equal(t, "ubuntu:ssh-ed25519 AAAA test", inst.Metadata.Items[0].Value)
+	assert.Equal(t, "regions/us-central1/subnetworks/holodeck-gpu-subnet", inst.NetworkInterfaces[0].Subnetwork)
+
+	fw := fake.Firewalls["holodeck-gpu-ingress"]
+	require.NotNil(t, fw)
+	assert.Equal(t, []string{"198.51.100.0/24"}, fw.SourceRanges, "explicit ranges skip IP detection")
+	assert.Equal(t, []string{"holodeck-gpu"}, fw.TargetTags)
+
+	require.NoError(t, p.Delete(context.Background()))
+	assert.Equal(t, 0, fake.ResourceCount(), "teardown order satisfies in-use checks")
+	env = cachedEnv(t, p)
+	assert.True(t, conditionTrue(env, v1alpha1.ConditionTerminated))
+	assert.Empty(t, property(env, PublicIP))
+}
+
+func TestCreateRollsBackOnInstanceFailure(t *testing.T) {
+	fake := gcpfake.New()
+	fake.FailNext("InsertInstance", errors.New("ZONE_RESOURCE_POOL_EXHAUSTED"))
+	p := newTestProvider(t, fake)
+
+	err := p.Create(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ZONE_RESOURCE_POOL_EXHAUSTED")
+	assert.Equal(t, 0, fake.ResourceCount(), "network, subnetwork and firewall are rolled back")
+
+	env := cachedEnv(t, p)
+	assert.Empty(t, property(env, Network))
+	assert.True(t, conditionTrue(env, v1alpha1.ConditionDegraded))
+}
+
+func TestCreateTimesOutWaitingForInstance(t *testing.T) {
+	fake := gcpfake.New()
+	fake.SeedBootDelay(1000)
+	p := newTestProvider(t, fake)
+	p.bootTimeout = 0
+
+	err := p.Create(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+	assert.Equal(t, 0, fake.ResourceCount())
+}
+
+func TestCreateDetectsIPWithoutIngressRanges(t *testing.T) {
+	fake := gcpfake.New()
+	p := newTestProvider(t, fake)
+	p.Spec.IngressIpRanges = nil
+
+	require.NoError(t, p.Create(context.Background()))
+	assert.Equal(t, []string{"203.0.113.200/32"}, fake.Firewalls["holodeck-gpu-ingress"].SourceRanges)
+}
+
+func TestCreateWithIngressRangesIgnoresDetectionFailure(t *testing.T) {
+	fake := gcpfake.New()
+	p := newTestProvider(t, fake)
+	p.detectIP = func() (string, error) { return "", errors.New("no network") }
+
+	require.NoError(t, p.Create(context.Background()))
+}
+
+func TestCreateStopsWaitingWhenCancelled(t *testing.T) {
+	fake := gcpfake.New()
+	fake.SeedBootDelay(1000)
+	p := newTestProvider(t, fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	block := make(chan struct{})
+	t.Cleanup(func() { close(block) })
+	p.sleep = func(time.Duration) {
+		cancel()
+		<-block
+	}
+
+	err := p.Create(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, fake.ResourceCount(), "rollback still runs")
+}
+
+func TestHostAddress(t *testing.T) {
+	fake := gcpfake.New()
+	p := newTestProvider(t, fake)
+	require.NoError(t, p.Create(context.Background()))
+
+	env := cachedEnv(t, p)
+	assert.Equal(t, property(env, PublicIP), hostAddress(&env))
+}
+
+func TestDeleteToleratesMissingResources(t *testing.T) {
+	fake := gcpfake.New()
+	p := newTestProvider(t, fake)
+	require.NoError(t, p.Create(context.Background()))
+
+	delete(fake.Instances, "holodeck-gpu")
+	require.NoError(t, p.Delete(context.Background()))
+	assert.Equal(t, 0, fake.ResourceCount())
+
+	// Deleting again is a no-op
+	require.NoError(t, p.Delete(context.Background()))
+	assert.Equal(t, 1, fake.CallsTo("DeleteNetwork"))
+}
+
+func TestDeleteFailureIsDegraded(t *testing.T) {
+	fake := gcpfake.New()
+	p := newTestProvider(t, fake)
+	require.NoError(t, p.Create(context.Background()))
+
+	fake.FailNext("DeleteFirewall", errors.New("quota exceeded"))
+	require.Error(t, p.Delete(context.Background()))
+	env := cachedEnv(t, p)
+	assert.True(t, conditionTrue(env, v1alpha1.ConditionDegraded))
+	assert.Empty(t, property(env, InstanceName), "already deleted resources are dropped from the cache")
+	assert.NotEmpty(t, property(env, Firewall))
+
+	require.NoError(t, p.Delete(context.Background()))
+	assert.Equal(t, 0, fake.ResourceCount())
+}
+
+func TestDryRun(t *testing.T) {
+	fake := gcpfake.New()
+	p := newTestProvider(t, fake)
+	assert.NoError(t, p.DryRun(context.Background()))
+
+	fake.SeedInstance("us-central1-a", "holodeck-gpu")
+	assert.ErrorContains(t, p.DryRun(context.Background()), "already exists")
+
+	p.Spec.Instance.OS = "fedora-42"
+	assert.ErrorContains(t, p.DryRun(context.Background()), "set gcp.image")
+}
+
+func TestResourceName(t *testing.T) {
+	assert.Equal(t, "holodeck-gpu-net", resourceName("Holodeck_GPU", "net"))
+	assert.Equal(t, "holodeck-42", resourceName("42", ""))
+	long := resourceName(strings.Repeat("a", 80), "subnet")
+	assert.Len(t, long, 63)
+	assert.True(t, strings.HasSuffix(long, "-subnet"))
+}
+
+func TestRegion(t *testing.T) {
+	assert.Equal(t, "us-central1", region("us-central1-a"))
+	assert.Equal(t, "europe-west4", region("europe-west4-b"))
+}
+
+func TestNewRequiresGCPSection(t *testing.T) {
+	env := v1alpha1.Environment{Spec: v1alpha1.EnvironmentSpec{Provider: v1alpha1.ProviderGCP}}
+	_, err := New(logger.NewLogger(), env, "", WithClient(gcpfake.New()))
+	assert.Error(t, err)
+}
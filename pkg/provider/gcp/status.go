@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gcp
+
+import (
+	"context"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Status returns the conditions recorded in the cache file.
+func (p *Provider) Status(_ context.Context) ([]metav1.Condition, error) {
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](p.cacheFile)
+	if err != nil {
+		return []metav1.Condition{}, err
+	}
+	if len(env.Status.Conditions) == 0 {
+		return []metav1.Condition{}, nil
+	}
+	return env.Status.Conditions, nil
+}
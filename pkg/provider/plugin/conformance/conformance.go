@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package conformance checks that a provider plugin implements the plugin
+// protocol the way holodeck expects. Plugin authors run it from a test:
+//
+//	func TestConformance(t *testing.T) {
+//		conformance.Run(t, "./holodeck-provider-mycloud", env)
+//	}
+//
+// Run creates and deletes real resources through the plugin, so env must
+// describe an environment the plugin can create.
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/provider/plugin"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Run checks the plugin executable at path against env: the handshake, the
+// handling of malformed requests, and a full dryrun, create, status, tag and
+// delete lifecycle including a repeated delete.
+func Run(t *testing.T, path string, env v1alpha1.Environment) {
+	t.Helper()
+	ctx := context.Background()
+
+	t.Run("Handshake", func(t *testing.T) {
+		caps, err := plugin.Handshake(ctx, path)
+		if err != nil {
+			t.Fatalf("Handshake() error = %v", err)
+		}
+		if env.Spec.Cluster != nil && !caps.Multinode {
+			t.Fatal("env uses spec.cluster but the plugin does not advertise multinode")
+		}
+	})
+
+	t.Run("RejectsUnsupportedVersion", func(t *testing.T) {
+		resp := roundTrip(t, path, plugin.Request{Version: "v0", Method: plugin.MethodInfo})
+		if resp.Error == "" {
+			t.Error("plugin accepted an unsupported protocol version")
+		}
+	})
+
+	t.Run("RejectsUnknownMethod", func(t *testing.T) {
+		resp := roundTrip(t, path, plugin.Request{
+			Version:     plugin.ProtocolVersion,
+			Method:      "no-such-method",
+			Environment: env.DeepCopy(),
+		})
+		if resp.Error == "" {
+			t.Error("plugin accepted an unknown method")
+		}
+	})
+
+	t.Run("Lifecycle", func(t *testing.T) {
+		cacheFile := filepath.Join(t.TempDir(), "cache.yaml")
+		log := &logger.FunLogger{Out: &testWriter{t: t}, Wg: &sync.WaitGroup{}, IsCI: true}
+		p, err := plugin.New(log, *env.DeepCopy(), cacheFile, path)
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+
+		if err := p.DryRun(ctx); err != nil {
+			t.Fatalf("DryRun() error = %v", err)
+		}
+		if err := p.Create(ctx); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		created := readCache(t, cacheFile)
+		checkHosts(t, &created)
+
+		conditions, err := p.Status(ctx)
+		if err != nil {
+			t.Fatalf("Status() error = %v", err)
+		}
+		if !isTrue(conditions, v1alpha1.ConditionAvailable) {
+			t.Errorf("Status() after create = %+v, want %s", conditions, v1alpha1.ConditionAvailable)
+		}
+
+		if err := p.UpdateResourcesTags(ctx, map[string]string{"holodeck-conformance": "true"}); err != nil {
+			t.Errorf("UpdateResourcesTags() error = %v", err)
+		}
+
+		if err := p.Delete(ctx); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		deleted := readCache(t, cacheFile)
+		if !isTrue(deleted.Status.Conditions, v1alpha1.ConditionTerminated) {
+			t.Errorf("conditions after delete = %+v, want %s", deleted.Status.Conditions, v1alpha1.ConditionTerminated)
+		}
+		if err := p.Delete(ctx); err != nil {
+			t.Errorf("second Delete() error = %v, deleting twice must succeed", err)
+		}
+	})
+}
+
+// checkHosts verifies that Create recorded hosts holodeck can provision.
+func checkHosts(t *testing.T, env *v1alpha1.Environment) {
+	t.Helper()
+	if env.Spec.Cluster == nil {
+		for _, prop := range env.Status.Properties {
+			if prop.Name == plugin.HostAddress && prop.Value != "" {
+				return
+			}
+		}
+		t.Error("Create() recorded no host address")
+		return
+	}
+
+	if env.Status.Cluster == nil || len(env.Status.Cluster.Nodes) == 0 {
+		t.Fatal("Create() recorded no cluster nodes")
+	}
+	controlPlanes := 0
+	for _, node := range env.Status.Cluster.Nodes {
+		if node.PublicIP == "" {
+			t.Errorf("node %q has no address", node.Name)
+		}
+		switch node.Role {
+		case "control-plane":
+			controlPlanes++
+		case "worker":
+		default:
+			t.Errorf("node %q has role %q, want control-plane or worker", node.Name, node.Role)
+		}
+	}
+	if controlPlanes == 0 {
+		t.Error("Create() returned no control-plane host")
+	}
+}
+
+// roundTrip sends req to the plugin and decodes its response. The plugin must
+// answer even requests it rejects.
+func roundTrip(t *testing.T, path string, req plugin.Request) plugin.Response {
+	t.Helper()
+	in, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("failed to encode request: %v", err)
+	}
+	var out bytes.Buffer
+	cmd := exec.Command(path) // #nosec G204 -- path is the plugin under test
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &out
+	cmd.Stderr = &testWriter{t: t}
+	_ = cmd.Run()
+
+	var resp plugin.Response
+	if err := json.Unmarshal(out.Bytes(), &resp); err != nil {
+		t.Fatalf("plugin wrote no valid response to a %q request: %v", req.Method, err)
+	}
+	return resp
+}
+
+func readCache(t *testing.T, cacheFile string) v1alpha1.Environment {
+	t.Helper()
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](cacheFile)
+	if err != nil {
+		t.Fatalf("failed to read cache file: %v", err)
+	}
+	return env
+}
+
+func isTrue(conditions []metav1.Condition, conditionType string) bool {
+	for _, c := range conditions {
+		if c.Type == conditionType {
+			return c.Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// testWriter forwards plugin stderr to the test log.
+type testWriter struct {
+	t *testing.T
+}
+
+func (w *testWriter) Write(b []byte) (int, error) {
+	w.t.Log(strings.TrimRight(string(b), "\n"))
+	return len(b), nil
+}
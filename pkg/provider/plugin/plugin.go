@@ -0,0 +1,264 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// HostAddress is the status property holding the SSH-reachable address
+	// of a single-node plugin environment.
+	HostAddress = "plugin-host-address"
+
+	// interruptGrace is how long a cancelled plugin may take to roll back
+	// after SIGINT before it is killed.
+	interruptGrace = 5 * time.Minute
+)
+
+// Provider is a provider.Provider backed by a plugin executable.
+type Provider struct {
+	path      string
+	cacheFile string
+
+	*v1alpha1.Environment
+	log *logger.FunLogger
+}
+
+// New creates a Provider that runs the plugin executable at path for env,
+// persisting state to cacheFile.
+func New(log *logger.FunLogger, env v1alpha1.Environment, cacheFile, path string) (*Provider, error) {
+	if path == "" {
+		return nil, fmt.Errorf("plugin path is required")
+	}
+	return &Provider{
+		path:        path,
+		cacheFile:   cacheFile,
+		Environment: &env,
+		log:         log,
+	}, nil
+}
+
+// Name returns the name of the provider
+func (p *Provider) Name() string { return string(p.Spec.Provider) }
+
+// Create asks the plugin to create the environment and records the hosts it
+// returns: in cluster mode as status.cluster.nodes, otherwise as the
+// HostAddress property. Rolling back a failed create is up to the plugin.
+func (p *Provider) Create(ctx context.Context) error {
+	p.log.Info("Creating resources with plugin %s", filepath.Base(p.path))
+	p.Environment.Status.Conditions = provider.BuildConditions(v1alpha1.ConditionProgressing, "v1alpha1.Creating", "Running plugin")
+	if err := p.writeCache(); err != nil {
+		return err
+	}
+
+	resp, err := p.call(ctx, &Request{Method: MethodCreate, Environment: p.Environment})
+	if err == nil && len(resp.Hosts) == 0 {
+		err = errors.New("plugin returned no hosts")
+	}
+	if err != nil {
+		p.Environment.Status.Conditions = provider.BuildConditions(v1alpha1.ConditionDegraded, "v1alpha1.Creating", err.Error())
+		_ = p.writeCache()
+		return fmt.Errorf("plugin create failed: %w", err)
+	}
+
+	p.Environment.Status.Properties = resp.Properties
+	if p.Spec.Cluster != nil {
+		p.Environment.Status.Cluster = clusterStatus(resp.Hosts, p.Spec.Username)
+	} else {
+		p.Environment.Status.Properties = append(p.Environment.Status.Properties,
+			v1alpha1.Properties{Name: HostAddress, Value: resp.Hosts[0].Address})
+	}
+	p.Environment.Status.Conditions = provider.BuildConditions(v1alpha1.ConditionAvailable, "", "")
+	return p.writeCache()
+}
+
+// Delete asks the plugin to delete the environment recorded in the cache.
+func (p *Provider) Delete(ctx context.Context) error {
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](p.cacheFile)
+	if err != nil {
+		return fmt.Errorf("error retrieving cache: %w", err)
+	}
+	p.Environment = &env
+
+	if _, err := p.call(ctx, &Request{Method: MethodDelete, Environment: p.Environment}); err != nil {
+		p.Environment.Status.Conditions = provider.BuildConditions(v1alpha1.ConditionDegraded, "v1alpha1.Destroying", err.Error())
+		_ = p.writeCache()
+		return fmt.Errorf("plugin delete failed: %w", err)
+	}
+
+	p.Environment.Status.Conditions = provider.BuildConditions(v1alpha1.ConditionTerminated, "v1alpha1.Terminated", "Plugin resources have been deleted")
+	return p.writeCache()
+}
+
+// DryRun asks the plugin to validate the environment.
+func (p *Provider) DryRun(ctx context.Context) error {
+	_, err := p.call(ctx, &Request{Method: MethodDryRun, Environment: p.Environment})
+	return err
+}
+
+// Status asks the plugin for the conditions of the environment recorded in
+// the cache, falling back to the recorded conditions.
+func (p *Provider) Status(ctx context.Context) ([]metav1.Condition, error) {
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](p.cacheFile)
+	if err != nil {
+		return []metav1.Condition{}, err
+	}
+
+	resp, err := p.call(ctx, &Request{Method: MethodStatus, Environment: &env})
+	if err != nil {
+		return []metav1.Condition{}, err
+	}
+	if len(resp.Conditions) > 0 {
+		return resp.Conditions, nil
+	}
+	if len(env.Status.Conditions) == 0 {
+		return []metav1.Condition{}, nil
+	}
+	return env.Status.Conditions, nil
+}
+
+// UpdateResourcesTags asks the plugin to tag resources.
+func (p *Provider) UpdateResourcesTags(ctx context.Context, tags map[string]string, resources ...string) error {
+	_, err := p.call(ctx, &Request{
+		Method:      MethodUpdateResourcesTags,
+		Environment: p.Environment,
+		Tags:        tags,
+		Resources:   resources,
+	})
+	return err
+}
+
+// call runs the plugin for one request. Cancelling ctx sends the plugin
+// SIGINT so it can roll back, and kills it after interruptGrace.
+func (p *Provider) call(ctx context.Context, req *Request) (*Response, error) {
+	return invoke(ctx, p.path, req, p.log.Out)
+}
+
+// invoke runs the plugin at path for req, forwarding its stderr to stderr.
+func invoke(ctx context.Context, path string, req *Request, stderr io.Writer) (*Response, error) {
+	req.Version = ProtocolVersion
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s request: %w", req.Method, err)
+	}
+
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, path) // #nosec G204 -- path is a holodeck-provider-* executable resolved from PATH
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &out
+	cmd.Stderr = stderr
+	cmd.Cancel = func() error { return cmd.Process.Signal(os.Interrupt) }
+	cmd.WaitDelay = interruptGrace
+	runErr := cmd.Run()
+
+	resp := &Response{}
+	if err := json.Unmarshal(out.Bytes(), resp); err != nil {
+		if runErr != nil {
+			return nil, fmt.Errorf("plugin %s: %w", req.Method, runErr)
+		}
+		return nil, fmt.Errorf("plugin %s: invalid response: %w", req.Method, err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("plugin %s: %s", req.Method, resp.Error)
+	}
+	if runErr != nil {
+		return nil, fmt.Errorf("plugin %s: %w", req.Method, runErr)
+	}
+	return resp, nil
+}
+
+// clusterStatus builds the cluster status for the hosts returned by a plugin.
+// The first control-plane host is the control-plane endpoint. The nodes stay
+// Pending until the cluster is provisioned on them.
+func clusterStatus(hosts []Host, defaultUsername string) *v1alpha1.ClusterStatus {
+	nodes := make([]v1alpha1.NodeStatus, 0, len(hosts))
+	endpoint := ""
+	for _, h := range hosts {
+		node := v1alpha1.NodeStatus{
+			Name:        h.Name,
+			Role:        h.Role,
+			PublicIP:    h.Address,
+			PrivateIP:   h.PrivateAddress,
+			SSHUsername: h.Username,
+			Phase:       "Pending",
+		}
+		if node.Name == "" {
+			node.Name = h.Address
+		}
+		if node.Role == "" {
+			node.Role = "control-plane"
+		}
+		if node.PrivateIP == "" {
+			node.PrivateIP = h.Address
+			// The SSH port of the address is not the one other nodes
+			// reach this host on
+			if host, _, err := net.SplitHostPort(h.Address); err == nil {
+				node.PrivateIP = host
+			}
+		}
+		if node.SSHUsername == "" {
+			node.SSHUsername = defaultUsername
+		}
+		if endpoint == "" && node.Role == "control-plane" {
+			endpoint = node.PrivateIP
+		}
+		nodes = append(nodes, node)
+	}
+
+	// #nosec G115 -- node count is bounded by the plugin response, will never overflow int32
+	nodeCount := int32(len(nodes))
+	return &v1alpha1.ClusterStatus{
+		Nodes:                nodes,
+		TotalNodes:           nodeCount,
+		Phase:                "Pending",
+		ControlPlaneEndpoint: endpoint,
+	}
+}
+
+func (p *Provider) writeCache() error {
+	if p.cacheFile == "" {
+		return nil
+	}
+	data, err := jyaml.MarshalYAML(p.Environment)
+	if err != nil {
+		return fmt.Errorf("failed to marshal environment: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(p.cacheFile), 0750); err != nil {
+		return err
+	}
+	if err := os.WriteFile(p.cacheFile, data, 0600); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	return nil
+}
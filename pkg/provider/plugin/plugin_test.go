@@ -0,0 +1,307 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeModeEnv switches the test binary into serving fakePlugin, with the
+// variable's value selecting its behavior.
+const fakeModeEnv = "HOLODECK_TEST_FAKE_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if mode := os.Getenv(fakeModeEnv); mode != "" {
+		Serve(fakePlugin{mode: mode})
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// fakePlugin is served by the re-executed test binary.
+type fakePlugin struct {
+	mode string
+}
+
+func (f fakePlugin) Info() Capabilities { return Capabilities{Multinode: true} }
+
+func (f fakePlugin) Create(ctx context.Context, env *v1alpha1.Environment) ([]Host, []v1alpha1.Properties, error) {
+	switch f.mode {
+	case "fail":
+		return nil, nil, errors.New("quota exceeded")
+	case "block":
+		<-ctx.Done()
+		return nil, nil, errors.New("interrupted, rolled back")
+	}
+	hosts := []Host{{Address: "198.51.100.10"}}
+	if env.Spec.Cluster != nil {
+		hosts = []Host{
+			{Name: "cp-0", Role: "control-plane", Address: "198.51.100.10", PrivateAddress: "10.0.0.10"},
+			{Name: "worker-0", Role: "worker", Address: "198.51.100.11", Username: "core"},
+		}
+	}
+	return hosts, []v1alpha1.Properties{{Name: "fake-id", Value: "fake-123"}}, nil
+}
+
+func (f fakePlugin) Delete(_ context.Context, env *v1alpha1.Environment) error {
+	for _, prop := range env.Status.Properties {
+		if prop.Name == "fake-id" && prop.Value == "fake-123" {
+			return nil
+		}
+	}
+	return errors.New("fake-id property was not handed back")
+}
+
+func (f fakePlugin) DryRun(_ context.Context, _ *v1alpha1.Environment) error { return nil }
+
+func (f fakePlugin) Status(_ context.Context, _ *v1alpha1.Environment) ([]metav1.Condition, error) {
+	return nil, nil
+}
+
+func (f fakePlugin) UpdateResourcesTags(_ context.Context, _ *v1alpha1.Environment, tags map[string]string, _ ...string) error {
+	if tags["team"] == "" {
+		return errors.New("missing team tag")
+	}
+	return nil
+}
+
+// installFake puts the test binary on PATH as holodeck-provider-<name>,
+// serving fakePlugin in mode.
+func installFake(t *testing.T, name, mode string) string {
+	t.Helper()
+	self, err := os.Executable()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	path := filepath.Join(dir, BinaryPrefix+name)
+	require.NoError(t, os.Symlink(self, path))
+	t.Setenv("PATH", dir)
+	t.Setenv(fakeModeEnv, mode)
+	return path
+}
+
+func testLogger() *logger.FunLogger {
+	return &logger.FunLogger{Out: io.Discard, Wg: &sync.WaitGroup{}, IsCI: true}
+}
+
+func testEnv(name string) v1alpha1.Environment {
+	env := v1alpha1.Environment{Spec: v1alpha1.EnvironmentSpec{Provider: v1alpha1.Provider(name)}}
+	env.Name = "plugin-test"
+	env.Spec.Username = "ubuntu"
+	return env
+}
+
+func TestResolve(t *testing.T) {
+	installFake(t, "fake-resolve", "ok")
+
+	r, err := provider.Lookup("fake-resolve")
+	require.NoError(t, err)
+	assert.Equal(t, "fake-resolve", r.Name)
+	assert.True(t, r.Capabilities.Multinode)
+
+	p, err := r.New(testLogger(), testEnv("fake-resolve"), filepath.Join(t.TempDir(), "cache.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "fake-resolve", p.Name())
+
+	_, err = provider.Lookup("not-installed")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown provider")
+
+	// Names that could escape PATH lookup are never resolved
+	_, err = provider.Lookup("../fake-resolve")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown provider")
+}
+
+func TestProvider_SingleNodeLifecycle(t *testing.T) {
+	path := installFake(t, "fake", "ok")
+	cacheFile := filepath.Join(t.TempDir(), "cache.yaml")
+	ctx := context.Background()
+
+	p, err := New(testLogger(), testEnv("fake"), cacheFile, path)
+	require.NoError(t, err)
+	require.NoError(t, p.DryRun(ctx))
+	require.NoError(t, p.Create(ctx))
+
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](cacheFile)
+	require.NoError(t, err)
+	assert.Contains(t, env.Status.Properties, v1alpha1.Properties{Name: HostAddress, Value: "198.51.100.10"})
+	assert.Contains(t, env.Status.Properties, v1alpha1.Properties{Name: "fake-id", Value: "fake-123"})
+
+	addr, err := provider.HostAddress(&env)
+	require.NoError(t, err)
+	assert.Equal(t, "198.51.100.10", addr)
+
+	conditions, err := p.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, hasTrue(conditions, v1alpha1.ConditionAvailable))
+
+	require.NoError(t, p.UpdateResourcesTags(ctx, map[string]string{"team": "gpu"}, "i-1"))
+	err = p.UpdateResourcesTags(ctx, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing team tag")
+
+	// Delete hands the recorded properties back to the plugin
+	require.NoError(t, p.Delete(ctx))
+	env, err = jyaml.UnmarshalFromFile[v1alpha1.Environment](cacheFile)
+	require.NoError(t, err)
+	assert.True(t, hasTrue(env.Status.Conditions, v1alpha1.ConditionTerminated))
+}
+
+func TestProvider_ClusterCreate(t *testing.T) {
+	path := installFake(t, "fake", "ok")
+	cacheFile := filepath.Join(t.TempDir(), "cache.yaml")
+	env := testEnv("fake")
+	env.Spec.Cluster = &v1alpha1.ClusterSpec{}
+
+	p, err := New(testLogger(), env, cacheFile, path)
+	require.NoError(t, err)
+	require.NoError(t, p.Create(context.Background()))
+
+	cluster := p.Environment.Status.Cluster
+	require.NotNil(t, cluster)
+	assert.Equal(t, int32(2), cluster.TotalNodes)
+	assert.Equal(t, "10.0.0.10", cluster.ControlPlaneEndpoint)
+	assert.Equal(t, v1alpha1.NodeStatus{
+		Name: "worker-0", Role: "worker", PublicIP: "198.51.100.11", PrivateIP: "198.51.100.11",
+		SSHUsername: "core", Phase: "Pending",
+	}, cluster.Nodes[1])
+	assert.Equal(t, "ubuntu", cluster.Nodes[0].SSHUsername)
+	assert.Zero(t, cluster.ReadyNodes, "hosts are not ready before provisioning")
+	assert.Equal(t, "Pending", cluster.Phase)
+}
+
+func TestClusterStatus_AddressWithPort(t *testing.T) {
+	cluster := clusterStatus([]Host{
+		{Name: "cp-0", Address: "203.0.113.10:2222"},
+		{Name: "worker-0", Role: "worker", Address: "[2001:db8::11]:2222"},
+		{Name: "worker-1", Role: "worker", Address: "203.0.113.12:2222", PrivateAddress: "10.0.0.12"},
+	}, "ubuntu")
+
+	require.Len(t, cluster.Nodes, 3)
+	assert.Equal(t, "203.0.113.10:2222", cluster.Nodes[0].PublicIP, "SSH keeps the port")
+	assert.Equal(t, "203.0.113.10", cluster.Nodes[0].PrivateIP)
+	assert.Equal(t, "203.0.113.10", cluster.ControlPlaneEndpoint)
+	assert.Equal(t, "2001:db8::11", cluster.Nodes[1].PrivateIP)
+	assert.Equal(t, "10.0.0.12", cluster.Nodes[2].PrivateIP)
+}
+
+func TestProvider_CreateFailure(t *testing.T) {
+	path := installFake(t, "fake", "fail")
+	cacheFile := filepath.Join(t.TempDir(), "cache.yaml")
+
+	p, err := New(testLogger(), testEnv("fake"), cacheFile, path)
+	require.NoError(t, err)
+	err = p.Create(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "quota exceeded")
+
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](cacheFile)
+	require.NoError(t, err)
+	assert.True(t, hasTrue(env.Status.Conditions, v1alpha1.ConditionDegraded))
+}
+
+func TestProvider_CreateInterrupted(t *testing.T) {
+	path := installFake(t, "fake", "block")
+
+	p, err := New(testLogger(), testEnv("fake"), filepath.Join(t.TempDir(), "cache.yaml"), path)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(500*time.Millisecond, cancel)
+
+	// The plugin gets SIGINT and reports its own rollback
+	err = p.Create(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "interrupted, rolled back")
+}
+
+func TestServeIO(t *testing.T) {
+	env := testEnv("fake")
+	tests := []struct {
+		name    string
+		req     Request
+		wantErr string
+	}{
+		{
+			name: "info",
+			req:  Request{Version: ProtocolVersion, Method: MethodInfo},
+		},
+		{
+			name:    "unsupported version",
+			req:     Request{Version: "v0", Method: MethodInfo},
+			wantErr: "unsupported protocol version",
+		},
+		{
+			name:    "unknown method",
+			req:     Request{Version: ProtocolVersion, Method: "reboot", Environment: &env},
+			wantErr: "unknown method",
+		},
+		{
+			name:    "missing environment",
+			req:     Request{Version: ProtocolVersion, Method: MethodCreate},
+			wantErr: "carries no environment",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in, err := json.Marshal(tt.req)
+			require.NoError(t, err)
+			var out bytes.Buffer
+			require.NoError(t, ServeIO(context.Background(), fakePlugin{mode: "ok"}, bytes.NewReader(in), &out))
+
+			var resp Response
+			require.NoError(t, json.Unmarshal(out.Bytes(), &resp))
+			if tt.wantErr == "" {
+				assert.Empty(t, resp.Error)
+				assert.Equal(t, ProtocolVersion, resp.Version)
+				return
+			}
+			assert.True(t, strings.Contains(resp.Error, tt.wantErr), "error %q, want %q", resp.Error, tt.wantErr)
+		})
+	}
+
+	err := ServeIO(context.Background(), fakePlugin{}, strings.NewReader("not json"), io.Discard)
+	require.Error(t, err)
+}
+
+func hasTrue(conditions []metav1.Condition, conditionType string) bool {
+	for _, c := range conditions {
+		if c.Type == conditionType {
+			return c.Status == metav1.ConditionTrue
+		}
+	}
+	return false
+}
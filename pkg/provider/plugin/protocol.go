@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package plugin implements out-of-process provider plugins. A plugin is an
+// executable named holodeck-provider-<name> on PATH; setting spec.provider to
+// <name> makes holodeck run it once per provider call, writing a JSON Request
+// to its stdin and reading a JSON Response from its stdout. Anything the
+// plugin writes to stderr is shown to the user as-is.
+//
+// Plugin authors implement the Plugin interface and call Serve from main, and
+// can check their plugin with the conformance package.
+package plugin
+
+import (
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProtocolVersion is the version of the wire protocol described here.
+// Plugins must echo it in their Info response.
+const ProtocolVersion = "v1"
+
+// BinaryPrefix is the file name prefix of plugin executables.
+const BinaryPrefix = "holodeck-provider-"
+
+// Methods of the wire protocol, mirroring provider.Provider.
+const (
+	MethodInfo                = "info"
+	MethodCreate              = "create"
+	MethodDelete              = "delete"
+	MethodDryRun              = "dryrun"
+	MethodStatus              = "status"
+	MethodUpdateResourcesTags = "updateResourcesTags"
+)
+
+// Request is written to the plugin's stdin.
+type Request struct {
+	// Version is the protocol version spoken by holodeck
+	Version string `json:"version"`
+	// Method is the operation to perform
+	Method string `json:"method"`
+	// Environment is the environment spec together with the status recorded
+	// by earlier calls, including the Properties returned by Create. It is
+	// unset for MethodInfo.
+	Environment *v1alpha1.Environment `json:"environment,omitempty"`
+	// Tags and Resources are set for MethodUpdateResourcesTags
+	Tags      map[string]string `json:"tags,omitempty"`
+	Resources []string          `json:"resources,omitempty"`
+}
+
+// Response is read from the plugin's stdout.
+type Response struct {
+	// Error reports a failed call. A plugin that exits non-zero without
+	// writing a Response is treated as failed as well.
+	Error string `json:"error,omitempty"`
+
+	// Version and Capabilities answer MethodInfo
+	Version      string       `json:"version,omitempty"`
+	Capabilities Capabilities `json:"capabilities,omitempty"`
+
+	// Hosts answers MethodCreate: the machines holodeck provisions over SSH.
+	// Single-node environments use the first host.
+	Hosts []Host `json:"hosts,omitempty"`
+	// Properties answers MethodCreate: opaque plugin state recorded in
+	// status.properties and handed back in later requests.
+	Properties []v1alpha1.Properties `json:"properties,omitempty"`
+
+	// Conditions answers MethodStatus. When empty, the conditions recorded
+	// by holodeck are reported.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Capabilities advertises the optional features of a plugin.
+type Capabilities struct {
+	// Multinode is true if the plugin can back spec.cluster environments
+	Multinode bool `json:"multinode,omitempty"`
+}
+
+// Host is a machine created by a plugin.
+type Host struct {
+	// Name is the node name used in status and logs. Defaults to Address.
+	Name string `json:"name,omitempty"`
+	// Role is "control-plane" or "worker". Ignored for single-node
+	// environments; defaults to "control-plane".
+	Role string `json:"role,omitempty"`
+	// Address is the SSH-reachable address of the host (host or host:port).
+	Address string `json:"address"`
+	// PrivateAddress is the address other nodes use to reach this host.
+	// Defaults to the host of Address, without its port.
+	PrivateAddress string `json:"privateAddress,omitempty"`
+	// Username for the SSH connection. Defaults to spec.username.
+	Username string `json:"username,omitempty"`
+}
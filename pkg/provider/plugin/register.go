@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"regexp"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+)
+
+// handshakeTimeout bounds the MethodInfo call made when resolving a plugin.
+const handshakeTimeout = 30 * time.Second
+
+// validName matches provider names that may name a plugin executable.
+var validName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+func init() {
+	provider.RegisterResolver(resolve)
+}
+
+// resolve looks for a holodeck-provider-<name> executable on PATH and
+// registers it after a successful handshake.
+func resolve(name string) (provider.Registration, bool, error) {
+	if !validName.MatchString(name) {
+		return provider.Registration{}, false, nil
+	}
+	path, err := exec.LookPath(BinaryPrefix + name)
+	if err != nil {
+		return provider.Registration{}, false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), handshakeTimeout)
+	defer cancel()
+	caps, err := Handshake(ctx, path)
+	if err != nil {
+		return provider.Registration{}, false, err
+	}
+
+	return provider.Registration{
+		Name: name,
+		New: func(log *logger.FunLogger, env v1alpha1.Environment, cacheFile string) (provider.Provider, error) {
+			return New(log, env, cacheFile, path)
+		},
+		Capabilities: provider.Capabilities{Multinode: caps.Multinode},
+		HostAddress:  hostAddress,
+	}, true, nil
+}
+
+// hostAddress returns the address of the first host the plugin created.
+func hostAddress(env *v1alpha1.Environment) string {
+	for _, p := range env.Status.Properties {
+		if p.Name == HostAddress {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+// Handshake asks the plugin at path for its capabilities and checks that it
+// speaks ProtocolVersion.
+func Handshake(ctx context.Context, path string) (Capabilities, error) {
+	resp, err := invoke(ctx, path, &Request{Method: MethodInfo}, io.Discard)
+	if err != nil {
+		return Capabilities{}, err
+	}
+	if resp.Version != ProtocolVersion {
+		return Capabilities{}, fmt.Errorf("plugin %s speaks protocol %q, holodeck speaks %q", path, resp.Version, ProtocolVersion)
+	}
+	return resp.Capabilities, nil
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Plugin is implemented by out-of-process providers. Every method except
+// Info receives the environment together with the status holodeck recorded
+// so far; state a plugin needs across calls belongs in the properties
+// returned by Create.
+type Plugin interface {
+	// Info advertises the optional features of the plugin
+	Info() Capabilities
+	// Create creates the resources and returns the hosts to provision. On
+	// failure, and when ctx is cancelled, it should roll back what it created.
+	Create(ctx context.Context, env *v1alpha1.Environment) ([]Host, []v1alpha1.Properties, error)
+	// Delete deletes the resources. Deleting twice must succeed.
+	Delete(ctx context.Context, env *v1alpha1.Environment) error
+	// DryRun runs preflight checks
+	DryRun(ctx context.Context, env *v1alpha1.Environment) error
+	// Status returns the conditions of the resources, or nil to report the
+	// conditions recorded by holodeck
+	Status(ctx context.Context, env *v1alpha1.Environment) ([]metav1.Condition, error)
+	// UpdateResourcesTags tags the resources
+	UpdateResourcesTags(ctx context.Context, env *v1alpha1.Environment, tags map[string]string, resources ...string) error
+}
+
+// Serve answers the request on stdin with a response on stdout. SIGINT and
+// SIGTERM cancel the context passed to the plugin. It exits non-zero if the
+// protocol itself fails. Call it from the plugin's main function.
+func Serve(p Plugin) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	err := ServeIO(ctx, p, os.Stdin, os.Stdout)
+	stop()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// ServeIO reads one Request from r, dispatches it to p and writes the
+// Response to w. Errors returned by p are reported in the Response; only
+// failures to read or write the protocol are returned.
+func ServeIO(ctx context.Context, p Plugin, r io.Reader, w io.Writer) error {
+	req := &Request{}
+	if err := json.NewDecoder(r).Decode(req); err != nil {
+		return fmt.Errorf("failed to decode request: %w", err)
+	}
+
+	resp := dispatch(ctx, p, req)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		return fmt.Errorf("failed to encode response: %w", err)
+	}
+	return nil
+}
+
+func dispatch(ctx context.Context, p Plugin, req *Request) *Response {
+	if req.Version != ProtocolVersion {
+		return &Response{Error: fmt.Sprintf("unsupported protocol version %q, want %q", req.Version, ProtocolVersion)}
+	}
+	if req.Method == MethodInfo {
+		return &Response{Version: ProtocolVersion, Capabilities: p.Info()}
+	}
+	if req.Environment == nil {
+		return &Response{Error: fmt.Sprintf("%s request carries no environment", req.Method)}
+	}
+
+	resp := &Response{}
+	var err error
+	switch req.Method {
+	case MethodCreate:
+		resp.Hosts, resp.Properties, err = p.Create(ctx, req.Environment)
+	case MethodDelete:
+		err = p.Delete(ctx, req.Environment)
+	case MethodDryRun:
+		err = p.DryRun(ctx, req.Environment)
+	case MethodStatus:
+		resp.Conditions, err = p.Status(ctx, req.Environment)
+	case MethodUpdateResourcesTags:
+		err = p.UpdateResourcesTags(ctx, req.Environment, req.Tags, req.Resources...)
+	default:
+		err = fmt.Errorf("unknown method %q", req.Method)
+	}
+	if err != nil {
+		return &Response{Error: err.Error()}
+	}
+	return resp
+}
@@ -17,6 +17,10 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -25,17 +29,58 @@ type Provider interface {
 	// Name returns a friendly name for this Provider
 	Name() string
 
-	// Core methods
+	// Core methods. Cancelling ctx aborts in-flight API calls; Create rolls
+	// back the resources it created before returning.
 
 	// Create creates the resources in the provider
-	Create() error
+	Create(ctx context.Context) error
 	// Delete deletes the resources in the provider
-	Delete() error
+	Delete(ctx context.Context) error
 	// DryRun preflight checks
-	DryRun() error
+	DryRun(ctx context.Context) error
 	// Status returns the status of the resources in the provider
-	Status() ([]metav1.Condition, error)
+	Status(ctx context.Context) ([]metav1.Condition, error)
 
 	// Metada methods
-	UpdateResourcesTags(tags map[string]string, resources ...string) error
+	UpdateResourcesTags(ctx context.Context, tags map[string]string, resources ...string) error
+}
+
+// Stopper is implemented by providers that can stop and start the instances
+// of an environment, see Capabilities.Stop.
+type Stopper interface {
+	// Stop stops the instances, keeping their disks
+	Stop(ctx context.Context) error
+	// Start starts stopped instances and refreshes their addresses
+	Start(ctx context.Context) error
+}
+
+// SnapshotOptions configures Snapshotter.Snapshot.
+type SnapshotOptions struct {
+	// Name is the name of the image
+	Name string
+	// NoReboot takes the image without rebooting the instance first, at the
+	// cost of file system consistency
+	NoReboot bool
+}
+
+// Snapshotter is implemented by providers that can create an image from the
+// instance of an environment, see Capabilities.Snapshot.
+type Snapshotter interface {
+	// Snapshot creates an image recording the provisioned components and
+	// returns its ID
+	Snapshot(ctx context.Context, opts SnapshotOptions) (string, error)
+}
+
+// Scaler is implemented by providers that can add and remove the worker
+// nodes of a running cluster, see Capabilities.Scale. The methods record
+// the new node set in status.cluster and the pool count in spec.cluster.
+type Scaler interface {
+	// AddWorkers launches count workers into the worker pool named pool
+	// ("" for spec.cluster.workers) and returns their node status
+	AddWorkers(ctx context.Context, pool string, count int) ([]v1alpha1.NodeStatus, error)
+	// RemoveWorkers terminates the named worker nodes
+	RemoveWorkers(ctx context.Context, names []string) error
+	// ReplaceWorker terminates the named worker and launches a new
+	// instance with the same name and pool in its place
+	ReplaceWorker(ctx context.Context, name string) (*v1alpha1.NodeStatus, error)
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -22,23 +23,23 @@ func (m *MockProvider) Name() string {
 	return m.name
 }
 
-func (m *MockProvider) Create() error {
+func (m *MockProvider) Create(ctx context.Context) error {
 	return m.createError
 }
 
-func (m *MockProvider) Delete() error {
+func (m *MockProvider) Delete(ctx context.Context) error {
 	return m.deleteError
 }
 
-func (m *MockProvider) DryRun() error {
+func (m *MockProvider) DryRun(ctx context.Context) error {
 	return m.dryRunError
 }
 
-func (m *MockProvider) Status() ([]metav1.Condition, error) {
+func (m *MockProvider) Status(ctx context.Context) ([]metav1.Condition, error) {
 	return m.conditions, m.statusError
 }
 
-func (m *MockProvider) UpdateResourcesTags(tags map[string]string, resources ...string) error {
+func (m *MockProvider) UpdateResourcesTags(ctx context.Context, tags map[string]string, resources ...string) error {
 	return m.updateTagsError
 }
 
@@ -119,28 +120,30 @@ func TestProviderInterface(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
 			// Test Name()
 			if got := tt.provider.Name(); got != tt.expectedName {
 				t.Errorf("Name() = %v, want %v", got, tt.expectedName)
 			}
 
 			// Test Create()
-			if err := tt.provider.Create(); err != nil && err.Error() != tt.provider.createError.Error() {
+			if err := tt.provider.Create(ctx); err != nil && err.Error() != tt.provider.createError.Error() {
 				t.Errorf("Create() error = %v, want %v", err, tt.provider.createError)
 			}
 
 			// Test Delete()
-			if err := tt.provider.Delete(); err != nil && err.Error() != tt.provider.deleteError.Error() {
+			if err := tt.provider.Delete(ctx); err != nil && err.Error() != tt.provider.deleteError.Error() {
 				t.Errorf("Delete() error = %v, want %v", err, tt.provider.deleteError)
 			}
 
 			// Test DryRun()
-			if err := tt.provider.DryRun(); err != nil && err.Error() != tt.provider.dryRunError.Error() {
+			if err := tt.provider.DryRun(ctx); err != nil && err.Error() != tt.provider.dryRunError.Error() {
 				t.Errorf("DryRun() error = %v, want %v", err, tt.provider.dryRunError)
 			}
 
 			// Test Status()
-			conditions, err := tt.provider.Status()
+			conditions, err := tt.provider.Status(ctx)
 			if err != nil && err.Error() != tt.provider.statusError.Error() {
 				t.Errorf("Status() error = %v, want %v", err, tt.provider.statusError)
 			}
@@ -149,7 +152,7 @@ func TestProviderInterface(t *testing.T) {
 			}
 
 			// Test UpdateResourcesTags()
-			if err := tt.provider.UpdateResourcesTags(map[string]string{"key": "value"}); err != nil && err.Error() != tt.provider.updateTagsError.Error() {
+			if err := tt.provider.UpdateResourcesTags(ctx, map[string]string{"key": "value"}); err != nil && err.Error() != tt.provider.updateTagsError.Error() {
 				t.Errorf("UpdateResourcesTags() error = %v, want %v", err, tt.provider.updateTagsError)
 			}
 		})
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+// Factory constructs a Provider for env, persisting state to cacheFile.
+type Factory func(log *logger.FunLogger, env v1alpha1.Environment, cacheFile string) (Provider, error)
+
+// Capabilities describes the optional features a provider supports.
+type Capabilities struct {
+	// Multinode is true if the provider can back spec.cluster environments
+	Multinode bool
+	// Stop is true if the provider can stop and start instances
+	Stop bool
+	// Snapshot is true if the provider can snapshot instances into images
+	Snapshot bool
+	// Scale is true if the provider can add and remove cluster workers
+	Scale bool
+}
+
+// Registration describes a provider backend.
+type Registration struct {
+	// Name is the provider name as used in spec.provider
+	Name string
+	// New constructs the provider
+	New Factory
+	// Defaults fills provider-specific defaults into env before New is
+	// called. Optional.
+	Defaults func(env *v1alpha1.Environment)
+	// Capabilities advertises the optional features of the provider
+	Capabilities Capabilities
+	// HostAddress returns the SSH-reachable address of a single-node
+	// environment from its spec and status, or "" if it is not known yet.
+	// Optional: without it, single-node environments of the provider cannot
+	// be reached over SSH.
+	HostAddress func(env *v1alpha1.Environment) string
+}
+
+// Resolver builds a registration on demand for a provider name that no
+// backend registered, e.g. by discovering an out-of-process plugin. It
+// returns ok=false if it does not handle name.
+type Resolver func(name string) (r Registration, ok bool, err error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Registration{}
+	resolvers  []Resolver
+)
+
+// Register makes a provider backend available by name. It is meant to be
+// called from the init function of the backend package and panics if the
+// registration is incomplete or the name is already taken.
+func Register(r Registration) {
+	if r.Name == "" || r.New == nil {
+		panic("provider: Register requires a name and a factory")
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, dup := registry[r.Name]; dup {
+		panic(fmt.Sprintf("provider: Register called twice for %q", r.Name))
+	}
+	registry[r.Name] = r
+}
+
+// RegisterResolver adds a fallback consulted by Lookup, in registration
+// order, for names that no backend registered.
+func RegisterResolver(fn Resolver) {
+	if fn == nil {
+		panic("provider: RegisterResolver requires a resolver")
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	resolvers = append(resolvers, fn)
+}
+
+// Lookup returns the registration for the named provider. Registered
+// backends take precedence over resolvers.
+func Lookup(name string) (Registration, error) {
+	registryMu.RLock()
+	r, ok := registry[name]
+	fallbacks := resolvers
+	registryMu.RUnlock()
+	if ok {
+		return r, nil
+	}
+
+	for _, resolve := range fallbacks {
+		r, ok, err := resolve(name)
+		if err != nil {
+			return Registration{}, fmt.Errorf("provider %q: %w", name, err)
+		}
+		if ok {
+			return r, nil
+		}
+	}
+	return Registration{}, fmt.Errorf("unknown provider %q", name)
+}
+
+// Names returns the sorted names of all registered providers. Providers
+// only reachable through a resolver are not listed.
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HostAddress resolves spec.provider of env and returns the SSH-reachable
+// address of the single-node environment.
+func HostAddress(env *v1alpha1.Environment) (string, error) {
+	r, err := Lookup(string(env.Spec.Provider))
+	if err != nil {
+		return "", err
+	}
+	if r.HostAddress == nil {
+		return "", fmt.Errorf("provider %q does not report host addresses", r.Name)
+	}
+	addr := r.HostAddress(env)
+	if addr == "" {
+		return "", fmt.Errorf("no host address recorded for provider %q", r.Name)
+	}
+	return addr, nil
+}
+
+// New resolves spec.provider of env and constructs the provider.
+func New(log *logger.FunLogger, env v1alpha1.Environment, cacheFile string) (Provider, error) {
+	r, err := Lookup(string(env.Spec.Provider))
+	if err != nil {
+		return nil, err
+	}
+	return r.New(log, env, cacheFile)
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package provider
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+func TestRegistry(t *testing.T) {
+	Register(Registration{
+		Name: "registry-test",
+		New: func(_ *logger.FunLogger, env v1alpha1.Environment, _ string) (Provider, error) {
+			return &MockProvider{name: env.Name}, nil
+		},
+		Capabilities: Capabilities{Multinode: true},
+	})
+
+	r, err := Lookup("registry-test")
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	if !r.Capabilities.Multinode || r.Capabilities.Stop {
+		t.Errorf("Lookup() capabilities = %+v", r.Capabilities)
+	}
+
+	env := v1alpha1.Environment{Spec: v1alpha1.EnvironmentSpec{Provider: "registry-test"}}
+	env.Name = "env"
+	p, err := New(logger.NewLogger(), env, "")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if p.Name() != "env" {
+		t.Errorf("New() provider name = %q, want %q", p.Name(), "env")
+	}
+
+	found := false
+	for _, name := range Names() {
+		if name == "registry-test" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Names() = %v, missing registry-test", Names())
+	}
+
+	if _, err := Lookup("does-not-exist"); err == nil || !strings.Contains(err.Error(), "unknown provider") {
+		t.Errorf("Lookup() error = %v, want unknown provider", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Register() did not panic on a duplicate name")
+		}
+	}()
+	Register(Registration{Name: "registry-test", New: r.New})
+}
+
+func TestRegistryResolver(t *testing.T) {
+	RegisterResolver(func(name string) (Registration, bool, error) {
+		switch name {
+		case "resolved-test":
+			return Registration{
+				Name: name,
+				New: func(_ *logger.FunLogger, env v1alpha1.Environment, _ string) (Provider, error) {
+					return &MockProvider{name: env.Name}, nil
+				},
+			}, true, nil
+		case "broken-test":
+			return Registration{}, false, errors.New("handshake failed")
+		}
+		return Registration{}, false, nil
+	})
+
+	r, err := Lookup("resolved-test")
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	if r.Name != "resolved-test" {
+		t.Errorf("Lookup() name = %q, want %q", r.Name, "resolved-test")
+	}
+
+	if _, err := Lookup("broken-test"); err == nil || !strings.Contains(err.Error(), "handshake failed") {
+		t.Errorf("Lookup() error = %v, want handshake failed", err)
+	}
+	if _, err := Lookup("unresolved-test"); err == nil || !strings.Contains(err.Error(), "unknown provider") {
+		t.Errorf("Lookup() error = %v, want unknown provider", err)
+	}
+}
+
+func TestHostAddress(t *testing.T) {
+	Register(Registration{
+		Name: "host-address-test",
+		New: func(_ *logger.FunLogger, env v1alpha1.Environment, _ string) (Provider, error) {
+			return &MockProvider{name: env.Name}, nil
+		},
+		HostAddress: func(env *v1alpha1.Environment) string { return env.Spec.HostUrl },
+	})
+	Register(Registration{
+		Name: "no-host-address-test",
+		New: func(_ *logger.FunLogger, env v1alpha1.Environment, _ string) (Provider, error) {
+			return &MockProvider{name: env.Name}, nil
+		},
+	})
+
+	env := &v1alpha1.Environment{Spec: v1alpha1.EnvironmentSpec{Provider: "host-address-test"}}
+	env.Spec.HostUrl = "203.0.113.10"
+	addr, err := HostAddress(env)
+	if err != nil || addr != "203.0.113.10" {
+		t.Errorf("HostAddress() = %q, %v", addr, err)
+	}
+
+	env.Spec.HostUrl = ""
+	if _, err := HostAddress(env); err == nil || !strings.Contains(err.Error(), "no host address recorded") {
+		t.Errorf("HostAddress() error = %v, want no host address recorded", err)
+	}
+
+	env.Spec.Provider = "no-host-address-test"
+	if _, err := HostAddress(env); err == nil || !strings.Contains(err.Error(), "does not report host addresses") {
+		t.Errorf("HostAddress() error = %v, want does not report host addresses", err)
+	}
+}
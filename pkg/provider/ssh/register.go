@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ssh
+
+import (
+	"os"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+)
+
+func init() {
+	provider.Register(provider.Registration{
+		Name: Name,
+		New: func(log *logger.FunLogger, env v1alpha1.Environment, cacheFile string) (provider.Provider, error) {
+			return New(log, env, cacheFile)
+		},
+		Defaults:    setDefaults,
+		HostAddress: hostAddress,
+		Capabilities: provider.Capabilities{
+			Multinode: true,
+		},
+	})
+}
+
+// hostAddress returns the host of a single-node environment as given in
+// spec.instance.hostUrl.
+func hostAddress(env *v1alpha1.Environment) string {
+	return env.Spec.HostUrl
+}
+
+// setDefaults fills SSH-specific defaults into env: if no username is
+// provided, the current user is assumed.
+func setDefaults(env *v1alpha1.Environment) {
+	if env.Spec.Username == "" {
+		env.Spec.Username = os.Getenv("USER")
+	}
+}
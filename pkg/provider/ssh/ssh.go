@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ssh implements the "ssh" provider: bring-your-own hosts that
+// holodeck reaches over SSH but does not create or destroy. In cluster mode
+// the control-plane and worker hosts come from spec.cluster.*.hosts and are
+// recorded in status.cluster.nodes so the cluster provisioner can consume
+// them exactly like cloud-created nodes.
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Name is the provider name as used in spec.provider.
+const Name = string(v1alpha1.ProviderSSH)
+
+// Provider is the SSH (bring-your-own host) provider.
+type Provider struct {
+	*v1alpha1.Environment
+	cacheFile string
+	log       *logger.FunLogger
+}
+
+// New creates a new SSH Provider for env, persisting state to cacheFile.
+func New(log *logger.FunLogger, env v1alpha1.Environment, cacheFile string) (*Provider, error) {
+	if err := env.Spec.ValidateHostInventory(); err != nil {
+		return nil, err
+	}
+	if err := env.Spec.ValidateEtcd(); err != nil {
+		return nil, err
+	}
+	if err := env.Spec.ValidateWorkerPools(); err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Environment: &env,
+		cacheFile:   cacheFile,
+		log:         log,
+	}, nil
+}
+
+// Name returns the name of the provider
+func (p *Provider) Name() string { return Name }
+
+// IsMultinode returns true if the environment declares a host inventory.
+func (p *Provider) IsMultinode() bool {
+	return p.Spec.Cluster != nil
+}
+
+// Create records the pre-existing hosts in the cache. Nothing is created:
+// in cluster mode status.cluster.nodes is populated from the host inventory,
+// in single-node mode spec.instance.hostUrl is used as-is.
+func (p *Provider) Create(_ context.Context) error {
+	p.log.Info("SSH infrastructure \u2601")
+	if p.IsMultinode() {
+		p.Environment.Status.Cluster = ClusterStatusFromInventory(p.Spec.Cluster, p.Spec.Username)
+		p.log.Info("Using %d pre-existing host(s)", p.Environment.Status.Cluster.TotalNodes)
+	}
+	p.Environment.Status.Conditions = provider.BuildConditions(v1alpha1.ConditionAvailable, "", "")
+	return p.writeCache()
+}
+
+// Delete marks the environment terminated. The hosts are not owned by
+// holodeck and are left untouched.
+func (p *Provider) Delete(_ context.Context) error {
+	p.log.Info("SSH hosts are not managed by holodeck, leaving them untouched")
+	p.Environment.Status.Conditions = provider.BuildConditions(v1alpha1.ConditionTerminated, "v1alpha1.Terminated", "SSH environment released")
+	return p.writeCache()
+}
+
+// DryRun validates the host inventory.
+func (p *Provider) DryRun(_ context.Context) error {
+	return p.Spec.ValidateHostInventory()
+}
+
+// Status returns the conditions recorded in the cache file.
+func (p *Provider) Status(_ context.Context) ([]metav1.Condition, error) {
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](p.cacheFile)
+	if err != nil {
+		return []metav1.Condition{}, err
+	}
+	if len(env.Status.Conditions) == 0 {
+		return []metav1.Condition{}, nil
+	}
+	return env.Status.Conditions, nil
+}
+
+// UpdateResourcesTags is a no-op: bring-your-own hosts carry no provider tags.
+func (p *Provider) UpdateResourcesTags(_ context.Context, tags map[string]string, resources ...string) error {
+	return nil
+}
+
+func (p *Provider) writeCache() error {
+	data, err := jyaml.MarshalYAML(p.Environment)
+	if err != nil {
+		return fmt.Errorf("failed to marshal environment: %w", err)
+	}
+	if err := os.WriteFile(p.cacheFile, data, 0600); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	return nil
+}
+
+// phasePending is the phase of hosts that are not provisioned yet
+const phasePending = "Pending"
+
+// ClusterStatusFromInventory builds the cluster status for a host inventory.
+// Hosts without a Name are named after their Address, hosts without a
+// PrivateAddress use their Address, and hosts without a Username inherit
+// defaultUsername. The first control-plane host is the control-plane endpoint.
+// The hosts are Pending until they are provisioned.
+func ClusterStatusFromInventory(cluster *v1alpha1.ClusterSpec, defaultUsername string) *v1alpha1.ClusterStatus {
+	var nodes []v1alpha1.NodeStatus
+	for _, h := range cluster.ControlPlane.Hosts {
+		nodes = append(nodes, nodeStatusFromHost(h, "control-plane", defaultUsername))
+	}
+	for _, w := range cluster.WorkerPoolList() {
+		for _, h := range w.Hosts {
+			node := nodeStatusFromHost(h, "worker", defaultUsername)
+			node.Pool = w.Name
+			nodes = append(nodes, node)
+		}
+	}
+
+	// #nosec G115 -- node count is bounded by the host inventory, will never overflow int32
+	nodeCount := int32(len(nodes))
+	status := &v1alpha1.ClusterStatus{
+		Nodes:      nodes,
+		TotalNodes: nodeCount,
+		Phase:      phasePending,
+	}
+	if len(nodes) > 0 {
+		status.ControlPlaneEndpoint = nodes[0].PrivateIP
+	}
+	return status
+}
+
+func nodeStatusFromHost(h v1alpha1.Host, role, defaultUsername string) v1alpha1.NodeStatus {
+	name := h.Name
+	if name == "" {
+		name = h.Address
+	}
+	privateIP := h.PrivateAddress
+	if privateIP == "" {
+		privateIP = h.Address
+		// The SSH port of the address is not the one other nodes reach
+		// this host on
+		if host, _, err := net.SplitHostPort(h.Address); err == nil {
+			privateIP = host
+		}
+	}
+	username := h.Username
+	if username == "" {
+		username = defaultUsername
+	}
+	return v1alpha1.NodeStatus{
+		Name:        name,
+		Role:        role,
+		PublicIP:    h.Address,
+		PrivateIP:   privateIP,
+		SSHUsername: username,
+		Phase:       phasePending,
+	}
+}
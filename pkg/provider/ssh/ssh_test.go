@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssh
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func inventoryEnv() v1alpha1.Environment {
+	return v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "byo"},
+		Spec: v1alpha1.EnvironmentSpec{
+			Provider: v1alpha1.ProviderSSH,
+			Auth:     v1alpha1.Auth{Username: "ubuntu", PrivateKey: "/keys/default"},
+			Cluster: &v1alpha1.ClusterSpec{
+				ControlPlane: v1alpha1.ControlPlaneSpec{
+					Hosts: []v1alpha1.Host{
+						{Name: "cp-0", Address: "203.0.113.10", PrivateAddress: "10.0.0.10"},
+					},
+				},
+				Workers: &v1alpha1.WorkerPoolSpec{
+					Hosts: []v1alpha1.Host{
+						{Address: "10.0.0.20", Username: "core"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestClusterStatusFromInventory(t *testing.T) {
+	env := inventoryEnv()
+
+	status := ClusterStatusFromInventory(env.Spec.Cluster, "ubuntu")
+
+	require.Len(t, status.Nodes, 2)
+	assert.Equal(t, int32(2), status.TotalNodes)
+	assert.Zero(t, status.ReadyNodes, "hosts are not ready before provisioning")
+	assert.Equal(t, "Pending", status.Phase)
+	assert.Equal(t, "10.0.0.10", status.ControlPlaneEndpoint)
+
+	cp := status.Nodes[0]
+	assert.Equal(t, "cp-0", cp.Name)
+	assert.Equal(t, "control-plane", cp.Role)
+	assert.Equal(t, "203.0.113.10", cp.PublicIP)
+	assert.Equal(t, "10.0.0.10", cp.PrivateIP)
+	assert.Equal(t, "ubuntu", cp.SSHUsername)
+
+	worker := status.Nodes[1]
+	assert.Equal(t, "10.0.0.20", worker.Name, "name defaults to the address")
+	assert.Equal(t, "worker", worker.Role)
+	assert.Equal(t, "10.0.0.20", worker.PrivateIP, "private IP defaults to the address")
+	assert.Equal(t, "core", worker.SSHUsername)
+}
+
+func TestClusterStatusFromInventory_WorkerPools(t *testing.T) {
+	env := inventoryEnv()
+	env.Spec.Cluster.WorkerPools = []v1alpha1.WorkerPoolSpec{
+		{Name: "cpu", Hosts: env.Spec.Cluster.Workers.Hosts},
+		{Name: "gpu", Hosts: []v1alpha1.Host{{Address: "10.0.0.30"}}},
+	}
+	env.Spec.Cluster.Workers = nil
+
+	status := ClusterStatusFromInventory(env.Spec.Cluster, "ubuntu")
+
+	require.Len(t, status.Nodes, 3)
+	assert.Empty(t, status.Nodes[0].Pool)
+	assert.Equal(t, "cpu", status.Nodes[1].Pool)
+	assert.Equal(t, "gpu", status.Nodes[2].Pool)
+	assert.Equal(t, "10.0.0.30", status.Nodes[2].Name)
+}
+
+func TestClusterStatusFromInventory_AddressWithPort(t *testing.T) {
+	env := inventoryEnv()
+	env.Spec.Cluster.ControlPlane.Hosts = []v1alpha1.Host{{Address: "203.0.113.10:2222"}}
+	env.Spec.Cluster.Workers.Hosts = []v1alpha1.Host{{Address: "[2001:db8::20]:2222", PrivateAddress: "10.0.0.20"}}
+
+	status := ClusterStatusFromInventory(env.Spec.Cluster, "ubuntu")
+
+	require.Len(t, status.Nodes, 2)
+	cp := status.Nodes[0]
+	assert.Equal(t, "203.0.113.10:2222", cp.PublicIP, "SSH keeps the port")
+	assert.Equal(t, "203.0.113.10", cp.PrivateIP, "private IP defaults to the host of the address")
+	assert.Equal(t, "203.0.113.10", status.ControlPlaneEndpoint)
+	assert.Equal(t, "10.0.0.20", status.Nodes[1].PrivateIP)
+}
+
+func TestProviderCreateWritesCache(t *testing.T) {
+	cacheFile := filepath.Join(t.TempDir(), "cache.yaml")
+
+	p, err := New(logger.NewLogger(), inventoryEnv(), cacheFile)
+	require.NoError(t, err)
+	require.NoError(t, p.Create(context.Background()))
+
+	cached, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](cacheFile)
+	require.NoError(t, err)
+	require.NotNil(t, cached.Status.Cluster)
+	assert.Len(t, cached.Status.Cluster.Nodes, 2)
+
+	conditions, err := p.Status(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, conditions)
+	assert.Equal(t, v1alpha1.ConditionAvailable, conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+}
+
+func TestNewRejectsInvalidInventory(t *testing.T) {
+	env := inventoryEnv()
+	env.Spec.Cluster.ControlPlane.Hosts = nil
+
+	_, err := New(logger.NewLogger(), env, filepath.Join(t.TempDir(), "cache.yaml"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	internalvsphere "github.com/NVIDIA/holodeck/internal/vsphere"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+)
+
+// Create clones the VM from its template, powers it on and waits for the
+// guest to report an IP address. On failure the VM is destroyed.
+func (p *Provider) Create(ctx context.Context) error {
+	spec := p.Spec.VSphere
+	cache := &vmCache{VMName: p.ObjectMeta.Name}
+
+	if err := p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionProgressing, "v1alpha1.Creating", "Cloning vSphere VM")); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	var err error
+	defer func() {
+		if err == nil {
+			return
+		}
+		if cache.VMID != "" {
+			// Destroy detached from ctx so an interrupted create still
+			// cleans up after itself.
+			p.log.Warning("Creation failed, destroying VM %s...", cache.VMName)
+			if cleanupErr := p.destroyVM(context.WithoutCancel(ctx), cache.VMID); cleanupErr != nil {
+				p.log.Warning("Cleanup failed: %v", cleanupErr)
+			}
+			cache.VMID = ""
+		}
+		_ = p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionDegraded, "v1alpha1.Creating", err.Error()))
+	}()
+
+	cancel := p.log.Loading("Cloning VM %s from template %s", cache.VMName, spec.Template)
+	cache.VMID, err = p.client.CloneVM(ctx, internalvsphere.CloneSpec{
+		Name:         cache.VMName,
+		Template:     spec.Template,
+		Datacenter:   spec.Datacenter,
+		Cluster:      spec.Cluster,
+		ResourcePool: spec.ResourcePool,
+		Datastore:    spec.Datastore,
+		Folder:       spec.Folder,
+	})
+	if err != nil {
+		cancel(logger.ErrLoadingFailed)
+		err = fmt.Errorf("error cloning VM: %w", err)
+		return err
+	}
+	cancel(nil)
+
+	if err = p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionProgressing, "v1alpha1.Creating", "VM cloned")); err != nil {
+		return err
+	}
+
+	p.log.Info("Powering on VM %s", cache.VMName)
+	if err = p.client.PowerOn(ctx, cache.VMID); err != nil {
+		err = fmt.Errorf("error powering on VM: %w", err)
+		return err
+	}
+
+	cache.IPAddress, err = p.waitForIP(ctx, cache.VMID)
+	if err != nil {
+		return err
+	}
+
+	if err = p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionAvailable, "", "")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// waitForIP polls the guest until VMware Tools reports an IP address.
+func (p *Provider) waitForIP(ctx context.Context, vmID string) (string, error) {
+	cancel := p.log.Loading("Waiting for VM %s to report an IP address", vmID)
+	deadline := time.Now().Add(p.ipTimeout)
+	for {
+		ip, err := p.client.GuestIP(ctx, vmID)
+		if err != nil {
+			cancel(logger.ErrLoadingFailed)
+			return "", fmt.Errorf("error reading guest IP: %w", err)
+		}
+		if ip != "" {
+			cancel(nil)
+			return ip, nil
+		}
+		if time.Now().After(deadline) {
+			cancel(logger.ErrLoadingFailed)
+			return "", fmt.Errorf("timed out after %v waiting for VM %s to report an IP address", p.ipTimeout, vmID)
+		}
+		p.sleep(ipPollInterval)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vsphere
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	internalvsphere "github.com/NVIDIA/holodeck/internal/vsphere"
+	"github.com/NVIDIA/holodeck/pkg/provider"
+)
+
+// Delete powers off and destroys the VM recorded in the cache.
+func (p *Provider) Delete(ctx context.Context) error {
+	cache, err := p.readCache()
+	if err != nil {
+		return fmt.Errorf("error retrieving cache: %w", err)
+	}
+
+	if cache.VMID != "" {
+		p.log.Info("Destroying VM %s", cache.VMName)
+		if err := p.destroyVM(ctx, cache.VMID); err != nil {
+			_ = p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionDegraded, "v1alpha1.Destroying", err.Error()))
+			return fmt.Errorf("error destroying vSphere VM: %w", err)
+		}
+	}
+
+	cache.IPAddress = ""
+	return p.updateStatus(cache, provider.BuildConditions(v1alpha1.ConditionTerminated, "v1alpha1.Terminated", "vSphere VM has been destroyed"))
+}
+
+// destroyVM powers off and deletes a VM. A VM that no longer exists is
+// treated as already destroyed.
+func (p *Provider) destroyVM(ctx context.Context, vmID string) error {
+	if err := p.client.PowerOff(ctx, vmID); err != nil {
+		if errors.Is(err, internalvsphere.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("error powering off VM %s: %w", vmID, err)
+	}
+	if err := p.client.DeleteVM(ctx, vmID); err != nil && !errors.Is(err, internalvsphere.ErrNotFound) {
+		return fmt.Errorf("error deleting VM %s: %w", vmID, err)
+	}
+	return nil
+}
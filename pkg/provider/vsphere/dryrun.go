@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vsphere
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/NVIDIA/holodeck/internal/logger"
+	internalvsphere "github.com/NVIDIA/holodeck/internal/vsphere"
+)
+
+// DryRun checks that the template exists and the VM name is free.
+func (p *Provider) DryRun(ctx context.Context) error {
+	spec := p.Spec.VSphere
+
+	if p.Spec.Cluster != nil {
+		return fmt.Errorf("provider %s does not support cluster mode", Name)
+	}
+
+	cancel := p.log.Loading("Checking template %s", spec.Template)
+	if _, err := p.client.FindVM(ctx, spec.Datacenter, spec.Template); err != nil {
+		cancel(logger.ErrLoadingFailed)
+		return fmt.Errorf("template %q not found in datacenter %q: %w", spec.Template, spec.Datacenter, err)
+	}
+	cancel(nil)
+
+	_, err := p.client.FindVM(ctx, spec.Datacenter, p.ObjectMeta.Name)
+	switch {
+	case err == nil:
+		return fmt.Errorf("a VM named %q already exists in datacenter %q", p.ObjectMeta.Name, spec.Datacenter)
+	case !errors.Is(err, internalvsphere.ErrNotFound):
+		return err
+	}
+	return nil
+}
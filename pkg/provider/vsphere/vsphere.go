@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package vsphere implements the "vsphere" provider: a single VM cloned from
+// a template on a vCenter-managed vSphere environment.
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	internalvsphere "github.com/NVIDIA/holodeck/internal/vsphere"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Name of this provider
+	Name = string(v1alpha1.ProviderVSphere)
+
+	// Status properties recorded in the cache
+	VMID      string = "vm-id"
+	VMName    string = "vm-name"
+	IPAddress string = "ip-address"
+
+	// Environment variables holding the vCenter connection settings
+	EnvServer   = "HOLODECK_VCENTER_SERVER"
+	EnvUsername = "HOLODECK_VCENTER_USERNAME"
+	EnvPassword = "HOLODECK_VCENTER_PASSWORD" //nolint:gosec // G101: environment variable name, not a credential
+
+	defaultIPTimeout = 10 * time.Minute
+	ipPollInterval   = 10 * time.Second
+)
+
+// Provider is the vSphere provider.
+type Provider struct {
+	client    internalvsphere.Client
+	cacheFile string
+	sleep     func(time.Duration)
+	ipTimeout time.Duration
+
+	*v1alpha1.Environment
+	log *logger.FunLogger
+}
+
+// Option is a functional option for configuring the Provider.
+type Option func(*Provider)
+
+// WithClient sets a custom vCenter client for the Provider.
+// This is primarily used for testing to inject fake clients.
+func WithClient(client internalvsphere.Client) Option {
+	return func(p *Provider) {
+		p.client = client
+	}
+}
+
+// WithSleep sets a custom sleep function for the Provider.
+// This is used in tests to eliminate real wall-clock delays.
+func WithSleep(fn func(time.Duration)) Option {
+	return func(p *Provider) {
+		p.sleep = fn
+	}
+}
+
+// New creates a new vSphere Provider. The vCenter server defaults to
+// HOLODECK_VCENTER_SERVER and the credentials are read from
+// HOLODECK_VCENTER_USERNAME and HOLODECK_VCENTER_PASSWORD.
+func New(log *logger.FunLogger, env v1alpha1.Environment, cacheFile string, opts ...Option) (*Provider, error) {
+	if err := env.Spec.VSphere.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		cacheFile:   cacheFile,
+		sleep:       time.Sleep,
+		ipTimeout:   defaultIPTimeout,
+		Environment: &env,
+		log:         log,
+	}
+
+	// Apply functional options
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	// Create the vCenter client if not injected (for testing)
+	if p.client == nil {
+		server := env.Spec.VSphere.Server
+		if server == "" {
+			server = os.Getenv(EnvServer)
+		}
+		username, password := os.Getenv(EnvUsername), os.Getenv(EnvPassword)
+		if username == "" || password == "" {
+			return nil, fmt.Errorf("%s and %s must be set", EnvUsername, EnvPassword)
+		}
+		client, err := internalvsphere.NewRESTClient(server, username, password, env.Spec.VSphere.InsecureSkipVerify)
+		if err != nil {
+			return nil, err
+		}
+		p.client = client
+	}
+
+	return p, nil
+}
+
+// Name returns the name of the provider
+func (p *Provider) Name() string { return Name }
+
+// UpdateResourcesTags is a no-op: vSphere tags require pre-created tag
+// categories which holodeck does not manage.
+func (p *Provider) UpdateResourcesTags(_ context.Context, tags map[string]string, resources ...string) error {
+	p.log.Debug("vSphere tagging is not supported, skipping")
+	return nil
+}
+
+// vmCache holds the VM state persisted in status.properties.
+type vmCache struct {
+	VMID      string
+	VMName    string
+	IPAddress string
+}
+
+// readCache reads the VM state from the cache file.
+func (p *Provider) readCache() (*vmCache, error) {
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](p.cacheFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &vmCache{}
+	for _, prop := range env.Status.Properties {
+		switch prop.Name {
+		case VMID:
+			cache.VMID = prop.Value
+		case VMName:
+			cache.VMName = prop.Value
+		case IPAddress:
+			cache.IPAddress = prop.Value
+		}
+	}
+	return cache, nil
+}
+
+// updateStatus records cache and conditions in the cache file.
+func (p *Provider) updateStatus(cache *vmCache, conditions []metav1.Condition) error {
+	env := p.DeepCopy()
+	env.Status.Properties = []v1alpha1.Properties{
+		{Name: VMID, Value: cache.VMID},
+		{Name: VMName, Value: cache.VMName},
+		{Name: IPAddress, Value: cache.IPAddress},
+	}
+	env.Status.Conditions = conditions
+
+	data, err := jyaml.MarshalYAML(env)
+	if err != nil {
+		return fmt.Errorf("failed to marshal environment: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(p.cacheFile), 0750); err != nil {
+		return err
+	}
+	if err := os.WriteFile(p.cacheFile, data, 0600); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vsphere
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/internal/vsphere/vspherefake"
+	"github.com/NVIDIA/holodeck/pkg/jyaml"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestProvider(t *testing.T, fake *vspherefake.Fake) *Provider {
+	t.Helper()
+	env := v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "holodeck-vm"},
+		Spec: v1alpha1.EnvironmentSpec{
+			Provider: v1alpha1.ProviderVSphere,
+			VSphere:  &v1alpha1.VSphere{Datacenter: "dc1", Template: "ubuntu-22.04"},
+		},
+	}
+	p, err := New(logger.NewLogger(), env, filepath.Join(t.TempDir(), "cache.yaml"),
+		WithClient(fake), WithSleep(func(time.Duration) {}))
+	require.NoError(t, err)
+	return p
+}
+
+func cachedEnv(t *testing.T, p *Provider) v1alpha1.Environment {
+	t.Helper()
+	env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](p.cacheFile)
+	require.NoError(t, err)
+	return env
+}
+
+func property(env v1alpha1.Environment, name string) string {
+	for _, p := range env.Status.Properties {
+		if p.Name == name {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+func TestCreateAndDelete(t *testing.T) {
+	fake := vspherefake.New()
+	fake.SeedTemplate("dc1", "ubuntu-22.04")
+	fake.SeedIPDelay(2)
+	p := newTestProvider(t, fake)
+
+	require.NoError(t, p.Create(context.Background()))
+
+	env := cachedEnv(t, p)
+	assert.NotEmpty(t, property(env, VMID))
+	assert.Equal(t, "holodeck-vm", property(env, VMName))
+	assert.NotEmpty(t, property(env, IPAddress))
+	assert.Equal(t, property(env, IPAddress), hostAddress(&env))
+	assert.Equal(t, 3, fake.CallsTo("GuestIP"), "waits until the guest reports an IP")
+	assert.Equal(t, 1, fake.VMCount())
+
+	conditions, err := p.Status(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.ConditionAvailable, conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+
+	require.NoError(t, p.Delete(context.Background()))
+	assert.Equal(t, 0, fake.VMCount())
+	env = cachedEnv(t, p)
+	for _, c := range env.Status.Conditions {
+		if c.Type == v1alpha1.ConditionTerminated {
+			assert.Equal(t, metav1.ConditionTrue, c.Status)
+		}
+	}
+}
+
+func TestCreateRollsBackOnPowerOnFailure(t *testing.T) {
+	fake := vspherefake.New()
+	fake.SeedTemplate("dc1", "ubuntu-22.04")
+	fake.FailNext("PowerOn", errors.New("insufficient resources"))
+	p := newTestProvider(t, fake)
+
+	err := p.Create(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "insufficient resources")
+	assert.Equal(t, 0, fake.VMCount(), "the cloned VM is destroyed")
+	assert.Equal(t, 1, fake.CallsTo("DeleteVM"))
+
+	env := cachedEnv(t, p)
+	assert.Empty(t, property(env, VMID))
+	for _, c := range env.Status.Conditions {
+		if c.Type == v1alpha1.ConditionDegraded {
+			assert.Equal(t, metav1.ConditionTrue, c.Status)
+		}
+	}
+}
+
+func TestCreateTimesOutWaitingForIP(t *testing.T) {
+	fake := vspherefake.New()
+	fake.SeedTemplate("dc1", "ubuntu-22.04")
+	fake.SeedIPDelay(1000)
+	p := newTestProvider(t, fake)
+	p.ipTimeout = 0
+
+	err := p.Create(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+	assert.Equal(t, 0, fake.VMCount())
+}
+
+func TestDryRun(t *testing.T) {
+	fake := vspherefake.New()
+	p := newTestProvider(t, fake)
+	assert.ErrorContains(t, p.DryRun(context.Background()), "template \"ubuntu-22.04\" not found")
+
+	fake.SeedTemplate("dc1", "ubuntu-22.04")
+	assert.NoError(t, p.DryRun(context.Background()))
+
+	fake.SeedTemplate("dc1", "holodeck-vm")
+	assert.ErrorContains(t, p.DryRun(context.Background()), "already exists")
+}
+
+func TestNewRequiresVSphereSection(t *testing.T) {
+	env := v1alpha1.Environment{Spec: v1alpha1.EnvironmentSpec{Provider: v1alpha1.ProviderVSphere}}
+	_, err := New(logger.NewLogger(), env, "", WithClient(vspherefake.New()))
+	assert.Error(t, err)
+}
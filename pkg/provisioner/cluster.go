@@ -52,6 +52,14 @@ type ClusterProvisioner struct {
 	ControlPlaneEndpoint string
 	// CACertHash is the CA certificate hash for secure joins
 	CACertHash string
+	// ExternalEtcd holds the etcd endpoints and API server client
+	// credentials of an external etcd topology, set once the etcd nodes
+	// are bootstrapped
+	ExternalEtcd *templates.ExternalEtcd
+	// Resume makes ProvisionCluster resume a provisioning that failed
+	// part-way: on each node, the components a previous run completed are
+	// skipped
+	Resume bool
 
 	// err holds a construction-time validation error (e.g. auth.sshConfig
 	// rejected in cluster mode, #851). NewClusterProvisioner cannot return
@@ -67,12 +75,58 @@ type NodeInfo struct {
 	Name        string
 	PublicIP    string
 	PrivateIP   string
-	Role        string    // "control-plane" or "worker"
+	Role        string    // "control-plane", "worker" or "etcd"
+	Pool        string    // worker pool name (optional)
 	SSHUsername string    // SSH username for this node (optional, falls back to ClusterProvisioner.UserName)
+	KeyPath     string    // SSH private key for this node (optional, falls back to ClusterProvisioner.KeyPath)
 	InstanceID  string    // EC2 instance ID (used by SSMTransport for private-subnet nodes)
 	Transport   Transport // Transport controls how SSH connections are established; nil falls back to DirectTransport
 }
 
+// ApplyHostInventory overlays per-host SSH settings from a bring-your-own
+// host inventory (provider "ssh") onto nodes, matching on node name: the
+// host's private key, and a bastion transport when the host sits behind a
+// jump host. Nodes are returned unchanged for any other provider.
+func ApplyHostInventory(log *logger.FunLogger, spec *v1alpha1.EnvironmentSpec, nodes []NodeInfo) []NodeInfo {
+	if spec.Provider != v1alpha1.ProviderSSH || spec.Cluster == nil {
+		return nodes
+	}
+
+	hosts := make(map[string]v1alpha1.Host)
+	inventory := append([]v1alpha1.Host{}, spec.Cluster.ControlPlane.Hosts...)
+	for _, w := range spec.Cluster.WorkerPoolList() {
+		inventory = append(inventory, w.Hosts...)
+	}
+	for _, h := range inventory {
+		name := h.Name
+		if name == "" {
+			name = h.Address
+		}
+		hosts[name] = h
+	}
+
+	out := make([]NodeInfo, 0, len(nodes))
+	for _, node := range nodes {
+		if h, ok := hosts[node.Name]; ok {
+			node.KeyPath = h.PrivateKey
+			if h.Bastion != nil {
+				userName := node.SSHUsername
+				if userName == "" {
+					userName = spec.Username
+				}
+				keyPath := node.KeyPath
+				if keyPath == "" {
+					keyPath = spec.PrivateKey
+				}
+				node.Transport = transportFromSSHConfig(node.PrivateIP, keyPath, userName,
+					&v1alpha1.SSHConfig{Bastion: h.Bastion}, log)
+			}
+		}
+		out = append(out, node)
+	}
+	return out
+}
+
 // NewClusterProvisioner creates a new cluster provisioner
 func NewClusterProvisioner(log *logger.FunLogger, keyPath, userName string, env *v1alpha1.Environment) *ClusterProvisioner {
 	cp := &ClusterProvisioner{
@@ -97,6 +151,16 @@ func (cp *ClusterProvisioner) getUsernameForNode(node NodeInfo) string {
 	return cp.UserName
 }
 
+// getKeyPathForNode returns the SSH private key path for a node, preferring
+// the per-node key if set (bring-your-own hosts), otherwise falling back to
+// the global key.
+func (cp *ClusterProvisioner) getKeyPathForNode(node NodeInfo) string {
+	if node.KeyPath != "" {
+		return node.KeyPath
+	}
+	return cp.KeyPath
+}
+
 // transportOptsForNode returns functional options for New() based on the node's transport.
 // If the node has a Transport configured, it is passed via WithTransport; otherwise
 // the default DirectTransport(PublicIP) is used automatically by New().
@@ -118,8 +182,9 @@ func hostForNode(node NodeInfo) string {
 }
 
 // ProvisionCluster provisions a multinode Kubernetes cluster
-// It follows the order: init first CP → join additional CPs → join workers
-func (cp *ClusterProvisioner) ProvisionCluster(nodes []NodeInfo) error {
+// It follows the order: bootstrap etcd (external topology) → init first CP →
+// join additional CPs → join workers
+func (cp *ClusterProvisioner) ProvisionCluster(ctx context.Context, nodes []NodeInfo) error {
 	if cp.err != nil {
 		return cp.err
 	}
@@ -127,13 +192,17 @@ func (cp *ClusterProvisioner) ProvisionCluster(nodes []NodeInfo) error {
 		return fmt.Errorf("no nodes to provision")
 	}
 
-	// Separate control-plane and worker nodes
+	// Separate control-plane, worker and dedicated etcd nodes
 	var controlPlanes []NodeInfo
 	var workers []NodeInfo
+	var etcdNodes []NodeInfo
 	for _, node := range nodes {
-		if node.Role == "control-plane" {
+		switch node.Role {
+		case "control-plane":
 			controlPlanes = append(controlPlanes, node)
-		} else {
+		case "etcd":
+			etcdNodes = append(etcdNodes, node)
+		default:
 			workers = append(workers, node)
 		}
 	}
@@ -141,20 +210,36 @@ func (cp *ClusterProvisioner) ProvisionCluster(nodes []NodeInfo) error {
 	if len(controlPlanes) == 0 {
 		return fmt.Errorf("at least one control-plane node is required")
 	}
+	if cp.Environment.Spec.Cluster.ExternalEtcd() && len(etcdNodes) == 0 {
+		return fmt.Errorf("external etcd topology requires at least one etcd node")
+	}
+
+	// Kubernetes runs on the control-plane and worker nodes only
+	k8sNodes := make([]NodeInfo, 0, len(controlPlanes)+len(workers))
+	k8sNodes = append(k8sNodes, controlPlanes...)
+	k8sNodes = append(k8sNodes, workers...)
 
 	// Determine control plane endpoint
 	// If HA with load balancer, use LB DNS; otherwise use first CP private IP
 	cp.ControlPlaneEndpoint = cp.determineControlPlaneEndpoint(controlPlanes[0])
 
-	// Phase 1: Provision base dependencies on ALL nodes in parallel
+	// Phase 1: Provision base dependencies on ALL Kubernetes nodes in parallel
 	cp.log.Info("Provisioning base dependencies on all nodes...")
-	if err := cp.provisionBaseOnAllNodes(nodes); err != nil {
+	if err := cp.provisionBaseOnAllNodes(ctx, k8sNodes); err != nil {
 		return fmt.Errorf("failed to provision base dependencies: %w", err)
 	}
 
+	// Phase 1b: Bootstrap the external etcd cluster (if any)
+	if len(etcdNodes) > 0 {
+		cp.log.Info("Bootstrapping external etcd on %d node(s)...", len(etcdNodes))
+		if err := cp.provisionEtcd(ctx, etcdNodes); err != nil {
+			return fmt.Errorf("failed to bootstrap etcd: %w", err)
+		}
+	}
+
 	// Phase 2: Initialize first control-plane node
 	cp.log.Info("Initializing first control-plane node: %s", controlPlanes[0].Name)
-	if err := cp.initFirstControlPlane(controlPlanes[0]); err != nil {
+	if err := cp.initFirstControlPlane(ctx, controlPlanes[0]); err != nil {
 		return fmt.Errorf("failed to initialize first control-plane: %w", err)
 	}
 
@@ -162,7 +247,7 @@ func (cp *ClusterProvisioner) ProvisionCluster(nodes []NodeInfo) error {
 	if len(controlPlanes) > 1 {
 		for i := 1; i < len(controlPlanes); i++ {
 			cp.log.Info("Joining control-plane node: %s", controlPlanes[i].Name)
-			if err := cp.joinControlPlane(controlPlanes[i]); err != nil {
+			if err := cp.joinControlPlane(ctx, controlPlanes[i]); err != nil {
 				return fmt.Errorf("failed to join control-plane %s: %w", controlPlanes[i].Name, err)
 			}
 		}
@@ -171,14 +256,14 @@ func (cp *ClusterProvisioner) ProvisionCluster(nodes []NodeInfo) error {
 	// Phase 4: Join worker nodes
 	for _, worker := range workers {
 		cp.log.Info("Joining worker node: %s", worker.Name)
-		if err := cp.joinWorker(worker); err != nil {
+		if err := cp.joinWorker(ctx, worker); err != nil {
 			return fmt.Errorf("failed to join worker %s: %w", worker.Name, err)
 		}
 	}
 
 	// Phase 5: Configure node roles, labels, and taints
 	cp.log.Info("Configuring node roles and labels...")
-	if err := cp.configureNodes(controlPlanes[0], nodes); err != nil {
+	if err := cp.configureNodes(ctx, controlPlanes[0], k8sNodes); err != nil {
 		return fmt.Errorf("failed to configure nodes: %w", err)
 	}
 
@@ -202,34 +287,13 @@ func (cp *ClusterProvisioner) determineControlPlaneEndpoint(firstCP NodeInfo) st
 
 // provisionBaseOnAllNodes provisions base dependencies (kernel, driver, runtime, toolkit)
 // on all nodes in parallel before Kubernetes installation
-func (cp *ClusterProvisioner) provisionBaseOnAllNodes(nodes []NodeInfo) error {
+func (cp *ClusterProvisioner) provisionBaseOnAllNodes(ctx context.Context, nodes []NodeInfo) error {
 	// Phase 1: Provision base dependencies in parallel
-	g, _ := errgroup.WithContext(context.Background())
+	g, gctx := errgroup.WithContext(ctx)
 	for _, node := range nodes {
 		node := node // capture loop variable
 		g.Go(func() error {
-			cp.log.Info("Provisioning base dependencies on %s (%s)", node.Name, node.PublicIP)
-
-			provisioner, err := New(cp.log, cp.KeyPath, cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
-			if err != nil {
-				return fmt.Errorf("failed to connect to %s: %w", node.Name, err)
-			}
-
-			// Create a modified environment without Kubernetes install
-			envCopy := cp.Environment.DeepCopy()
-			envCopy.Spec.Kubernetes.Install = false
-
-			if _, err := provisioner.Run(*envCopy); err != nil {
-				if provisioner.Client != nil {
-					_ = provisioner.Client.Close()
-				}
-				return fmt.Errorf("failed to provision base on %s: %w", node.Name, err)
-			}
-			// Client may be nil after Run() if node rebooted
-			if provisioner.Client != nil {
-				_ = provisioner.Client.Close()
-			}
-			return nil
+			return cp.provisionBase(gctx, node)
 		})
 	}
 	if err := g.Wait(); err != nil {
@@ -238,21 +302,55 @@ func (cp *ClusterProvisioner) provisionBaseOnAllNodes(nodes []NodeInfo) error {
 
 	// Phase 2: Install K8s prerequisites in parallel
 	cp.log.Info("Installing Kubernetes prerequisites on all nodes...")
-	g2, _ := errgroup.WithContext(context.Background())
+	g2, gctx2 := errgroup.WithContext(ctx)
 	for _, node := range nodes {
 		node := node // capture loop variable
 		g2.Go(func() error {
-			return cp.installK8sPrereqs(node)
+			return cp.installK8sPrereqs(gctx2, node)
 		})
 	}
 	return g2.Wait()
 }
 
+// provisionBase runs the provisioner on a node with the Kubernetes install
+// left out, installing the kernel, driver, runtime and toolkit
+func (cp *ClusterProvisioner) provisionBase(ctx context.Context, node NodeInfo) error {
+	cp.log.Info("Provisioning base dependencies on %s (%s)", node.Name, node.PublicIP)
+
+	opts := cp.transportOptsForNode(node)
+	if cp.Resume {
+		opts = append(opts, WithResume())
+	}
+	provisioner, err := New(ctx, cp.log, cp.getKeyPathForNode(node), cp.getUsernameForNode(node), hostForNode(node), opts...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", node.Name, err)
+	}
+
+	// Create a modified environment without Kubernetes install
+	envCopy := cp.Environment.DeepCopy()
+	envCopy.Spec.Kubernetes.Install = false
+
+	if _, err := provisioner.Run(ctx, *envCopy); err != nil {
+		if provisioner.Client != nil {
+			_ = provisioner.Client.Close()
+		}
+		return fmt.Errorf("failed to provision base on %s: %w", node.Name, err)
+	}
+	// Client may be nil after Run() if node rebooted
+	if provisioner.Client != nil {
+		_ = provisioner.Client.Close()
+	}
+	return nil
+}
+
 // installK8sPrereqs installs Kubernetes binaries on a node
-func (cp *ClusterProvisioner) installK8sPrereqs(node NodeInfo) error {
+func (cp *ClusterProvisioner) installK8sPrereqs(ctx context.Context, node NodeInfo) error {
+	if done, err := cp.completedOn(ctx, node, kubeadmPrereqComponent); err != nil || done {
+		return err
+	}
 	cp.log.Info("Installing K8s binaries on %s (%s)", node.Name, node.PublicIP)
 
-	provisioner, err := New(cp.log, cp.KeyPath, cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
+	provisioner, err := New(ctx, cp.log, cp.getKeyPathForNode(node), cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to %s: %w", node.Name, err)
 	}
@@ -315,8 +413,13 @@ func (cp *ClusterProvisioner) installK8sPrereqs(node NodeInfo) error {
 }
 
 // initFirstControlPlane initializes the first control-plane node with kubeadm init
-func (cp *ClusterProvisioner) initFirstControlPlane(node NodeInfo) error {
-	provisioner, err := New(cp.log, cp.KeyPath, cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
+func (cp *ClusterProvisioner) initFirstControlPlane(ctx context.Context, node NodeInfo) error {
+	initialized, err := cp.completedOn(ctx, node, kubeadmInitComponent)
+	if err != nil {
+		return err
+	}
+
+	provisioner, err := New(ctx, cp.log, cp.getKeyPathForNode(node), cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to %s: %w", node.Name, err)
 	}
@@ -325,30 +428,34 @@ func (cp *ClusterProvisioner) initFirstControlPlane(node NodeInfo) error {
 	// Set the endpoint host for kubeadm config
 	cp.Environment.Spec.Kubernetes.K8sEndpointHost = cp.ControlPlaneEndpoint
 
-	// Generate the init script
-	var tpl bytes.Buffer
-	if err := addScriptHeader(&tpl); err != nil {
-		return fmt.Errorf("failed to add script header: %w", err)
-	}
+	if !initialized {
+		// Generate the init script
+		var tpl bytes.Buffer
+		if err := addScriptHeader(&tpl); err != nil {
+			return fmt.Errorf("failed to add script header: %w", err)
+		}
 
-	// Generate kubeadm init script with certificate key for HA
-	initConfig := templates.KubeadmInitConfig{
-		Environment:          cp.Environment,
-		ControlPlaneEndpoint: cp.ControlPlaneEndpoint,
-		IsHA:                 cp.isHAEnabled(),
-	}
+		// Generate kubeadm init script with certificate key for HA
+		initConfig := templates.KubeadmInitConfig{
+			Environment:          cp.Environment,
+			ControlPlaneEndpoint: cp.ControlPlaneEndpoint,
+			IsHA:                 cp.isHAEnabled(),
+			ExternalEtcd:         cp.ExternalEtcd,
+		}
 
-	if err := initConfig.Execute(&tpl); err != nil {
-		return fmt.Errorf("failed to generate kubeadm init script: %w", err)
-	}
+		if err := initConfig.Execute(&tpl); err != nil {
+			return fmt.Errorf("failed to generate kubeadm init script: %w", err)
+		}
 
-	// Run the init script
-	provisioner.tpl = tpl
-	if err := provisioner.provision(); err != nil {
-		return fmt.Errorf("failed to run kubeadm init: %w", err)
+		// Run the init script
+		provisioner.tpl = tpl
+		if err := provisioner.provision(ctx); err != nil {
+			return fmt.Errorf("failed to run kubeadm init: %w", err)
+		}
 	}
 
-	// Extract join information from the first control-plane
+	// Extract join information from the first control-plane, also when
+	// resuming since joins need fresh credentials
 	if err := cp.extractJoinInfo(provisioner); err != nil {
 		return fmt.Errorf("failed to extract join info: %w", err)
 	}
@@ -368,13 +475,20 @@ func (cp *ClusterProvisioner) extractJoinInfo(provisioner *Provisioner) error {
 	}
 	defer func() { _ = session.Close() }()
 
+	// With external etcd, upload-certs needs the init configuration to
+	// include the API server's etcd client certificates in the bundle
+	uploadArgs := "--upload-certs"
+	if cp.Environment.Spec.Cluster.ExternalEtcd() {
+		uploadArgs += " --config=/etc/kubernetes/kubeadm-init.yaml"
+	}
+
 	script := `set -e
 TOKEN=$(sudo kubeadm token create --ttl 2h)
 HASH=$(openssl x509 -pubkey -in /etc/kubernetes/pki/ca.crt | openssl rsa -pubin -outform der 2>/dev/null | openssl dgst -sha256 -hex | sed 's/^.* //')
 CERTKEY=""
 ` + fmt.Sprintf(`if [ "%t" = "true" ]; then
-  CERTKEY=$(sudo kubeadm init phase upload-certs --upload-certs 2>/dev/null | tail -1)
-fi`, cp.isHAEnabled()) + `
+  CERTKEY=$(sudo kubeadm init phase upload-certs %s 2>/dev/null | tail -1)
+fi`, cp.isHAEnabled(), uploadArgs) + `
 printf '%s\n%s\n%s' "$TOKEN" "$HASH" "$CERTKEY"
 `
 
@@ -407,8 +521,12 @@ printf '%s\n%s\n%s' "$TOKEN" "$HASH" "$CERTKEY"
 }
 
 // joinControlPlane joins an additional control-plane node to the cluster
-func (cp *ClusterProvisioner) joinControlPlane(node NodeInfo) error {
-	provisioner, err := New(cp.log, cp.KeyPath, cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
+func (cp *ClusterProvisioner) joinControlPlane(ctx context.Context, node NodeInfo) error {
+	if done, err := cp.completedOn(ctx, node, kubeadmJoinComponent); err != nil || done {
+		return err
+	}
+
+	provisioner, err := New(ctx, cp.log, cp.getKeyPathForNode(node), cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to %s: %w", node.Name, err)
 	}
@@ -434,7 +552,7 @@ func (cp *ClusterProvisioner) joinControlPlane(node NodeInfo) error {
 
 	// Run the join script
 	provisioner.tpl = tpl
-	if err := provisioner.provision(); err != nil {
+	if err := provisioner.provision(ctx); err != nil {
 		return fmt.Errorf("failed to run kubeadm join: %w", err)
 	}
 
@@ -442,8 +560,12 @@ func (cp *ClusterProvisioner) joinControlPlane(node NodeInfo) error {
 }
 
 // joinWorker joins a worker node to the cluster
-func (cp *ClusterProvisioner) joinWorker(node NodeInfo) error {
-	provisioner, err := New(cp.log, cp.KeyPath, cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
+func (cp *ClusterProvisioner) joinWorker(ctx context.Context, node NodeInfo) error {
+	if done, err := cp.completedOn(ctx, node, kubeadmJoinComponent); err != nil || done {
+		return err
+	}
+
+	provisioner, err := New(ctx, cp.log, cp.getKeyPathForNode(node), cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to %s: %w", node.Name, err)
 	}
@@ -468,7 +590,7 @@ func (cp *ClusterProvisioner) joinWorker(node NodeInfo) error {
 
 	// Run the join script
 	provisioner.tpl = tpl
-	if err := provisioner.provision(); err != nil {
+	if err := provisioner.provision(ctx); err != nil {
 		return fmt.Errorf("failed to run kubeadm join: %w", err)
 	}
 
@@ -484,8 +606,8 @@ func (cp *ClusterProvisioner) isHAEnabled() bool {
 
 // configureNodes applies labels, taints, and roles to all cluster nodes
 // This is run from the first control-plane node after all nodes have joined
-func (cp *ClusterProvisioner) configureNodes(firstCP NodeInfo, nodes []NodeInfo) error {
-	provisioner, err := New(cp.log, cp.KeyPath, cp.getUsernameForNode(firstCP), hostForNode(firstCP), cp.transportOptsForNode(firstCP)...)
+func (cp *ClusterProvisioner) configureNodes(ctx context.Context, firstCP NodeInfo, nodes []NodeInfo) error {
+	provisioner, err := New(ctx, cp.log, cp.getKeyPathForNode(firstCP), cp.getUsernameForNode(firstCP), hostForNode(firstCP), cp.transportOptsForNode(firstCP)...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to %s: %w", firstCP.Name, err)
 	}
@@ -551,13 +673,13 @@ func (cp *ClusterProvisioner) configureNodes(firstCP NodeInfo, nodes []NodeInfo)
 	}
 
 	// Configure worker nodes
-	cp.log.Info("Configuring worker node labels...")
-	workerLabels := cp.getWorkerLabels()
+	cp.log.Info("Configuring worker node labels and taints...")
 
 	for _, node := range nodes {
 		if node.Role != "worker" {
 			continue
 		}
+		workerLabels := cp.getWorkerLabels(node.Pool)
 
 		fmt.Fprintf(&script, "echo 'Configuring worker node with IP %s...'\n", node.PrivateIP)
 
@@ -573,6 +695,13 @@ func (cp *ClusterProvisioner) configureNodes(firstCP NodeInfo, nodes []NodeInfo)
 			fmt.Fprintf(&script, "  sudo -E kubectl label node \"$WORKER_NODE\" %s=%s --overwrite\n", key, value)
 		}
 
+		// Apply worker pool taints
+		if pool := cp.workerPool(node.Pool); pool != nil {
+			for _, t := range pool.Taints {
+				fmt.Fprintf(&script, "  sudo -E kubectl taint node \"$WORKER_NODE\" %s --overwrite\n", taintArg(t))
+			}
+		}
+
 		script.WriteString("fi\n\n")
 	}
 
@@ -618,18 +747,20 @@ func (cp *ClusterProvisioner) getControlPlaneLabels() map[string]string {
 	return labels
 }
 
-// getWorkerLabels returns labels to apply to worker nodes
-func (cp *ClusterProvisioner) getWorkerLabels() map[string]string {
+// getWorkerLabels returns labels to apply to the worker nodes of pool, ""
+// for the unnamed spec.cluster.workers pool
+func (cp *ClusterProvisioner) getWorkerLabels(pool string) map[string]string {
 	labels := make(map[string]string)
 
 	// Default labels
 	labels["nvidia.com/holodeck.role"] = "worker"
+	if pool != "" {
+		labels["nvidia.com/holodeck.pool"] = pool
+	}
 
 	// Add custom labels from spec
-	if cp.Environment.Spec.Cluster != nil &&
-		cp.Environment.Spec.Cluster.Workers != nil &&
-		cp.Environment.Spec.Cluster.Workers.Labels != nil {
-		for k, v := range cp.Environment.Spec.Cluster.Workers.Labels {
+	if wp := cp.workerPool(pool); wp != nil {
+		for k, v := range wp.Labels {
 			labels[k] = v
 		}
 	}
@@ -637,6 +768,19 @@ func (cp *ClusterProvisioner) getWorkerLabels() map[string]string {
 	return labels
 }
 
+// taintArg formats t as a kubectl taint argument
+func taintArg(t v1alpha1.Taint) string {
+	if t.Value == "" {
+		return t.Key + ":" + t.Effect
+	}
+	return t.Key + "=" + t.Value + ":" + t.Effect
+}
+
+// workerPool returns the worker pool named pool, or nil if there is none
+func (cp *ClusterProvisioner) workerPool(pool string) *v1alpha1.WorkerPoolSpec {
+	return cp.Environment.Spec.Cluster.WorkerPool(pool)
+}
+
 // isControlPlaneDedicated returns true if control-plane nodes should be dedicated
 // (i.e., keep the NoSchedule taint to prevent workload scheduling)
 func (cp *ClusterProvisioner) isControlPlaneDedicated() bool {
@@ -670,7 +814,7 @@ type NodeHealth struct {
 
 // GetClusterHealth checks the health of a multinode cluster by querying the first control-plane.
 // firstCPHost is the SSH-reachable address — PublicIP for direct SSH, PrivateIP for SSM transport.
-func (cp *ClusterProvisioner) GetClusterHealth(firstCPHost string) (*ClusterHealth, error) {
+func (cp *ClusterProvisioner) GetClusterHealth(ctx context.Context, firstCPHost string) (*ClusterHealth, error) {
 	if cp.err != nil {
 		return nil, cp.err
 	}
@@ -687,21 +831,25 @@ func (cp *ClusterProvisioner) GetClusterHealth(firstCPHost string) (*ClusterHeal
 		}
 	}
 	// Build transport options from node info in environment status
+	keyPath := cp.KeyPath
 	var transportOpts []Option
 	if cp.Environment != nil && cp.Environment.Status.Cluster != nil {
 		for _, node := range cp.Environment.Status.Cluster.Nodes {
 			if node.PublicIP == firstCPHost || node.PrivateIP == firstCPHost {
 				nodeInfo := NodeInfo{
+					Name:       node.Name,
 					PublicIP:   node.PublicIP,
 					PrivateIP:  node.PrivateIP,
 					InstanceID: node.InstanceID,
 				}
+				nodeInfo = ApplyHostInventory(cp.log, &cp.Environment.Spec, []NodeInfo{nodeInfo})[0]
+				keyPath = cp.getKeyPathForNode(nodeInfo)
 				transportOpts = cp.transportOptsForNode(nodeInfo)
 				break
 			}
 		}
 	}
-	provisioner, err := New(cp.log, cp.KeyPath, username, firstCPHost, transportOpts...)
+	provisioner, err := New(ctx, cp.log, keyPath, username, firstCPHost, transportOpts...)
 	if err != nil {
 		return &ClusterHealth{
 			Healthy: false,
@@ -806,7 +954,7 @@ func (cp *ClusterProvisioner) GetClusterHealth(firstCPHost string) (*ClusterHeal
 }
 
 // GetClusterHealthFromEnv gets cluster health using environment configuration
-func GetClusterHealthFromEnv(log *logger.FunLogger, env *v1alpha1.Environment) (*ClusterHealth, error) {
+func GetClusterHealthFromEnv(ctx context.Context, log *logger.FunLogger, env *v1alpha1.Environment) (*ClusterHealth, error) {
 	if env.Spec.Cluster == nil || env.Status.Cluster == nil {
 		return nil, fmt.Errorf("not a multinode cluster")
 	}
@@ -829,7 +977,7 @@ func GetClusterHealthFromEnv(log *logger.FunLogger, env *v1alpha1.Environment) (
 	}
 
 	cp := NewClusterProvisioner(log, env.Spec.PrivateKey, env.Spec.Username, env)
-	return cp.GetClusterHealth(firstCPHost)
+	return cp.GetClusterHealth(ctx, firstCPHost)
 }
 
 // Note: addScriptHeader is defined in provisioner.go
@@ -17,6 +17,7 @@
 package provisioner
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -216,6 +217,7 @@ func TestClusterProvisioner_getWorkerLabels(t *testing.T) {
 	tests := []struct {
 		name     string
 		env      *v1alpha1.Environment
+		pool     string
 		expected map[string]string
 	}{
 		{
@@ -265,17 +267,60 @@ func TestClusterProvisioner_getWorkerLabels(t *testing.T) {
 				"environment":              "production",
 			},
 		},
+		{
+			name: "Named pool labels",
+			env: &v1alpha1.Environment{
+				Spec: v1alpha1.EnvironmentSpec{
+					Cluster: &v1alpha1.ClusterSpec{
+						WorkerPools: []v1alpha1.WorkerPoolSpec{
+							{Name: "cpu", Count: 2, Labels: map[string]string{"tier": "cpu"}},
+							{Name: "gpu", Count: 1, Labels: map[string]string{"gpu": "true"}},
+						},
+					},
+				},
+			},
+			pool: "gpu",
+			expected: map[string]string{
+				"nvidia.com/holodeck.role": "worker",
+				"nvidia.com/holodeck.pool": "gpu",
+				"gpu":                      "true",
+			},
+		},
+		{
+			name: "Unknown pool",
+			env: &v1alpha1.Environment{
+				Spec: v1alpha1.EnvironmentSpec{
+					Cluster: &v1alpha1.ClusterSpec{
+						WorkerPools: []v1alpha1.WorkerPoolSpec{
+							{Name: "cpu", Count: 2, Labels: map[string]string{"tier": "cpu"}},
+						},
+					},
+				},
+			},
+			pool: "gpu",
+			expected: map[string]string{
+				"nvidia.com/holodeck.role": "worker",
+				"nvidia.com/holodeck.pool": "gpu",
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cp := NewClusterProvisioner(log, "", "", tt.env)
-			labels := cp.getWorkerLabels()
+			labels := cp.getWorkerLabels(tt.pool)
 			assert.Equal(t, tt.expected, labels)
 		})
 	}
 }
 
+func TestTaintArg(t *testing.T) {
+	assert.Equal(t, "nvidia.com/gpu=present:NoSchedule",
+		taintArg(v1alpha1.Taint{Key: "nvidia.com/gpu", Value: "present", Effect: "NoSchedule"}))
+	assert.Equal(t, "dedicated:NoExecute",
+		taintArg(v1alpha1.Taint{Key: "dedicated", Effect: "NoExecute"}))
+}
+
 func TestClusterProvisioner_determineControlPlaneEndpoint(t *testing.T) {
 	log := logger.NewLogger()
 
@@ -375,7 +420,7 @@ func TestClusterProvisioner_ProvisionCluster_Validation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cp := NewClusterProvisioner(log, "/fake/key", "ubuntu", env)
-			err := cp.ProvisionCluster(tt.nodes)
+			err := cp.ProvisionCluster(context.Background(), tt.nodes)
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.errMsg)
@@ -570,7 +615,7 @@ func TestClusterProvisioner_ProvisionCluster_RejectsSSHConfigInClusterMode(t *te
 	}
 	cp := NewClusterProvisioner(log, "/path/to/key", "ubuntu", env)
 
-	err := cp.ProvisionCluster(nil)
+	err := cp.ProvisionCluster(context.Background(), nil)
 	require.Error(t, err)
 	assert.Equal(t, wantSSHConfigClusterModeErr, err.Error())
 }
@@ -589,7 +634,7 @@ func TestClusterProvisioner_GetClusterHealth_RejectsSSHConfigInClusterMode(t *te
 	}
 	cp := NewClusterProvisioner(log, "/path/to/key", "ubuntu", env)
 
-	health, err := cp.GetClusterHealth("198.51.100.10")
+	health, err := cp.GetClusterHealth(context.Background(), "198.51.100.10")
 	assert.Nil(t, health)
 	require.Error(t, err)
 	assert.Equal(t, wantSSHConfigClusterModeErr, err.Error())
@@ -50,6 +50,21 @@ var (
 		containerToolkitInstaller: containerToolkit,
 		kernelInstaller:           kernel,
 	}
+
+	// stateComponents maps each built-in dependency to the components its
+	// scripts record in the host state directory once installed, which
+	// depend on the install source for Kubernetes.
+	stateComponents = map[string][]string{
+		kubeadmInstaller:          {"kubernetes-kubeadm", "kubernetes-kubeadm-git", "kubernetes-kubeadm-latest"},
+		kindInstaller:             {"kubernetes-kind", "kubernetes-kind-git", "kubernetes-kind-latest"},
+		microk8sInstaller:         {"kubernetes-microk8s"},
+		containerdRuntime:         {"containerd"},
+		crioRuntime:               {"crio"},
+		dockerRuntime:             {"docker"},
+		nvdriverInstaller:         {"nvidia-driver"},
+		containerToolkitInstaller: {"nvidia-container-toolkit"},
+		kernelInstaller:           {"kernel"},
+	}
 )
 
 type ProvisionFunc func(tpl *bytes.Buffer, env v1alpha1.Environment) error
@@ -241,6 +256,13 @@ type DependencyResolver struct {
 	Dependencies []ProvisionFunc
 	env          *v1alpha1.Environment
 	baseDir      string
+	skipped      []string
+	// names holds the name of each entry of Dependencies
+	names []string
+	// installed holds the components recorded as installed on the host
+	// when resuming, nil otherwise
+	installed map[string]bool
+	completed []string
 }
 
 // DependencyConfigurator defines methods for configuring dependencies
@@ -261,46 +283,53 @@ func NewDependencies(env *v1alpha1.Environment) *DependencyResolver {
 	}
 }
 
+// add appends the named built-in dependency.
+func (d *DependencyResolver) add(name string) {
+	d.Dependencies = append(d.Dependencies, functions[name])
+	d.names = append(d.names, name)
+}
+
 func (d *DependencyResolver) withKubernetes() {
 	switch d.env.Spec.Kubernetes.KubernetesInstaller {
 	case kubeadmInstaller:
-		d.Dependencies = append(d.Dependencies, functions[kubeadmInstaller])
+		d.add(kubeadmInstaller)
 	case kindInstaller:
-		d.Dependencies = append(d.Dependencies, functions[kindInstaller])
+		d.add(kindInstaller)
 	case microk8sInstaller:
 		// reset the list to only include microk8s
 		d.Dependencies = nil
-		d.Dependencies = append(d.Dependencies, functions[microk8sInstaller])
+		d.names = nil
+		d.add(microk8sInstaller)
 	default:
 		// default to kubeadm if KubernetesInstaller is empty
-		d.Dependencies = append(d.Dependencies, functions[kubeadmInstaller])
+		d.add(kubeadmInstaller)
 	}
 }
 
 func (d *DependencyResolver) withContainerRuntime() {
 	switch d.env.Spec.ContainerRuntime.Name {
 	case containerdRuntime:
-		d.Dependencies = append(d.Dependencies, functions[containerdRuntime])
+		d.add(containerdRuntime)
 	case crioRuntime:
-		d.Dependencies = append(d.Dependencies, functions[crioRuntime])
+		d.add(crioRuntime)
 	case dockerRuntime:
-		d.Dependencies = append(d.Dependencies, functions[dockerRuntime])
+		d.add(dockerRuntime)
 	default:
 		// default to containerd if ContainerRuntime.Name is empty
-		d.Dependencies = append(d.Dependencies, functions[containerdRuntime])
+		d.add(containerdRuntime)
 	}
 }
 
 func (d *DependencyResolver) withContainerToolkit() {
-	d.Dependencies = append(d.Dependencies, functions[containerToolkitInstaller])
+	d.add(containerToolkitInstaller)
 }
 
 func (d *DependencyResolver) withNVDriver() {
-	d.Dependencies = append(d.Dependencies, functions[nvdriverInstaller])
+	d.add(nvdriverInstaller)
 }
 
 func (d *DependencyResolver) withKernel() {
-	d.Dependencies = append(d.Dependencies, functions[kernelInstaller])
+	d.add(kernelInstaller)
 }
 
 // SetBaseDir sets the base directory for resolving relative file paths in custom templates.
@@ -324,6 +353,7 @@ func (d *DependencyResolver) addCustomTemplates(phase v1alpha1.TemplatePhase) {
 		d.Dependencies = append(d.Dependencies, func(buf *bytes.Buffer, env v1alpha1.Environment) error {
 			return d.executeCustomTemplate(buf, tpl)
 		})
+		d.names = append(d.names, templates.CustomTemplateComponent(tpl.Name))
 	}
 }
 
@@ -362,6 +392,75 @@ func (d *DependencyResolver) ensureKindCompatibleDocker() {
 	}
 }
 
+// preinstalled reports whether status.imageComponents records the component
+// as baked into the golden image with the provenance the spec asks for.
+// status.components is not consulted: every provisioning run records it, and
+// a rerun such as update --reprovision must install again. Kubernetes is
+// never skipped: its installer reuses the binaries it finds, but cluster
+// bootstrap is specific to each instance.
+func (d *DependencyResolver) preinstalled(component func(*v1alpha1.ComponentsStatus) *v1alpha1.ComponentProvenance) bool {
+	if d.env.Status.ImageComponents == nil {
+		return false
+	}
+	wanted := BuildComponentsStatus(*d.env)
+	if wanted == nil {
+		return false
+	}
+	return provenanceMatches(component(d.env.Status.ImageComponents), component(wanted))
+}
+
+// Names returns the name of each dependency Resolve returned, in the same
+// order: the installer name for built-in components and "custom-<name>" for
+// custom templates.
+func (d *DependencyResolver) Names() []string {
+	return d.names
+}
+
+// Skipped returns the components that Resolve left out because they are
+// already installed.
+func (d *DependencyResolver) Skipped() []string {
+	return d.skipped
+}
+
+// Resume makes Resolve start at the first dependency that a previous run
+// did not complete, given the components the host state directory records
+// as installed. The dependencies before it are left out.
+func (d *DependencyResolver) Resume(installed map[string]bool) {
+	d.installed = installed
+}
+
+// Completed returns the names of the dependencies that Resolve left out
+// because a previous run completed them.
+func (d *DependencyResolver) Completed() []string {
+	return d.completed
+}
+
+// isCompleted reports whether the host records the named dependency as
+// installed. Custom templates record themselves under their own name.
+func (d *DependencyResolver) isCompleted(name string) bool {
+	components, ok := stateComponents[name]
+	if !ok {
+		return d.installed[name]
+	}
+	for _, component := range components {
+		if d.installed[component] {
+			return true
+		}
+	}
+	return false
+}
+
+// skipCompleted drops the leading dependencies a previous run completed.
+func (d *DependencyResolver) skipCompleted() {
+	n := 0
+	for n < len(d.names) && d.isCompleted(d.names[n]) {
+		n++
+	}
+	d.completed = append(d.completed, d.names[:n]...)
+	d.Dependencies = d.Dependencies[n:]
+	d.names = d.names[n:]
+}
+
 // Resolve returns the dependency list in the correct order
 func (d *DependencyResolver) Resolve() []ProvisionFunc {
 	// Phase: pre-install (before any Holodeck components)
@@ -374,7 +473,11 @@ func (d *DependencyResolver) Resolve() []ProvisionFunc {
 
 	// Add NVDriver to the list
 	if d.env.Spec.NVIDIADriver.Install {
-		d.withNVDriver()
+		if d.preinstalled(func(cs *v1alpha1.ComponentsStatus) *v1alpha1.ComponentProvenance { return cs.Driver }) {
+			d.skipped = append(d.skipped, "NVIDIA driver")
+		} else {
+			d.withNVDriver()
+		}
 	}
 
 	// Ensure compatible Docker version for KIND source builds
@@ -382,7 +485,11 @@ func (d *DependencyResolver) Resolve() []ProvisionFunc {
 
 	// Add Container Runtime to the list
 	if d.env.Spec.ContainerRuntime.Install {
-		d.withContainerRuntime()
+		if d.preinstalled(func(cs *v1alpha1.ComponentsStatus) *v1alpha1.ComponentProvenance { return cs.Runtime }) {
+			d.skipped = append(d.skipped, "container runtime")
+		} else {
+			d.withContainerRuntime()
+		}
 	}
 
 	// Phase: post-runtime (after container runtime installation)
@@ -390,7 +497,11 @@ func (d *DependencyResolver) Resolve() []ProvisionFunc {
 
 	// Add Container Toolkit to the list
 	if d.env.Spec.NVIDIAContainerToolkit.Install {
-		d.withContainerToolkit()
+		if d.preinstalled(func(cs *v1alpha1.ComponentsStatus) *v1alpha1.ComponentProvenance { return cs.Toolkit }) {
+			d.skipped = append(d.skipped, "NVIDIA Container Toolkit")
+		} else {
+			d.withContainerToolkit()
+		}
 	}
 
 	// Phase: post-toolkit (after NVIDIA Container Toolkit installation)
@@ -407,5 +518,9 @@ func (d *DependencyResolver) Resolve() []ProvisionFunc {
 	// Phase: post-install (after all Holodeck components)
 	d.addCustomTemplates(v1alpha1.TemplatePhasePostInstall)
 
+	if d.installed != nil {
+		d.skipCompleted()
+	}
+
 	return d.Dependencies
 }
@@ -122,6 +122,63 @@ var _ = Describe("DependencyResolver", func() {
 			})
 		})
 
+		Context("with components recorded on the image", func() {
+			var env v1alpha1.Environment
+
+			BeforeEach(func() {
+				env = v1alpha1.Environment{
+					Spec: v1alpha1.EnvironmentSpec{
+						NVIDIADriver: v1alpha1.NVIDIADriver{
+							Install: true,
+							Branch:  "575",
+						},
+						ContainerRuntime: v1alpha1.ContainerRuntime{
+							Install: true,
+							Name:    v1alpha1.ContainerRuntimeContainerd,
+							Version: "1.7.27",
+						},
+						Kubernetes: v1alpha1.Kubernetes{
+							Install:           true,
+							KubernetesVersion: "v1.33.0",
+						},
+					},
+				}
+				// As recorded by a golden image baked from the same spec
+				env.Status.ImageComponents = provisioner.BuildComponentsStatus(env)
+				env.Status.ImageComponents.Driver.Commit = "ignored"
+			})
+
+			It("should skip components whose provenance matches", func() {
+				d := provisioner.NewDependencies(&env)
+				deps := d.Resolve()
+				Expect(deps).To(HaveLen(1))
+				Expect(d.Skipped()).To(ConsistOf("NVIDIA driver", "container runtime"))
+			})
+
+			It("should install components whose provenance differs", func() {
+				env.Spec.ContainerRuntime.Version = "2.0.0"
+				d := provisioner.NewDependencies(&env)
+				deps := d.Resolve()
+				Expect(deps).To(HaveLen(2))
+				Expect(d.Skipped()).To(ConsistOf("NVIDIA driver"))
+			})
+
+			It("should install components missing from the record", func() {
+				env.Status.ImageComponents.Runtime = nil
+				d := provisioner.NewDependencies(&env)
+				Expect(d.Resolve()).To(HaveLen(2))
+				Expect(d.Skipped()).To(ConsistOf("NVIDIA driver"))
+			})
+
+			It("should install components recorded only by a previous run", func() {
+				env.Status.Components = env.Status.ImageComponents
+				env.Status.ImageComponents = nil
+				d := provisioner.NewDependencies(&env)
+				Expect(d.Resolve()).To(HaveLen(3))
+				Expect(d.Skipped()).To(BeEmpty())
+			})
+		})
+
 		Context("with Kernel", func() {
 			It("should add kernel dependency", func() {
 				env := v1alpha1.Environment{
@@ -165,6 +222,7 @@ var _ = Describe("DependencyResolver", func() {
 
 				// Expected order: kernel, nvdriver, containerd, toolkit, kubeadm
 				Expect(deps).To(HaveLen(5))
+				Expect(d.Names()).To(Equal([]string{"kernel", "nvdriver", "containerd", "containerToolkit", "kubeadm"}))
 			})
 		})
 
@@ -187,6 +245,67 @@ var _ = Describe("DependencyResolver", func() {
 
 				// microk8s resets the list, so only 1 dependency
 				Expect(deps).To(HaveLen(1))
+				Expect(d.Names()).To(Equal([]string{"microk8s"}))
+			})
+		})
+
+		Context("when resuming", func() {
+			var env v1alpha1.Environment
+
+			BeforeEach(func() {
+				env = v1alpha1.Environment{
+					Spec: v1alpha1.EnvironmentSpec{
+						NVIDIADriver: v1alpha1.NVIDIADriver{Install: true},
+						ContainerRuntime: v1alpha1.ContainerRuntime{
+							Install: true,
+							Name:    v1alpha1.ContainerRuntimeContainerd,
+						},
+						NVIDIAContainerToolkit: v1alpha1.NVIDIAContainerToolkit{Install: true},
+						Kubernetes: v1alpha1.Kubernetes{
+							Install:             true,
+							KubernetesInstaller: "kubeadm",
+						},
+						CustomTemplates: []v1alpha1.CustomTemplate{
+							{Name: "motd", Phase: v1alpha1.TemplatePhasePostRuntime, Inline: "echo hi"},
+						},
+					},
+				}
+			})
+
+			It("should start at the first dependency that did not complete", func() {
+				d := provisioner.NewDependencies(&env)
+				d.Resume(map[string]bool{
+					"nvidia-driver":      true,
+					"containerd":         true,
+					"custom-motd":        true,
+					"kubernetes-kubeadm": true,
+				})
+				deps := d.Resolve()
+
+				// kubeadm completed out of order, so it runs again after the toolkit
+				Expect(deps).To(HaveLen(2))
+				Expect(d.Names()).To(Equal([]string{"containerToolkit", "kubeadm"}))
+				Expect(d.Completed()).To(Equal([]string{"nvdriver", "containerd", "custom-motd"}))
+			})
+
+			It("should match the state recorded for each install source", func() {
+				d := provisioner.NewDependencies(&env)
+				d.Resume(map[string]bool{
+					"nvidia-driver":            true,
+					"containerd":               true,
+					"custom-motd":              true,
+					"nvidia-container-toolkit": true,
+					"kubernetes-kubeadm-git":   true,
+				})
+				Expect(d.Resolve()).To(BeEmpty())
+				Expect(d.Completed()).To(HaveLen(5))
+			})
+
+			It("should run everything when nothing completed", func() {
+				d := provisioner.NewDependencies(&env)
+				d.Resume(map[string]bool{})
+				Expect(d.Resolve()).To(HaveLen(5))
+				Expect(d.Completed()).To(BeEmpty())
 			})
 		})
 	})
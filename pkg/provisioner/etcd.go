@@ -0,0 +1,310 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provisioner
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/pkg/provisioner/templates"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// etcdCertValidity is the lifetime of the external etcd certificates
+const etcdCertValidity = 10 * 365 * 24 * time.Hour
+
+// etcdCAScript prints the etcd CA certificate and key an etcd member keeps,
+// or nothing if the member was not bootstrapped.
+const etcdCAScript = `if sudo test -f /etc/etcd/pki/ca.key; then
+    sudo cat /etc/etcd/pki/ca.crt /etc/etcd/pki/ca.key
+fi
+`
+
+// etcdPKI is the certificate authority of an external etcd cluster. It is
+// generated locally on the first run, and each member and the API server
+// receive their certificates in the scripts that bootstrap them. Members
+// keep the CA, and later runs read it back from them, so that certificates
+// issued by a rerun are trusted by the members already running.
+type etcdPKI struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	// CACert and CAKey are the PEM-encoded CA certificate and private key
+	CACert string
+	CAKey  string
+}
+
+// newEtcdPKI generates a self-signed etcd certificate authority.
+func newEtcdPKI() (*etcdPKI, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate etcd CA key: %w", err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "etcd-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(etcdCertValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd CA certificate: %w", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal etcd CA key: %w", err)
+	}
+	return parseEtcdPKI(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})))
+}
+
+// parseEtcdPKI parses the PEM-encoded etcd CA certificate and key.
+func parseEtcdPKI(data string) (*etcdPKI, error) {
+	pki := &etcdPKI{}
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		encoded := string(pem.EncodeToMemory(block))
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse etcd CA certificate: %w", err)
+			}
+			pki.cert, pki.CACert = cert, encoded
+		case "EC PRIVATE KEY":
+			key, err := x509.ParseECPrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse etcd CA key: %w", err)
+			}
+			pki.key, pki.CAKey = key, encoded
+		}
+	}
+	if pki.cert == nil || pki.key == nil {
+		return nil, fmt.Errorf("etcd CA certificate or key is missing")
+	}
+	return pki, nil
+}
+
+// memberCert issues the certificate an etcd member uses for both client and
+// peer traffic, valid for its private IP and loopback.
+func (p *etcdPKI) memberCert(member templates.EtcdMember) (string, string, error) {
+	ip := net.ParseIP(member.IP)
+	if ip == nil {
+		return "", "", fmt.Errorf("invalid private IP for etcd member %s: %q", member.Name, member.IP)
+	}
+	return p.issue(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: member.Name},
+		DNSNames:    []string{member.Name, "localhost"},
+		IPAddresses: []net.IP{ip, net.IPv4(127, 0, 0, 1)},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	})
+}
+
+// apiServerClientCert issues the client certificate kube-apiserver uses to
+// reach etcd.
+func (p *etcdPKI) apiServerClientCert() (string, string, error) {
+	return p.issue(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: "kube-apiserver-etcd-client", Organization: []string{"system:masters"}},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+}
+
+// issue signs tmpl with the CA and returns the PEM-encoded certificate and
+// private key.
+func (p *etcdPKI) issue(tmpl *x509.Certificate) (string, string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate key for %s: %w", tmpl.Subject.CommonName, err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	now := time.Now()
+	tmpl.SerialNumber = serial
+	tmpl.NotBefore = now.Add(-time.Hour)
+	tmpl.NotAfter = now.Add(etcdCertValidity)
+	tmpl.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, p.cert, &key.PublicKey, p.key)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create certificate for %s: %w", tmpl.Subject.CommonName, err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal key for %s: %w", tmpl.Subject.CommonName, err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})), nil
+}
+
+// etcdMembers returns the etcd members of the dedicated etcd nodes.
+func etcdMembers(nodes []NodeInfo) []templates.EtcdMember {
+	members := make([]templates.EtcdMember, 0, len(nodes))
+	for _, node := range nodes {
+		members = append(members, templates.EtcdMember{Name: node.Name, IP: node.PrivateIP})
+	}
+	return members
+}
+
+// provisionEtcd bootstraps the external etcd cluster on the dedicated etcd
+// nodes in parallel, since a new member only becomes healthy once a quorum
+// of its peers is up, and records the API server's etcd client credentials
+// for kubeadm init. When resuming, members a previous run bootstrapped are
+// skipped.
+func (cp *ClusterProvisioner) provisionEtcd(ctx context.Context, nodes []NodeInfo) error {
+	pki, err := cp.etcdPKI(ctx, nodes)
+	if err != nil {
+		return err
+	}
+	externalEtcd, configs, err := etcdConfigs(cp.Environment, nodes, pki)
+	if err != nil {
+		return err
+	}
+	cp.ExternalEtcd = externalEtcd
+
+	g, gctx := errgroup.WithContext(ctx)
+	for i, node := range nodes {
+		config := configs[i]
+		g.Go(func() error {
+			done, err := cp.completedOn(gctx, node, etcdComponent)
+			if err != nil || done {
+				return err
+			}
+			return cp.runEtcdBootstrap(gctx, node, config)
+		})
+	}
+	return g.Wait()
+}
+
+// etcdPKI returns the etcd CA a previous run left on the etcd nodes, or a
+// new one if no node has it.
+func (cp *ClusterProvisioner) etcdPKI(ctx context.Context, nodes []NodeInfo) (*etcdPKI, error) {
+	for _, node := range nodes {
+		provisioner, err := New(ctx, cp.log, cp.getKeyPathForNode(node), cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to %s: %w", node.Name, err)
+		}
+		out, err := provisioner.output(ctx, etcdCAScript)
+		if provisioner.Client != nil {
+			_ = provisioner.Client.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read etcd CA from %s: %w", node.Name, err)
+		}
+		if strings.TrimSpace(out) == "" {
+			continue
+		}
+		pki, err := parseEtcdPKI(out)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", node.Name, err)
+		}
+		cp.log.Info("Using the etcd certificate authority of %s", node.Name)
+		return pki, nil
+	}
+	return newEtcdPKI()
+}
+
+// etcdConfigs issues certificates from the etcd CA for the etcd nodes and
+// returns the API server's etcd client credentials and the bootstrap
+// configuration of each node.
+func etcdConfigs(env *v1alpha1.Environment, nodes []NodeInfo, pki *etcdPKI) (*templates.ExternalEtcd, []templates.EtcdConfig, error) {
+	members := etcdMembers(nodes)
+
+	clientCert, clientKey, err := pki.apiServerClientCert()
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoints := make([]string, 0, len(members))
+	for _, m := range members {
+		endpoints = append(endpoints, m.ClientURL())
+	}
+	externalEtcd := &templates.ExternalEtcd{
+		Endpoints:  endpoints,
+		CACert:     pki.CACert,
+		ClientCert: clientCert,
+		ClientKey:  clientKey,
+	}
+
+	configs := make([]templates.EtcdConfig, 0, len(members))
+	for _, member := range members {
+		cert, key, err := pki.memberCert(member)
+		if err != nil {
+			return nil, nil, err
+		}
+		configs = append(configs, templates.EtcdConfig{
+			Environment: env,
+			Member:      member,
+			Members:     members,
+			CACert:      pki.CACert,
+			CAKey:       pki.CAKey,
+			Cert:        cert,
+			Key:         key,
+		})
+	}
+	return externalEtcd, configs, nil
+}
+
+// runEtcdBootstrap runs the etcd bootstrap script on an etcd node
+func (cp *ClusterProvisioner) runEtcdBootstrap(ctx context.Context, node NodeInfo, config templates.EtcdConfig) error {
+	cp.log.Info("Bootstrapping etcd on %s (%s)", node.Name, node.PublicIP)
+
+	provisioner, err := New(ctx, cp.log, cp.getKeyPathForNode(node), cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", node.Name, err)
+	}
+	defer provisioner.Client.Close() // nolint: errcheck
+
+	var tpl bytes.Buffer
+	if err := addScriptHeader(&tpl); err != nil {
+		return fmt.Errorf("failed to add script header: %w", err)
+	}
+	if err := config.Execute(&tpl); err != nil {
+		return fmt.Errorf("failed to generate etcd script: %w", err)
+	}
+
+	provisioner.tpl = tpl
+	if err := provisioner.provision(ctx); err != nil {
+		return fmt.Errorf("failed to bootstrap etcd on %s: %w", node.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package provisioner
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/provisioner/templates"
+	"github.com/NVIDIA/holodeck/pkg/sshutil/sshtest"
+)
+
+func parseTestCert(t *testing.T, data string) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode([]byte(data))
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestEtcdPKI(t *testing.T) {
+	pki, err := newEtcdPKI()
+	require.NoError(t, err)
+	ca := parseTestCert(t, pki.CACert)
+	assert.True(t, ca.IsCA)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	certPEM, keyPEM, err := pki.memberCert(templates.EtcdMember{Name: "demo-etcd-0", IP: "10.0.0.10"})
+	require.NoError(t, err)
+	member := parseTestCert(t, certPEM)
+	for _, usage := range []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth} {
+		_, err := member.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{usage}})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, member.VerifyHostname("10.0.0.10"))
+	assert.NoError(t, member.VerifyHostname("127.0.0.1"))
+	assert.True(t, member.IPAddresses[0].Equal(net.ParseIP("10.0.0.10")))
+	keyBlock, _ := pem.Decode([]byte(keyPEM))
+	require.NotNil(t, keyBlock)
+	assert.Equal(t, "EC PRIVATE KEY", keyBlock.Type)
+
+	clientPEM, _, err := pki.apiServerClientCert()
+	require.NoError(t, err)
+	client := parseTestCert(t, clientPEM)
+	_, err = client.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	assert.NoError(t, err)
+	_, err = client.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}})
+	assert.Error(t, err)
+
+	_, _, err = pki.memberCert(templates.EtcdMember{Name: "bad", IP: "not-an-ip"})
+	assert.EqualError(t, err, `invalid private IP for etcd member bad: "not-an-ip"`)
+}
+
+func TestParseEtcdPKI(t *testing.T) {
+	pki, err := newEtcdPKI()
+	require.NoError(t, err)
+
+	parsed, err := parseEtcdPKI(pki.CACert + pki.CAKey)
+	require.NoError(t, err)
+	assert.Equal(t, pki.CACert, parsed.CACert)
+	assert.Equal(t, pki.CAKey, parsed.CAKey)
+
+	// Certificates issued by the parsed CA are trusted by the original one
+	roots := x509.NewCertPool()
+	roots.AddCert(parseTestCert(t, pki.CACert))
+	clientPEM, _, err := parsed.apiServerClientCert()
+	require.NoError(t, err)
+	_, err = parseTestCert(t, clientPEM).Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	assert.NoError(t, err)
+
+	_, err = parseEtcdPKI(pki.CACert)
+	assert.EqualError(t, err, "etcd CA certificate or key is missing")
+}
+
+func TestEtcdPKIFromNodes(t *testing.T) {
+	t.Setenv("HOME", t.TempDir()) // isolate TOFU
+	keyPath, pub := sshtest.GenerateKey(t)
+	existing, err := newEtcdPKI()
+	require.NoError(t, err)
+
+	fresh := sshtest.NewServer(t, pub)
+	bootstrapped := sshtest.NewServer(t, pub, sshtest.WithExecOutput(existing.CACert+existing.CAKey))
+	cp := NewClusterProvisioner(logger.NewLogger(), keyPath, "tester", &v1alpha1.Environment{})
+
+	pki, err := cp.etcdPKI(context.Background(), []NodeInfo{
+		{Name: "etcd-0", PublicIP: fresh.Addr(), Role: "etcd"},
+		{Name: "etcd-1", PublicIP: bootstrapped.Addr(), Role: "etcd"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, existing.CACert, pki.CACert, "a rerun must reuse the CA the members trust")
+
+	pki, err = cp.etcdPKI(context.Background(), []NodeInfo{{Name: "etcd-0", PublicIP: fresh.Addr(), Role: "etcd"}})
+	require.NoError(t, err)
+	assert.NotEqual(t, existing.CACert, pki.CACert)
+	assert.NotEmpty(t, pki.CAKey)
+}
+
+func TestProvisionCluster_ExternalEtcdWithoutEtcdNodes(t *testing.T) {
+	env := &v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			Cluster: &v1alpha1.ClusterSpec{
+				ControlPlane: v1alpha1.ControlPlaneSpec{Count: 3},
+				HighAvailability: &v1alpha1.HAConfig{
+					Enabled:      true,
+					EtcdTopology: v1alpha1.EtcdTopologyExternal,
+				},
+			},
+		},
+	}
+	cp := NewClusterProvisioner(logger.NewLogger(), "/tmp/key", "ubuntu", env)
+
+	err := cp.ProvisionCluster(context.Background(), []NodeInfo{
+		{Name: "cp-0", PrivateIP: "10.0.0.1", Role: "control-plane"},
+	})
+	assert.EqualError(t, err, "external etcd topology requires at least one etcd node")
+}
@@ -36,7 +36,7 @@ func TestProvisioner_EnsureClient_HealsDroppedConnection(t *testing.T) {
 	keyPath, pub := sshtest.GenerateKey(t)
 	srv := sshtest.NewServer(t, pub, sshtest.WithExecOutput("ok\n"))
 
-	p, err := New(logger.NewLogger(), keyPath, "tester", srv.Addr())
+	p, err := New(context.Background(), logger.NewLogger(), keyPath, "tester", srv.Addr())
 	require.NoError(t, err)
 	require.NotNil(t, p.Client)
 
@@ -57,6 +57,23 @@ func TestProvisioner_EnsureClient_HealsDroppedConnection(t *testing.T) {
 	assert.Same(t, before, p.Client, "live connection must be reused, not re-dialed")
 }
 
+func TestProvisioner_WaitForNodeReboot_Cancelled(t *testing.T) {
+	t.Setenv("HOME", t.TempDir()) // isolate TOFU
+	keyPath, pub := sshtest.GenerateKey(t)
+	srv := sshtest.NewServer(t, pub)
+
+	p, err := New(context.Background(), logger.NewLogger(), keyPath, "tester", srv.Addr())
+	require.NoError(t, err)
+
+	// A cancelled ctx must abort the wait instead of sleeping out the
+	// reboot retry interval.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = p.waitForNodeReboot(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, p.Client)
+}
+
 // countingCloser wraps a Transport and counts Close() calls. It proves the
 // provisioner owns the transport lifecycle (T3-critic carry-forward): a
 // BastionTransport/SSMTransport must be torn down on both the success path
@@ -84,7 +101,7 @@ func TestProvisioner_Close_ClosesTransport(t *testing.T) {
 	srv := sshtest.NewServer(t, pub, sshtest.WithExecOutput("ok\n"))
 
 	ct := &countingCloser{inner: sshutil.NewDirectTransport(srv.Addr())}
-	p, err := New(logger.NewLogger(), keyPath, "tester", srv.Addr(), WithTransport(ct))
+	p, err := New(context.Background(), logger.NewLogger(), keyPath, "tester", srv.Addr(), WithTransport(ct))
 	require.NoError(t, err)
 	require.NotNil(t, p.Client)
 
@@ -101,7 +118,7 @@ func TestProvisioner_New_DialFailure_ClosesTransport(t *testing.T) {
 	keyPath, _ := sshtest.GenerateKey(t)
 
 	ct := &countingCloser{inner: sshutil.NewDirectTransport("127.0.0.1:1")}
-	_, err := New(logger.NewLogger(), keyPath, "tester", "127.0.0.1:1",
+	_, err := New(context.Background(), logger.NewLogger(), keyPath, "tester", "127.0.0.1:1",
 		WithTransport(ct), WithSSHConfig(&v1alpha1.SSHConfig{MaxRetries: 1}))
 	require.Error(t, err)
 	assert.Equal(t, int32(1), ct.closes.Load(), "New must close the transport when the dial fails")
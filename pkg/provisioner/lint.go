@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provisioner
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/pkg/provisioner/templates"
+)
+
+// Lint renders the scripts of env like Render and checks that bash can
+// parse each of them, so that a broken custom template is caught before
+// any host is created. It returns the number of scripts checked and the
+// syntax errors found, one per script, identified by its rendered path.
+func Lint(env v1alpha1.Environment, opts RenderOptions) (int, error) {
+	files, err := Render(env, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	// Cluster nodes share most of their scripts, check each one once
+	checked := make(map[string]bool, len(files))
+	var errs []error
+	for _, f := range files {
+		if filepath.Ext(f.Path) != ".sh" || checked[string(f.Content)] {
+			continue
+		}
+		checked[string(f.Content)] = true
+		if err := templates.CheckSyntax(f.Content); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", f.Path, err))
+		}
+	}
+	return len(checked), errors.Join(errs...)
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provisioner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+)
+
+func TestLint(t *testing.T) {
+	env := v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo"},
+		Spec: v1alpha1.EnvironmentSpec{
+			ContainerRuntime: v1alpha1.ContainerRuntime{Install: true, Name: v1alpha1.ContainerRuntimeContainerd},
+			Kubernetes:       v1alpha1.Kubernetes{Install: true, KubernetesInstaller: "kubeadm"},
+			Cluster: &v1alpha1.ClusterSpec{
+				ControlPlane: v1alpha1.ControlPlaneSpec{Count: 1},
+				Workers:      &v1alpha1.WorkerPoolSpec{Count: 2},
+			},
+			CustomTemplates: []v1alpha1.CustomTemplate{
+				{Name: "motd", Inline: "echo 'welcome' | sudo tee /etc/motd"},
+			},
+		},
+	}
+
+	// Workers share all their scripts, and all but the join script with
+	// the control-plane node
+	checked, err := Lint(env, RenderOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 5, checked)
+
+	env.Spec.CustomTemplates = append(env.Spec.CustomTemplates,
+		v1alpha1.CustomTemplate{Name: "broken", Inline: "if [ -f /etc/motd ]; then\n  echo \"missing fi\n"})
+	env.Spec.Cluster = nil
+	_, err = Lint(env, RenderOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "04-custom-broken.sh: ")
+	assert.NotContains(t, err.Error(), "custom-motd")
+}
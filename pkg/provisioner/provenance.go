@@ -17,6 +17,10 @@
 package provisioner
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
 )
 
@@ -125,3 +129,154 @@ func BuildComponentsStatus(env v1alpha1.Environment) *v1alpha1.ComponentsStatus
 	}
 	return cs
 }
+
+// provenanceMatches reports whether the provenance recorded for an installed
+// component satisfies the provenance wanted by the spec. The resolved commit
+// is not part of the spec and is ignored.
+func provenanceMatches(recorded, wanted *v1alpha1.ComponentProvenance) bool {
+	if recorded == nil || wanted == nil {
+		return false
+	}
+	return recorded.Source == wanted.Source &&
+		recorded.Version == wanted.Version &&
+		recorded.Branch == wanted.Branch &&
+		recorded.Repo == wanted.Repo &&
+		recorded.Ref == wanted.Ref
+}
+
+// componentProbe describes how to read what a provisioned component reports
+// on the host: a command printing its version, and the PROVENANCE.json that
+// git and latest installs write with the resolved commit.
+type componentProbe struct {
+	name       string
+	version    string
+	provenance string
+}
+
+// componentProbes returns the probes for the components installed by env,
+// named like the fields of ComponentsStatus.
+func componentProbes(env v1alpha1.Environment) []componentProbe {
+	var probes []componentProbe
+	if env.Spec.NVIDIADriver.Install {
+		probes = append(probes, componentProbe{
+			name:       "driver",
+			version:    "nvidia-smi --query-gpu=driver_version --format=csv,noheader | head -n1",
+			provenance: "/etc/nvidia-driver/PROVENANCE.json",
+		})
+	}
+	if env.Spec.ContainerRuntime.Install {
+		probe := componentProbe{name: "runtime"}
+		switch env.Spec.ContainerRuntime.Name {
+		case v1alpha1.ContainerRuntimeDocker:
+			probe.version = "sudo docker version --format '{{.Server.Version}}'"
+			probe.provenance = "/etc/docker/PROVENANCE.json"
+		case v1alpha1.ContainerRuntimeCrio:
+			probe.version = "crio --version | awk '/^Version:/ {print $2}'"
+			probe.provenance = "/etc/crio/PROVENANCE.json"
+		default:
+			probe.version = "containerd --version | awk '{print $3}'"
+			probe.provenance = "/etc/containerd/PROVENANCE.json"
+		}
+		probes = append(probes, probe)
+	}
+	if env.Spec.NVIDIAContainerToolkit.Install {
+		probes = append(probes, componentProbe{
+			name:       "toolkit",
+			version:    "nvidia-ctk --version | head -n1 | awk '{print $NF}'",
+			provenance: "/etc/nvidia-container-toolkit/PROVENANCE.json",
+		})
+	}
+	if env.Spec.Kubernetes.Install {
+		probe := componentProbe{name: "kubernetes", provenance: "/etc/kubernetes/PROVENANCE.json"}
+		const kubeletVersion = "get nodes -o jsonpath='{.items[0].status.nodeInfo.kubeletVersion}'"
+		switch env.Spec.Kubernetes.KubernetesInstaller {
+		case "kind":
+			probe.version = "kubectl " + kubeletVersion
+		case "microk8s":
+			probe.version = "sudo microk8s kubectl " + kubeletVersion
+		default:
+			probe.version = "kubelet --version | awk '{print $2}'"
+		}
+		probes = append(probes, probe)
+	}
+	return probes
+}
+
+// observeScript returns the script printing a "<component>.version=" and a
+// "<component>.commit=" line per probe. Probes that fail print empty values.
+func observeScript(probes []componentProbe) string {
+	var script strings.Builder
+	script.WriteString("#!/bin/bash\n")
+	for _, probe := range probes {
+		fmt.Fprintf(&script, "echo \"%s.version=$( (%s) 2>/dev/null)\"\n", probe.name, probe.version)
+		fmt.Fprintf(&script, "echo \"%s.commit=$(sudo jq -r '.commit // empty' %s 2>/dev/null)\"\n", probe.name, probe.provenance)
+	}
+	return script.String()
+}
+
+// applyObserved records the versions and commits printed by observeScript
+// in cs. Empty values leave the provenance untouched.
+func applyObserved(cs *v1alpha1.ComponentsStatus, output string) {
+	components := map[string]*v1alpha1.ComponentProvenance{
+		"driver":     cs.Driver,
+		"runtime":    cs.Runtime,
+		"toolkit":    cs.Toolkit,
+		"kubernetes": cs.Kubernetes,
+	}
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok || value == "" {
+			continue
+		}
+		name, field, _ := strings.Cut(key, ".")
+		prov := components[name]
+		if prov == nil {
+			continue
+		}
+		switch field {
+		case "version":
+			prov.ObservedVersion = value
+		case "commit":
+			prov.Commit = value
+		}
+	}
+}
+
+// observeVersions queries the host for the versions the installed
+// components report and records them in cs next to the requested ones.
+// Failing to query the host is not fatal: the requested provenance is
+// still recorded.
+func (p *Provisioner) observeVersions(ctx context.Context, env v1alpha1.Environment, cs *v1alpha1.ComponentsStatus) {
+	probes := componentProbes(env)
+	if cs == nil || len(probes) == 0 {
+		return
+	}
+
+	output, err := p.output(ctx, observeScript(probes))
+	if err != nil {
+		p.log.Warning("Failed to query installed component versions: %v", err)
+		return
+	}
+	applyObserved(cs, output)
+}
+
+// output runs script on the host and returns its standard output.
+func (p *Provisioner) output(ctx context.Context, script string) (string, error) {
+	if err := p.ensureClient(ctx); err != nil {
+		return "", err
+	}
+	session, err := p.Client.NewSession()
+	if err != nil {
+		return "", fmt.Errorf("failed to create session: %w", err)
+	}
+	defer func() { _ = session.Close() }()
+
+	stop := context.AfterFunc(ctx, func() { _ = session.Close() })
+	defer stop()
+
+	out, err := session.Output(script)
+	if err != nil {
+		return "", fmt.Errorf("failed to run script: %w", err)
+	}
+	return string(out), nil
+}
@@ -17,12 +17,15 @@
 package provisioner
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/sshutil/sshtest"
 )
 
 func TestBuildComponentsStatus_Empty(t *testing.T) {
@@ -209,3 +212,76 @@ func TestBuildComponentsStatus_AllComponents(t *testing.T) {
 	assert.NotNil(t, cs.Toolkit)
 	assert.NotNil(t, cs.Kubernetes)
 }
+
+func TestProvenanceMatches(t *testing.T) {
+	wanted := &v1alpha1.ComponentProvenance{Source: "git", Repo: "https://github.com/NVIDIA/nvidia-container-toolkit.git", Ref: "v1.17.0"}
+
+	recorded := *wanted
+	recorded.Commit = "abc123"
+	assert.True(t, provenanceMatches(&recorded, wanted), "resolved commit must be ignored")
+
+	recorded.Ref = "main"
+	assert.False(t, provenanceMatches(&recorded, wanted))
+	assert.False(t, provenanceMatches(nil, wanted))
+	assert.False(t, provenanceMatches(wanted, nil))
+}
+
+func TestObserveScript(t *testing.T) {
+	env := v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			NVIDIADriver:     v1alpha1.NVIDIADriver{Install: true},
+			ContainerRuntime: v1alpha1.ContainerRuntime{Install: true, Name: v1alpha1.ContainerRuntimeDocker},
+			Kubernetes:       v1alpha1.Kubernetes{Install: true, KubernetesInstaller: "kind"},
+		},
+	}
+	script := observeScript(componentProbes(env))
+
+	assert.Contains(t, script, `echo "driver.version=$( (nvidia-smi --query-gpu=driver_version --format=csv,noheader | head -n1) 2>/dev/null)"`)
+	assert.Contains(t, script, "sudo docker version --format '{{.Server.Version}}'")
+	assert.Contains(t, script, "/etc/docker/PROVENANCE.json")
+	assert.Contains(t, script, "kubectl get nodes -o jsonpath='{.items[0].status.nodeInfo.kubeletVersion}'")
+	assert.NotContains(t, script, "toolkit.")
+	assert.NotContains(t, script, "kubelet --version")
+}
+
+func TestApplyObserved(t *testing.T) {
+	cs := &v1alpha1.ComponentsStatus{
+		Driver:  &v1alpha1.ComponentProvenance{Source: "package", Branch: "575"},
+		Toolkit: &v1alpha1.ComponentProvenance{Source: "latest", Branch: "main"},
+	}
+	applyObserved(cs, "driver.version=575.57.08\n"+
+		"driver.commit=\n"+
+		"toolkit.version=1.18.0-rc.1\n"+
+		"toolkit.commit=a1b2c3d4\n"+
+		"kubernetes.version=v1.33.1\n"+
+		"garbage\n")
+
+	assert.Equal(t, "575.57.08", cs.Driver.ObservedVersion)
+	assert.Empty(t, cs.Driver.Commit)
+	assert.Equal(t, "575", cs.Driver.Branch, "requested provenance is kept")
+	assert.Equal(t, "1.18.0-rc.1", cs.Toolkit.ObservedVersion)
+	assert.Equal(t, "a1b2c3d4", cs.Toolkit.Commit)
+	assert.Nil(t, cs.Kubernetes, "components that were not installed stay unset")
+}
+
+func TestObserveVersions(t *testing.T) {
+	t.Setenv("HOME", t.TempDir()) // isolate TOFU
+	keyPath, pub := sshtest.GenerateKey(t)
+	srv := sshtest.NewServer(t, pub, sshtest.WithExecOutput("kubernetes.version=v1.33.1\nkubernetes.commit=\n"))
+
+	p, err := New(context.Background(), logger.NewLogger(), keyPath, "tester", srv.Addr())
+	require.NoError(t, err)
+	defer p.Close() // nolint: errcheck
+
+	env := v1alpha1.Environment{
+		Spec: v1alpha1.EnvironmentSpec{
+			Kubernetes: v1alpha1.Kubernetes{Install: true, KubernetesInstaller: "kubeadm", KubernetesVersion: "v1.33.1"},
+		},
+	}
+	cs := BuildComponentsStatus(env)
+	p.observeVersions(context.Background(), env, cs)
+
+	require.NotNil(t, cs.Kubernetes)
+	assert.Equal(t, "v1.33.1", cs.Kubernetes.Version)
+	assert.Equal(t, "v1.33.1", cs.Kubernetes.ObservedVersion)
+}
@@ -64,11 +64,13 @@ type Provisioner struct {
 	transport Transport
 	dialer    *sshutil.Dialer
 	sshConfig *v1alpha1.SSHConfig
+	// resume is set by WithResume
+	resume bool
 
 	log *logger.FunLogger
 }
 
-func New(log *logger.FunLogger, keyPath, userName, hostUrl string, opts ...Option) (*Provisioner, error) {
+func New(ctx context.Context, log *logger.FunLogger, keyPath, userName, hostUrl string, opts ...Option) (*Provisioner, error) {
 	p := &Provisioner{
 		HostUrl:  hostUrl,
 		UserName: userName,
@@ -100,8 +102,7 @@ func New(log *logger.FunLogger, keyPath, userName, hostUrl string, opts ...Optio
 		p.dialer = dialerFromSSHConfig(keyPath, userName, p.sshConfig, log)
 	}
 
-	//nolint:contextcheck // New has no ctx parameter (follow-up); Background is the adoption boundary.
-	if err := p.ensureClient(context.Background()); err != nil {
+	if err := p.ensureClient(ctx); err != nil {
 		// Error path: close the transport so a failed SSM/bastion dial does not
 		// leak the aws process or the hop-1 SSH client (T3-critic carry-forward).
 		_ = p.transport.Close()
@@ -153,8 +154,9 @@ func (p *Provisioner) Close() error {
 	return err
 }
 
-// waitForNodeReboot waits for the node to reboot and come back online after a kernel version change
-func (p *Provisioner) waitForNodeReboot() error {
+// waitForNodeReboot waits for the node to reboot and come back online after a
+// kernel version change. It returns early with ctx's error if ctx is done.
+func (p *Provisioner) waitForNodeReboot(ctx context.Context) error {
 	p.log.Info("Kernel version change detected, waiting for node to reboot...")
 
 	// Check if the connection is still active before closing
@@ -178,11 +180,14 @@ func (p *Provisioner) waitForNodeReboot() error {
 
 	for i := 0; i < maxRetries; i++ {
 		p.log.Info("Waiting for node to come back online...")
-		time.Sleep(retryInterval)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryInterval):
+		}
 
 		// p.Client is nil here, so ensureClient re-dials the rebooted node.
-		//nolint:contextcheck // Run/waitForNodeReboot have no ctx parameter (follow-up); Background is the adoption boundary.
-		if err := p.ensureClient(context.Background()); err == nil {
+		if err := p.ensureClient(ctx); err == nil {
 			p.log.Info("Node is back online, continuing with provisioning...")
 			return nil
 		}
@@ -197,8 +202,8 @@ func (p *Provisioner) waitForNodeReboot() error {
 
 // Run provisions the environment and returns component provenance status.
 // The returned ComponentsStatus captures source/version/commit information
-// for each installed component.
-func (p *Provisioner) Run(env v1alpha1.Environment) (*v1alpha1.ComponentsStatus, error) {
+// for each installed component. Cancelling ctx aborts the running script.
+func (p *Provisioner) Run(ctx context.Context, env v1alpha1.Environment) (*v1alpha1.ComponentsStatus, error) {
 	// Validate all user-supplied inputs that will be interpolated into shell scripts
 	if err := templates.ValidateTemplateInputs(env); err != nil {
 		return nil, fmt.Errorf("template input validation failed: %w", err)
@@ -233,7 +238,26 @@ func (p *Provisioner) Run(env v1alpha1.Environment) (*v1alpha1.ComponentsStatus,
 		}
 	}
 
-	for _, node := range dependencies.Resolve() {
+	if p.resume {
+		installed, err := p.installedComponents(ctx)
+		if err != nil {
+			return nil, err
+		}
+		dependencies.Resume(installed)
+	}
+
+	provisionFuncs := dependencies.Resolve()
+	for _, name := range dependencies.Skipped() {
+		p.log.Info("Skipping %s: already installed with the requested version", name)
+	}
+	for _, name := range dependencies.Completed() {
+		p.log.Info("Skipping %s: completed by a previous run", name)
+	}
+	if p.resume && len(provisionFuncs) > 0 {
+		p.log.Info("Resuming provisioning at %s", dependencies.Names()[0])
+	}
+
+	for _, node := range provisionFuncs {
 		// Add script header and common functions to the script
 		if err := addScriptHeader(&p.tpl); err != nil {
 			return nil, fmt.Errorf("failed to add shebang to the script: %w", err)
@@ -243,13 +267,13 @@ func (p *Provisioner) Run(env v1alpha1.Environment) (*v1alpha1.ComponentsStatus,
 			return nil, fmt.Errorf("failed to execute template: %w", err)
 		}
 		// Provision the instance
-		if err := p.provision(); err != nil {
+		if err := p.provision(ctx); err != nil {
 			return nil, fmt.Errorf("failed to provision: %w", err)
 		}
 
 		// If kernel version is specified, wait for the node to reboot
 		if env.Spec.Kernel.Version != "" {
-			if err := p.waitForNodeReboot(); err != nil {
+			if err := p.waitForNodeReboot(ctx); err != nil {
 				return nil, err
 			}
 		} else {
@@ -264,8 +288,11 @@ func (p *Provisioner) Run(env v1alpha1.Environment) (*v1alpha1.ComponentsStatus,
 		p.tpl.Reset()
 	}
 
-	// Build component provenance status from spec
-	return BuildComponentsStatus(env), nil
+	// Build component provenance status from spec, then record what the
+	// host reports was actually installed
+	cs := BuildComponentsStatus(env)
+	p.observeVersions(ctx, env, cs)
+	return cs, nil
 }
 
 // resetConnection is the deliberate force-refresh between dependencies: it
@@ -292,11 +319,10 @@ func (p *Provisioner) resetConnection() error {
 	return nil
 }
 
-func (p *Provisioner) provision() error {
+func (p *Provisioner) provision(ctx context.Context) error {
 	// Reuse a live connection, heal a dropped one (resetConnection between
 	// dependencies force-refreshes by nil-ing p.Client, which makes this re-dial).
-	//nolint:contextcheck // Run/provision have no ctx parameter (follow-up); Background is the adoption boundary.
-	if err := p.ensureClient(context.Background()); err != nil {
+	if err := p.ensureClient(ctx); err != nil {
 		return err
 	}
 
@@ -329,9 +355,18 @@ func (p *Provisioner) provision() error {
 		wg.Wait()
 		return fmt.Errorf("failed to start session: %w", err)
 	}
+
+	// Closing the session on cancellation makes Wait return, so an
+	// interrupted provision does not block on the remote script.
+	stop := context.AfterFunc(ctx, func() { _ = session.Close() })
+	defer stop()
+
 	if err = session.Wait(); err != nil {
 		_ = writer.Close()
 		wg.Wait()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("provisioning interrupted: %w", ctxErr)
+		}
 		return fmt.Errorf("failed to wait for session: %w", err)
 	}
 
@@ -0,0 +1,306 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provisioner
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/pkg/provisioner/templates"
+)
+
+// Placeholders Render substitutes for values that only exist once the
+// environment is provisioned. The host is from the TEST-NET-1 documentation
+// range and the join credentials are well-formed but invalid.
+const (
+	RenderHost           = "192.0.2.1"
+	RenderJoinToken      = "abcdef.0123456789abcdef"
+	RenderCACertHash     = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+	RenderCertificateKey = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+)
+
+// unsafeFileChars matches characters left out of rendered file names.
+var unsafeFileChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// RenderOptions configures Render.
+type RenderOptions struct {
+	// Host is the address of a single-node environment, used as the
+	// Kubernetes endpoint. Defaults to RenderHost.
+	Host string
+	// Nodes are the nodes of a cluster environment. When empty, they are
+	// derived from the spec, named the way the AWS provider names its
+	// instances and given placeholder private IPs.
+	Nodes []NodeInfo
+	// Node limits a cluster rendering to the named node.
+	Node string
+}
+
+// RenderedFile is a provisioning script or configuration file, with a path
+// relative to the output directory.
+type RenderedFile struct {
+	Path    string
+	Content []byte
+}
+
+// Render generates the provisioning scripts and configuration files of env
+// without connecting to any host: one script per dependency, in the order
+// Run executes them, each with the script header and common functions, and
+// the kubeadm or kind configuration. For a cluster, it generates the files
+// of each node in a directory named after the node, including the
+// Kubernetes prerequisites, kubeadm init and join and etcd bootstrap
+// scripts. Join scripts carry placeholder credentials, since these are only
+// created by kubeadm init.
+func Render(env v1alpha1.Environment, opts RenderOptions) ([]RenderedFile, error) {
+	if err := templates.ValidateTemplateInputs(env); err != nil {
+		return nil, fmt.Errorf("template input validation failed: %w", err)
+	}
+	if env.Spec.Cluster == nil {
+		if opts.Node != "" {
+			return nil, fmt.Errorf("node %q not found: environment is not a cluster", opts.Node)
+		}
+		host := opts.Host
+		if host == "" {
+			host = RenderHost
+		}
+		r := &renderer{}
+		if err := r.renderInstance(env, host); err != nil {
+			return nil, err
+		}
+		return r.files, nil
+	}
+	return renderCluster(env, opts)
+}
+
+// renderer collects the files rendered for a node.
+type renderer struct {
+	// dir is the directory of the node's files, empty for a single node
+	dir   string
+	files []RenderedFile
+	steps int
+}
+
+// script adds the next script of the node, made of the script header and
+// the body generated by execute.
+func (r *renderer) script(name string, execute func(*bytes.Buffer) error) error {
+	var tpl bytes.Buffer
+	if err := addScriptHeader(&tpl); err != nil {
+		return err
+	}
+	if err := execute(&tpl); err != nil {
+		return fmt.Errorf("failed to render %s: %w", name, err)
+	}
+	r.steps++
+	r.file(fmt.Sprintf("%02d-%s.sh", r.steps, name), tpl.Bytes())
+	return nil
+}
+
+func (r *renderer) file(name string, content []byte) {
+	name = unsafeFileChars.ReplaceAllString(name, "_")
+	r.files = append(r.files, RenderedFile{Path: filepath.Join(r.dir, name), Content: content})
+}
+
+// renderInstance renders what Run provisions on a host reachable at host.
+func (r *renderer) renderInstance(env v1alpha1.Environment, host string) error {
+	dependencies := NewDependencies(&env)
+
+	if env.Spec.Kubernetes.Install {
+		switch env.Spec.Kubernetes.KubernetesInstaller {
+		case kubeadmInstaller:
+			env.Spec.Kubernetes.K8sEndpointHost = host
+			kubernetes, err := templates.NewKubernetes(env)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes template: %w", err)
+			}
+			if !kubernetes.UseLegacyInit {
+				kConfig, err := templates.NewKubeadmConfig(env)
+				if err != nil {
+					return fmt.Errorf("failed to create kubeadm config: %w", err)
+				}
+				content, err := kConfig.GenerateKubeadmConfig()
+				if err != nil {
+					return fmt.Errorf("failed to generate kubeadm config: %w", err)
+				}
+				r.file("kubeadm-config.yaml", []byte(content))
+			}
+		case kindInstaller:
+			if env.Spec.Kubernetes.KindConfig != "" {
+				content, err := os.ReadFile(env.Spec.Kubernetes.KindConfig) //nolint:gosec // path from user-provided config
+				if err != nil {
+					return fmt.Errorf("failed to read kind config: %w", err)
+				}
+				r.file(filepath.Base(remoteKindConfig), content)
+			}
+		}
+	}
+
+	provisionFuncs := dependencies.Resolve()
+	names := dependencies.Names()
+	for i, node := range provisionFuncs {
+		if err := r.script(names[i], func(tpl *bytes.Buffer) error {
+			return node(tpl, env)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderCluster renders the files of the cluster nodes, following the
+// phases of ProvisionCluster.
+func renderCluster(env v1alpha1.Environment, opts RenderOptions) ([]RenderedFile, error) {
+	nodes := opts.Nodes
+	if len(nodes) == 0 {
+		nodes = specNodes(&env)
+	}
+
+	var controlPlanes, etcdNodes []NodeInfo
+	found := opts.Node == ""
+	for _, node := range nodes {
+		switch node.Role {
+		case "control-plane":
+			controlPlanes = append(controlPlanes, node)
+		case "etcd":
+			etcdNodes = append(etcdNodes, node)
+		}
+		found = found || node.Name == opts.Node
+	}
+	if !found {
+		return nil, fmt.Errorf("node %q not found in cluster", opts.Node)
+	}
+	if len(controlPlanes) == 0 {
+		return nil, fmt.Errorf("at least one control-plane node is required")
+	}
+	if env.Spec.Cluster.ExternalEtcd() && len(etcdNodes) == 0 {
+		return nil, fmt.Errorf("external etcd topology requires at least one etcd node")
+	}
+
+	cp := &ClusterProvisioner{Environment: &env}
+	cp.ControlPlaneEndpoint = cp.determineControlPlaneEndpoint(controlPlanes[0])
+	cp.JoinToken = RenderJoinToken
+	cp.CACertHash = RenderCACertHash
+	if cp.isHAEnabled() {
+		cp.CertificateKey = RenderCertificateKey
+	}
+
+	etcdConfig := make(map[string]templates.EtcdConfig, len(etcdNodes))
+	if len(etcdNodes) > 0 {
+		pki, err := newEtcdPKI()
+		if err != nil {
+			return nil, err
+		}
+		externalEtcd, configs, err := etcdConfigs(&env, etcdNodes, pki)
+		if err != nil {
+			return nil, err
+		}
+		cp.ExternalEtcd = externalEtcd
+		for i, node := range etcdNodes {
+			etcdConfig[node.Name] = configs[i]
+		}
+	}
+
+	var files []RenderedFile
+	for _, node := range nodes {
+		if opts.Node != "" && node.Name != opts.Node {
+			continue
+		}
+		r := &renderer{dir: unsafeFileChars.ReplaceAllString(node.Name, "_")}
+		var err error
+		if node.Role == "etcd" {
+			config := etcdConfig[node.Name]
+			err = r.script("etcd", config.Execute)
+		} else {
+			err = cp.renderKubernetesNode(r, node, node.Name == controlPlanes[0].Name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", node.Name, err)
+		}
+		files = append(files, r.files...)
+	}
+	return files, nil
+}
+
+// renderKubernetesNode renders the base dependencies, Kubernetes
+// prerequisites and kubeadm init or join scripts of a node.
+func (cp *ClusterProvisioner) renderKubernetesNode(r *renderer, node NodeInfo, first bool) error {
+	envCopy := cp.Environment.DeepCopy()
+	envCopy.Spec.Kubernetes.Install = false
+	if err := r.renderInstance(*envCopy, hostForNode(node)); err != nil {
+		return err
+	}
+
+	prereqConfig := templates.KubeadmPrereqConfig{
+		Environment: cp.Environment,
+	}
+	if err := r.script("kubernetes-prereq", prereqConfig.Execute); err != nil {
+		return err
+	}
+
+	if first {
+		envCopy := cp.Environment.DeepCopy()
+		envCopy.Spec.Kubernetes.K8sEndpointHost = cp.ControlPlaneEndpoint
+		initConfig := templates.KubeadmInitConfig{
+			Environment:          envCopy,
+			ControlPlaneEndpoint: cp.ControlPlaneEndpoint,
+			IsHA:                 cp.isHAEnabled(),
+			ExternalEtcd:         cp.ExternalEtcd,
+		}
+		return r.script("kubeadm-init", initConfig.Execute)
+	}
+
+	joinConfig := templates.KubeadmJoinConfig{
+		ControlPlaneEndpoint: cp.ControlPlaneEndpoint,
+		Token:                cp.JoinToken,
+		CACertHash:           cp.CACertHash,
+		IsControlPlane:       node.Role == "control-plane",
+	}
+	if joinConfig.IsControlPlane {
+		joinConfig.CertificateKey = cp.CertificateKey
+	}
+	return r.script("kubeadm-join", joinConfig.Execute)
+}
+
+// specNodes returns the nodes the spec of a cluster environment asks for,
+// named like the AWS provider names its instances and with private IPs
+// from the TEST-NET-1 documentation range.
+func specNodes(env *v1alpha1.Environment) []NodeInfo {
+	cluster := env.Spec.Cluster
+	var nodes []NodeInfo
+	add := func(role, pool string, count int32) {
+		for i := range count {
+			name := fmt.Sprintf("%s-%s-%d", env.Name, role, i)
+			if pool != "" {
+				name = fmt.Sprintf("%s-%s-%s-%d", env.Name, role, pool, i)
+			}
+			nodes = append(nodes, NodeInfo{
+				Name:      name,
+				PrivateIP: fmt.Sprintf("192.0.2.%d", len(nodes)+1),
+				Role:      role,
+				Pool:      pool,
+			})
+		}
+	}
+	add("control-plane", "", max(cluster.ControlPlane.Count, 1))
+	add("etcd", "", cluster.EtcdCount())
+	for _, w := range cluster.WorkerPoolList() {
+		add("worker", w.Name, w.Count)
+	}
+	return nodes
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provisioner
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+)
+
+// renderedFiles indexes rendered files by path.
+func renderedFiles(files []RenderedFile) map[string]string {
+	m := make(map[string]string, len(files))
+	for _, f := range files {
+		m[filepath.ToSlash(f.Path)] = string(f.Content)
+	}
+	return m
+}
+
+func TestRender_SingleNode(t *testing.T) {
+	env := v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo"},
+		Spec: v1alpha1.EnvironmentSpec{
+			NVIDIADriver:           v1alpha1.NVIDIADriver{Install: true},
+			ContainerRuntime:       v1alpha1.ContainerRuntime{Install: true, Name: v1alpha1.ContainerRuntimeContainerd},
+			NVIDIAContainerToolkit: v1alpha1.NVIDIAContainerToolkit{Install: true},
+			Kubernetes:             v1alpha1.Kubernetes{Install: true, KubernetesInstaller: "kubeadm"},
+			CustomTemplates: []v1alpha1.CustomTemplate{
+				{Name: "say hello", Inline: "echo hello"},
+			},
+		},
+	}
+
+	files, err := Render(env, RenderOptions{})
+	require.NoError(t, err)
+
+	var paths []string
+	for _, f := range files {
+		paths = append(paths, f.Path)
+	}
+	assert.Equal(t, []string{
+		"kubeadm-config.yaml",
+		"01-nvdriver.sh",
+		"02-containerd.sh",
+		"03-containerToolkit.sh",
+		"04-kubeadm.sh",
+		"05-custom-say_hello.sh",
+	}, paths)
+
+	rendered := renderedFiles(files)
+	for path, content := range rendered {
+		if strings.HasSuffix(path, ".sh") {
+			assert.True(t, strings.HasPrefix(content, Shebang), "%s lacks the shebang", path)
+			assert.Contains(t, content, "holodeck_log()", "%s lacks the common functions", path)
+		}
+	}
+	assert.Contains(t, rendered["kubeadm-config.yaml"], RenderHost)
+	assert.Contains(t, rendered["05-custom-say_hello.sh"], "echo hello")
+}
+
+func TestRender_SingleNodeRejectsNode(t *testing.T) {
+	_, err := Render(v1alpha1.Environment{}, RenderOptions{Node: "worker-0"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a cluster")
+}
+
+func TestRender_Cluster(t *testing.T) {
+	env := v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo"},
+		Spec: v1alpha1.EnvironmentSpec{
+			ContainerRuntime: v1alpha1.ContainerRuntime{Install: true, Name: v1alpha1.ContainerRuntimeContainerd},
+			Kubernetes:       v1alpha1.Kubernetes{Install: true, KubernetesInstaller: "kubeadm"},
+			Cluster: &v1alpha1.ClusterSpec{
+				ControlPlane: v1alpha1.ControlPlaneSpec{Count: 1},
+				Workers:      &v1alpha1.WorkerPoolSpec{Count: 1},
+			},
+		},
+	}
+
+	files, err := Render(env, RenderOptions{})
+	require.NoError(t, err)
+	rendered := renderedFiles(files)
+	assert.Len(t, rendered, 6)
+
+	assert.Contains(t, rendered, "demo-control-plane-0/01-containerd.sh")
+	assert.Contains(t, rendered, "demo-control-plane-0/02-kubernetes-prereq.sh")
+	assert.Contains(t, rendered["demo-control-plane-0/03-kubeadm-init.sh"], `CONTROL_PLANE_ENDPOINT="192.0.2.1"`)
+
+	assert.Contains(t, rendered, "demo-worker-0/01-containerd.sh")
+	assert.Contains(t, rendered, "demo-worker-0/02-kubernetes-prereq.sh")
+	join := rendered["demo-worker-0/03-kubeadm-join.sh"]
+	assert.Contains(t, join, `CONTROL_PLANE_ENDPOINT="192.0.2.1"`)
+	assert.Contains(t, join, RenderJoinToken)
+	assert.Contains(t, join, RenderCACertHash)
+	assert.NotContains(t, join, RenderCertificateKey)
+
+	files, err = Render(env, RenderOptions{Node: "demo-worker-0"})
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+
+	_, err = Render(env, RenderOptions{Node: "demo-worker-1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `node "demo-worker-1" not found`)
+}
+
+func TestRender_ClusterNodes(t *testing.T) {
+	env := v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo"},
+		Spec: v1alpha1.EnvironmentSpec{
+			ContainerRuntime: v1alpha1.ContainerRuntime{Install: true, Name: v1alpha1.ContainerRuntimeContainerd},
+			Kubernetes:       v1alpha1.Kubernetes{Install: true, KubernetesInstaller: "kubeadm"},
+			Cluster: &v1alpha1.ClusterSpec{
+				ControlPlane: v1alpha1.ControlPlaneSpec{Count: 1},
+			},
+		},
+	}
+
+	files, err := Render(env, RenderOptions{Nodes: []NodeInfo{
+		{Name: "cp", PrivateIP: "10.0.0.10", Role: "control-plane"},
+		{Name: "gpu", PrivateIP: "10.0.0.11", Role: "worker"},
+	}})
+	require.NoError(t, err)
+	rendered := renderedFiles(files)
+	assert.Contains(t, rendered["cp/03-kubeadm-init.sh"], `CONTROL_PLANE_ENDPOINT="10.0.0.10"`)
+	assert.Contains(t, rendered["gpu/03-kubeadm-join.sh"], `CONTROL_PLANE_ENDPOINT="10.0.0.10"`)
+}
+
+func TestRender_ExternalEtcd(t *testing.T) {
+	env := v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo"},
+		Spec: v1alpha1.EnvironmentSpec{
+			ContainerRuntime: v1alpha1.ContainerRuntime{Install: true, Name: v1alpha1.ContainerRuntimeContainerd},
+			Kubernetes:       v1alpha1.Kubernetes{Install: true, KubernetesInstaller: "kubeadm"},
+			Cluster: &v1alpha1.ClusterSpec{
+				ControlPlane: v1alpha1.ControlPlaneSpec{Count: 2},
+				HighAvailability: &v1alpha1.HAConfig{
+					Enabled:      true,
+					EtcdTopology: v1alpha1.EtcdTopologyExternal,
+				},
+				Etcd: &v1alpha1.EtcdPoolSpec{Count: 1},
+			},
+		},
+	}
+
+	files, err := Render(env, RenderOptions{})
+	require.NoError(t, err)
+	rendered := renderedFiles(files)
+
+	assert.Contains(t, rendered["demo-etcd-0/01-etcd.sh"], "BEGIN CERTIFICATE")
+	assert.Contains(t, rendered["demo-control-plane-0/03-kubeadm-init.sh"], "https://192.0.2.3:2379")
+	assert.Contains(t, rendered["demo-control-plane-1/03-kubeadm-join.sh"], RenderCertificateKey)
+}
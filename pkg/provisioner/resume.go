@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provisioner
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Components the cluster scripts record in the host state directory.
+const (
+	kubeadmPrereqComponent = "kubernetes-prereq"
+	kubeadmInitComponent   = "kubernetes-kubeadm-init"
+	kubeadmJoinComponent   = "kubernetes-kubeadm-join"
+	etcdComponent          = "etcd"
+)
+
+// installedScript lists the components whose state file in the host state
+// directory records them as installed, one per line.
+const installedScript = `for f in /var/lib/holodeck/state/*.state; do
+    if [ -f "$f" ] && grep -qx 'status=installed' "$f"; then
+        basename "$f" .state
+    fi
+done
+`
+
+// WithResume makes Run resume a provisioning that failed part-way: the
+// dependencies that a previous run completed, as recorded in the host state
+// directory, are not run again and provisioning starts at the first
+// dependency that did not complete.
+func WithResume() Option {
+	return func(p *Provisioner) {
+		p.resume = true
+	}
+}
+
+// installedComponents returns the components the host state directory
+// records as installed.
+func (p *Provisioner) installedComponents(ctx context.Context) (map[string]bool, error) {
+	out, err := p.output(ctx, installedScript)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read provisioning state: %w", err)
+	}
+	return parseInstalled(out), nil
+}
+
+// parseInstalled parses the output of installedScript.
+func parseInstalled(out string) map[string]bool {
+	installed := make(map[string]bool)
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		if component := strings.TrimSpace(scanner.Text()); component != "" {
+			installed[component] = true
+		}
+	}
+	return installed
+}
+
+// installedOn returns the components the state directory of node records
+// as installed.
+func (cp *ClusterProvisioner) installedOn(ctx context.Context, node NodeInfo) (map[string]bool, error) {
+	provisioner, err := New(ctx, cp.log, cp.getKeyPathForNode(node), cp.getUsernameForNode(node), hostForNode(node), cp.transportOptsForNode(node)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", node.Name, err)
+	}
+	defer func() {
+		if provisioner.Client != nil {
+			_ = provisioner.Client.Close()
+		}
+	}()
+
+	installed, err := provisioner.installedComponents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", node.Name, err)
+	}
+	return installed, nil
+}
+
+// completedOn reports whether, when resuming, a previous run completed
+// component on node, in which case the caller skips it.
+func (cp *ClusterProvisioner) completedOn(ctx context.Context, node NodeInfo, component string) (bool, error) {
+	if !cp.Resume {
+		return false, nil
+	}
+	installed, err := cp.installedOn(ctx, node)
+	if err != nil {
+		return false, err
+	}
+	if installed[component] {
+		cp.log.Info("Skipping %s on %s: completed by a previous run", component, node.Name)
+	}
+	return installed[component], nil
+}
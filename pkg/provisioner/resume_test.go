@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provisioner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+	"github.com/NVIDIA/holodeck/pkg/sshutil/sshtest"
+)
+
+func TestParseInstalled(t *testing.T) {
+	installed := parseInstalled("nvidia-driver\ncontainerd\n\n  custom-motd  \n")
+	assert.Equal(t, map[string]bool{
+		"nvidia-driver": true,
+		"containerd":    true,
+		"custom-motd":   true,
+	}, installed)
+	assert.Empty(t, parseInstalled(""))
+}
+
+func TestInstalledComponents(t *testing.T) {
+	t.Setenv("HOME", t.TempDir()) // isolate TOFU
+	keyPath, pub := sshtest.GenerateKey(t)
+	srv := sshtest.NewServer(t, pub, sshtest.WithExecOutput("nvidia-driver\ncontainerd\n"))
+
+	p, err := New(context.Background(), logger.NewLogger(), keyPath, "tester", srv.Addr(), WithResume())
+	require.NoError(t, err)
+	defer p.Close() // nolint: errcheck
+	assert.True(t, p.resume)
+
+	installed, err := p.installedComponents(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"nvidia-driver": true, "containerd": true}, installed)
+}
+
+func TestCompletedOn(t *testing.T) {
+	t.Setenv("HOME", t.TempDir()) // isolate TOFU
+	keyPath, pub := sshtest.GenerateKey(t)
+	srv := sshtest.NewServer(t, pub, sshtest.WithExecOutput("kubernetes-prereq\nkubernetes-kubeadm-join\n"))
+	node := NodeInfo{Name: "worker-0", PublicIP: srv.Addr(), Role: "worker"}
+
+	cp := NewClusterProvisioner(logger.NewLogger(), keyPath, "tester", &v1alpha1.Environment{})
+	done, err := cp.completedOn(context.Background(), node, kubeadmJoinComponent)
+	require.NoError(t, err)
+	assert.False(t, done, "nothing is skipped unless resuming")
+
+	cp.Resume = true
+	done, err = cp.completedOn(context.Background(), node, kubeadmJoinComponent)
+	require.NoError(t, err)
+	assert.True(t, done)
+
+	done, err = cp.completedOn(context.Background(), node, kubeadmInitComponent)
+	require.NoError(t, err)
+	assert.False(t, done)
+}
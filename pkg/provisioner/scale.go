@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provisioner
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// JoinWorkers provisions new worker nodes and joins them to the running
+// cluster whose first control-plane node is firstCP, using a join token
+// freshly minted on it.
+func (cp *ClusterProvisioner) JoinWorkers(ctx context.Context, firstCP NodeInfo, workers []NodeInfo) error {
+	if cp.err != nil {
+		return cp.err
+	}
+	if len(workers) == 0 {
+		return nil
+	}
+
+	cp.ControlPlaneEndpoint = cp.determineControlPlaneEndpoint(firstCP)
+
+	cp.log.Info("Provisioning base dependencies on %d new worker(s)...", len(workers))
+	if err := cp.provisionBaseOnAllNodes(ctx, workers); err != nil {
+		return fmt.Errorf("failed to provision base dependencies: %w", err)
+	}
+
+	provisioner, err := New(ctx, cp.log, cp.getKeyPathForNode(firstCP), cp.getUsernameForNode(firstCP), hostForNode(firstCP), cp.transportOptsForNode(firstCP)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", firstCP.Name, err)
+	}
+	err = cp.extractJoinInfo(provisioner)
+	_ = provisioner.Client.Close()
+	if err != nil {
+		return fmt.Errorf("failed to extract join info: %w", err)
+	}
+
+	for _, worker := range workers {
+		cp.log.Info("Joining worker node: %s", worker.Name)
+		if err := cp.joinWorker(ctx, worker); err != nil {
+			return fmt.Errorf("failed to join worker %s: %w", worker.Name, err)
+		}
+	}
+
+	cp.log.Info("Configuring new worker nodes...")
+	if err := cp.configureNodes(ctx, firstCP, workers); err != nil {
+		return fmt.Errorf("failed to configure nodes: %w", err)
+	}
+	return nil
+}
+
+// RemoveWorkers cordons and drains worker nodes and deletes them from the
+// cluster whose first control-plane node is firstCP, so that their
+// instances can be terminated. Nodes that never registered are skipped.
+func (cp *ClusterProvisioner) RemoveWorkers(ctx context.Context, firstCP NodeInfo, workers []NodeInfo) error {
+	if cp.err != nil {
+		return cp.err
+	}
+	if len(workers) == 0 {
+		return nil
+	}
+
+	script, err := removeNodesScript(workers)
+	if err != nil {
+		return err
+	}
+
+	provisioner, err := New(ctx, cp.log, cp.getKeyPathForNode(firstCP), cp.getUsernameForNode(firstCP), hostForNode(firstCP), cp.transportOptsForNode(firstCP)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", firstCP.Name, err)
+	}
+	defer provisioner.Client.Close() // nolint: errcheck
+
+	session, err := provisioner.Client.NewSession()
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+	defer func() { _ = session.Close() }()
+
+	cp.log.Info("Draining %d worker node(s)...", len(workers))
+	output, err := session.CombinedOutput(script)
+	if err != nil {
+		cp.log.Info("Node removal output: %s", string(output))
+		return fmt.Errorf("failed to remove nodes: %w", err)
+	}
+	cp.log.Info("Node removal output:\n%s", string(output))
+	return nil
+}
+
+// ReprovisionNode reruns the base provisioning of a single Kubernetes node
+// of the cluster, e.g. after a driver install wedged. The install scripts
+// skip what is already in place, so only the missing pieces are redone.
+func (cp *ClusterProvisioner) ReprovisionNode(ctx context.Context, node NodeInfo) error {
+	if cp.err != nil {
+		return cp.err
+	}
+	if node.Role == "etcd" {
+		return fmt.Errorf("node %s is an etcd node, only control-plane and worker nodes can be reprovisioned", node.Name)
+	}
+	return cp.provisionBase(ctx, node)
+}
+
+// removeNodesScript returns the script that cordons, drains and deletes
+// nodes, looked up by private IP as in configureNodes.
+func removeNodesScript(nodes []NodeInfo) (string, error) {
+	var script strings.Builder
+	script.WriteString("#!/bin/bash\nset -e\n")
+	script.WriteString("export KUBECONFIG=/etc/kubernetes/admin.conf\n\n")
+
+	for _, node := range nodes {
+		if net.ParseIP(node.PrivateIP) == nil {
+			return "", fmt.Errorf("invalid private IP for node %s: %q", node.Name, node.PrivateIP)
+		}
+		fmt.Fprintf(&script, "echo 'Removing node with IP %s...'\n", node.PrivateIP)
+		fmt.Fprintf(&script, "NODE=$(sudo -E kubectl get nodes -o wide --no-headers | grep -w '%s' | awk '{print $1}')\n", node.PrivateIP)
+		script.WriteString("if [ -n \"$NODE\" ]; then\n")
+		script.WriteString("  sudo -E kubectl cordon \"$NODE\"\n")
+		script.WriteString("  sudo -E kubectl drain \"$NODE\" --ignore-daemonsets --delete-emptydir-data --timeout=300s\n")
+		script.WriteString("  sudo -E kubectl delete node \"$NODE\"\n")
+		script.WriteString("fi\n\n")
+	}
+	return script.String(), nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+	"github.com/NVIDIA/holodeck/internal/logger"
+)
+
+func TestRemoveNodesScript(t *testing.T) {
+	script, err := removeNodesScript([]NodeInfo{
+		{Name: "w-1", PrivateIP: "10.0.0.11", Role: "worker"},
+		{Name: "w-2", PrivateIP: "10.0.0.12", Role: "worker"},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, script, "grep -w '10.0.0.11'")
+	assert.Contains(t, script, "grep -w '10.0.0.12'")
+	assert.Equal(t, 2, strings.Count(script, `kubectl cordon "$NODE"`))
+	assert.Equal(t, 2, strings.Count(script, `kubectl drain "$NODE" --ignore-daemonsets --delete-emptydir-data`))
+	assert.Equal(t, 2, strings.Count(script, `kubectl delete node "$NODE"`))
+	assert.Less(t, strings.Index(script, "cordon"), strings.Index(script, "drain"))
+	assert.Less(t, strings.Index(script, "drain"), strings.Index(script, "delete node"))
+}
+
+func TestRemoveNodesScript_InvalidIP(t *testing.T) {
+	_, err := removeNodesScript([]NodeInfo{{Name: "w-1", PrivateIP: "10.0.0.1; reboot"}})
+	assert.EqualError(t, err, `invalid private IP for node w-1: "10.0.0.1; reboot"`)
+}
+
+func TestScaleWorkers_NoWorkers(t *testing.T) {
+	env := &v1alpha1.Environment{Spec: v1alpha1.EnvironmentSpec{Cluster: &v1alpha1.ClusterSpec{}}}
+	cp := NewClusterProvisioner(logger.NewLogger(), "/tmp/key", "ubuntu", env)
+	firstCP := NodeInfo{Name: "cp-0", PrivateIP: "10.0.0.1", Role: "control-plane"}
+
+	assert.NoError(t, cp.JoinWorkers(context.Background(), firstCP, nil))
+	assert.NoError(t, cp.RemoveWorkers(context.Background(), firstCP, nil))
+
+	cp.err = errors.New("invalid configuration")
+	workers := []NodeInfo{{Name: "w-0", PrivateIP: "10.0.0.10", Role: "worker"}}
+	assert.EqualError(t, cp.JoinWorkers(context.Background(), firstCP, workers), "invalid configuration")
+	assert.EqualError(t, cp.RemoveWorkers(context.Background(), firstCP, workers), "invalid configuration")
+}
+
+func TestReprovisionNode_RejectsEtcd(t *testing.T) {
+	env := &v1alpha1.Environment{Spec: v1alpha1.EnvironmentSpec{Cluster: &v1alpha1.ClusterSpec{}}}
+	cp := NewClusterProvisioner(logger.NewLogger(), "/tmp/key", "ubuntu", env)
+
+	err := cp.ReprovisionNode(context.Background(), NodeInfo{Name: "etcd-0", PrivateIP: "10.0.0.5", Role: "etcd"})
+	assert.EqualError(t, err, "node etcd-0 is an etcd node, only control-plane and worker nodes can be reprovisioned")
+
+	cp.err = errors.New("invalid configuration")
+	err = cp.ReprovisionNode(context.Background(), NodeInfo{Name: "w-0", PrivateIP: "10.0.0.10", Role: "worker"})
+	assert.EqualError(t, err, "invalid configuration")
+}
@@ -186,7 +186,7 @@ func TestTransportFromSSHConfig_ConnectTimeout(t *testing.T) {
 // no-validation regression path fast (one failed dial to a dead port) instead
 // of the 20x1s default envelope.
 func TestNew_RejectsInvalidSSHConfig(t *testing.T) {
-	_, err := New(logger.NewLogger(), "/keys/target", "tester", "127.0.0.1:1",
+	_, err := New(context.Background(), logger.NewLogger(), "/keys/target", "tester", "127.0.0.1:1",
 		WithSSHConfig(&v1alpha1.SSHConfig{KnownHostsPolicy: "bogus", MaxRetries: 1}))
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "knownHostsPolicy",
@@ -234,7 +234,7 @@ func TestNew_HandshakeTimeout(t *testing.T) {
 	// all 20 retries (~5 min), so we observe progress from the outside.
 	errCh := make(chan error, 1)
 	go func() {
-		_, err := New(log, keyPath, "testuser", "black-hole-host", WithTransport(transport))
+		_, err := New(context.Background(), log, keyPath, "testuser", "black-hole-host", WithTransport(transport))
 		errCh <- err
 	}()
 
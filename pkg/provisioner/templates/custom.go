@@ -47,6 +47,12 @@ func sanitizeName(s string) string {
 	return shellSafeNamePattern.ReplaceAllString(s, "_")
 }
 
+// CustomTemplateComponent returns the component name under which a custom
+// template records its completion in the host state directory.
+func CustomTemplateComponent(name string) string {
+	return "custom-" + sanitizeName(name)
+}
+
 const maxURLResponseBytes = 10 * 1024 * 1024 // 10MB
 
 // sha256Hex computes the hex-encoded SHA256 hash of data.
@@ -183,6 +189,7 @@ func (ct *CustomTemplateExecutor) Execute(tpl *bytes.Buffer, _ v1alpha1.Environm
 	}
 
 	fmt.Fprintf(tpl, `holodeck_log "INFO" "custom" "[CUSTOM] Template '%s' completed"`+"\n", safeName)
+	fmt.Fprintf(tpl, `holodeck_mark_installed "%s" "%s"`+"\n", CustomTemplateComponent(ct.Name), safePhase)
 
 	return nil
 }
@@ -177,6 +177,9 @@ func TestCustomTemplateExecute(t *testing.T) {
 	if !strings.Contains(out, "echo hello") {
 		t.Error("output missing script content")
 	}
+	if !strings.Contains(out, `holodeck_mark_installed "custom-test-execute" "post-install"`) {
+		t.Errorf("output missing completion state: %s", out)
+	}
 }
 
 func TestCustomTemplateExecute_ContinueOnError(t *testing.T) {
@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/NVIDIA/holodeck/api/holodeck/v1alpha1"
+)
+
+// EtcdTemplate is the bash script for bootstrapping a member of an external
+// etcd cluster. Members serve clients and peers over mutual TLS using a
+// single certificate signed by the cluster's etcd CA. Each member also keeps
+// the CA key, so that later runs issue certificates from the same CA.
+const EtcdTemplate = `
+COMPONENT="etcd"
+ETCD_VERSION="{{.Version}}"
+ETCD_NAME="{{.Name}}"
+ETCD_IP="{{.IP}}"
+INITIAL_CLUSTER="{{.InitialCluster}}"
+ARCH="$(dpkg --print-architecture 2>/dev/null || (uname -m | sed 's/x86_64/amd64/;s/aarch64/arm64/'))"
+ETCD_PKI="/etc/etcd/pki"
+
+holodeck_progress "$COMPONENT" 1 4 "Checking existing installation"
+
+if systemctl is-active --quiet etcd; then
+    holodeck_log "INFO" "$COMPONENT" "etcd already running"
+    holodeck_mark_installed "$COMPONENT" "$ETCD_VERSION"
+    exit 0
+fi
+
+holodeck_progress "$COMPONENT" 2 4 "Installing etcd ${ETCD_VERSION}"
+
+if [[ ! -x /usr/local/bin/etcd ]]; then
+    TMP_DIR=$(mktemp -d)
+    holodeck_retry 3 "$COMPONENT" curl -fsSL -o "${TMP_DIR}/etcd.tar.gz" \
+        "https://github.com/etcd-io/etcd/releases/download/${ETCD_VERSION}/etcd-${ETCD_VERSION}-linux-${ARCH}.tar.gz"
+    tar -C "$TMP_DIR" -xzf "${TMP_DIR}/etcd.tar.gz" --strip-components=1
+    sudo install -m 0755 "${TMP_DIR}/etcd" "${TMP_DIR}/etcdctl" /usr/local/bin/
+    rm -rf "$TMP_DIR"
+else
+    holodeck_log "INFO" "$COMPONENT" "etcd binaries already installed"
+fi
+
+holodeck_progress "$COMPONENT" 3 4 "Writing certificates"
+
+sudo mkdir -p "$ETCD_PKI" /var/lib/etcd
+sudo chmod 700 /var/lib/etcd
+sudo tee "${ETCD_PKI}/ca.crt" > /dev/null <<'EOF'
+{{.CACert}}EOF
+sudo tee "${ETCD_PKI}/ca.key" > /dev/null <<'EOF'
+{{.CAKey}}EOF
+sudo tee "${ETCD_PKI}/member.crt" > /dev/null <<'EOF'
+{{.Cert}}EOF
+sudo tee "${ETCD_PKI}/member.key" > /dev/null <<'EOF'
+{{.Key}}EOF
+sudo chmod 600 "${ETCD_PKI}/ca.key" "${ETCD_PKI}/member.key"
+
+holodeck_progress "$COMPONENT" 4 4 "Starting etcd"
+
+sudo tee /etc/systemd/system/etcd.service > /dev/null <<EOF
+[Unit]
+Description=etcd
+Documentation=https://etcd.io/docs/
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+Type=notify
+ExecStart=/usr/local/bin/etcd \\
+  --name=${ETCD_NAME} \\
+  --data-dir=/var/lib/etcd \\
+  --listen-client-urls=https://${ETCD_IP}:2379,https://127.0.0.1:2379 \\
+  --advertise-client-urls=https://${ETCD_IP}:2379 \\
+  --listen-peer-urls=https://${ETCD_IP}:2380 \\
+  --initial-advertise-peer-urls=https://${ETCD_IP}:2380 \\
+  --initial-cluster=${INITIAL_CLUSTER} \\
+  --initial-cluster-state=new \\
+  --initial-cluster-token=holodeck-etcd \\
+  --client-cert-auth=true \\
+  --trusted-ca-file=${ETCD_PKI}/ca.crt \\
+  --cert-file=${ETCD_PKI}/member.crt \\
+  --key-file=${ETCD_PKI}/member.key \\
+  --peer-client-cert-auth=true \\
+  --peer-trusted-ca-file=${ETCD_PKI}/ca.crt \\
+  --peer-cert-file=${ETCD_PKI}/member.crt \\
+  --peer-key-file=${ETCD_PKI}/member.key
+Restart=on-failure
+RestartSec=5
+LimitNOFILE=65536
+
+[Install]
+WantedBy=multi-user.target
+EOF
+
+sudo systemctl daemon-reload
+sudo systemctl enable etcd
+# A new member only reports ready once the cluster has quorum, so do not
+# block on it: the other members are being started concurrently.
+sudo systemctl start --no-block etcd
+
+holodeck_log "INFO" "$COMPONENT" "Waiting for etcd cluster health"
+holodeck_retry 30 "$COMPONENT" sudo /usr/local/bin/etcdctl \
+    --endpoints="https://127.0.0.1:2379" \
+    --cacert="${ETCD_PKI}/ca.crt" \
+    --cert="${ETCD_PKI}/member.crt" \
+    --key="${ETCD_PKI}/member.key" \
+    endpoint health
+
+holodeck_mark_installed "$COMPONENT" "$ETCD_VERSION"
+holodeck_log "INFO" "$COMPONENT" "etcd member ${ETCD_NAME} started successfully"
+`
+
+var etcdTmpl = template.Must(template.New("etcd").Parse(EtcdTemplate))
+
+const defaultEtcdVersion = "v3.5.21"
+
+// EtcdMember identifies a member of an external etcd cluster.
+type EtcdMember struct {
+	Name string
+	IP   string
+}
+
+// PeerURL returns the URL other members use to reach the member.
+func (m EtcdMember) PeerURL() string {
+	return fmt.Sprintf("https://%s:2380", m.IP)
+}
+
+// ClientURL returns the URL clients such as the API server use to reach
+// the member.
+func (m EtcdMember) ClientURL() string {
+	return fmt.Sprintf("https://%s:2379", m.IP)
+}
+
+// EtcdConfig holds configuration for bootstrapping an etcd member
+type EtcdConfig struct {
+	Environment *v1alpha1.Environment
+	// Member is the member being bootstrapped
+	Member EtcdMember
+	// Members lists every member of the cluster, including Member
+	Members []EtcdMember
+	// CACert and CAKey are the PEM-encoded etcd CA certificate and private
+	// key, and Cert and Key the member's certificate and private key
+	CACert string
+	CAKey  string
+	Cert   string
+	Key    string
+}
+
+// EtcdVersion returns the etcd release to install for env.
+func EtcdVersion(env *v1alpha1.Environment) string {
+	if env.Spec.Cluster == nil || env.Spec.Cluster.Etcd == nil || env.Spec.Cluster.Etcd.Version == "" {
+		return defaultEtcdVersion
+	}
+	version := env.Spec.Cluster.Etcd.Version
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	return version
+}
+
+// Execute generates the etcd bootstrap script
+func (c *EtcdConfig) Execute(tpl *bytes.Buffer) error {
+	initialCluster := make([]string, 0, len(c.Members))
+	for _, m := range c.Members {
+		initialCluster = append(initialCluster, m.Name+"="+m.PeerURL())
+	}
+
+	data := struct {
+		Version        string
+		Name           string
+		IP             string
+		InitialCluster string
+		CACert         string
+		CAKey          string
+		Cert           string
+		Key            string
+	}{
+		Version:        EtcdVersion(c.Environment),
+		Name:           c.Member.Name,
+		IP:             c.Member.IP,
+		InitialCluster: strings.Join(initialCluster, ","),
+		CACert:         c.CACert,
+		CAKey:          c.CAKey,
+		Cert:           c.Cert,
+		Key:            c.Key,
+	}
+
+	if err := etcdTmpl.Execute(tpl, data); err != nil {
+		return fmt.Errorf("failed to execute etcd template: %w", err)
+	}
+	return nil
+}
@@ -153,6 +153,53 @@ fi
 
 # Initialize cluster
 if [[ ! -f /etc/kubernetes/admin.conf ]]; then
+{{- if .ExternalEtcd }}
+    # External etcd: install the API server's etcd client certificate and
+    # point kubeadm at the etcd members. etcd.external can only be set in a
+    # kubeadm configuration file, which replaces the equivalent flags.
+    holodeck_log "INFO" "$COMPONENT" "Using external etcd: {{.EtcdEndpoints}}"
+    sudo mkdir -p /etc/kubernetes/pki/etcd
+    sudo tee /etc/kubernetes/pki/etcd/ca.crt > /dev/null <<'EOF'
+{{.ExternalEtcd.CACert}}EOF
+    sudo tee /etc/kubernetes/pki/apiserver-etcd-client.crt > /dev/null <<'EOF'
+{{.ExternalEtcd.ClientCert}}EOF
+    sudo tee /etc/kubernetes/pki/apiserver-etcd-client.key > /dev/null <<'EOF'
+{{.ExternalEtcd.ClientKey}}EOF
+    sudo chmod 600 /etc/kubernetes/pki/apiserver-etcd-client.key
+
+    sudo tee /etc/kubernetes/kubeadm-init.yaml > /dev/null <<EOF
+apiVersion: kubeadm.k8s.io/v1beta4
+kind: InitConfiguration
+localAPIEndpoint:
+  advertiseAddress: "${NODE_PRIVATE_IP}"
+---
+apiVersion: kubeadm.k8s.io/v1beta4
+kind: ClusterConfiguration
+kubernetesVersion: "${K8S_VERSION}"
+controlPlaneEndpoint: "${INIT_ENDPOINT}:6443"
+networking:
+  podSubnet: "192.168.0.0/16"
+apiServer:
+  certSANs:
+    - "${CONTROL_PLANE_ENDPOINT}"
+    - "${NODE_PRIVATE_IP}"
+    - "${INIT_ENDPOINT}"
+etcd:
+  external:
+    endpoints:
+{{- range .ExternalEtcd.Endpoints }}
+      - "{{ . }}"
+{{- end }}
+    caFile: /etc/kubernetes/pki/etcd/ca.crt
+    certFile: /etc/kubernetes/pki/apiserver-etcd-client.crt
+    keyFile: /etc/kubernetes/pki/apiserver-etcd-client.key
+EOF
+
+    INIT_ARGS=(
+        --config=/etc/kubernetes/kubeadm-init.yaml
+        --ignore-preflight-errors=all
+    )
+{{- else }}
     INIT_ARGS=(
         --kubernetes-version="${K8S_VERSION}"
         --pod-network-cidr=192.168.0.0/16
@@ -161,6 +208,7 @@ if [[ ! -f /etc/kubernetes/admin.conf ]]; then
         --apiserver-cert-extra-sans="${CONTROL_PLANE_ENDPOINT},${NODE_PRIVATE_IP},${INIT_ENDPOINT}"
         --ignore-preflight-errors=all
     )
+{{- end }}
 
     # For HA, upload certs so other control-plane nodes can join
     if [[ "$IS_HA" == "true" ]]; then
@@ -394,6 +442,18 @@ type KubeadmInitConfig struct {
 	Environment          *v1alpha1.Environment
 	ControlPlaneEndpoint string
 	IsHA                 bool
+	// ExternalEtcd configures the API server to use dedicated etcd nodes;
+	// nil runs etcd stacked on the control-plane nodes
+	ExternalEtcd *ExternalEtcd
+}
+
+// ExternalEtcd holds the etcd client endpoints and the PEM-encoded
+// credentials the API server uses to reach an external etcd cluster
+type ExternalEtcd struct {
+	Endpoints  []string
+	CACert     string
+	ClientCert string
+	ClientKey  string
 }
 
 // Execute generates the kubeadm init script
@@ -407,10 +467,16 @@ func (c *KubeadmInitConfig) Execute(tpl *bytes.Buffer) error {
 		*Kubernetes
 		ControlPlaneEndpoint string
 		IsHA                 string
+		ExternalEtcd         *ExternalEtcd
+		EtcdEndpoints        string
 	}{
 		Kubernetes:           k,
 		ControlPlaneEndpoint: c.ControlPlaneEndpoint,
 		IsHA:                 fmt.Sprintf("%t", c.IsHA),
+		ExternalEtcd:         c.ExternalEtcd,
+	}
+	if c.ExternalEtcd != nil {
+		data.EtcdEndpoints = strings.Join(c.ExternalEtcd.Endpoints, ",")
 	}
 
 	if err := kubeadmInitTmpl.Execute(tpl, data); err != nil {
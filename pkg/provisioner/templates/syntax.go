@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// CheckSyntax parses script as bash without running any of its commands
+// and returns the first syntax error found, such as an unbalanced quote or
+// an unterminated block or here-document.
+func CheckSyntax(script []byte) error {
+	parser := syntax.NewParser(syntax.Variant(syntax.LangBash))
+	_, err := parser.Parse(bytes.NewReader(script), "")
+	var perr syntax.ParseError
+	switch {
+	case errors.As(err, &perr):
+		return fmt.Errorf("line %d: %s", perr.Pos.Line(), perr.Text)
+	case err != nil:
+		return fmt.Errorf("failed to check script syntax: %w", err)
+	}
+	return nil
+}
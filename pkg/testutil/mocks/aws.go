@@ -160,6 +160,10 @@ type MockEC2Client struct {
 	// Security Group Revoke operations
 	RevokeSecurityGroupIngressFunc func(ctx context.Context, params *ec2.RevokeSecurityGroupIngressInput, optFns ...func(*ec2.Options)) (*ec2.RevokeSecurityGroupIngressOutput, error)
 	RevokeSecurityGroupEgressFunc  func(ctx context.Context, params *ec2.RevokeSecurityGroupEgressInput, optFns ...func(*ec2.Options)) (*ec2.RevokeSecurityGroupEgressOutput, error)
+
+	// Region operations
+	DescribeRegionsFunc           func(ctx context.Context, params *ec2.DescribeRegionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error)
+	DescribeAvailabilityZonesFunc func(ctx context.Context, params *ec2.DescribeAvailabilityZonesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAvailabilityZonesOutput, error)
 }
 
 // VPC operations
@@ -363,6 +367,14 @@ func (m *MockEC2Client) TerminateInstances(ctx context.Context, params *ec2.Term
 	return &ec2.TerminateInstancesOutput{}, nil
 }
 
+func (m *MockEC2Client) StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	return &ec2.StopInstancesOutput{}, nil
+}
+
+func (m *MockEC2Client) StartInstances(ctx context.Context, params *ec2.StartInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	return &ec2.StartInstancesOutput{}, nil
+}
+
 func (m *MockEC2Client) DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
 	if m.DescribeInstancesFunc != nil {
 		return m.DescribeInstancesFunc(ctx, params, optFns...)
@@ -398,6 +410,10 @@ func (m *MockEC2Client) DescribeImages(ctx context.Context, params *ec2.Describe
 	}, nil
 }
 
+func (m *MockEC2Client) CreateImage(ctx context.Context, params *ec2.CreateImageInput, optFns ...func(*ec2.Options)) (*ec2.CreateImageOutput, error) {
+	return &ec2.CreateImageOutput{ImageId: strPtr("ami-mock-snapshot")}, nil
+}
+
 // Tagging and Network operations
 
 func (m *MockEC2Client) CreateTags(ctx context.Context, params *ec2.CreateTagsInput, optFns ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
@@ -468,6 +484,10 @@ func (m *MockEC2Client) ReleaseAddress(ctx context.Context, params *ec2.ReleaseA
 	return &ec2.ReleaseAddressOutput{}, nil
 }
 
+func (m *MockEC2Client) DescribeAddresses(ctx context.Context, params *ec2.DescribeAddressesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error) {
+	return &ec2.DescribeAddressesOutput{}, nil
+}
+
 // NAT Gateway operations
 
 func (m *MockEC2Client) CreateNatGateway(ctx context.Context, params *ec2.CreateNatGatewayInput, optFns ...func(*ec2.Options)) (*ec2.CreateNatGatewayOutput, error) {
@@ -488,6 +508,42 @@ func (m *MockEC2Client) ModifySubnetAttribute(ctx context.Context, params *ec2.M
 	return &ec2.ModifySubnetAttributeOutput{}, nil
 }
 
+// Placement Group operations
+
+func (m *MockEC2Client) CreatePlacementGroup(ctx context.Context, params *ec2.CreatePlacementGroupInput, optFns ...func(*ec2.Options)) (*ec2.CreatePlacementGroupOutput, error) {
+	return &ec2.CreatePlacementGroupOutput{}, nil
+}
+
+func (m *MockEC2Client) DeletePlacementGroup(ctx context.Context, params *ec2.DeletePlacementGroupInput, optFns ...func(*ec2.Options)) (*ec2.DeletePlacementGroupOutput, error) {
+	return &ec2.DeletePlacementGroupOutput{}, nil
+}
+
+func (m *MockEC2Client) DescribePlacementGroups(ctx context.Context, params *ec2.DescribePlacementGroupsInput, optFns ...func(*ec2.Options)) (*ec2.DescribePlacementGroupsOutput, error) {
+	return &ec2.DescribePlacementGroupsOutput{}, nil
+}
+
+// Capacity Reservation operations
+
+func (m *MockEC2Client) DescribeCapacityReservations(ctx context.Context, params *ec2.DescribeCapacityReservationsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeCapacityReservationsOutput, error) {
+	return &ec2.DescribeCapacityReservationsOutput{}, nil
+}
+
+// Region operations
+
+func (m *MockEC2Client) DescribeRegions(ctx context.Context, params *ec2.DescribeRegionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error) {
+	if m.DescribeRegionsFunc != nil {
+		return m.DescribeRegionsFunc(ctx, params, optFns...)
+	}
+	return &ec2.DescribeRegionsOutput{}, nil
+}
+
+func (m *MockEC2Client) DescribeAvailabilityZones(ctx context.Context, params *ec2.DescribeAvailabilityZonesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAvailabilityZonesOutput, error) {
+	if m.DescribeAvailabilityZonesFunc != nil {
+		return m.DescribeAvailabilityZonesFunc(ctx, params, optFns...)
+	}
+	return &ec2.DescribeAvailabilityZonesOutput{}, nil
+}
+
 // Security Group Revoke operations
 
 func (m *MockEC2Client) RevokeSecurityGroupIngress(ctx context.Context, params *ec2.RevokeSecurityGroupIngressInput, optFns ...func(*ec2.Options)) (*ec2.RevokeSecurityGroupIngressOutput, error) {
@@ -17,6 +17,7 @@
 package utils
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -115,11 +116,11 @@ func RewriteKubeConfigServer(path string, serverURL string) error {
 }
 
 // GetKubeConfig downloads the kubeconfig file from the remote host
-func GetKubeConfig(log *logger.FunLogger, cfg *v1alpha1.Environment, hostUrl string, dest string) error {
+func GetKubeConfig(ctx context.Context, log *logger.FunLogger, cfg *v1alpha1.Environment, hostUrl string, dest string) error {
 	remoteFilePath := "${HOME}/.kube/config"
 
 	// Create a new ssh session
-	p, err := provisioner.New(log, cfg.Spec.PrivateKey, cfg.Spec.Username, hostUrl,
+	p, err := provisioner.New(ctx, log, cfg.Spec.PrivateKey, cfg.Spec.Username, hostUrl,
 		provisioner.WithSSHConfig(cfg.Spec.SSHConfig))
 	if err != nil {
 		return err
@@ -18,6 +18,7 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -336,7 +337,7 @@ func TestGetKubeConfig_StrictPolicyReachesDialer(t *testing.T) {
 		},
 	}
 
-	err := GetKubeConfig(logger.NewLogger(), cfg, srv.Addr(), filepath.Join(t.TempDir(), "kubeconfig"))
+	err := GetKubeConfig(context.Background(), logger.NewLogger(), cfg, srv.Addr(), filepath.Join(t.TempDir(), "kubeconfig"))
 	require.Error(t, err, "strict policy against an unknown host must reject the dial")
 	assert.Contains(t, err.Error(), "strict",
 		"the strict host-key policy must reach the Dialer through the production New call site")
@@ -116,7 +116,7 @@ var _ = DescribeTable("AWS Cluster E2E",
 		// Ensure cluster cleanup even if test fails
 		DeferCleanup(func() {
 			GinkgoWriter.Println("Cleaning up cluster resources...")
-			Expect(state.provider.Delete()).To(Succeed(), "Failed to delete cluster")
+			Expect(state.provider.Delete(context.Background())).To(Succeed(), "Failed to delete cluster")
 		})
 
 		state.opts.cfg.Spec.PrivateKey = sshKey
@@ -125,7 +125,7 @@ var _ = DescribeTable("AWS Cluster E2E",
 		if state.opts.cfg.Spec.Cluster == nil || state.opts.cfg.Spec.Cluster.ControlPlane.OS == "" {
 			state.opts.cfg.Spec.Username = "ubuntu"
 		}
-		Expect(state.provider.Create()).To(Succeed(), "Failed to create cluster infrastructure")
+		Expect(state.provider.Create(context.Background())).To(Succeed(), "Failed to create cluster infrastructure")
 
 		By("Verifying cluster status in cache")
 		env, err := jyaml.UnmarshalFromFile[v1alpha1.Environment](state.opts.cachefile)
@@ -180,7 +180,7 @@ var _ = DescribeTable("AWS Cluster E2E",
 		)
 
 		// Provision the cluster
-		Expect(cp.ProvisionCluster(nodes)).To(Succeed(), "Failed to provision cluster")
+		Expect(cp.ProvisionCluster(context.Background(), nodes)).To(Succeed(), "Failed to provision cluster")
 
 		By("Verifying cluster health")
 		// Get first control-plane host for health check — prefer PublicIP,
@@ -199,7 +199,7 @@ var _ = DescribeTable("AWS Cluster E2E",
 		Expect(firstCPHost).NotTo(BeEmpty(), "First control plane host should not be empty")
 
 		// Check cluster health
-		health, err := cp.GetClusterHealth(firstCPHost)
+		health, err := cp.GetClusterHealth(context.Background(), firstCPHost)
 		Expect(err).NotTo(HaveOccurred(), "Failed to get cluster health")
 		Expect(health).NotTo(BeNil(), "Cluster health should not be nil")
 		Expect(health.APIServerStatus).To(Equal("Running"), "API server should be running")
@@ -100,7 +100,7 @@ var _ = DescribeTable("AWS Environment E2E",
 
 		// --- Test logic (copied from original) ---
 		By("Configuration Validation")
-		Expect(state.provider.DryRun()).To(Succeed(), "Provider validation failed")
+		Expect(state.provider.DryRun(context.Background())).To(Succeed(), "Provider validation failed")
 		Expect(provisioner.Dryrun(state.log, state.opts.cfg)).To(Succeed(), "Provisioner validation failed")
 		Expect(state.opts.cfg.Spec.Instance.Type).NotTo(BeEmpty(), "Instance type should not be empty")
 		Expect(state.opts.cfg.Spec.Instance.Region).NotTo(BeEmpty(), "Region should not be empty")
@@ -108,7 +108,7 @@ var _ = DescribeTable("AWS Environment E2E",
 		By("Environment Management")
 		// Ensure environment cleanup even if test fails
 		DeferCleanup(func() {
-			Expect(state.provider.Delete()).To(Succeed(), "Failed to delete environment")
+			Expect(state.provider.Delete(context.Background())).To(Succeed(), "Failed to delete environment")
 		})
 
 		state.opts.cfg.Spec.PrivateKey = sshKey
@@ -118,7 +118,7 @@ var _ = DescribeTable("AWS Environment E2E",
 			//nolint:staticcheck // Auth is embedded but explicit access is clearer
 			state.opts.cfg.Spec.Auth.Username = "ubuntu"
 		}
-		Expect(state.provider.Create()).To(Succeed(), "Failed to create environment")
+		Expect(state.provider.Create(context.Background())).To(Succeed(), "Failed to create environment")
 		Expect(state.opts.cfg.Name).NotTo(BeEmpty(), "Environment name should not be empty")
 
 		By("Provisioning the environment")
@@ -138,7 +138,7 @@ var _ = DescribeTable("AWS Environment E2E",
 		if sshUsername == "" {
 			sshUsername = state.opts.cfg.Spec.Username
 		}
-		p, err := provisioner.New(state.log, state.opts.cfg.Spec.PrivateKey, sshUsername, hostUrl)
+		p, err := provisioner.New(context.Background(), state.log, state.opts.cfg.Spec.PrivateKey, sshUsername, hostUrl)
 		Expect(err).NotTo(HaveOccurred(), "Failed to create provisioner")
 		defer func() {
 			if p.Client != nil {
@@ -152,7 +152,7 @@ var _ = DescribeTable("AWS Environment E2E",
 				p.Client = nil
 			}
 		}()
-		_, provisionErr := p.Run(env)
+		_, provisionErr := p.Run(context.Background(), env)
 		Expect(provisionErr).NotTo(HaveOccurred(), "Failed to provision environment")
 
 		By("Kubernetes Configuration")
@@ -17,6 +17,7 @@
 package e2e
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -71,8 +72,8 @@ var _ = Describe("AWS Mock E2E", Label("mock"), func() {
 		func(cfgFile string, wantInstances int, wantNLB bool, wantSubnets int, wantRouteTables int, wantSecurityGroups int) {
 			p, fake, _, cacheFile := newMockProvider(cfgFile)
 
-			Expect(p.DryRun()).To(Succeed())
-			Expect(p.Create()).To(Succeed())
+			Expect(p.DryRun(context.Background())).To(Succeed())
+			Expect(p.Create(context.Background())).To(Succeed())
 
 			counts := fake.Store.ResourceCounts()
 			Expect(counts["instances"]).To(Equal(wantInstances),
@@ -97,7 +98,7 @@ var _ = Describe("AWS Mock E2E", Label("mock"), func() {
 			_, err := os.Stat(cacheFile)
 			Expect(err).NotTo(HaveOccurred(), "Create must write the cache file")
 
-			Expect(p.Delete()).To(Succeed())
+			Expect(p.Delete(context.Background())).To(Succeed())
 			Expect(fake.Store.Empty()).To(BeTrue(),
 				"orphaned resources after delete: %v", fake.Store.ResourceCounts())
 		},
@@ -117,7 +118,7 @@ var _ = Describe("AWS Mock E2E", Label("mock"), func() {
 			p, fake, _, _ := newMockProvider("test_aws.yml")
 			fake.Store.FailNext(failMethod, fmt.Errorf("injected: %s down", failMethod))
 
-			Expect(p.Create()).NotTo(Succeed())
+			Expect(p.Create(context.Background())).NotTo(Succeed())
 			Expect(fake.Store.Empty()).To(BeTrue(),
 				"cleanupStack left orphans after %s failure: %v", failMethod, fake.Store.ResourceCounts())
 		},
@@ -134,20 +135,20 @@ var _ = Describe("AWS Mock E2E", Label("mock"), func() {
 
 		// cluster.go has no in-flight rollback stack, so a mid-create failure
 		// leaves partial resources behind (documented behavior).
-		Expect(p.Create()).NotTo(Succeed())
+		Expect(p.Create(context.Background())).NotTo(Succeed())
 		Expect(fake.Store.Empty()).To(BeFalse(),
 			"partial cluster resources should remain before Delete")
 
-		Expect(p.Delete()).To(Succeed())
+		Expect(p.Delete(context.Background())).To(Succeed())
 		Expect(fake.Store.Empty()).To(BeTrue(),
 			"Delete must reap partially-created cluster: %v", fake.Store.ResourceCounts())
 	})
 
 	It("Delete is idempotent", func() {
 		p, fake, _, _ := newMockProvider("test_aws.yml")
-		Expect(p.Create()).To(Succeed())
-		Expect(p.Delete()).To(Succeed())
-		Expect(p.Delete()).To(Succeed(), "second delete must tolerate NotFound errors")
+		Expect(p.Create(context.Background())).To(Succeed())
+		Expect(p.Delete(context.Background())).To(Succeed())
+		Expect(p.Delete(context.Background())).To(Succeed(), "second delete must tolerate NotFound errors")
 		Expect(fake.Store.Empty()).To(BeTrue())
 	})
 })
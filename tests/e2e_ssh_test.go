@@ -17,6 +17,7 @@
 package e2e
 
 import (
+	"context"
 	"crypto/ed25519"
 	"crypto/rand"
 	"encoding/pem"
@@ -235,7 +236,7 @@ func waitForSSH(hostURL, keyPath string, log *logger.FunLogger) {
 			return err
 		}
 		_ = conn.Close()
-		client, err := common.ConnectSSH(log, keyPath, sshUser, hostURL)
+		client, err := common.ConnectSSH(context.Background(), log, keyPath, sshUser, hostURL)
 		if err != nil {
 			return err
 		}
@@ -271,16 +272,16 @@ var _ = Describe("Real SSH ProviderSSH E2E", Label("real-ssh"), func() {
 		// marker template. Run executes it on the container, writing
 		// /tmp/holodeck-marker. WithSSHConfig certifies the wiring path
 		// end-to-end (fixture sshConfig -> New -> Dialer/transport).
-		p, err := provisioner.New(log, cfg.Spec.PrivateKey, cfg.Spec.Username, cfg.Spec.HostUrl,
+		p, err := provisioner.New(context.Background(), log, cfg.Spec.PrivateKey, cfg.Spec.Username, cfg.Spec.HostUrl,
 			provisioner.WithSSHConfig(cfg.Spec.SSHConfig))
 		Expect(err).NotTo(HaveOccurred(), "provisioner.New failed to connect")
 		DeferCleanup(func() { _ = p.Close() })
 
-		_, err = p.Run(cfg)
+		_, err = p.Run(context.Background(), cfg)
 		Expect(err).NotTo(HaveOccurred(), "provisioning run failed")
 
 		// Read the marker back through the holodeck ssh exec path.
-		client, err := common.ConnectSSH(log, keyPath, sshUser, hostURL)
+		client, err := common.ConnectSSH(context.Background(), log, keyPath, sshUser, hostURL)
 		Expect(err).NotTo(HaveOccurred(), "ConnectSSH read-back failed")
 		DeferCleanup(func() { _ = client.Close() })
 